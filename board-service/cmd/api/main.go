@@ -6,7 +6,15 @@ import (
 	"board-service/internal/database"
 	"board-service/internal/middleware"
 	"board-service/pkg/logger"
+	"context"
+	"errors"
+	"net/http"
 	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+	_ "time/tzdata" // Project time zones must resolve in images without zoneinfo
 
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -36,6 +44,12 @@ import (
 // @name Authorization
 // @description Type "Bearer" followed by a space and JWT token.
 
+// shutdownTimeout bounds how long in-flight requests may take to finish
+const shutdownTimeout = 30 * time.Second
+
+// workerShutdownTimeout bounds how long background workers may take to finish their current batch
+const workerShutdownTimeout = 30 * time.Second
+
 func main() {
 	// 1. Load configuration
 	cfg, err := config.Load()
@@ -73,36 +87,90 @@ func main() {
 		log.Fatal("Failed to initialize application", zap.Error(err))
 	}
 
-	// 6. Configure Gin mode
+	// 6. Start background workers (stopped on shutdown)
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+	for _, run := range []func(context.Context){
+		app.OutboxRelay.Run,
+		app.WebhookWorker.Run,
+		app.TrashRetentionJob.Run,
+		app.RecurrenceScheduler.Run,
+		app.ReminderScheduler.Run,
+	} {
+		workers.Add(1)
+		go func(run func(context.Context)) {
+			defer workers.Done()
+			run(workerCtx)
+		}(run)
+	}
+
+	// 7. Configure Gin mode
 	if cfg.Server.Env == "prod" {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
-	// 7. Create router and register middleware
+	// 8. Create router and register middleware
 	r := gin.New()
 	r.Use(middleware.RequestIDMiddleware())
 	r.Use(middleware.LoggerMiddleware(log))
 	r.Use(middleware.RecoveryMiddleware(log))
 	r.Use(middleware.CORSMiddleware(cfg.CORS.Origins))
 
-	// 8. Register Swagger (development only)
+	// 9. Register Swagger (development only)
 	if cfg.Server.Env == "dev" {
 		r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 		log.Info("Swagger UI enabled", zap.String("url", "http://localhost:"+cfg.Server.Port+"/swagger/index.html"))
 	}
 
-	// 9. Register Prometheus metrics endpoint
+	// 10. Register Prometheus metrics endpoint
 	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
-	// 10. Register all routes through the application
+	// 11. Register all routes through the application
 	app.RegisterRoutes(r, cfg)
 
-	// 11. Start server
+	// 12. Start server
 	addr := ":" + cfg.Server.Port
+	srv := &http.Server{Addr: addr, Handler: r}
+	// Event streams stay open until the client leaves; end them so Shutdown does not wait for them
+	srv.RegisterOnShutdown(app.ProjectEventHandler.CloseStreams)
 	log.Info("Server starting", zap.String("address", addr))
 
-	if err := r.Run(addr); err != nil {
-		log.Fatal("Server failed to start", zap.Error(err))
-		os.Exit(1)
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal("Server failed to start", zap.Error(err))
+			os.Exit(1)
+		}
+	}()
+
+	// 13. Graceful shutdown on SIGINT/SIGTERM
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	log.Info("Shutting down server")
+
+	// Stop the workers first so no new batch is claimed while requests drain
+	stopWorkers()
+	workersDone := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(workersDone)
+	}()
+	workerTimeout := time.NewTimer(workerShutdownTimeout)
+	defer workerTimeout.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Error("Server forced to shutdown", zap.Error(err))
 	}
+
+	select {
+	case <-workersDone:
+		log.Info("Background workers stopped")
+	case <-workerTimeout.C:
+		log.Warn("Timed out waiting for background workers to stop")
+	}
+
+	log.Info("Server exited")
 }
@@ -7,10 +7,18 @@ import (
 	"board-service/internal/cache"
 	"board-service/internal/client"
 	"board-service/internal/config"
+	"board-service/internal/event"
 	"board-service/internal/handler"
 	"board-service/internal/middleware"
+	"board-service/internal/outbox"
+	"board-service/internal/recurrence"
+	"board-service/internal/reminder"
 	"board-service/internal/repository"
 	"board-service/internal/service"
+	"board-service/internal/storage"
+	"board-service/internal/trash"
+	"board-service/internal/webhook"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/wire"
@@ -32,6 +40,22 @@ var repositorySet = wire.NewSet(
 	repository.NewFieldOptionRepository,
 	repository.NewBoardOrderRepository,
 	repository.NewViewRepository,
+	repository.NewBoardActivityRepository,
+	repository.NewWebhookRepository,
+	repository.NewTrashRepository,
+	repository.NewNotificationRepository,
+	repository.NewBoardRelationRepository,
+	repository.NewChecklistRepository,
+	repository.NewAttachmentRepository,
+	repository.NewWorkLogRepository,
+	repository.NewSprintRepository,
+	repository.NewStageChangeRepository,
+	repository.NewMilestoneRepository,
+	repository.NewProjectTemplateRepository,
+	repository.NewBoardTemplateRepository,
+	repository.NewBoardRecurrenceRepository,
+	repository.NewReminderRepository,
+	repository.NewSearchRepository,
 )
 
 // cacheSet은 모든 cache providers를 포함합니다
@@ -41,6 +65,45 @@ var cacheSet = wire.NewSet(
 	cache.NewFieldCache,
 )
 
+// eventSet은 실시간 이벤트 providers를 포함합니다
+// 서비스 계층은 트랜잭션 안에서 outbox에 이벤트를 기록하고, outbox Relay가 Redis Stream,
+// RedisBroker(SSE 스트림), webhook Dispatcher로 발행합니다
+var eventSet = wire.NewSet(
+	event.NewRedisBroker,
+	wire.Bind(new(event.Subscriber), new(*event.RedisBroker)),
+	provideOutboxSink,
+	provideOutboxRelay,
+)
+
+// webhookSet은 Webhook 전송 providers를 포함합니다
+var webhookSet = wire.NewSet(
+	webhook.NewDispatcher,
+	provideWebhookWorker,
+)
+
+// trashSet은 휴지통 보관 기간 관리 providers를 포함합니다
+var trashSet = wire.NewSet(
+	provideTrashRetention,
+	provideTrashRetentionJob,
+)
+
+// recurrenceSet은 반복 보드 생성 스케줄러 providers를 포함합니다
+var recurrenceSet = wire.NewSet(
+	provideRecurrenceScheduler,
+)
+
+// reminderSet은 마감일 알림 스케줄러와 발송 Notifier providers를 포함합니다
+var reminderSet = wire.NewSet(
+	provideReminderConfig,
+	provideReminderNotifier,
+	provideReminderScheduler,
+)
+
+// storageSet은 첨부 파일 저장소 providers를 포함합니다
+var storageSet = wire.NewSet(
+	provideAttachmentStorage,
+)
+
 // clientSet은 모든 외부 service client providers를 포함합니다
 var clientSet = wire.NewSet(
 	provideUserClient,
@@ -48,12 +111,30 @@ var clientSet = wire.NewSet(
 
 // serviceSet은 모든 service providers를 포함합니다
 var serviceSet = wire.NewSet(
+	provideProjectDeletionMode,
+	provideCommentThreadDepth,
+	provideAttachmentPolicy,
 	service.NewBoardService,
 	service.NewProjectService,
 	service.NewCommentService,
 	service.NewFieldService,
 	service.NewFieldValueService,
 	service.NewViewService,
+	service.NewBoardActivityService,
+	service.NewProjectEventService,
+	service.NewWebhookService,
+	service.NewTrashService,
+	service.NewNotificationService,
+	service.NewBoardRelationService,
+	service.NewChecklistService,
+	service.NewAttachmentService,
+	service.NewTimeTrackingService,
+	service.NewSprintService,
+	service.NewAnalyticsService,
+	service.NewMilestoneService,
+	service.NewBoardTemplateService,
+	service.NewReminderService,
+	service.NewSearchService,
 )
 
 // handlerSet은 모든 handler providers를 포함합니다
@@ -64,6 +145,20 @@ var handlerSet = wire.NewSet(
 	handler.NewCommentHandler,
 	handler.NewFieldHandler,
 	handler.NewViewHandler,
+	handler.NewBoardActivityHandler,
+	handler.NewProjectEventHandler,
+	handler.NewWebhookHandler,
+	handler.NewTrashHandler,
+	handler.NewNotificationHandler,
+	handler.NewBoardRelationHandler,
+	handler.NewChecklistHandler,
+	handler.NewAttachmentHandler,
+	handler.NewTimeTrackingHandler,
+	handler.NewSprintHandler,
+	handler.NewAnalyticsHandler,
+	handler.NewMilestoneHandler,
+	handler.NewBoardTemplateHandler,
+	handler.NewSearchHandler,
 )
 
 // ==================== Provider Functions ====================
@@ -73,6 +168,116 @@ func provideUserClient(cfg *config.Config) client.UserClient {
 	return client.NewUserClient(cfg.UserService.URL)
 }
 
+// provideOutboxSink는 outbox Relay가 발행할 대상을 구성합니다
+// Redis Stream(내구성 있는 소비자용), Redis pub/sub(SSE 스트림), Webhook으로 함께 발행합니다
+func provideOutboxSink(cfg *config.Config, rdb *redis.Client, broker *event.RedisBroker, dispatcher *webhook.Dispatcher) outbox.Sink {
+	return event.NewMultiPublisher(
+		outbox.NewRedisStreamSink(rdb, cfg.Outbox.Stream, cfg.Outbox.StreamMaxLen),
+		broker,
+		dispatcher,
+	)
+}
+
+// provideOutboxRelay는 설정값을 반영한 outbox Relay를 생성합니다
+func provideOutboxRelay(db *gorm.DB, sink outbox.Sink, cfg *config.Config, log *zap.Logger) *outbox.Relay {
+	relayConfig := outbox.DefaultRelayConfig()
+	if cfg.Outbox.PollIntervalMs > 0 {
+		relayConfig.PollInterval = time.Duration(cfg.Outbox.PollIntervalMs) * time.Millisecond
+	}
+	return outbox.NewRelay(db, sink, relayConfig, log)
+}
+
+// provideProjectDeletionMode는 설정된 프로젝트 삭제 방식을 반환합니다
+func provideProjectDeletionMode(cfg *config.Config) service.ProjectDeletionMode {
+	return service.ProjectDeletionMode(cfg.Project.DeletionMode)
+}
+
+// provideCommentThreadDepth는 설정된 댓글 답글 단계 수를 반환합니다
+func provideCommentThreadDepth(cfg *config.Config) service.CommentThreadDepth {
+	return service.CommentThreadDepth(cfg.Comment.MaxThreadDepth)
+}
+
+// provideAttachmentStorage는 설정된 첨부 파일 저장소(local 또는 S3 호환)를 생성합니다
+func provideAttachmentStorage(cfg *config.Config) (storage.Storage, error) {
+	if cfg.Attachment.Storage == "s3" {
+		return storage.NewS3Storage(storage.S3Config{
+			Endpoint:  cfg.S3.Endpoint,
+			Region:    cfg.S3.Region,
+			Bucket:    cfg.S3.Bucket,
+			AccessKey: cfg.S3.AccessKey,
+			SecretKey: cfg.S3.SecretKey,
+		})
+	}
+	return storage.NewLocalStorage(cfg.Attachment.LocalDir)
+}
+
+// provideAttachmentPolicy는 설정된 첨부 파일 크기/형식 제한과 다운로드 링크 서명 방식을 반환합니다
+func provideAttachmentPolicy(cfg *config.Config) service.AttachmentPolicy {
+	return service.AttachmentPolicy{
+		MaxSize:      int64(cfg.Attachment.MaxSizeMB) * 1024 * 1024,
+		AllowedTypes: cfg.Attachment.AllowedTypes,
+		URLExpiry:    time.Duration(cfg.Attachment.URLExpirySeconds) * time.Second,
+		Signer:       storage.NewURLSigner(cfg.Attachment.URLSecret),
+	}
+}
+
+// provideTrashRetention은 설정된 휴지통 보관 기간을 반환합니다
+func provideTrashRetention(cfg *config.Config) service.TrashRetention {
+	return service.TrashRetention(time.Duration(cfg.Trash.RetentionDays) * 24 * time.Hour)
+}
+
+// provideTrashRetentionJob은 보관 기간이 지난 휴지통 항목을 영구 삭제하는 Job을 생성합니다
+func provideTrashRetentionJob(trashService service.TrashService, log *zap.Logger) *trash.RetentionJob {
+	return trash.NewRetentionJob(trashService, trash.DefaultRetentionInterval, log)
+}
+
+// provideRecurrenceScheduler는 보드 템플릿의 반복 규칙에 따라 보드를 생성하는 스케줄러를 생성합니다
+func provideRecurrenceScheduler(boardService service.BoardService, log *zap.Logger) *recurrence.Scheduler {
+	return recurrence.NewScheduler(boardService, recurrence.DefaultPollInterval, recurrence.DefaultBatchSize, log)
+}
+
+// provideReminderConfig는 설정된 마감일 알림 시점과 일일 요약 발송 시각을 반환합니다
+func provideReminderConfig(cfg *config.Config) reminder.Config {
+	reminderConfig := reminder.DefaultConfig()
+	reminderConfig.LeadTimes = cfg.Reminder.LeadTimes
+	reminderConfig.DigestHour = cfg.Reminder.DigestHour
+	if loc, err := time.LoadLocation(cfg.Reminder.DigestTimeZone); err == nil {
+		reminderConfig.DigestLocation = loc
+	}
+	return reminderConfig
+}
+
+// provideReminderNotifier는 SMTP가 설정되어 있으면 SMTP Notifier를, 아니면 로그만 남기는 Notifier를 생성합니다
+func provideReminderNotifier(cfg *config.Config, log *zap.Logger) reminder.Notifier {
+	if cfg.SMTP.Host == "" {
+		return reminder.NewLogNotifier(log)
+	}
+	return reminder.NewSMTPNotifier(reminder.SMTPConfig{
+		Host:     cfg.SMTP.Host,
+		Port:     cfg.SMTP.Port,
+		Username: cfg.SMTP.Username,
+		Password: cfg.SMTP.Password,
+		From:     cfg.SMTP.From,
+	})
+}
+
+// provideReminderScheduler는 마감일 알림과 일일 요약을 발송하는 스케줄러를 생성합니다
+func provideReminderScheduler(cfg *config.Config, reminderService service.ReminderService, log *zap.Logger) *reminder.Scheduler {
+	return reminder.NewScheduler(reminderService, time.Duration(cfg.Reminder.PollIntervalSeconds)*time.Second, log)
+}
+
+// provideWebhookWorker는 설정값을 반영한 Webhook 전송 Worker를 생성합니다
+func provideWebhookWorker(cfg *config.Config, repo repository.WebhookRepository, log *zap.Logger) *webhook.Worker {
+	workerConfig := webhook.DefaultWorkerConfig()
+	if cfg.Webhook.PollIntervalSeconds > 0 {
+		workerConfig.PollInterval = time.Duration(cfg.Webhook.PollIntervalSeconds) * time.Second
+	}
+	if cfg.Webhook.MaxAttempts > 0 {
+		workerConfig.MaxAttempts = cfg.Webhook.MaxAttempts
+	}
+	return webhook.NewWorker(repo, workerConfig, log)
+}
+
 // ==================== Wire Injectors ====================
 
 // InitializeApplication은 전체 애플리케이션을 초기화합니다
@@ -87,6 +292,12 @@ func InitializeApplication(
 		// All provider sets
 		repositorySet,
 		cacheSet,
+		eventSet,
+		webhookSet,
+		trashSet,
+		recurrenceSet,
+		reminderSet,
+		storageSet,
 		clientSet,
 		serviceSet,
 		handlerSet,
@@ -101,12 +312,33 @@ func InitializeApplication(
 
 // Application은 모든 핸들러를 포함하는 구조체입니다
 type Application struct {
-	HealthHandler  *handler.HealthHandler
-	ProjectHandler *handler.ProjectHandler
-	BoardHandler   *handler.BoardHandler
-	CommentHandler *handler.CommentHandler
-	FieldHandler   *handler.FieldHandler
-	ViewHandler    *handler.ViewHandler
+	HealthHandler        *handler.HealthHandler
+	ProjectHandler       *handler.ProjectHandler
+	BoardHandler         *handler.BoardHandler
+	CommentHandler       *handler.CommentHandler
+	FieldHandler         *handler.FieldHandler
+	ViewHandler          *handler.ViewHandler
+	BoardActivityHandler *handler.BoardActivityHandler
+	ProjectEventHandler  *handler.ProjectEventHandler
+	WebhookHandler       *handler.WebhookHandler
+	TrashHandler         *handler.TrashHandler
+	NotificationHandler  *handler.NotificationHandler
+	BoardRelationHandler *handler.BoardRelationHandler
+	ChecklistHandler     *handler.ChecklistHandler
+	AttachmentHandler    *handler.AttachmentHandler
+	TimeTrackingHandler  *handler.TimeTrackingHandler
+	SprintHandler        *handler.SprintHandler
+	AnalyticsHandler     *handler.AnalyticsHandler
+	MilestoneHandler     *handler.MilestoneHandler
+	BoardTemplateHandler *handler.BoardTemplateHandler
+	SearchHandler        *handler.SearchHandler
+
+	// Background workers
+	WebhookWorker       *webhook.Worker
+	OutboxRelay         *outbox.Relay
+	TrashRetentionJob   *trash.RetentionJob
+	RecurrenceScheduler *recurrence.Scheduler
+	ReminderScheduler   *reminder.Scheduler
 }
 
 // NewApplication은 Application을 생성합니다
@@ -118,14 +350,52 @@ func NewApplication(
 	commentHandler *handler.CommentHandler,
 	fieldHandler *handler.FieldHandler,
 	viewHandler *handler.ViewHandler,
+	boardActivityHandler *handler.BoardActivityHandler,
+	projectEventHandler *handler.ProjectEventHandler,
+	webhookHandler *handler.WebhookHandler,
+	trashHandler *handler.TrashHandler,
+	notificationHandler *handler.NotificationHandler,
+	boardRelationHandler *handler.BoardRelationHandler,
+	checklistHandler *handler.ChecklistHandler,
+	attachmentHandler *handler.AttachmentHandler,
+	timeTrackingHandler *handler.TimeTrackingHandler,
+	sprintHandler *handler.SprintHandler,
+	analyticsHandler *handler.AnalyticsHandler,
+	milestoneHandler *handler.MilestoneHandler,
+	boardTemplateHandler *handler.BoardTemplateHandler,
+	searchHandler *handler.SearchHandler,
+	webhookWorker *webhook.Worker,
+	outboxRelay *outbox.Relay,
+	trashRetentionJob *trash.RetentionJob,
+	recurrenceScheduler *recurrence.Scheduler,
+	reminderScheduler *reminder.Scheduler,
 ) *Application {
 	return &Application{
-		HealthHandler:  healthHandler,
-		ProjectHandler: projectHandler,
-		BoardHandler:   boardHandler,
-		CommentHandler: commentHandler,
-		FieldHandler:   fieldHandler,
-		ViewHandler:    viewHandler,
+		HealthHandler:        healthHandler,
+		ProjectHandler:       projectHandler,
+		BoardHandler:         boardHandler,
+		CommentHandler:       commentHandler,
+		FieldHandler:         fieldHandler,
+		ViewHandler:          viewHandler,
+		BoardActivityHandler: boardActivityHandler,
+		ProjectEventHandler:  projectEventHandler,
+		WebhookHandler:       webhookHandler,
+		TrashHandler:         trashHandler,
+		NotificationHandler:  notificationHandler,
+		BoardRelationHandler: boardRelationHandler,
+		ChecklistHandler:     checklistHandler,
+		AttachmentHandler:    attachmentHandler,
+		TimeTrackingHandler:  timeTrackingHandler,
+		SprintHandler:        sprintHandler,
+		AnalyticsHandler:     analyticsHandler,
+		MilestoneHandler:     milestoneHandler,
+		BoardTemplateHandler: boardTemplateHandler,
+		SearchHandler:        searchHandler,
+		WebhookWorker:        webhookWorker,
+		OutboxRelay:          outboxRelay,
+		TrashRetentionJob:    trashRetentionJob,
+		RecurrenceScheduler:  recurrenceScheduler,
+		ReminderScheduler:    reminderScheduler,
 	}
 }
 
@@ -134,6 +404,9 @@ func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 	// Health check (no authentication required)
 	handler.RegisterRoutes(r, app.HealthHandler)
 
+	// Attachment downloads are authorized by the signed link instead of a token
+	r.GET("/api/attachments/:attachmentId/download", app.AttachmentHandler.DownloadAttachment)
+
 	// API routes group (authentication required)
 	api := r.Group("/api")
 	api.Use(middleware.AuthMiddleware(cfg.JWT.Secret))
@@ -160,12 +433,56 @@ func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 			projects.PUT("/:projectId/members/:memberId/role", app.ProjectHandler.UpdateMemberRole)
 			projects.DELETE("/:projectId/members/:memberId", app.ProjectHandler.RemoveMember)
 
+			// Templates and cloning
+			projects.POST("/:projectId/templates", app.ProjectHandler.CreateTemplate)
+			projects.POST("/:projectId/clone", app.ProjectHandler.CloneProject)
+
 			// Project fields
 			projects.GET("/:projectId/fields", app.FieldHandler.GetFieldsByProject)
 			projects.PUT("/:projectId/fields/order", app.FieldHandler.UpdateFieldOrder)
 
 			// Project views
 			projects.GET("/:projectId/views", app.ViewHandler.GetViewsByProject)
+
+			// Real-time project events (SSE)
+			projects.GET("/:projectId/events", app.ProjectEventHandler.StreamProjectEvents)
+
+			// Project webhooks
+			projects.POST("/:projectId/webhooks", app.WebhookHandler.CreateWebhook)
+			projects.GET("/:projectId/webhooks", app.WebhookHandler.GetWebhooks)
+			projects.GET("/:projectId/webhooks/:webhookId", app.WebhookHandler.GetWebhook)
+			projects.PATCH("/:projectId/webhooks/:webhookId", app.WebhookHandler.UpdateWebhook)
+			projects.DELETE("/:projectId/webhooks/:webhookId", app.WebhookHandler.DeleteWebhook)
+			projects.GET("/:projectId/webhooks/:webhookId/deliveries", app.WebhookHandler.GetWebhookDeliveries)
+			projects.GET("/:projectId/webhooks/:webhookId/deliveries/:deliveryId", app.WebhookHandler.GetWebhookDelivery)
+			projects.POST("/:projectId/webhooks/:webhookId/deliveries/:deliveryId/redeliver", app.WebhookHandler.RedeliverWebhookDelivery)
+
+			// Project trash (boards, comments)
+			projects.GET("/:projectId/trash", app.TrashHandler.GetProjectTrash)
+			projects.POST("/:projectId/trash/:trashId/restore", app.TrashHandler.RestoreTrashItem)
+			projects.DELETE("/:projectId/trash/:trashId", app.TrashHandler.PurgeTrashItem)
+
+			// Project time tracking
+			projects.GET("/:projectId/worklogs", app.TimeTrackingHandler.GetProjectWorkLogs)
+			projects.GET("/:projectId/time-report", app.TimeTrackingHandler.GetTimeReport)
+
+			// Project sprints
+			projects.POST("/:projectId/sprints", app.SprintHandler.CreateSprint)
+			projects.GET("/:projectId/sprints", app.SprintHandler.GetSprints)
+
+			// Project analytics (Stage history)
+			projects.GET("/:projectId/analytics/cumulative-flow", app.AnalyticsHandler.GetCumulativeFlow)
+			projects.GET("/:projectId/analytics/burndown", app.AnalyticsHandler.GetBurndown)
+			projects.GET("/:projectId/analytics/cycle-time", app.AnalyticsHandler.GetCycleTime)
+
+			// Project milestones (release versions)
+			projects.POST("/:projectId/milestones", app.MilestoneHandler.CreateMilestone)
+			projects.GET("/:projectId/milestones", app.MilestoneHandler.GetMilestones)
+
+			// Project board templates
+			projects.POST("/:projectId/board-templates", app.BoardTemplateHandler.CreateBoardTemplate)
+			projects.GET("/:projectId/board-templates", app.BoardTemplateHandler.GetBoardTemplates)
+			projects.GET("/:projectId/board-recurrences", app.BoardTemplateHandler.GetProjectBoardRecurrences)
 		}
 
 		// Board routes
@@ -174,9 +491,51 @@ func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 			boards.POST("", app.BoardHandler.CreateBoard)
 			boards.GET("/:boardId", app.BoardHandler.GetBoard)
 			boards.GET("", app.BoardHandler.GetBoards)
+			boards.GET("/watched", app.NotificationHandler.GetWatchedBoards)
+			boards.GET("/by-key/:key", app.BoardHandler.GetBoardByKey)
 			boards.PUT("/:boardId", app.BoardHandler.UpdateBoard)
 			boards.DELETE("/:boardId", app.BoardHandler.DeleteBoard)
 			boards.PUT("/:boardId/move", app.BoardHandler.MoveBoard)
+			boards.PUT("/:boardId/project", app.BoardHandler.MoveBoardToProject)
+			boards.PUT("/:boardId/parent", app.BoardHandler.SetParentBoard)
+			boards.POST("/:boardId/duplicate", app.BoardHandler.DuplicateBoard)
+
+			// Board activity history
+			boards.GET("/:boardId/activity", app.BoardActivityHandler.GetBoardActivities)
+
+			// Board watchers
+			boards.GET("/:boardId/watch", app.NotificationHandler.GetBoardWatch)
+			boards.POST("/:boardId/watch", app.NotificationHandler.WatchBoard)
+			boards.DELETE("/:boardId/watch", app.NotificationHandler.UnwatchBoard)
+
+			// Board relations
+			boards.GET("/:boardId/relations", app.BoardRelationHandler.GetBoardRelations)
+			boards.POST("/:boardId/relations", app.BoardRelationHandler.CreateBoardRelation)
+			boards.DELETE("/:boardId/relations/:relationId", app.BoardRelationHandler.DeleteBoardRelation)
+
+			// Board checklist
+			boards.GET("/:boardId/checklist", app.ChecklistHandler.GetChecklist)
+			boards.POST("/:boardId/checklist", app.ChecklistHandler.CreateChecklistItem)
+			boards.PATCH("/:boardId/checklist/:itemId", app.ChecklistHandler.UpdateChecklistItem)
+			boards.PUT("/:boardId/checklist/:itemId/move", app.ChecklistHandler.MoveChecklistItem)
+			boards.DELETE("/:boardId/checklist/:itemId", app.ChecklistHandler.DeleteChecklistItem)
+
+			// Board attachments
+			boards.GET("/:boardId/attachments", app.AttachmentHandler.GetAttachments)
+			boards.POST("/:boardId/attachments", app.AttachmentHandler.UploadAttachment)
+
+			// Board time tracking
+			boards.PUT("/:boardId/estimate", app.TimeTrackingHandler.UpdateEstimate)
+			boards.GET("/:boardId/worklogs", app.TimeTrackingHandler.GetWorkLogs)
+			boards.POST("/:boardId/worklogs", app.TimeTrackingHandler.CreateWorkLog)
+			boards.PATCH("/:boardId/worklogs/:workLogId", app.TimeTrackingHandler.UpdateWorkLog)
+			boards.DELETE("/:boardId/worklogs/:workLogId", app.TimeTrackingHandler.DeleteWorkLog)
+
+			// Board sprint
+			boards.PUT("/:boardId/sprint", app.SprintHandler.SetBoardSprint)
+
+			// Board milestone
+			boards.PUT("/:boardId/milestone", app.MilestoneHandler.SetBoardMilestone)
 
 			// Board field values
 			boards.GET("/:boardId/field-values", app.FieldHandler.GetBoardFieldValues)
@@ -190,6 +549,73 @@ func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 			comments.GET("", app.CommentHandler.GetCommentsByBoardID)
 			comments.PUT("/:commentId", app.CommentHandler.UpdateComment)
 			comments.DELETE("/:commentId", app.CommentHandler.DeleteComment)
+			comments.GET("/:commentId/replies", app.CommentHandler.GetCommentReplies)
+			comments.POST("/:commentId/reactions", app.CommentHandler.ToggleReaction)
+		}
+
+		// Attachment routes
+		attachments := api.Group("/attachments")
+		{
+			attachments.GET("/:attachmentId/url", app.AttachmentHandler.GetDownloadURL)
+			attachments.DELETE("/:attachmentId", app.AttachmentHandler.DeleteAttachment)
+		}
+
+		// Sprint routes
+		sprints := api.Group("/sprints")
+		{
+			sprints.GET("/:sprintId", app.SprintHandler.GetSprint)
+			sprints.PATCH("/:sprintId", app.SprintHandler.UpdateSprint)
+			sprints.DELETE("/:sprintId", app.SprintHandler.DeleteSprint)
+			sprints.POST("/:sprintId/start", app.SprintHandler.StartSprint)
+			sprints.POST("/:sprintId/close", app.SprintHandler.CloseSprint)
+			sprints.GET("/:sprintId/report", app.SprintHandler.GetSprintReport)
+		}
+
+		// Milestone routes
+		milestones := api.Group("/milestones")
+		{
+			milestones.GET("/:milestoneId", app.MilestoneHandler.GetMilestone)
+			milestones.PATCH("/:milestoneId", app.MilestoneHandler.UpdateMilestone)
+			milestones.DELETE("/:milestoneId", app.MilestoneHandler.DeleteMilestone)
+		}
+
+		// Board template routes
+		boardTemplates := api.Group("/board-templates")
+		{
+			boardTemplates.GET("/:templateId", app.BoardTemplateHandler.GetBoardTemplate)
+			boardTemplates.PATCH("/:templateId", app.BoardTemplateHandler.UpdateBoardTemplate)
+			boardTemplates.DELETE("/:templateId", app.BoardTemplateHandler.DeleteBoardTemplate)
+			boardTemplates.GET("/:templateId/recurrence", app.BoardTemplateHandler.GetBoardRecurrence)
+			boardTemplates.PUT("/:templateId/recurrence", app.BoardTemplateHandler.SetBoardRecurrence)
+			boardTemplates.DELETE("/:templateId/recurrence", app.BoardTemplateHandler.DeleteBoardRecurrence)
+		}
+
+		// Full-text search (boards and comments of my projects)
+		api.GET("/search", app.SearchHandler.Search)
+
+		// Notification inbox routes
+		notifications := api.Group("/notifications")
+		{
+			notifications.GET("", app.NotificationHandler.GetNotifications)
+			notifications.GET("/unread-count", app.NotificationHandler.GetUnreadCount)
+			notifications.PATCH("/:notificationId/read", app.NotificationHandler.MarkRead)
+			notifications.POST("/read-all", app.NotificationHandler.MarkAllRead)
+		}
+
+		// Project template routes (workspace level)
+		projectTemplates := api.Group("/project-templates")
+		{
+			projectTemplates.GET("", app.ProjectHandler.GetTemplates)
+			projectTemplates.GET("/:templateId", app.ProjectHandler.GetTemplate)
+			projectTemplates.DELETE("/:templateId", app.ProjectHandler.DeleteTemplate)
+		}
+
+		// Trash routes (deleted projects)
+		trashGroup := api.Group("/trash")
+		{
+			trashGroup.GET("/projects", app.TrashHandler.GetTrashedProjects)
+			trashGroup.POST("/projects/:projectId/restore", app.TrashHandler.RestoreProject)
+			trashGroup.DELETE("/projects/:projectId", app.TrashHandler.PurgeProject)
 		}
 
 		// Custom Fields routes
@@ -10,15 +10,23 @@ import (
 	"board-service/internal/cache"
 	"board-service/internal/client"
 	"board-service/internal/config"
+	"board-service/internal/event"
 	"board-service/internal/handler"
 	"board-service/internal/middleware"
+	"board-service/internal/outbox"
+	"board-service/internal/recurrence"
+	"board-service/internal/reminder"
 	"board-service/internal/repository"
 	"board-service/internal/service"
+	"board-service/internal/storage"
+	"board-service/internal/trash"
+	"board-service/internal/webhook"
 	"github.com/gin-gonic/gin"
 	"github.com/google/wire"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"time"
 )
 
 import (
@@ -39,58 +47,274 @@ func InitializeApplication(cfg *config.Config, log *zap.Logger, db *gorm.DB, rdb
 	fieldOptionRepository := repository.NewFieldOptionRepository(db)
 	boardOrderRepository := repository.NewBoardOrderRepository(db)
 	viewRepository := repository.NewViewRepository(db)
+	projectTemplateRepository := repository.NewProjectTemplateRepository(db)
 	userClient := provideUserClient(cfg)
 	workspaceCache := cache.NewWorkspaceCache(rdb)
 	userInfoCache := cache.NewUserInfoCache(rdb)
-	projectService := service.NewProjectService(projectRepository, roleRepository, fieldRepository, boardRepository, projectFieldRepository, fieldOptionRepository, boardOrderRepository, viewRepository, userClient, workspaceCache, userInfoCache, log, db)
+	fieldCache := cache.NewFieldCache(rdb)
+	attachmentRepository := repository.NewAttachmentRepository(db)
+	storageStorage, err := provideAttachmentStorage(cfg)
+	if err != nil {
+		return nil, err
+	}
+	projectDeletionMode := provideProjectDeletionMode(cfg)
+	projectService := service.NewProjectService(projectRepository, roleRepository, fieldRepository, boardRepository, projectFieldRepository, fieldOptionRepository, boardOrderRepository, viewRepository, projectTemplateRepository, attachmentRepository, storageStorage, userClient, workspaceCache, userInfoCache, fieldCache, projectDeletionMode, log, db)
 	projectHandler := handler.NewProjectHandler(projectService)
 	commentRepository := repository.NewCommentRepository(db)
-	boardService := service.NewBoardService(boardRepository, projectRepository, roleRepository, fieldRepository, commentRepository, userClient, userInfoCache, log, db)
+	boardActivityRepository := repository.NewBoardActivityRepository(db)
+	notificationRepository := repository.NewNotificationRepository(db)
+	boardRelationRepository := repository.NewBoardRelationRepository(db)
+	checklistRepository := repository.NewChecklistRepository(db)
+	workLogRepository := repository.NewWorkLogRepository(db)
+	boardTemplateRepository := repository.NewBoardTemplateRepository(db)
+	boardRecurrenceRepository := repository.NewBoardRecurrenceRepository(db)
+	boardService := service.NewBoardService(boardRepository, projectRepository, roleRepository, fieldRepository, commentRepository, boardActivityRepository, notificationRepository, boardRelationRepository, checklistRepository, workLogRepository, boardTemplateRepository, boardRecurrenceRepository, userClient, userInfoCache, fieldCache, log, db)
 	boardHandler := handler.NewBoardHandler(boardService)
-	commentService := service.NewCommentService(commentRepository, boardRepository, projectRepository, userClient, userInfoCache, log, db)
+	commentThreadDepth := provideCommentThreadDepth(cfg)
+	commentService := service.NewCommentService(commentRepository, boardRepository, projectRepository, roleRepository, boardActivityRepository, notificationRepository, userClient, userInfoCache, commentThreadDepth, log, db)
 	commentHandler := handler.NewCommentHandler(commentService)
-	fieldCache := cache.NewFieldCache(rdb)
 	fieldService := service.NewFieldService(fieldRepository, projectRepository, fieldCache, log, db)
-	fieldValueService := service.NewFieldValueService(fieldRepository, boardRepository, projectRepository, fieldCache, log, db)
+	fieldValueService := service.NewFieldValueService(fieldRepository, boardRepository, projectRepository, boardActivityRepository, notificationRepository, fieldCache, userClient, userInfoCache, log, db)
 	fieldHandler := handler.NewFieldHandler(fieldService, fieldValueService)
-	viewService := service.NewViewService(fieldRepository, boardRepository, projectRepository, fieldCache, log, db)
+	viewService := service.NewViewService(fieldRepository, boardRepository, projectRepository, boardRelationRepository, checklistRepository, workLogRepository, fieldCache, log, db)
 	viewHandler := handler.NewViewHandler(viewService)
-	application := NewApplication(healthHandler, projectHandler, boardHandler, commentHandler, fieldHandler, viewHandler)
+	boardActivityService := service.NewBoardActivityService(boardActivityRepository, boardRepository, projectRepository, userClient, userInfoCache, log)
+	boardActivityHandler := handler.NewBoardActivityHandler(boardActivityService)
+	redisBroker := event.NewRedisBroker(rdb, log)
+	projectEventService := service.NewProjectEventService(redisBroker, projectRepository, log)
+	projectEventHandler := handler.NewProjectEventHandler(projectEventService)
+	webhookRepository := repository.NewWebhookRepository(db)
+	webhookService := service.NewWebhookService(webhookRepository, projectRepository, roleRepository, log)
+	webhookHandler := handler.NewWebhookHandler(webhookService)
+	trashRepository := repository.NewTrashRepository(db)
+	trashRetention := provideTrashRetention(cfg)
+	trashService := service.NewTrashService(trashRepository, projectRepository, roleRepository, attachmentRepository, storageStorage, trashRetention, log, db)
+	trashHandler := handler.NewTrashHandler(trashService)
+	notificationService := service.NewNotificationService(notificationRepository, boardRepository, projectRepository, roleRepository, userClient, userInfoCache, log)
+	notificationHandler := handler.NewNotificationHandler(notificationService)
+	boardRelationService := service.NewBoardRelationService(boardRelationRepository, boardRepository, projectRepository, roleRepository, boardActivityRepository, log, db)
+	boardRelationHandler := handler.NewBoardRelationHandler(boardRelationService)
+	checklistService := service.NewChecklistService(checklistRepository, boardRepository, projectRepository, roleRepository, boardActivityRepository, log, db)
+	checklistHandler := handler.NewChecklistHandler(checklistService)
+	attachmentPolicy := provideAttachmentPolicy(cfg)
+	attachmentService := service.NewAttachmentService(attachmentRepository, boardRepository, commentRepository, projectRepository, roleRepository, boardActivityRepository, storageStorage, attachmentPolicy, log, db)
+	attachmentHandler := handler.NewAttachmentHandler(attachmentService)
+	timeTrackingService := service.NewTimeTrackingService(workLogRepository, boardRepository, projectRepository, roleRepository, boardActivityRepository, log, db)
+	timeTrackingHandler := handler.NewTimeTrackingHandler(timeTrackingService)
+	sprintRepository := repository.NewSprintRepository(db)
+	sprintService := service.NewSprintService(sprintRepository, boardRepository, fieldRepository, projectRepository, roleRepository, boardActivityRepository, log, db)
+	sprintHandler := handler.NewSprintHandler(sprintService)
+	stageChangeRepository := repository.NewStageChangeRepository(db)
+	analyticsService := service.NewAnalyticsService(stageChangeRepository, fieldRepository, projectRepository, roleRepository, fieldCache, log)
+	analyticsHandler := handler.NewAnalyticsHandler(analyticsService)
+	milestoneRepository := repository.NewMilestoneRepository(db)
+	milestoneService := service.NewMilestoneService(milestoneRepository, boardRepository, fieldRepository, projectRepository, roleRepository, boardActivityRepository, log, db)
+	milestoneHandler := handler.NewMilestoneHandler(milestoneService)
+	boardTemplateService := service.NewBoardTemplateService(boardTemplateRepository, boardRecurrenceRepository, fieldRepository, projectRepository, roleRepository, log, db)
+	boardTemplateHandler := handler.NewBoardTemplateHandler(boardTemplateService)
+	searchRepository := repository.NewSearchRepository(db)
+	searchService := service.NewSearchService(searchRepository, fieldRepository, projectRepository, roleRepository)
+	searchHandler := handler.NewSearchHandler(searchService)
+	worker := provideWebhookWorker(cfg, webhookRepository, log)
+	dispatcher := webhook.NewDispatcher(webhookRepository, log)
+	sink := provideOutboxSink(cfg, rdb, redisBroker, dispatcher)
+	relay := provideOutboxRelay(db, sink, cfg, log)
+	retentionJob := provideTrashRetentionJob(trashService, log)
+	scheduler := provideRecurrenceScheduler(boardService, log)
+	reminderRepository := repository.NewReminderRepository(db)
+	notifier := provideReminderNotifier(cfg, log)
+	reminderConfig := provideReminderConfig(cfg)
+	reminderService := service.NewReminderService(reminderRepository, notificationRepository, projectRepository, fieldRepository, userClient, notifier, reminderConfig, log, db)
+	reminderScheduler := provideReminderScheduler(cfg, reminderService, log)
+	application := NewApplication(healthHandler, projectHandler, boardHandler, commentHandler, fieldHandler, viewHandler, boardActivityHandler, projectEventHandler, webhookHandler, trashHandler, notificationHandler, boardRelationHandler, checklistHandler, attachmentHandler, timeTrackingHandler, sprintHandler, analyticsHandler, milestoneHandler, boardTemplateHandler, searchHandler, worker, relay, retentionJob, scheduler, reminderScheduler)
 	return application, nil
 }
 
 // wire.go:
 
 // repositorySet은 모든 repository providers를 포함합니다
-var repositorySet = wire.NewSet(repository.NewRoleRepository, repository.NewProjectRepository, repository.NewBoardRepository, repository.NewCommentRepository, repository.NewFieldRepository, repository.NewProjectFieldRepository, repository.NewFieldOptionRepository, repository.NewBoardOrderRepository, repository.NewViewRepository)
+var repositorySet = wire.NewSet(repository.NewRoleRepository, repository.NewProjectRepository, repository.NewBoardRepository, repository.NewCommentRepository, repository.NewFieldRepository, repository.NewProjectFieldRepository, repository.NewFieldOptionRepository, repository.NewBoardOrderRepository, repository.NewViewRepository, repository.NewBoardActivityRepository, repository.NewWebhookRepository, repository.NewTrashRepository, repository.NewNotificationRepository, repository.NewBoardRelationRepository, repository.NewChecklistRepository, repository.NewAttachmentRepository, repository.NewWorkLogRepository, repository.NewSprintRepository, repository.NewStageChangeRepository, repository.NewMilestoneRepository, repository.NewProjectTemplateRepository, repository.NewBoardTemplateRepository, repository.NewBoardRecurrenceRepository, repository.NewReminderRepository, repository.NewSearchRepository)
 
 // cacheSet은 모든 cache providers를 포함합니다
 var cacheSet = wire.NewSet(cache.NewWorkspaceCache, cache.NewUserInfoCache, cache.NewFieldCache)
 
+// eventSet은 실시간 이벤트 providers를 포함합니다
+// 서비스 계층은 트랜잭션 안에서 outbox에 이벤트를 기록하고, outbox Relay가 Redis Stream,
+// RedisBroker(SSE 스트림), webhook Dispatcher로 발행합니다
+var eventSet = wire.NewSet(event.NewRedisBroker, wire.Bind(new(event.Subscriber), new(*event.RedisBroker)), provideOutboxSink, provideOutboxRelay)
+
+// webhookSet은 Webhook 전송 providers를 포함합니다
+var webhookSet = wire.NewSet(webhook.NewDispatcher, provideWebhookWorker)
+
+// trashSet은 휴지통 보관 기간 관리 providers를 포함합니다
+var trashSet = wire.NewSet(provideTrashRetention, provideTrashRetentionJob)
+
+// recurrenceSet은 반복 보드 생성 스케줄러 providers를 포함합니다
+var recurrenceSet = wire.NewSet(provideRecurrenceScheduler)
+
+// reminderSet은 마감일 알림 스케줄러와 발송 Notifier providers를 포함합니다
+var reminderSet = wire.NewSet(provideReminderConfig, provideReminderNotifier, provideReminderScheduler)
+
+// storageSet은 첨부 파일 저장소 providers를 포함합니다
+var storageSet = wire.NewSet(
+	provideAttachmentStorage,
+)
+
 // clientSet은 모든 외부 service client providers를 포함합니다
 var clientSet = wire.NewSet(
 	provideUserClient,
 )
 
 // serviceSet은 모든 service providers를 포함합니다
-var serviceSet = wire.NewSet(service.NewBoardService, service.NewProjectService, service.NewCommentService, service.NewFieldService, service.NewFieldValueService, service.NewViewService)
+var serviceSet = wire.NewSet(provideProjectDeletionMode, provideCommentThreadDepth, provideAttachmentPolicy, service.NewBoardService, service.NewProjectService, service.NewCommentService, service.NewFieldService, service.NewFieldValueService, service.NewViewService, service.NewBoardActivityService, service.NewProjectEventService, service.NewWebhookService, service.NewTrashService, service.NewNotificationService, service.NewBoardRelationService, service.NewChecklistService, service.NewAttachmentService, service.NewTimeTrackingService, service.NewSprintService, service.NewAnalyticsService, service.NewMilestoneService, service.NewBoardTemplateService, service.NewReminderService, service.NewSearchService)
 
 // handlerSet은 모든 handler providers를 포함합니다
-var handlerSet = wire.NewSet(handler.NewHealthHandler, handler.NewProjectHandler, handler.NewBoardHandler, handler.NewCommentHandler, handler.NewFieldHandler, handler.NewViewHandler)
+var handlerSet = wire.NewSet(handler.NewHealthHandler, handler.NewProjectHandler, handler.NewBoardHandler, handler.NewCommentHandler, handler.NewFieldHandler, handler.NewViewHandler, handler.NewBoardActivityHandler, handler.NewProjectEventHandler, handler.NewWebhookHandler, handler.NewTrashHandler, handler.NewNotificationHandler, handler.NewBoardRelationHandler, handler.NewChecklistHandler, handler.NewAttachmentHandler, handler.NewTimeTrackingHandler, handler.NewSprintHandler, handler.NewAnalyticsHandler, handler.NewMilestoneHandler, handler.NewBoardTemplateHandler, handler.NewSearchHandler)
 
 // provideUserClient는 UserClient를 생성합니다
 func provideUserClient(cfg *config.Config) client.UserClient {
 	return client.NewUserClient(cfg.UserService.URL)
 }
 
+// provideOutboxSink는 outbox Relay가 발행할 대상을 구성합니다
+// Redis Stream(내구성 있는 소비자용), Redis pub/sub(SSE 스트림), Webhook으로 함께 발행합니다
+func provideOutboxSink(cfg *config.Config, rdb *redis.Client, broker *event.RedisBroker, dispatcher *webhook.Dispatcher) outbox.Sink {
+	return event.NewMultiPublisher(
+		outbox.NewRedisStreamSink(rdb, cfg.Outbox.Stream, cfg.Outbox.StreamMaxLen),
+		broker,
+		dispatcher,
+	)
+}
+
+// provideOutboxRelay는 설정값을 반영한 outbox Relay를 생성합니다
+func provideOutboxRelay(db *gorm.DB, sink outbox.Sink, cfg *config.Config, log *zap.Logger) *outbox.Relay {
+	relayConfig := outbox.DefaultRelayConfig()
+	if cfg.Outbox.PollIntervalMs > 0 {
+		relayConfig.PollInterval = time.Duration(cfg.Outbox.PollIntervalMs) * time.Millisecond
+	}
+	return outbox.NewRelay(db, sink, relayConfig, log)
+}
+
+// provideProjectDeletionMode는 설정된 프로젝트 삭제 방식을 반환합니다
+func provideProjectDeletionMode(cfg *config.Config) service.ProjectDeletionMode {
+	return service.ProjectDeletionMode(cfg.Project.DeletionMode)
+}
+
+// provideCommentThreadDepth는 설정된 댓글 답글 단계 수를 반환합니다
+func provideCommentThreadDepth(cfg *config.Config) service.CommentThreadDepth {
+	return service.CommentThreadDepth(cfg.Comment.MaxThreadDepth)
+}
+
+// provideAttachmentStorage는 설정된 첨부 파일 저장소(local 또는 S3 호환)를 생성합니다
+func provideAttachmentStorage(cfg *config.Config) (storage.Storage, error) {
+	if cfg.Attachment.Storage == "s3" {
+		return storage.NewS3Storage(storage.S3Config{
+			Endpoint:  cfg.S3.Endpoint,
+			Region:    cfg.S3.Region,
+			Bucket:    cfg.S3.Bucket,
+			AccessKey: cfg.S3.AccessKey,
+			SecretKey: cfg.S3.SecretKey,
+		})
+	}
+	return storage.NewLocalStorage(cfg.Attachment.LocalDir)
+}
+
+// provideAttachmentPolicy는 설정된 첨부 파일 크기/형식 제한과 다운로드 링크 서명 방식을 반환합니다
+func provideAttachmentPolicy(cfg *config.Config) service.AttachmentPolicy {
+	return service.AttachmentPolicy{
+		MaxSize:      int64(cfg.Attachment.MaxSizeMB) * 1024 * 1024,
+		AllowedTypes: cfg.Attachment.AllowedTypes,
+		URLExpiry:    time.Duration(cfg.Attachment.URLExpirySeconds) * time.Second,
+		Signer:       storage.NewURLSigner(cfg.Attachment.URLSecret),
+	}
+}
+
+// provideTrashRetention은 설정된 휴지통 보관 기간을 반환합니다
+func provideTrashRetention(cfg *config.Config) service.TrashRetention {
+	return service.TrashRetention(time.Duration(cfg.Trash.RetentionDays) * 24 * time.Hour)
+}
+
+// provideTrashRetentionJob은 보관 기간이 지난 휴지통 항목을 영구 삭제하는 Job을 생성합니다
+func provideTrashRetentionJob(trashService service.TrashService, log *zap.Logger) *trash.RetentionJob {
+	return trash.NewRetentionJob(trashService, trash.DefaultRetentionInterval, log)
+}
+
+// provideRecurrenceScheduler는 보드 템플릿의 반복 규칙에 따라 보드를 생성하는 스케줄러를 생성합니다
+func provideRecurrenceScheduler(boardService service.BoardService, log *zap.Logger) *recurrence.Scheduler {
+	return recurrence.NewScheduler(boardService, recurrence.DefaultPollInterval, recurrence.DefaultBatchSize, log)
+}
+
+// provideReminderConfig는 설정된 마감일 알림 시점과 일일 요약 발송 시각을 반환합니다
+func provideReminderConfig(cfg *config.Config) reminder.Config {
+	reminderConfig := reminder.DefaultConfig()
+	reminderConfig.LeadTimes = cfg.Reminder.LeadTimes
+	reminderConfig.DigestHour = cfg.Reminder.DigestHour
+	if loc, err := time.LoadLocation(cfg.Reminder.DigestTimeZone); err == nil {
+		reminderConfig.DigestLocation = loc
+	}
+	return reminderConfig
+}
+
+// provideReminderNotifier는 SMTP가 설정되어 있으면 SMTP Notifier를, 아니면 로그만 남기는 Notifier를 생성합니다
+func provideReminderNotifier(cfg *config.Config, log *zap.Logger) reminder.Notifier {
+	if cfg.SMTP.Host == "" {
+		return reminder.NewLogNotifier(log)
+	}
+	return reminder.NewSMTPNotifier(reminder.SMTPConfig{
+		Host:     cfg.SMTP.Host,
+		Port:     cfg.SMTP.Port,
+		Username: cfg.SMTP.Username,
+		Password: cfg.SMTP.Password,
+		From:     cfg.SMTP.From,
+	})
+}
+
+// provideReminderScheduler는 마감일 알림과 일일 요약을 발송하는 스케줄러를 생성합니다
+func provideReminderScheduler(cfg *config.Config, reminderService service.ReminderService, log *zap.Logger) *reminder.Scheduler {
+	return reminder.NewScheduler(reminderService, time.Duration(cfg.Reminder.PollIntervalSeconds)*time.Second, log)
+}
+
+// provideWebhookWorker는 설정값을 반영한 Webhook 전송 Worker를 생성합니다
+func provideWebhookWorker(cfg *config.Config, repo repository.WebhookRepository, log *zap.Logger) *webhook.Worker {
+	workerConfig := webhook.DefaultWorkerConfig()
+	if cfg.Webhook.PollIntervalSeconds > 0 {
+		workerConfig.PollInterval = time.Duration(cfg.Webhook.PollIntervalSeconds) * time.Second
+	}
+	if cfg.Webhook.MaxAttempts > 0 {
+		workerConfig.MaxAttempts = cfg.Webhook.MaxAttempts
+	}
+	return webhook.NewWorker(repo, workerConfig, log)
+}
+
 // Application은 모든 핸들러를 포함하는 구조체입니다
 type Application struct {
-	HealthHandler  *handler.HealthHandler
-	ProjectHandler *handler.ProjectHandler
-	BoardHandler   *handler.BoardHandler
-	CommentHandler *handler.CommentHandler
-	FieldHandler   *handler.FieldHandler
-	ViewHandler    *handler.ViewHandler
+	HealthHandler        *handler.HealthHandler
+	ProjectHandler       *handler.ProjectHandler
+	BoardHandler         *handler.BoardHandler
+	CommentHandler       *handler.CommentHandler
+	FieldHandler         *handler.FieldHandler
+	ViewHandler          *handler.ViewHandler
+	BoardActivityHandler *handler.BoardActivityHandler
+	ProjectEventHandler  *handler.ProjectEventHandler
+	WebhookHandler       *handler.WebhookHandler
+	TrashHandler         *handler.TrashHandler
+	NotificationHandler  *handler.NotificationHandler
+	BoardRelationHandler *handler.BoardRelationHandler
+	ChecklistHandler     *handler.ChecklistHandler
+	AttachmentHandler    *handler.AttachmentHandler
+	TimeTrackingHandler  *handler.TimeTrackingHandler
+	SprintHandler        *handler.SprintHandler
+	AnalyticsHandler     *handler.AnalyticsHandler
+	MilestoneHandler     *handler.MilestoneHandler
+	BoardTemplateHandler *handler.BoardTemplateHandler
+	SearchHandler        *handler.SearchHandler
+
+	// Background workers
+	WebhookWorker       *webhook.Worker
+	OutboxRelay         *outbox.Relay
+	TrashRetentionJob   *trash.RetentionJob
+	RecurrenceScheduler *recurrence.Scheduler
+	ReminderScheduler   *reminder.Scheduler
 }
 
 // NewApplication은 Application을 생성합니다
@@ -102,20 +326,59 @@ func NewApplication(
 	commentHandler *handler.CommentHandler,
 	fieldHandler *handler.FieldHandler,
 	viewHandler *handler.ViewHandler,
+	boardActivityHandler *handler.BoardActivityHandler,
+	projectEventHandler *handler.ProjectEventHandler,
+	webhookHandler *handler.WebhookHandler,
+	trashHandler *handler.TrashHandler,
+	notificationHandler *handler.NotificationHandler,
+	boardRelationHandler *handler.BoardRelationHandler,
+	checklistHandler *handler.ChecklistHandler,
+	attachmentHandler *handler.AttachmentHandler,
+	timeTrackingHandler *handler.TimeTrackingHandler,
+	sprintHandler *handler.SprintHandler,
+	analyticsHandler *handler.AnalyticsHandler,
+	milestoneHandler *handler.MilestoneHandler,
+	boardTemplateHandler *handler.BoardTemplateHandler,
+	searchHandler *handler.SearchHandler,
+	webhookWorker *webhook.Worker,
+	outboxRelay *outbox.Relay,
+	trashRetentionJob *trash.RetentionJob,
+	recurrenceScheduler *recurrence.Scheduler,
+	reminderScheduler *reminder.Scheduler,
 ) *Application {
 	return &Application{
-		HealthHandler:  healthHandler,
-		ProjectHandler: projectHandler,
-		BoardHandler:   boardHandler,
-		CommentHandler: commentHandler,
-		FieldHandler:   fieldHandler,
-		ViewHandler:    viewHandler,
+		HealthHandler:        healthHandler,
+		ProjectHandler:       projectHandler,
+		BoardHandler:         boardHandler,
+		CommentHandler:       commentHandler,
+		FieldHandler:         fieldHandler,
+		ViewHandler:          viewHandler,
+		BoardActivityHandler: boardActivityHandler,
+		ProjectEventHandler:  projectEventHandler,
+		WebhookHandler:       webhookHandler,
+		TrashHandler:         trashHandler,
+		NotificationHandler:  notificationHandler,
+		BoardRelationHandler: boardRelationHandler,
+		ChecklistHandler:     checklistHandler,
+		AttachmentHandler:    attachmentHandler,
+		TimeTrackingHandler:  timeTrackingHandler,
+		SprintHandler:        sprintHandler,
+		AnalyticsHandler:     analyticsHandler,
+		MilestoneHandler:     milestoneHandler,
+		BoardTemplateHandler: boardTemplateHandler,
+		SearchHandler:        searchHandler,
+		WebhookWorker:        webhookWorker,
+		OutboxRelay:          outboxRelay,
+		TrashRetentionJob:    trashRetentionJob,
+		RecurrenceScheduler:  recurrenceScheduler,
+		ReminderScheduler:    reminderScheduler,
 	}
 }
 
 // RegisterRoutes는 모든 라우트를 등록합니다
 func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 	handler.RegisterRoutes(r, app.HealthHandler)
+	r.GET("/api/attachments/:attachmentId/download", app.AttachmentHandler.DownloadAttachment)
 
 	api := r.Group("/api")
 	api.Use(middleware.AuthMiddleware(cfg.JWT.Secret))
@@ -140,10 +403,40 @@ func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 			projects.PUT("/:projectId/members/:memberId/role", app.ProjectHandler.UpdateMemberRole)
 			projects.DELETE("/:projectId/members/:memberId", app.ProjectHandler.RemoveMember)
 
+			projects.POST("/:projectId/templates", app.ProjectHandler.CreateTemplate)
+			projects.POST("/:projectId/clone", app.ProjectHandler.CloneProject)
+
 			projects.GET("/:projectId/fields", app.FieldHandler.GetFieldsByProject)
 			projects.PUT("/:projectId/fields/order", app.FieldHandler.UpdateFieldOrder)
 
 			projects.GET("/:projectId/views", app.ViewHandler.GetViewsByProject)
+
+			projects.GET("/:projectId/events", app.ProjectEventHandler.StreamProjectEvents)
+
+			projects.POST("/:projectId/webhooks", app.WebhookHandler.CreateWebhook)
+			projects.GET("/:projectId/webhooks", app.WebhookHandler.GetWebhooks)
+			projects.GET("/:projectId/webhooks/:webhookId", app.WebhookHandler.GetWebhook)
+			projects.PATCH("/:projectId/webhooks/:webhookId", app.WebhookHandler.UpdateWebhook)
+			projects.DELETE("/:projectId/webhooks/:webhookId", app.WebhookHandler.DeleteWebhook)
+			projects.GET("/:projectId/webhooks/:webhookId/deliveries", app.WebhookHandler.GetWebhookDeliveries)
+			projects.GET("/:projectId/webhooks/:webhookId/deliveries/:deliveryId", app.WebhookHandler.GetWebhookDelivery)
+			projects.POST("/:projectId/webhooks/:webhookId/deliveries/:deliveryId/redeliver", app.WebhookHandler.RedeliverWebhookDelivery)
+
+			projects.GET("/:projectId/trash", app.TrashHandler.GetProjectTrash)
+			projects.POST("/:projectId/trash/:trashId/restore", app.TrashHandler.RestoreTrashItem)
+			projects.DELETE("/:projectId/trash/:trashId", app.TrashHandler.PurgeTrashItem)
+			projects.GET("/:projectId/worklogs", app.TimeTrackingHandler.GetProjectWorkLogs)
+			projects.GET("/:projectId/time-report", app.TimeTrackingHandler.GetTimeReport)
+			projects.POST("/:projectId/sprints", app.SprintHandler.CreateSprint)
+			projects.GET("/:projectId/sprints", app.SprintHandler.GetSprints)
+			projects.GET("/:projectId/analytics/cumulative-flow", app.AnalyticsHandler.GetCumulativeFlow)
+			projects.GET("/:projectId/analytics/burndown", app.AnalyticsHandler.GetBurndown)
+			projects.GET("/:projectId/analytics/cycle-time", app.AnalyticsHandler.GetCycleTime)
+			projects.POST("/:projectId/milestones", app.MilestoneHandler.CreateMilestone)
+			projects.GET("/:projectId/milestones", app.MilestoneHandler.GetMilestones)
+			projects.POST("/:projectId/board-templates", app.BoardTemplateHandler.CreateBoardTemplate)
+			projects.GET("/:projectId/board-templates", app.BoardTemplateHandler.GetBoardTemplates)
+			projects.GET("/:projectId/board-recurrences", app.BoardTemplateHandler.GetProjectBoardRecurrences)
 		}
 
 		boards := api.Group("/boards")
@@ -151,9 +444,39 @@ func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 			boards.POST("", app.BoardHandler.CreateBoard)
 			boards.GET("/:boardId", app.BoardHandler.GetBoard)
 			boards.GET("", app.BoardHandler.GetBoards)
+			boards.GET("/watched", app.NotificationHandler.GetWatchedBoards)
+			boards.GET("/by-key/:key", app.BoardHandler.GetBoardByKey)
 			boards.PUT("/:boardId", app.BoardHandler.UpdateBoard)
 			boards.DELETE("/:boardId", app.BoardHandler.DeleteBoard)
 			boards.PUT("/:boardId/move", app.BoardHandler.MoveBoard)
+			boards.PUT("/:boardId/project", app.BoardHandler.MoveBoardToProject)
+			boards.PUT("/:boardId/parent", app.BoardHandler.SetParentBoard)
+			boards.POST("/:boardId/duplicate", app.BoardHandler.DuplicateBoard)
+			boards.GET("/:boardId/activity", app.BoardActivityHandler.GetBoardActivities)
+
+			boards.GET("/:boardId/watch", app.NotificationHandler.GetBoardWatch)
+			boards.POST("/:boardId/watch", app.NotificationHandler.WatchBoard)
+			boards.DELETE("/:boardId/watch", app.NotificationHandler.UnwatchBoard)
+
+			boards.GET("/:boardId/relations", app.BoardRelationHandler.GetBoardRelations)
+			boards.POST("/:boardId/relations", app.BoardRelationHandler.CreateBoardRelation)
+			boards.DELETE("/:boardId/relations/:relationId", app.BoardRelationHandler.DeleteBoardRelation)
+
+			boards.GET("/:boardId/checklist", app.ChecklistHandler.GetChecklist)
+			boards.POST("/:boardId/checklist", app.ChecklistHandler.CreateChecklistItem)
+			boards.PATCH("/:boardId/checklist/:itemId", app.ChecklistHandler.UpdateChecklistItem)
+			boards.PUT("/:boardId/checklist/:itemId/move", app.ChecklistHandler.MoveChecklistItem)
+			boards.DELETE("/:boardId/checklist/:itemId", app.ChecklistHandler.DeleteChecklistItem)
+
+			boards.GET("/:boardId/attachments", app.AttachmentHandler.GetAttachments)
+			boards.POST("/:boardId/attachments", app.AttachmentHandler.UploadAttachment)
+			boards.PUT("/:boardId/estimate", app.TimeTrackingHandler.UpdateEstimate)
+			boards.GET("/:boardId/worklogs", app.TimeTrackingHandler.GetWorkLogs)
+			boards.POST("/:boardId/worklogs", app.TimeTrackingHandler.CreateWorkLog)
+			boards.PATCH("/:boardId/worklogs/:workLogId", app.TimeTrackingHandler.UpdateWorkLog)
+			boards.DELETE("/:boardId/worklogs/:workLogId", app.TimeTrackingHandler.DeleteWorkLog)
+			boards.PUT("/:boardId/sprint", app.SprintHandler.SetBoardSprint)
+			boards.PUT("/:boardId/milestone", app.MilestoneHandler.SetBoardMilestone)
 
 			boards.GET("/:boardId/field-values", app.FieldHandler.GetBoardFieldValues)
 			api.DELETE("/boards/:boardId/field-values/:fieldId", app.FieldHandler.DeleteFieldValue)
@@ -165,6 +488,65 @@ func (app *Application) RegisterRoutes(r *gin.Engine, cfg *config.Config) {
 			comments.GET("", app.CommentHandler.GetCommentsByBoardID)
 			comments.PUT("/:commentId", app.CommentHandler.UpdateComment)
 			comments.DELETE("/:commentId", app.CommentHandler.DeleteComment)
+			comments.GET("/:commentId/replies", app.CommentHandler.GetCommentReplies)
+			comments.POST("/:commentId/reactions", app.CommentHandler.ToggleReaction)
+		}
+
+		attachments := api.Group("/attachments")
+		{
+			attachments.GET("/:attachmentId/url", app.AttachmentHandler.GetDownloadURL)
+			attachments.DELETE("/:attachmentId", app.AttachmentHandler.DeleteAttachment)
+		}
+
+		sprints := api.Group("/sprints")
+		{
+			sprints.GET("/:sprintId", app.SprintHandler.GetSprint)
+			sprints.PATCH("/:sprintId", app.SprintHandler.UpdateSprint)
+			sprints.DELETE("/:sprintId", app.SprintHandler.DeleteSprint)
+			sprints.POST("/:sprintId/start", app.SprintHandler.StartSprint)
+			sprints.POST("/:sprintId/close", app.SprintHandler.CloseSprint)
+			sprints.GET("/:sprintId/report", app.SprintHandler.GetSprintReport)
+		}
+
+		milestones := api.Group("/milestones")
+		{
+			milestones.GET("/:milestoneId", app.MilestoneHandler.GetMilestone)
+			milestones.PATCH("/:milestoneId", app.MilestoneHandler.UpdateMilestone)
+			milestones.DELETE("/:milestoneId", app.MilestoneHandler.DeleteMilestone)
+		}
+
+		boardTemplates := api.Group("/board-templates")
+		{
+			boardTemplates.GET("/:templateId", app.BoardTemplateHandler.GetBoardTemplate)
+			boardTemplates.PATCH("/:templateId", app.BoardTemplateHandler.UpdateBoardTemplate)
+			boardTemplates.DELETE("/:templateId", app.BoardTemplateHandler.DeleteBoardTemplate)
+			boardTemplates.GET("/:templateId/recurrence", app.BoardTemplateHandler.GetBoardRecurrence)
+			boardTemplates.PUT("/:templateId/recurrence", app.BoardTemplateHandler.SetBoardRecurrence)
+			boardTemplates.DELETE("/:templateId/recurrence", app.BoardTemplateHandler.DeleteBoardRecurrence)
+		}
+
+		api.GET("/search", app.SearchHandler.Search)
+
+		notifications := api.Group("/notifications")
+		{
+			notifications.GET("", app.NotificationHandler.GetNotifications)
+			notifications.GET("/unread-count", app.NotificationHandler.GetUnreadCount)
+			notifications.PATCH("/:notificationId/read", app.NotificationHandler.MarkRead)
+			notifications.POST("/read-all", app.NotificationHandler.MarkAllRead)
+		}
+
+		projectTemplates := api.Group("/project-templates")
+		{
+			projectTemplates.GET("", app.ProjectHandler.GetTemplates)
+			projectTemplates.GET("/:templateId", app.ProjectHandler.GetTemplate)
+			projectTemplates.DELETE("/:templateId", app.ProjectHandler.DeleteTemplate)
+		}
+
+		trashGroup := api.Group("/trash")
+		{
+			trashGroup.GET("/projects", app.TrashHandler.GetTrashedProjects)
+			trashGroup.POST("/projects/:projectId/restore", app.TrashHandler.RestoreProject)
+			trashGroup.DELETE("/projects/:projectId", app.TrashHandler.PurgeProject)
 		}
 
 		api.POST("/fields", app.FieldHandler.CreateField)
@@ -1222,7 +1222,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete a project (OWNER only)",
+                "description": "Delete a project with all boards, fields, views and members (OWNER only). Soft or permanent deletion follows PROJECT_DELETION_MODE",
                 "consumes": [
                     "application/json"
                 ],
@@ -30,6 +30,11 @@ type FieldCache interface {
 	GetViewResults(ctx context.Context, viewID, filterHash string) ([]byte, error)
 	SetViewResults(ctx context.Context, viewID, filterHash string, resultsJSON []byte, ttl time.Duration) error
 	InvalidateViewResults(ctx context.Context, viewID string) error
+
+	// Project analytics caching (one entry per report and date range)
+	GetProjectAnalytics(ctx context.Context, projectID, reportKey string) ([]byte, error)
+	SetProjectAnalytics(ctx context.Context, projectID, reportKey string, reportJSON []byte, ttl time.Duration) error
+	InvalidateProjectAnalytics(ctx context.Context, projectID string) error
 }
 
 type fieldCache struct {
@@ -161,3 +166,44 @@ func (c *fieldCache) InvalidateViewResults(ctx context.Context, viewID string) e
 	keysToDelete := append(keys, trackingSetKey)
 	return c.client.Del(ctx, keysToDelete...).Err()
 }
+
+// ==================== Project Analytics ====================
+
+func (c *fieldCache) GetProjectAnalytics(ctx context.Context, projectID, reportKey string) ([]byte, error) {
+	key := fmt.Sprintf("project:%s:analytics:%s", projectID, reportKey)
+	val, err := c.client.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+func (c *fieldCache) SetProjectAnalytics(ctx context.Context, projectID, reportKey string, reportJSON []byte, ttl time.Duration) error {
+	reportCacheKey := fmt.Sprintf("project:%s:analytics:%s", projectID, reportKey)
+	trackingSetKey := fmt.Sprintf("project:%s:analytics_keys", projectID)
+
+	// Track the report key like view results so that a stage change drops every range at once
+	pipe := c.client.Pipeline()
+	pipe.Set(ctx, reportCacheKey, reportJSON, ttl)
+	pipe.SAdd(ctx, trackingSetKey, reportCacheKey)
+	pipe.Expire(ctx, trackingSetKey, ttl+time.Hour)
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *fieldCache) InvalidateProjectAnalytics(ctx context.Context, projectID string) error {
+	trackingSetKey := fmt.Sprintf("project:%s:analytics_keys", projectID)
+
+	keys, err := c.client.SMembers(ctx, trackingSetKey).Result()
+	if err != nil {
+		return err
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	keysToDelete := append(keys, trackingSetKey)
+	return c.client.Del(ctx, keysToDelete...).Err()
+}
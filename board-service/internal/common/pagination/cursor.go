@@ -0,0 +1,127 @@
+package pagination
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ErrInvalidCursor is returned for a cursor that was not issued for the list
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is the position of a row in a keyset-paginated list: the values the list is sorted by and the row's ID
+// A backward cursor (prev_cursor) reads the rows before the position, otherwise the rows after it
+type Cursor struct {
+	Values   []interface{}
+	ID       string
+	Backward bool
+}
+
+// CursorRequest represents keyset pagination parameters
+// Cursor is nil for the first page
+type CursorRequest struct {
+	Cursor       *Cursor
+	Limit        int
+	IncludeTotal bool
+}
+
+// CursorResponse represents keyset pagination metadata
+type CursorResponse struct {
+	Limit      int     `json:"limit"`
+	Total      *int64  `json:"total,omitempty"` // Only if requested
+	NextCursor *string `json:"next_cursor"`
+	PrevCursor *string `json:"prev_cursor"`
+}
+
+// NewCursorRequest decodes the cursor and applies the default and maximum limit
+// The total is counted for the first page unless includeTotal is false, and for later pages only if it is true
+func NewCursorRequest(cursor string, limit int, includeTotal *bool) (CursorRequest, error) {
+	_, limit = ValidatePaginationParams(DefaultPage, limit)
+	req := CursorRequest{Limit: limit, IncludeTotal: cursor == ""}
+	if includeTotal != nil {
+		req.IncludeTotal = *includeTotal
+	}
+	if cursor == "" {
+		return req, nil
+	}
+	decoded, err := DecodeCursor(cursor)
+	if err != nil {
+		return CursorRequest{}, err
+	}
+	req.Cursor = decoded
+	return req, nil
+}
+
+// encodedCursor is the JSON form of a cursor
+type encodedCursor struct {
+	Values   []cursorValue `json:"v"`
+	ID       string        `json:"id"`
+	Backward bool          `json:"b,omitempty"`
+}
+
+// cursorValue keeps times apart from strings so that they are compared as times again
+type cursorValue struct {
+	Time  *time.Time  `json:"t,omitempty"`
+	Value interface{} `json:"v,omitempty"`
+}
+
+// EncodeCursor returns the opaque form of a cursor
+func EncodeCursor(cursor *Cursor) *string {
+	if cursor == nil {
+		return nil
+	}
+	encoded := encodedCursor{Values: make([]cursorValue, 0, len(cursor.Values)), ID: cursor.ID, Backward: cursor.Backward}
+	for _, value := range cursor.Values {
+		if t, ok := value.(time.Time); ok {
+			encoded.Values = append(encoded.Values, cursorValue{Time: &t})
+			continue
+		}
+		encoded.Values = append(encoded.Values, cursorValue{Value: value})
+	}
+	data, _ := json.Marshal(encoded)
+	opaque := base64.RawURLEncoding.EncodeToString(data)
+	return &opaque
+}
+
+// DecodeCursor parses a cursor returned by EncodeCursor
+// Whole numbers are read as int64, other numbers as float64
+func DecodeCursor(opaque string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(opaque)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var encoded encodedCursor
+	if err := decoder.Decode(&encoded); err != nil || encoded.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	cursor := &Cursor{Values: make([]interface{}, 0, len(encoded.Values)), ID: encoded.ID, Backward: encoded.Backward}
+	for _, value := range encoded.Values {
+		switch {
+		case value.Time != nil:
+			cursor.Values = append(cursor.Values, *value.Time)
+		case value.Value == nil:
+			cursor.Values = append(cursor.Values, nil)
+		default:
+			switch v := value.Value.(type) {
+			case string, bool:
+				cursor.Values = append(cursor.Values, v)
+			case json.Number:
+				if whole, err := v.Int64(); err == nil {
+					cursor.Values = append(cursor.Values, whole)
+				} else if fraction, err := v.Float64(); err == nil {
+					cursor.Values = append(cursor.Values, fraction)
+				} else {
+					return nil, ErrInvalidCursor
+				}
+			default:
+				return nil, ErrInvalidCursor
+			}
+		}
+	}
+	return cursor, nil
+}
@@ -0,0 +1,52 @@
+package pagination
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	createdAt := time.Date(2030, 1, 2, 3, 4, 5, 600, time.UTC)
+	cursor := &Cursor{Values: []interface{}{createdAt, nil, "Alpha", int64(3), 2.5, true}, ID: "board-1", Backward: true}
+
+	encoded := EncodeCursor(cursor)
+	require.NotNil(t, encoded)
+	decoded, err := DecodeCursor(*encoded)
+	require.NoError(t, err)
+	assert.Equal(t, cursor.ID, decoded.ID)
+	assert.True(t, decoded.Backward)
+	require.Len(t, decoded.Values, 6)
+	assert.True(t, createdAt.Equal(decoded.Values[0].(time.Time)))
+	assert.Equal(t, []interface{}{nil, "Alpha", int64(3), 2.5, true}, decoded.Values[1:])
+
+	assert.Nil(t, EncodeCursor(nil))
+}
+
+func TestDecodeCursor_RejectsInvalidCursors(t *testing.T) {
+	for _, opaque := range []string{"not base64!", "bm90IGpzb24", "eyJ2IjpbXX0", "eyJ2IjpbeyJ2Ijp7fX1dLCJpZCI6IngifQ"} {
+		_, err := DecodeCursor(opaque)
+		assert.ErrorIs(t, err, ErrInvalidCursor, opaque)
+	}
+}
+
+func TestNewCursorRequest(t *testing.T) {
+	req, err := NewCursorRequest("", 0, nil)
+	require.NoError(t, err)
+	assert.Nil(t, req.Cursor)
+	assert.Equal(t, DefaultLimit, req.Limit)
+	assert.True(t, req.IncludeTotal)
+
+	include := true
+	req, err = NewCursorRequest(*EncodeCursor(&Cursor{ID: "board-1"}), 500, &include)
+	require.NoError(t, err)
+	assert.Equal(t, "board-1", req.Cursor.ID)
+	assert.Equal(t, MaxLimit, req.Limit)
+	assert.True(t, req.IncludeTotal)
+
+	req, err = NewCursorRequest(*EncodeCursor(&Cursor{ID: "board-1"}), 10, nil)
+	require.NoError(t, err)
+	assert.False(t, req.IncludeTotal)
+}
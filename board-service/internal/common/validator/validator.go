@@ -147,6 +147,20 @@ func ValidateDateFormat(dateStr, fieldName string) (*time.Time, error) {
 	return &parsed, nil
 }
 
+// ValidateDayFormat checks if a date string is a calendar day (YYYY-MM-DD) and returns its midnight in UTC
+func ValidateDayFormat(dateStr, fieldName string) (*time.Time, error) {
+	parsed, err := time.Parse("2006-01-02", dateStr)
+	if err != nil {
+		return nil, apperrors.Wrap(
+			err,
+			apperrors.ErrCodeBadRequest,
+			fmt.Sprintf("%s의 날짜 형식이 올바르지 않습니다 (YYYY-MM-DD required)", fieldName),
+			400,
+		)
+	}
+	return &parsed, nil
+}
+
 // ValidateFutureDate checks if a date is in the future
 func ValidateFutureDate(date time.Time, fieldName string) error {
 	if date.Before(time.Now()) {
@@ -1,12 +1,19 @@
 package config
 
 import (
+	"crypto/hkdf"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
+// attachmentURLSecretLabel separates the download link key derived from SECRET_KEY from other uses of it
+const attachmentURLSecretLabel = "board-service attachment download links v1"
+
 // Config holds all configuration for the application
 type Config struct {
 	Server struct {
@@ -32,6 +39,52 @@ type Config struct {
 	Log struct {
 		Level string // debug, info, warn, error
 	}
+	Webhook struct {
+		PollIntervalSeconds int // How often the delivery worker looks for due deliveries
+		MaxAttempts         int // Attempts before a delivery is marked FAILED
+	}
+	Outbox struct {
+		PollIntervalMs int    // How often the relay looks for unpublished events
+		Stream         string // Redis stream the relay appends events to
+		StreamMaxLen   int64  // Approximate max entries kept in the stream
+	}
+	Project struct {
+		DeletionMode string // soft: mark project data deleted, purge: remove it permanently
+	}
+	Trash struct {
+		RetentionDays int // Days before deleted items are purged permanently (0 disables)
+	}
+	Comment struct {
+		MaxThreadDepth int // How many levels of replies a top-level comment can have
+	}
+	Attachment struct {
+		Storage          string   // local or s3
+		LocalDir         string   // Root directory of the local storage
+		MaxSizeMB        int      // Largest file that can be uploaded
+		AllowedTypes     []string // MIME types detected from the content; "image/*" allows a whole family
+		URLExpirySeconds int      // How long a signed download link stays valid
+		URLSecret        string   // HMAC secret of download links (defaults to a key derived from SECRET_KEY)
+	}
+	Reminder struct {
+		PollIntervalSeconds int             // How often the scheduler looks for due reminders and digests
+		LeadTimes           []time.Duration // How long before the due date boards are reminded, longest first
+		DigestHour          int             // Local hour (0-23) the daily digest is sent at
+		DigestTimeZone      string          // IANA time zone of the digest hour
+	}
+	SMTP struct {
+		Host     string // Empty logs reminder mails instead of sending them
+		Port     int
+		Username string
+		Password string
+		From     string
+	}
+	S3 struct {
+		Endpoint  string // https://s3.ap-northeast-2.amazonaws.com or http://minio:9000
+		Region    string
+		Bucket    string
+		AccessKey string
+		SecretKey string
+	}
 }
 
 // Load loads configuration from environment variables
@@ -44,6 +97,26 @@ func Load() (*Config, error) {
 	v.SetDefault("USE_AUTO_MIGRATE", true)
 	v.SetDefault("LOG_LEVEL", "info")
 	v.SetDefault("CORS_ORIGINS", "http://localhost:3000")
+	v.SetDefault("WEBHOOK_POLL_INTERVAL_SECONDS", 5)
+	v.SetDefault("WEBHOOK_MAX_ATTEMPTS", 8)
+	v.SetDefault("TRASH_RETENTION_DAYS", 30)
+	v.SetDefault("COMMENT_MAX_THREAD_DEPTH", 3)
+	v.SetDefault("OUTBOX_POLL_INTERVAL_MS", 500)
+	v.SetDefault("OUTBOX_STREAM", "board-service:events")
+	v.SetDefault("OUTBOX_STREAM_MAX_LEN", 100000)
+	v.SetDefault("PROJECT_DELETION_MODE", "soft")
+	v.SetDefault("ATTACHMENT_STORAGE", "local")
+	v.SetDefault("ATTACHMENT_LOCAL_DIR", "./data/attachments")
+	v.SetDefault("ATTACHMENT_MAX_SIZE_MB", 20)
+	v.SetDefault("ATTACHMENT_ALLOWED_TYPES", "image/*,application/pdf,text/plain,application/zip")
+	v.SetDefault("ATTACHMENT_URL_EXPIRY_SECONDS", 300)
+	v.SetDefault("S3_REGION", "us-east-1")
+	v.SetDefault("REMINDER_POLL_INTERVAL_SECONDS", 60)
+	v.SetDefault("REMINDER_LEAD_TIMES", "24h,1h")
+	v.SetDefault("REMINDER_DIGEST_HOUR", 9)
+	v.SetDefault("REMINDER_DIGEST_TIME_ZONE", "UTC")
+	v.SetDefault("SMTP_PORT", 587)
+	v.SetDefault("SMTP_FROM", "weAlist <no-reply@wealist.com>")
 
 	// Bind environment variables only (no .env file loading)
 	v.AutomaticEnv()
@@ -90,5 +163,104 @@ func Load() (*Config, error) {
 	// Logging
 	cfg.Log.Level = v.GetString("LOG_LEVEL")
 
+	// Webhooks
+	cfg.Webhook.PollIntervalSeconds = v.GetInt("WEBHOOK_POLL_INTERVAL_SECONDS")
+	cfg.Webhook.MaxAttempts = v.GetInt("WEBHOOK_MAX_ATTEMPTS")
+
+	// Outbox
+	cfg.Outbox.PollIntervalMs = v.GetInt("OUTBOX_POLL_INTERVAL_MS")
+	cfg.Outbox.Stream = v.GetString("OUTBOX_STREAM")
+	cfg.Outbox.StreamMaxLen = v.GetInt64("OUTBOX_STREAM_MAX_LEN")
+
+	// Project
+	cfg.Project.DeletionMode = strings.ToLower(v.GetString("PROJECT_DELETION_MODE"))
+	if cfg.Project.DeletionMode != "soft" && cfg.Project.DeletionMode != "purge" {
+		return nil, fmt.Errorf("PROJECT_DELETION_MODE must be soft or purge, got %q", cfg.Project.DeletionMode)
+	}
+
+	// Trash
+	cfg.Trash.RetentionDays = v.GetInt("TRASH_RETENTION_DAYS")
+	if cfg.Trash.RetentionDays < 0 {
+		return nil, fmt.Errorf("TRASH_RETENTION_DAYS must not be negative, got %d", cfg.Trash.RetentionDays)
+	}
+
+	// Comments
+	cfg.Comment.MaxThreadDepth = v.GetInt("COMMENT_MAX_THREAD_DEPTH")
+	if cfg.Comment.MaxThreadDepth < 1 {
+		return nil, fmt.Errorf("COMMENT_MAX_THREAD_DEPTH must be at least 1, got %d", cfg.Comment.MaxThreadDepth)
+	}
+
+	// Attachments
+	cfg.Attachment.Storage = strings.ToLower(v.GetString("ATTACHMENT_STORAGE"))
+	if cfg.Attachment.Storage != "local" && cfg.Attachment.Storage != "s3" {
+		return nil, fmt.Errorf("ATTACHMENT_STORAGE must be local or s3, got %q", cfg.Attachment.Storage)
+	}
+	cfg.Attachment.LocalDir = v.GetString("ATTACHMENT_LOCAL_DIR")
+	cfg.Attachment.MaxSizeMB = v.GetInt("ATTACHMENT_MAX_SIZE_MB")
+	if cfg.Attachment.MaxSizeMB < 1 {
+		return nil, fmt.Errorf("ATTACHMENT_MAX_SIZE_MB must be at least 1, got %d", cfg.Attachment.MaxSizeMB)
+	}
+	cfg.Attachment.AllowedTypes = nil
+	for _, allowed := range strings.Split(v.GetString("ATTACHMENT_ALLOWED_TYPES"), ",") {
+		if allowed = strings.ToLower(strings.TrimSpace(allowed)); allowed != "" {
+			cfg.Attachment.AllowedTypes = append(cfg.Attachment.AllowedTypes, allowed)
+		}
+	}
+	cfg.Attachment.URLExpirySeconds = v.GetInt("ATTACHMENT_URL_EXPIRY_SECONDS")
+	if cfg.Attachment.URLExpirySeconds < 1 {
+		return nil, fmt.Errorf("ATTACHMENT_URL_EXPIRY_SECONDS must be at least 1, got %d", cfg.Attachment.URLExpirySeconds)
+	}
+	cfg.Attachment.URLSecret = v.GetString("ATTACHMENT_URL_SECRET")
+	if cfg.Attachment.URLSecret == "" {
+		// Never sign links with the JWT key itself: a leaked link signature must not help forge tokens
+		secret, err := hkdf.Key(sha256.New, []byte(cfg.JWT.Secret), nil, attachmentURLSecretLabel, 32)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive the attachment URL secret: %w", err)
+		}
+		cfg.Attachment.URLSecret = hex.EncodeToString(secret)
+	}
+
+	// Reminders
+	cfg.Reminder.PollIntervalSeconds = v.GetInt("REMINDER_POLL_INTERVAL_SECONDS")
+	if cfg.Reminder.PollIntervalSeconds < 1 {
+		return nil, fmt.Errorf("REMINDER_POLL_INTERVAL_SECONDS must be at least 1, got %d", cfg.Reminder.PollIntervalSeconds)
+	}
+	cfg.Reminder.LeadTimes = nil
+	for _, lead := range strings.Split(v.GetString("REMINDER_LEAD_TIMES"), ",") {
+		if lead = strings.TrimSpace(lead); lead == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(lead)
+		if err != nil || duration < time.Minute {
+			return nil, fmt.Errorf("REMINDER_LEAD_TIMES must be durations of at least 1m, got %q", lead)
+		}
+		if n := len(cfg.Reminder.LeadTimes); n > 0 && duration >= cfg.Reminder.LeadTimes[n-1] {
+			return nil, fmt.Errorf("REMINDER_LEAD_TIMES must be listed longest first, got %q", v.GetString("REMINDER_LEAD_TIMES"))
+		}
+		cfg.Reminder.LeadTimes = append(cfg.Reminder.LeadTimes, duration)
+	}
+	cfg.Reminder.DigestHour = v.GetInt("REMINDER_DIGEST_HOUR")
+	if cfg.Reminder.DigestHour < 0 || cfg.Reminder.DigestHour > 23 {
+		return nil, fmt.Errorf("REMINDER_DIGEST_HOUR must be between 0 and 23, got %d", cfg.Reminder.DigestHour)
+	}
+	cfg.Reminder.DigestTimeZone = v.GetString("REMINDER_DIGEST_TIME_ZONE")
+	if _, err := time.LoadLocation(cfg.Reminder.DigestTimeZone); err != nil {
+		return nil, fmt.Errorf("REMINDER_DIGEST_TIME_ZONE is not a known time zone: %q", cfg.Reminder.DigestTimeZone)
+	}
+
+	// SMTP (reminder digests)
+	cfg.SMTP.Host = v.GetString("SMTP_HOST")
+	cfg.SMTP.Port = v.GetInt("SMTP_PORT")
+	cfg.SMTP.Username = v.GetString("SMTP_USERNAME")
+	cfg.SMTP.Password = v.GetString("SMTP_PASSWORD")
+	cfg.SMTP.From = v.GetString("SMTP_FROM")
+
+	// S3-compatible storage (only read when ATTACHMENT_STORAGE=s3)
+	cfg.S3.Endpoint = v.GetString("S3_ENDPOINT")
+	cfg.S3.Region = v.GetString("S3_REGION")
+	cfg.S3.Bucket = v.GetString("S3_BUCKET")
+	cfg.S3.AccessKey = v.GetString("S3_ACCESS_KEY")
+	cfg.S3.SecretKey = v.GetString("S3_SECRET_KEY")
+
 	return cfg, nil
 }
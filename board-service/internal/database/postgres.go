@@ -77,13 +77,38 @@ func autoMigrateAll(db *gorm.DB, logger *zap.Logger) error {
 		&domain.ProjectMember{},
 		&domain.ProjectJoinRequest{},
 		&domain.Board{},
+		&domain.BoardKeyAlias{},    // Previous board keys kept resolvable after project moves
+		&domain.BoardRelation{},    // Blocks / relates / duplicates links between boards
+		&domain.ChecklistItem{},    // Checklist steps of a board
+		&domain.Attachment{},       // Files of boards and comments (content in storage.Storage)
+		&domain.AttachmentBlob{},   // Locks of stored attachment content
+		&domain.WorkLog{},          // Time spent on boards
+		&domain.Sprint{},           // Project iterations
+		&domain.SprintBoard{},      // Sprint scope history
+		&domain.BoardStageChange{}, // Stage change log for project analytics
+		&domain.Milestone{},        // Project release targets
+		&domain.ProjectTemplate{},  // Reusable project structures of a workspace
+		&domain.BoardTemplate{},    // Reusable board skeletons of a project
+		&domain.BoardRecurrence{},  // Schedules creating boards from board templates
 		&domain.Comment{},
+		&domain.CommentReaction{}, // Emoji reactions on comments
 		// Custom fields system (new ProjectField system replaces CustomRole/CustomStage/CustomImportance)
 		&domain.ProjectField{},
 		&domain.FieldOption{},
 		&domain.BoardFieldValue{},
 		&domain.SavedView{},
 		&domain.UserBoardOrder{}, // Fractional indexing for board ordering in views
+		&domain.BoardActivity{},  // Board change history (audit trail)
+		// Outgoing webhooks
+		&domain.Webhook{},
+		&domain.WebhookDelivery{},
+		&domain.WebhookDeliveryAttempt{},
+		&domain.OutboxEvent{},    // Transactional outbox for domain events
+		&domain.TrashItem{},      // Restorable deleted boards, comments and projects
+		&domain.Notification{},   // Per-user notification inbox
+		&domain.BoardWatcher{},   // Recipients of board-level notifications
+		&domain.BoardReminder{},  // Sent due date reminders (idempotency markers)
+		&domain.ReminderDigest{}, // Sent daily reminder digests (idempotency markers)
 	}
 
 	return db.AutoMigrate(models...)
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Attachment is a file uploaded to a board, optionally as part of one of its comments
+// The content is stored once per SHA-256 checksum; attachments with the same content share the object
+type Attachment struct {
+	BaseModel
+	BoardID     uuid.UUID  `gorm:"type:uuid;not null;index" json:"board_id"`
+	CommentID   *uuid.UUID `gorm:"type:uuid;index" json:"comment_id"`
+	FileName    string     `gorm:"type:varchar(255);not null" json:"file_name"`
+	ContentType string     `gorm:"type:varchar(100);not null" json:"content_type"` // Detected from the content, not the client
+	Size        int64      `gorm:"not null" json:"size"`
+	Checksum    string     `gorm:"type:varchar(64);not null" json:"checksum"` // Hex-encoded SHA-256 of the content
+	StorageKey  string     `gorm:"type:varchar(255);not null;index" json:"storage_key"`
+	UploadedBy  uuid.UUID  `gorm:"type:uuid;not null" json:"uploaded_by"`
+}
+
+func (Attachment) TableName() string {
+	return "attachments"
+}
+
+// AttachmentBlob is the lock row of a stored object, keyed like the attachments that share it
+// Uploads reusing the object and the removal after its last attachment is deleted lock the row,
+// so the object is never removed while an attachment that reuses it is being created.
+// The row outlives the object and is locked again when the same content is uploaded later
+type AttachmentBlob struct {
+	StorageKey string    `gorm:"type:varchar(255);primaryKey" json:"storage_key"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (AttachmentBlob) TableName() string {
+	return "attachment_blobs"
+}
+
+// MaxAttachmentFileNameLength is the maximum length of a file name in characters
+const MaxAttachmentFileNameLength = 255
+
+// NewAttachment creates an attachment of a board or, with a comment ID, of one of its comments
+func NewAttachment(boardID uuid.UUID, commentID *uuid.UUID, fileName, contentType string, size int64, checksum, storageKey string, uploadedBy uuid.UUID) (*Attachment, error) {
+	name, err := sanitizeFileName(fileName)
+	if err != nil {
+		return nil, err
+	}
+	if size <= 0 {
+		return nil, NewValidationError("file", "빈 파일은 첨부할 수 없습니다")
+	}
+	return &Attachment{
+		BoardID:     boardID,
+		CommentID:   commentID,
+		FileName:    name,
+		ContentType: contentType,
+		Size:        size,
+		Checksum:    checksum,
+		StorageKey:  storageKey,
+		UploadedBy:  uploadedBy,
+	}, nil
+}
+
+// IsCommentAttachment reports whether the file was attached to a comment rather than to the board itself
+func (a *Attachment) IsCommentAttachment() bool {
+	return a.CommentID != nil
+}
+
+// sanitizeFileName keeps the base name of an uploaded file without path or control characters
+func sanitizeFileName(fileName string) (string, error) {
+	name := filepath.Base(strings.ReplaceAll(fileName, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+
+	if name == "" || name == "." || name == "/" {
+		return "", NewValidationError("file", "파일 이름은 필수입니다")
+	}
+	if utf8.RuneCountInString(name) > MaxAttachmentFileNameLength {
+		return "", NewValidationError("file", "파일 이름은 255자를 초과할 수 없습니다")
+	}
+	return name, nil
+}
@@ -8,13 +8,22 @@ import (
 
 type Board struct {
 	BaseModel
-	ProjectID          uuid.UUID   `gorm:"type:uuid;not null;index" json:"project_id"`
+	ProjectID          uuid.UUID   `gorm:"type:uuid;not null;index;uniqueIndex:idx_boards_project_number,priority:1" json:"project_id"`
+	Number             int         `gorm:"not null;uniqueIndex:idx_boards_project_number,priority:2" json:"number"` // Gap-free per project, allocated from Project.BoardSequence
+	Key                string      `gorm:"type:varchar(20);not null;uniqueIndex" json:"key"`                       // Project key + number (e.g. "WEB-123"), denormalized for lookups
 	Title              string      `gorm:"type:varchar(255);not null" json:"title"`
 	Description        string      `gorm:"type:text" json:"description"`
 	AssigneeID         *uuid.UUID  `gorm:"type:uuid;index" json:"assignee_id"`
 	ParticipantIDs     []uuid.UUID `gorm:"type:uuid[];default:'{}'" json:"participant_ids"` // Multiple assignees (participants)
 	CreatedBy          uuid.UUID   `gorm:"type:uuid;not null;index" json:"created_by"`
 	DueDate            *time.Time  `gorm:"index" json:"due_date"`
+	ParentBoardID      *uuid.UUID  `gorm:"type:uuid;index" json:"parent_board_id"` // Set when the board is a sub-task of another board of the project
+	SprintID           *uuid.UUID  `gorm:"type:uuid;index" json:"sprint_id"`       // Sprint the board is planned in (nil for the backlog)
+	MilestoneID        *uuid.UUID  `gorm:"type:uuid;index" json:"milestone_id"`    // Milestone (release) the board is targeted at
+
+	// Time tracking estimates in minutes (logged time is the sum of the board's WorkLogs)
+	OriginalEstimateMinutes  *int `json:"original_estimate_minutes"`
+	RemainingEstimateMinutes *int `json:"remaining_estimate_minutes"`
 
 	// Custom fields cache (JSONB for fast filtering with GIN index)
 	// All custom fields (stages, roles, importance, etc.) are stored here
@@ -29,10 +38,32 @@ func (Board) TableName() string {
 
 // IsOverdue returns true if the board has a due date and it's in the past
 func (b *Board) IsOverdue() bool {
+	return b.IsOverdueAt(time.Now())
+}
+
+// IsOverdueAt returns true if the board has a due date before now
+func (b *Board) IsOverdueAt(now time.Time) bool {
 	if b.DueDate == nil {
 		return false
 	}
-	return b.DueDate.Before(time.Now())
+	return b.DueDate.Before(now)
+}
+
+// Assignees returns the assignee and the participants of the board without duplicates
+func (b *Board) Assignees() []uuid.UUID {
+	assignees := make([]uuid.UUID, 0, len(b.ParticipantIDs)+1)
+	seen := make(map[uuid.UUID]bool, len(b.ParticipantIDs)+1)
+	if b.AssigneeID != nil {
+		assignees = append(assignees, *b.AssigneeID)
+		seen[*b.AssigneeID] = true
+	}
+	for _, participantID := range b.ParticipantIDs {
+		if !seen[participantID] {
+			seen[participantID] = true
+			assignees = append(assignees, participantID)
+		}
+	}
+	return assignees
 }
 
 // IsAssigned returns true if the board has an assignee
@@ -88,6 +119,67 @@ func (b *Board) IsCreatedBy(userID uuid.UUID) bool {
 	return b.CreatedBy == userID
 }
 
+// IsSubtask returns true if the board has a parent board
+func (b *Board) IsSubtask() bool {
+	return b.ParentBoardID != nil
+}
+
+// SetParent makes the board a sub-task of the given board of the same project
+// Cycles through deeper levels (A → B → A) are checked by the service against the stored hierarchy
+func (b *Board) SetParent(parent *Board) error {
+	if parent.ID == b.ID {
+		return NewValidationError("parentBoardId", "보드를 자기 자신의 하위 보드로 지정할 수 없습니다")
+	}
+	if parent.ProjectID != b.ProjectID {
+		return NewValidationError("parentBoardId", "같은 프로젝트의 보드만 상위 보드로 지정할 수 있습니다")
+	}
+	parentID := parent.ID
+	b.ParentBoardID = &parentID
+	b.UpdatedAt = time.Now()
+	return nil
+}
+
+// ClearParent makes the board a top-level board again
+func (b *Board) ClearParent() {
+	b.ParentBoardID = nil
+	b.UpdatedAt = time.Now()
+}
+
+// AssignSprint plans the board in a sprint, or moves it back to the backlog (nil)
+func (b *Board) AssignSprint(sprintID *uuid.UUID) {
+	b.SprintID = sprintID
+	b.UpdatedAt = time.Now()
+}
+
+// AssignMilestone targets the board at a milestone, or removes it from its milestone (nil)
+func (b *Board) AssignMilestone(milestoneID *uuid.UUID) {
+	b.MilestoneID = milestoneID
+	b.UpdatedAt = time.Now()
+}
+
+// SetEstimates replaces the original and remaining estimates of the board (nil clears an estimate)
+func (b *Board) SetEstimates(originalMinutes, remainingMinutes *int) error {
+	if originalMinutes != nil && (*originalMinutes < 0 || *originalMinutes > MaxEstimateMinutes) {
+		return NewValidationError("originalEstimateMinutes", "예상 시간은 0분 이상 100000시간 이하여야 합니다")
+	}
+	if remainingMinutes != nil && (*remainingMinutes < 0 || *remainingMinutes > MaxEstimateMinutes) {
+		return NewValidationError("remainingEstimateMinutes", "남은 예상 시간은 0분 이상 100000시간 이하여야 합니다")
+	}
+	b.OriginalEstimateMinutes = originalMinutes
+	b.RemainingEstimateMinutes = remainingMinutes
+	b.UpdatedAt = time.Now()
+	return nil
+}
+
+// AssignNumber gives the board its number and key in the project
+// Moving a board to another project assigns a new number; the previous key is kept as a BoardKeyAlias
+func (b *Board) AssignNumber(project *Project, number int) {
+	b.ProjectID = project.ID
+	b.Number = number
+	b.Key = project.BoardKey(number)
+	b.UpdatedAt = time.Now()
+}
+
 // MarkAsDeleted marks the board as deleted (soft delete)
 func (b *Board) MarkAsDeleted() {
 	b.IsDeleted = true
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// BoardActivityAction represents the kind of change recorded in a board activity
+type BoardActivityAction string
+
+const (
+	BoardActivityCreated           BoardActivityAction = "BOARD_CREATED"
+	BoardActivityUpdated           BoardActivityAction = "BOARD_UPDATED"       // title, description, assignee, participants, due_date
+	BoardActivityFieldValueChanged BoardActivityAction = "FIELD_VALUE_CHANGED" // custom field value set/cleared
+	BoardActivityMoved             BoardActivityAction = "BOARD_MOVED"         // moved between groups in a view
+	BoardActivityDeleted           BoardActivityAction = "BOARD_DELETED"       // moved to the trash
+	BoardActivityCommentAdded      BoardActivityAction = "COMMENT_ADDED"
+	BoardActivityCommentUpdated    BoardActivityAction = "COMMENT_UPDATED"
+	BoardActivityCommentDeleted    BoardActivityAction = "COMMENT_DELETED"
+)
+
+// Built-in board attributes tracked by BOARD_UPDATED activities
+const (
+	BoardActivityFieldTitle        = "title"
+	BoardActivityFieldDescription  = "description"
+	BoardActivityFieldAssignee     = "assignee"
+	BoardActivityFieldParticipants = "participants"
+	BoardActivityFieldDueDate      = "due_date"
+	BoardActivityFieldKey          = "key"        // Board moved to another project
+	BoardActivityFieldRelation     = "relation"   // Relation to another board added or removed
+	BoardActivityFieldParent       = "parent"     // Parent board (sub-task hierarchy)
+	BoardActivityFieldChecklist    = "checklist"  // Checklist item added, changed or removed
+	BoardActivityFieldAttachment   = "attachment" // File attached or removed
+	BoardActivityFieldEstimate     = "estimate"   // Original or remaining estimate
+	BoardActivityFieldWorkLog      = "work_log"   // Work log added, changed or removed
+	BoardActivityFieldSprint       = "sprint"     // Sprint the board is planned in
+	BoardActivityFieldMilestone    = "milestone"  // Milestone the board is targeted at
+	BoardActivityFieldCustom       = "custom_field"
+	BoardActivityFieldComment      = "comment"
+)
+
+// BoardActivity is an append-only audit record of a single change on a board
+// OldValue/NewValue are stored as JSON so that any value type (string, array, option) can be represented
+type BoardActivity struct {
+	BaseModel
+	BoardID   uuid.UUID           `gorm:"type:uuid;not null;index" json:"board_id"`
+	ProjectID uuid.UUID           `gorm:"type:uuid;not null;index" json:"project_id"`
+	ActorID   uuid.UUID           `gorm:"type:uuid;not null;index" json:"actor_id"`
+	Action    BoardActivityAction `gorm:"type:varchar(50);not null;index" json:"action"`
+	Field     string              `gorm:"type:varchar(100)" json:"field"`      // Changed attribute (see BoardActivityField* constants)
+	FieldID   *uuid.UUID          `gorm:"type:uuid;index" json:"field_id"`     // Custom field ID (custom_field only)
+	FieldName string              `gorm:"type:varchar(255)" json:"field_name"` // Custom field name at the time of change
+	CommentID *uuid.UUID          `gorm:"type:uuid" json:"comment_id"`         // Comment ID (comment activities only)
+	OldValue  *string             `gorm:"type:text" json:"old_value"`          // JSON encoded previous value
+	NewValue  *string             `gorm:"type:text" json:"new_value"`          // JSON encoded new value
+}
+
+func (BoardActivity) TableName() string {
+	return "board_activities"
+}
+
+// ==================== Rich Domain Model - Business Methods ====================
+
+// NewBoardActivity creates an activity for the given board and actor
+func NewBoardActivity(board *Board, actorID uuid.UUID, action BoardActivityAction) BoardActivity {
+	return BoardActivity{
+		BoardID:   board.ID,
+		ProjectID: board.ProjectID,
+		ActorID:   actorID,
+		Action:    action,
+	}
+}
+
+// SetChange records the changed attribute with its previous and new values
+func (a *BoardActivity) SetChange(field string, oldValue, newValue *string) {
+	a.Field = field
+	a.OldValue = oldValue
+	a.NewValue = newValue
+}
+
+// SetCustomFieldChange records a change of the given custom field with its previous and new values
+func (a *BoardActivity) SetCustomFieldChange(field *ProjectField, oldValue, newValue *string) {
+	fieldID := field.ID
+	a.SetChange(BoardActivityFieldCustom, oldValue, newValue)
+	a.FieldID = &fieldID
+	a.FieldName = field.Name
+}
+
+// HasChanged returns true if the old and new values differ
+func (a *BoardActivity) HasChanged() bool {
+	if a.OldValue == nil || a.NewValue == nil {
+		return a.OldValue != a.NewValue
+	}
+	return *a.OldValue != *a.NewValue
+}
+
+// IsCommentActivity returns true if the activity was caused by a comment
+func (a *BoardActivity) IsCommentActivity() bool {
+	return a.Action == BoardActivityCommentAdded ||
+		a.Action == BoardActivityCommentUpdated ||
+		a.Action == BoardActivityCommentDeleted
+}
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// BoardKeyAlias keeps a previous key of a board that moved to another project
+// so that references in chat, commits and standups keep resolving to the board
+type BoardKeyAlias struct {
+	BaseModel
+	Key     string    `gorm:"type:varchar(20);not null;uniqueIndex" json:"key"`
+	BoardID uuid.UUID `gorm:"type:uuid;not null;index" json:"board_id"`
+}
+
+func (BoardKeyAlias) TableName() string {
+	return "board_key_aliases"
+}
+
+// NormalizeBoardKey trims and upper-cases a board key given by a user ("web-12" -> "WEB-12")
+func NormalizeBoardKey(key string) string {
+	return strings.ToUpper(strings.TrimSpace(key))
+}
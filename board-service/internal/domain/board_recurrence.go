@@ -0,0 +1,305 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecurrenceFrequency is the unit of a recurrence rule
+type RecurrenceFrequency string
+
+const (
+	RecurrenceDaily   RecurrenceFrequency = "DAILY"
+	RecurrenceWeekly  RecurrenceFrequency = "WEEKLY"
+	RecurrenceMonthly RecurrenceFrequency = "MONTHLY"
+)
+
+// IsValid returns true for a known frequency
+func (f RecurrenceFrequency) IsValid() bool {
+	switch f {
+	case RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly:
+		return true
+	}
+	return false
+}
+
+const (
+	// MaxRecurrenceInterval is the largest number of days, weeks or months between occurrences
+	MaxRecurrenceInterval = 31
+
+	// MaxRecurrenceOccurrences is the largest count end condition
+	MaxRecurrenceOccurrences = 1000
+
+	// DefaultRecurrenceTimeOfDay is the local time boards are created at when none is given
+	DefaultRecurrenceTimeOfDay = "09:00"
+
+	// recurrenceSearchDays bounds the search for the next occurrence (MONTHLY every 31 months is ~943 days)
+	recurrenceSearchDays = 1000
+)
+
+// recurrenceWeekdays maps the RRULE weekday codes to time.Weekday
+var recurrenceWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// RecurrenceWeekdayCode returns the RRULE code (MO, TU, ...) of a weekday
+func RecurrenceWeekdayCode(day time.Weekday) string {
+	for code, weekday := range recurrenceWeekdays {
+		if weekday == day {
+			return code
+		}
+	}
+	return ""
+}
+
+// RecurrenceRule is an RRULE-like schedule: every Interval days, weeks (on Weekdays) or months
+// (on MonthDay) at TimeOfDay in the project's time zone, from StartsOn until EndsOn or MaxOccurrences
+type RecurrenceRule struct {
+	Frequency      RecurrenceFrequency
+	Interval       int            // 1 if zero
+	Weekdays       []time.Weekday // WEEKLY; the weekday of StartsOn if empty
+	MonthDay       int            // MONTHLY (1-31); the day of StartsOn if zero; the last day in shorter months
+	TimeOfDay      string         // HH:MM; DefaultRecurrenceTimeOfDay if empty
+	StartsOn       time.Time      // First day (midnight UTC, read as a local calendar day)
+	EndsOn         *time.Time     // Last day, inclusive (midnight UTC)
+	MaxOccurrences *int           // Number of boards to create
+}
+
+// BoardRecurrence creates boards from a board template on a schedule
+// NextRunAt is the instant of the next occurrence (nil once the rule has ended);
+// the scheduler creates the board and advances it in the same transaction
+type BoardRecurrence struct {
+	BaseModel
+	TemplateID      uuid.UUID           `gorm:"type:uuid;not null;index" json:"template_id"`
+	ProjectID       uuid.UUID           `gorm:"type:uuid;not null;index" json:"project_id"`
+	Frequency       RecurrenceFrequency `gorm:"type:varchar(10);not null" json:"frequency"`
+	Interval        int                 `gorm:"column:repeat_interval;not null;default:1" json:"interval"` // Every n days, weeks or months
+	Weekdays        string              `gorm:"type:varchar(20)" json:"weekdays"`                          // Comma separated RRULE codes, e.g. "MO,TH"
+	MonthDay        int                 `gorm:"not null;default:0" json:"month_day"`                       // 0 unless MONTHLY
+	TimeOfDay       string              `gorm:"type:varchar(5);not null" json:"time_of_day"`
+	StartsOn        time.Time           `gorm:"type:date;not null" json:"starts_on"`
+	EndsOn          *time.Time          `gorm:"type:date" json:"ends_on"`
+	MaxOccurrences  *int                `json:"max_occurrences"`
+	OccurrenceCount int                 `gorm:"not null;default:0" json:"occurrence_count"` // Boards created so far
+	NextRunAt       *time.Time          `gorm:"index" json:"next_run_at"`
+	LastRunAt       *time.Time          `json:"last_run_at"`
+	LastBoardID     *uuid.UUID          `gorm:"type:uuid" json:"last_board_id"`
+	CreatedBy       uuid.UUID           `gorm:"type:uuid;not null" json:"created_by"` // Author of the created boards
+}
+
+func (BoardRecurrence) TableName() string {
+	return "board_recurrences"
+}
+
+// NewBoardRecurrence creates the recurrence of a board template with a validated rule
+func NewBoardRecurrence(template *BoardTemplate, rule RecurrenceRule, createdBy uuid.UUID) (*BoardRecurrence, error) {
+	recurrence := &BoardRecurrence{
+		TemplateID: template.ID,
+		ProjectID:  template.ProjectID,
+		CreatedBy:  createdBy,
+	}
+	if err := recurrence.SetRule(rule); err != nil {
+		return nil, err
+	}
+	return recurrence, nil
+}
+
+// ==================== Rich Domain Model - Business Methods ====================
+
+// SetRule replaces the schedule with validation and restarts the occurrence count
+// The caller schedules the next run afterwards
+func (r *BoardRecurrence) SetRule(rule RecurrenceRule) error {
+	if !rule.Frequency.IsValid() {
+		return NewValidationError("frequency", "반복 주기는 DAILY, WEEKLY, MONTHLY 중 하나여야 합니다")
+	}
+	if rule.Interval == 0 {
+		rule.Interval = 1
+	}
+	if rule.Interval < 1 || rule.Interval > MaxRecurrenceInterval {
+		return NewValidationError("interval", "반복 간격은 1~31이어야 합니다")
+	}
+	if rule.TimeOfDay == "" {
+		rule.TimeOfDay = DefaultRecurrenceTimeOfDay
+	}
+	if _, err := time.Parse("15:04", rule.TimeOfDay); err != nil {
+		return NewValidationError("timeOfDay", "생성 시각은 HH:MM 형식이어야 합니다")
+	}
+	if rule.EndsOn != nil && rule.EndsOn.Before(rule.StartsOn) {
+		return NewValidationError("endsOn", "종료일은 시작일보다 빠를 수 없습니다")
+	}
+	if rule.MaxOccurrences != nil && (*rule.MaxOccurrences < 1 || *rule.MaxOccurrences > MaxRecurrenceOccurrences) {
+		return NewValidationError("maxOccurrences", "반복 횟수는 1~1000이어야 합니다")
+	}
+
+	r.Weekdays = ""
+	r.MonthDay = 0
+	switch rule.Frequency {
+	case RecurrenceWeekly:
+		weekdays := rule.Weekdays
+		if len(weekdays) == 0 {
+			weekdays = []time.Weekday{rule.StartsOn.Weekday()}
+		}
+		codes := make([]string, 0, len(weekdays))
+		seen := map[time.Weekday]bool{}
+		// Monday first, like the week the interval is counted in
+		for _, day := range []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday} {
+			for _, weekday := range weekdays {
+				if weekday == day && !seen[day] {
+					seen[day] = true
+					codes = append(codes, RecurrenceWeekdayCode(day))
+				}
+			}
+		}
+		r.Weekdays = strings.Join(codes, ",")
+	case RecurrenceMonthly:
+		monthDay := rule.MonthDay
+		if monthDay == 0 {
+			monthDay = rule.StartsOn.Day()
+		}
+		if monthDay < 1 || monthDay > 31 {
+			return NewValidationError("monthDay", "반복 날짜는 1~31이어야 합니다")
+		}
+		r.MonthDay = monthDay
+	}
+
+	r.Frequency = rule.Frequency
+	r.Interval = rule.Interval
+	r.TimeOfDay = rule.TimeOfDay
+	r.StartsOn = rule.StartsOn
+	r.EndsOn = rule.EndsOn
+	r.MaxOccurrences = rule.MaxOccurrences
+	r.OccurrenceCount = 0
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+// WeekdayCodes returns the weekdays of a WEEKLY rule (e.g. ["MO", "TH"])
+func (r *BoardRecurrence) WeekdayCodes() []string {
+	if r.Weekdays == "" {
+		return []string{}
+	}
+	return strings.Split(r.Weekdays, ",")
+}
+
+// Schedule sets NextRunAt to the first occurrence after the given instant in the time zone,
+// or to nil when the end date or the number of occurrences has been reached
+func (r *BoardRecurrence) Schedule(after time.Time, loc *time.Location) {
+	r.NextRunAt = r.nextOccurrence(after, loc)
+	r.UpdatedAt = time.Now()
+}
+
+// RecordRun counts a created board and schedules the next occurrence after now
+// Occurrences missed while no scheduler was running are skipped, not created in a burst
+func (r *BoardRecurrence) RecordRun(boardID uuid.UUID, now time.Time, loc *time.Location) {
+	r.OccurrenceCount++
+	r.LastRunAt = &now
+	r.LastBoardID = &boardID
+	r.Schedule(now, loc)
+}
+
+// Stop ends the rule without creating further boards
+func (r *BoardRecurrence) Stop() {
+	r.NextRunAt = nil
+	r.UpdatedAt = time.Now()
+}
+
+// IsDue returns true if the next occurrence is not after now
+func (r *BoardRecurrence) IsDue(now time.Time) bool {
+	return r.NextRunAt != nil && !r.NextRunAt.After(now)
+}
+
+// nextOccurrence walks the calendar days of the time zone from the later of the start day
+// and the day of `after`, returning the first matching day whose creation time is after `after`
+func (r *BoardRecurrence) nextOccurrence(after time.Time, loc *time.Location) *time.Time {
+	if r.MaxOccurrences != nil && r.OccurrenceCount >= *r.MaxOccurrences {
+		return nil
+	}
+	clock, err := time.Parse("15:04", r.TimeOfDay)
+	if err != nil {
+		return nil
+	}
+
+	start := calendarDay(r.StartsOn)
+	day := calendarDay(after.In(loc))
+	if day.Before(start) {
+		day = start
+	}
+	for i := 0; i < recurrenceSearchDays; i, day = i+1, day.AddDate(0, 0, 1) {
+		if r.EndsOn != nil && day.After(calendarDay(*r.EndsOn)) {
+			return nil
+		}
+		if !r.matches(day, start) {
+			continue
+		}
+		at := time.Date(day.Year(), day.Month(), day.Day(), clock.Hour(), clock.Minute(), 0, 0, loc)
+		// A time in a DST gap may normalize to before the gap; move it forward past the gap instead
+		if wall, want := at.Hour()*60+at.Minute(), clock.Hour()*60+clock.Minute(); wall < want {
+			at = at.Add(time.Duration(want-wall) * time.Minute)
+		}
+		if at.After(after) {
+			at = at.UTC()
+			return &at
+		}
+	}
+	return nil
+}
+
+// matches reports whether a calendar day is an occurrence day of the rule
+func (r *BoardRecurrence) matches(day, start time.Time) bool {
+	switch r.Frequency {
+	case RecurrenceDaily:
+		days := int(day.Sub(start).Hours() / 24)
+		return days%r.Interval == 0
+	case RecurrenceWeekly:
+		weeks := int(weekStart(day).Sub(weekStart(start)).Hours() / (24 * 7))
+		if weeks%r.Interval != 0 {
+			return false
+		}
+		code := RecurrenceWeekdayCode(day.Weekday())
+		for _, weekday := range r.WeekdayCodes() {
+			if weekday == code {
+				return true
+			}
+		}
+		return false
+	case RecurrenceMonthly:
+		months := (day.Year()-start.Year())*12 + int(day.Month()-start.Month())
+		if months%r.Interval != 0 {
+			return false
+		}
+		lastDay := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		monthDay := r.MonthDay
+		if monthDay > lastDay {
+			monthDay = lastDay
+		}
+		return day.Day() == monthDay
+	}
+	return false
+}
+
+// calendarDay returns the calendar day of t as midnight UTC
+func calendarDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// weekStart returns the Monday of the week of a calendar day
+func weekStart(day time.Time) time.Time {
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// ParseRecurrenceWeekdays converts RRULE weekday codes (MO, TU, ...) to weekdays
+func ParseRecurrenceWeekdays(codes []string) ([]time.Weekday, error) {
+	weekdays := make([]time.Weekday, 0, len(codes))
+	for _, code := range codes {
+		weekday, ok := recurrenceWeekdays[strings.ToUpper(strings.TrimSpace(code))]
+		if !ok {
+			return nil, NewValidationError("weekdays", "요일은 MO, TU, WE, TH, FR, SA, SU 중 하나여야 합니다")
+		}
+		weekdays = append(weekdays, weekday)
+	}
+	return weekdays, nil
+}
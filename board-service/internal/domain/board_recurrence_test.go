@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRecurrence(t *testing.T, rule RecurrenceRule) *BoardRecurrence {
+	template, err := NewBoardTemplate(uuid.New(), "Weekly sync", uuid.New())
+	require.NoError(t, err)
+	recurrence, err := NewBoardRecurrence(template, rule, uuid.New())
+	require.NoError(t, err)
+	return recurrence
+}
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+// runs collects the next n occurrences, recording a run at each
+func runs(recurrence *BoardRecurrence, after time.Time, loc *time.Location, n int) []time.Time {
+	var occurrences []time.Time
+	recurrence.Schedule(after, loc)
+	for i := 0; i < n && recurrence.NextRunAt != nil; i++ {
+		next := *recurrence.NextRunAt
+		occurrences = append(occurrences, next)
+		recurrence.RecordRun(uuid.New(), next, loc)
+	}
+	return occurrences
+}
+
+func TestBoardRecurrence_SetRule_Validates(t *testing.T) {
+	template, err := NewBoardTemplate(uuid.New(), "Weekly sync", uuid.New())
+	require.NoError(t, err)
+	ends := day(2029, 12, 31)
+	tooMany := MaxRecurrenceOccurrences + 1
+
+	for name, rule := range map[string]RecurrenceRule{
+		"frequency":    {Frequency: "YEARLY", StartsOn: day(2030, 1, 7)},
+		"interval":     {Frequency: RecurrenceDaily, Interval: MaxRecurrenceInterval + 1, StartsOn: day(2030, 1, 7)},
+		"time of day":  {Frequency: RecurrenceDaily, TimeOfDay: "25:00", StartsOn: day(2030, 1, 7)},
+		"ends on":      {Frequency: RecurrenceDaily, StartsOn: day(2030, 1, 7), EndsOn: &ends},
+		"occurrences":  {Frequency: RecurrenceDaily, StartsOn: day(2030, 1, 7), MaxOccurrences: &tooMany},
+		"month of day": {Frequency: RecurrenceMonthly, MonthDay: 32, StartsOn: day(2030, 1, 7)},
+	} {
+		_, err := NewBoardRecurrence(template, rule, uuid.New())
+		assert.Error(t, err, name)
+	}
+
+	_, err = ParseRecurrenceWeekdays([]string{"MO", "XX"})
+	assert.Error(t, err)
+}
+
+func TestBoardRecurrence_Defaults(t *testing.T) {
+	weekly := newTestRecurrence(t, RecurrenceRule{Frequency: RecurrenceWeekly, StartsOn: day(2030, 1, 10)})
+	assert.Equal(t, []string{"TH"}, weekly.WeekdayCodes(), "the weekday of the start day")
+	assert.Equal(t, 1, weekly.Interval)
+	assert.Equal(t, DefaultRecurrenceTimeOfDay, weekly.TimeOfDay)
+
+	weekdays, err := ParseRecurrenceWeekdays([]string{"th", "MO", "TH"})
+	require.NoError(t, err)
+	weekly = newTestRecurrence(t, RecurrenceRule{Frequency: RecurrenceWeekly, Weekdays: weekdays, StartsOn: day(2030, 1, 7)})
+	assert.Equal(t, []string{"MO", "TH"}, weekly.WeekdayCodes(), "Monday first, without duplicates")
+
+	monthly := newTestRecurrence(t, RecurrenceRule{Frequency: RecurrenceMonthly, StartsOn: day(2030, 1, 31)})
+	assert.Equal(t, 31, monthly.MonthDay, "the day of the start day")
+}
+
+func TestBoardRecurrence_Daily(t *testing.T) {
+	recurrence := newTestRecurrence(t, RecurrenceRule{Frequency: RecurrenceDaily, Interval: 3, TimeOfDay: "08:30", StartsOn: day(2030, 1, 7)})
+
+	// Scheduling before the start day waits for the start day
+	assert.Equal(t, []time.Time{
+		time.Date(2030, 1, 7, 8, 30, 0, 0, time.UTC),
+		time.Date(2030, 1, 10, 8, 30, 0, 0, time.UTC),
+		time.Date(2030, 1, 13, 8, 30, 0, 0, time.UTC),
+	}, runs(recurrence, day(2029, 12, 1), time.UTC, 3))
+}
+
+func TestBoardRecurrence_WeeklyInTimeZone(t *testing.T) {
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	require.NoError(t, err)
+	// Every other week on Monday and Thursday, starting on Thursday 2030-01-10
+	recurrence := newTestRecurrence(t, RecurrenceRule{
+		Frequency: RecurrenceWeekly, Interval: 2, Weekdays: []time.Weekday{time.Monday, time.Thursday}, StartsOn: day(2030, 1, 10),
+	})
+
+	// 09:00 in Seoul is midnight UTC
+	assert.Equal(t, []time.Time{
+		day(2030, 1, 10),
+		day(2030, 1, 21),
+		day(2030, 1, 24),
+		day(2030, 2, 4),
+	}, runs(recurrence, day(2030, 1, 1), seoul, 4))
+}
+
+func TestBoardRecurrence_MonthlyClampsToLastDay(t *testing.T) {
+	recurrence := newTestRecurrence(t, RecurrenceRule{Frequency: RecurrenceMonthly, MonthDay: 31, TimeOfDay: "00:00", StartsOn: day(2030, 1, 1)})
+
+	assert.Equal(t, []time.Time{
+		day(2030, 1, 31),
+		day(2030, 2, 28),
+		day(2030, 3, 31),
+		day(2030, 4, 30),
+	}, runs(recurrence, day(2030, 1, 1), time.UTC, 4))
+}
+
+func TestBoardRecurrence_DaylightSavingGap(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	recurrence := newTestRecurrence(t, RecurrenceRule{Frequency: RecurrenceDaily, TimeOfDay: "02:30", StartsOn: day(2027, 3, 13)})
+
+	occurrences := runs(recurrence, day(2027, 3, 13), newYork, 3)
+	require.Len(t, occurrences, 3)
+	assert.Equal(t, "02:30", occurrences[0].In(newYork).Format("15:04"))
+	assert.Equal(t, "03:30", occurrences[1].In(newYork).Format("15:04"), "02:30 does not exist on 2027-03-14")
+	assert.Equal(t, "02:30", occurrences[2].In(newYork).Format("15:04"))
+}
+
+func TestBoardRecurrence_EndConditions(t *testing.T) {
+	ends := day(2030, 1, 9)
+	byDate := newTestRecurrence(t, RecurrenceRule{Frequency: RecurrenceDaily, TimeOfDay: "00:00", StartsOn: day(2030, 1, 7), EndsOn: &ends})
+	assert.Len(t, runs(byDate, day(2030, 1, 1), time.UTC, 10), 3, "the end day is inclusive")
+	assert.Nil(t, byDate.NextRunAt)
+
+	two := 2
+	byCount := newTestRecurrence(t, RecurrenceRule{Frequency: RecurrenceDaily, StartsOn: day(2030, 1, 7), MaxOccurrences: &two})
+	assert.Len(t, runs(byCount, day(2030, 1, 1), time.UTC, 10), 2)
+	assert.Equal(t, 2, byCount.OccurrenceCount)
+	assert.Nil(t, byCount.NextRunAt)
+}
+
+func TestBoardRecurrence_RecordRunSkipsMissedOccurrences(t *testing.T) {
+	recurrence := newTestRecurrence(t, RecurrenceRule{Frequency: RecurrenceDaily, TimeOfDay: "09:00", StartsOn: day(2030, 1, 7)})
+	recurrence.Schedule(day(2030, 1, 1), time.UTC)
+	assert.True(t, recurrence.IsDue(day(2030, 1, 10)))
+
+	// The scheduler was down for three days
+	recurrence.RecordRun(uuid.New(), day(2030, 1, 10), time.UTC)
+	assert.Equal(t, 1, recurrence.OccurrenceCount)
+	assert.Equal(t, time.Date(2030, 1, 10, 9, 0, 0, 0, time.UTC), *recurrence.NextRunAt)
+	assert.False(t, recurrence.IsDue(day(2030, 1, 10)))
+
+	recurrence.Stop()
+	assert.False(t, recurrence.IsDue(day(2031, 1, 1)))
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// BoardRelationType is the kind of link between two boards
+// Relations are stored in one direction only; "blocked by" is an incoming BLOCKS relation
+type BoardRelationType string
+
+const (
+	BoardRelationBlocks     BoardRelationType = "BLOCKS"     // Source must be finished before target
+	BoardRelationRelates    BoardRelationType = "RELATES"    // Symmetric, no ordering
+	BoardRelationDuplicates BoardRelationType = "DUPLICATES" // Source duplicates target
+)
+
+// Labels accepted by the API besides the stored types
+// They describe the relation from the other end and are stored with the boards swapped
+const (
+	BoardRelationBlockedBy    = "BLOCKED_BY"
+	BoardRelationDuplicatedBy = "DUPLICATED_BY"
+)
+
+// BoardRelation links two boards of the same workspace, possibly in different projects
+type BoardRelation struct {
+	BaseModel
+	SourceBoardID uuid.UUID         `gorm:"type:uuid;not null;uniqueIndex:idx_board_relation,priority:1" json:"source_board_id"`
+	TargetBoardID uuid.UUID         `gorm:"type:uuid;not null;uniqueIndex:idx_board_relation,priority:2;index" json:"target_board_id"`
+	Type          BoardRelationType `gorm:"type:varchar(20);not null;uniqueIndex:idx_board_relation,priority:3" json:"type"`
+	CreatedBy     uuid.UUID         `gorm:"type:uuid;not null" json:"created_by"`
+
+	SourceBoard *Board `gorm:"foreignKey:SourceBoardID" json:"source_board,omitempty"`
+	TargetBoard *Board `gorm:"foreignKey:TargetBoardID" json:"target_board,omitempty"`
+}
+
+func (BoardRelation) TableName() string {
+	return "board_relations"
+}
+
+// ParseBoardRelationType converts an API label into the stored type
+// swapped is true when the label describes the relation from the target's side (BLOCKED_BY, DUPLICATED_BY)
+func ParseBoardRelationType(label string) (relationType BoardRelationType, swapped bool, err error) {
+	switch strings.ToUpper(strings.TrimSpace(label)) {
+	case string(BoardRelationBlocks):
+		return BoardRelationBlocks, false, nil
+	case BoardRelationBlockedBy:
+		return BoardRelationBlocks, true, nil
+	case string(BoardRelationRelates):
+		return BoardRelationRelates, false, nil
+	case string(BoardRelationDuplicates):
+		return BoardRelationDuplicates, false, nil
+	case BoardRelationDuplicatedBy:
+		return BoardRelationDuplicates, true, nil
+	}
+	return "", false, NewValidationError("type", "관계 유형은 BLOCKS, BLOCKED_BY, RELATES, DUPLICATES, DUPLICATED_BY 중 하나여야 합니다")
+}
+
+// NewBoardRelation creates a relation after checking that it does not link a board to itself
+func NewBoardRelation(sourceBoardID, targetBoardID uuid.UUID, relationType BoardRelationType, createdBy uuid.UUID) (*BoardRelation, error) {
+	if sourceBoardID == targetBoardID {
+		return nil, NewValidationError("targetBoardId", "보드를 자기 자신과 연결할 수 없습니다")
+	}
+	return &BoardRelation{
+		SourceBoardID: sourceBoardID,
+		TargetBoardID: targetBoardID,
+		Type:          relationType,
+		CreatedBy:     createdBy,
+	}, nil
+}
+
+// IsDirected returns false for relations that read the same from both ends (RELATES)
+func (r *BoardRelation) IsDirected() bool {
+	return r.Type != BoardRelationRelates
+}
+
+// Involves returns true if the board is one of the two ends of the relation
+func (r *BoardRelation) Involves(boardID uuid.UUID) bool {
+	return r.SourceBoardID == boardID || r.TargetBoardID == boardID
+}
+
+// LabelFor returns the relation type as seen from the given board
+// The target of a BLOCKS relation sees BLOCKED_BY, the target of DUPLICATES sees DUPLICATED_BY
+func (r *BoardRelation) LabelFor(boardID uuid.UUID) string {
+	if r.TargetBoardID != boardID || !r.IsDirected() {
+		return string(r.Type)
+	}
+	if r.Type == BoardRelationBlocks {
+		return BoardRelationBlockedBy
+	}
+	return BoardRelationDuplicatedBy
+}
+
+// OtherBoard returns the board at the other end of the relation (nil if not preloaded)
+func (r *BoardRelation) OtherBoard(boardID uuid.UUID) *Board {
+	if r.SourceBoardID == boardID {
+		return r.TargetBoard
+	}
+	return r.SourceBoard
+}
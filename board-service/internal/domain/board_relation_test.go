@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBoardRelationType(t *testing.T) {
+	tests := []struct {
+		label    string
+		expected BoardRelationType
+		swapped  bool
+	}{
+		{"BLOCKS", BoardRelationBlocks, false},
+		{"blocked_by", BoardRelationBlocks, true},
+		{"RELATES", BoardRelationRelates, false},
+		{"DUPLICATES", BoardRelationDuplicates, false},
+		{" DUPLICATED_BY ", BoardRelationDuplicates, true},
+	}
+
+	for _, tt := range tests {
+		relationType, swapped, err := ParseBoardRelationType(tt.label)
+		assert.NoError(t, err, tt.label)
+		assert.Equal(t, tt.expected, relationType, tt.label)
+		assert.Equal(t, tt.swapped, swapped, tt.label)
+	}
+
+	_, _, err := ParseBoardRelationType("RELATED_TO")
+	assert.Error(t, err)
+}
+
+func TestNewBoardRelation_RejectsSelfLink(t *testing.T) {
+	boardID := uuid.New()
+
+	_, err := NewBoardRelation(boardID, boardID, BoardRelationBlocks, uuid.New())
+
+	assert.Error(t, err)
+}
+
+func TestBoardRelation_LabelFor(t *testing.T) {
+	source := uuid.New()
+	target := uuid.New()
+
+	blocks := &BoardRelation{SourceBoardID: source, TargetBoardID: target, Type: BoardRelationBlocks}
+	assert.Equal(t, "BLOCKS", blocks.LabelFor(source))
+	assert.Equal(t, "BLOCKED_BY", blocks.LabelFor(target))
+
+	duplicates := &BoardRelation{SourceBoardID: source, TargetBoardID: target, Type: BoardRelationDuplicates}
+	assert.Equal(t, "DUPLICATED_BY", duplicates.LabelFor(target))
+
+	relates := &BoardRelation{SourceBoardID: source, TargetBoardID: target, Type: BoardRelationRelates}
+	assert.Equal(t, "RELATES", relates.LabelFor(target))
+	assert.True(t, relates.Involves(target))
+	assert.False(t, relates.Involves(uuid.New()))
+}
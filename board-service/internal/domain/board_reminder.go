@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BoardReminderKind is why a reminder about the due date of a board was sent
+type BoardReminderKind string
+
+const (
+	BoardReminderDueSoon BoardReminderKind = "DUE_SOON" // The due date is within a lead time
+	BoardReminderOverdue BoardReminderKind = "OVERDUE"  // The due date has passed
+)
+
+// BoardReminder marks a due date reminder of a board as sent
+// The unique index is the idempotency key: only the scheduler whose insert succeeds notifies,
+// and moving the due date makes the reminders due again
+type BoardReminder struct {
+	BaseModel
+	BoardID     uuid.UUID         `gorm:"type:uuid;not null;uniqueIndex:idx_board_reminder,priority:1" json:"board_id"`
+	Kind        BoardReminderKind `gorm:"type:varchar(20);not null;uniqueIndex:idx_board_reminder,priority:2" json:"kind"`
+	LeadMinutes int               `gorm:"not null;default:0;uniqueIndex:idx_board_reminder,priority:3" json:"lead_minutes"` // DUE_SOON lead time; 0 for OVERDUE
+	DueDate     time.Time         `gorm:"not null;uniqueIndex:idx_board_reminder,priority:4" json:"due_date"`               // Due date the reminder was for
+	Recipients  int               `gorm:"not null;default:0" json:"recipients"`                                             // 0 if the board was already done
+}
+
+func (BoardReminder) TableName() string {
+	return "board_reminders"
+}
+
+// NewBoardReminder creates the marker of a reminder about the board's current due date
+// The board must have a due date
+func NewBoardReminder(board *Board, kind BoardReminderKind, lead time.Duration) *BoardReminder {
+	return &BoardReminder{
+		BoardID:     board.ID,
+		Kind:        kind,
+		LeadMinutes: int(lead / time.Minute),
+		DueDate:     *board.DueDate,
+	}
+}
+
+// NotificationType returns the inbox notification type of the reminder
+func (r *BoardReminder) NotificationType() NotificationType {
+	if r.Kind == BoardReminderOverdue {
+		return NotificationOverdue
+	}
+	return NotificationDueSoon
+}
+
+// ReminderDigest marks the daily due date digest of a user as sent
+type ReminderDigest struct {
+	BaseModel
+	UserID     uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_reminder_digest,priority:1" json:"user_id"`
+	DigestDate time.Time `gorm:"type:date;not null;uniqueIndex:idx_reminder_digest,priority:2" json:"digest_date"`
+	BoardCount int       `gorm:"not null;default:0" json:"board_count"`
+	Delivered  bool      `gorm:"not null;default:false" json:"delivered"` // False if the user has no email address
+}
+
+func (ReminderDigest) TableName() string {
+	return "reminder_digests"
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BoardStageChange records a change of a board's Stage value
+// The change log is the history behind the cumulative flow, burndown and cycle time analytics,
+// while BoardFieldValue only holds the current value
+type BoardStageChange struct {
+	BaseModel
+	ProjectID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"project_id"`
+	BoardID      uuid.UUID  `gorm:"type:uuid;not null;index" json:"board_id"`
+	FieldID      uuid.UUID  `gorm:"type:uuid;not null" json:"field_id"`
+	FromOptionID *uuid.UUID `gorm:"type:uuid" json:"from_option_id"` // Nil when the board had no stage
+	ToOptionID   *uuid.UUID `gorm:"type:uuid" json:"to_option_id"`   // Nil when the stage was cleared
+	ChangedBy    uuid.UUID  `gorm:"type:uuid;not null" json:"changed_by"`
+	ChangedAt    time.Time  `gorm:"not null;index" json:"changed_at"`
+}
+
+func (BoardStageChange) TableName() string {
+	return "board_stage_changes"
+}
+
+// NewBoardStageChange records the board moving from one Stage option to another
+// It returns nil if the option did not change
+func NewBoardStageChange(board *Board, fieldID uuid.UUID, fromOptionID, toOptionID *uuid.UUID, changedBy uuid.UUID, changedAt time.Time) *BoardStageChange {
+	if sameOption(fromOptionID, toOptionID) {
+		return nil
+	}
+	return &BoardStageChange{
+		ProjectID:    board.ProjectID,
+		BoardID:      board.ID,
+		FieldID:      fieldID,
+		FromOptionID: fromOptionID,
+		ToOptionID:   toOptionID,
+		ChangedBy:    changedBy,
+		ChangedAt:    changedAt,
+	}
+}
+
+func sameOption(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBoardStageChange_OnlyForActualChanges(t *testing.T) {
+	board := &Board{BaseModel: BaseModel{ID: uuid.New()}, ProjectID: uuid.New()}
+	fieldID, actorID := uuid.New(), uuid.New()
+	waiting, done := uuid.New(), uuid.New()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	change := NewBoardStageChange(board, fieldID, &waiting, &done, actorID, now)
+	require.NotNil(t, change)
+	assert.Equal(t, board.ProjectID, change.ProjectID)
+	assert.Equal(t, board.ID, change.BoardID)
+	assert.Equal(t, done, *change.ToOptionID)
+	assert.Equal(t, now, change.ChangedAt)
+
+	assert.NotNil(t, NewBoardStageChange(board, fieldID, nil, &waiting, actorID, now), "first stage")
+	assert.NotNil(t, NewBoardStageChange(board, fieldID, &done, nil, actorID, now), "stage cleared")
+
+	same := waiting
+	assert.Nil(t, NewBoardStageChange(board, fieldID, &waiting, &same, actorID, now))
+	assert.Nil(t, NewBoardStageChange(board, fieldID, nil, nil, actorID, now))
+}
@@ -0,0 +1,215 @@
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// BoardTemplateAssigneeRule decides who is assigned to a board created from a template
+// when the request does not name an assignee
+type BoardTemplateAssigneeRule string
+
+const (
+	BoardTemplateAssigneeNone    BoardTemplateAssigneeRule = "NONE"    // Unassigned
+	BoardTemplateAssigneeCreator BoardTemplateAssigneeRule = "CREATOR" // The user creating the board
+	BoardTemplateAssigneeUser    BoardTemplateAssigneeRule = "USER"    // A fixed project member (AssigneeID)
+)
+
+// BoardTemplate is a reusable skeleton for new boards of a project, such as a bug report or a release checklist
+type BoardTemplate struct {
+	BaseModel
+	ProjectID    uuid.UUID                 `gorm:"type:uuid;not null;index" json:"project_id"`
+	Name         string                    `gorm:"type:varchar(100);not null" json:"name"`
+	TitlePattern string                    `gorm:"type:varchar(200)" json:"title_pattern"` // {title} and {date} placeholders
+	Description  string                    `gorm:"type:text" json:"description"`
+	FieldValues  string                    `gorm:"type:jsonb;not null;default:'{}'" json:"field_values"` // Field ID to value, as in the field-values API
+	Checklist    string                    `gorm:"type:jsonb;not null;default:'[]'" json:"checklist"`    // Item contents in order
+	AssigneeRule BoardTemplateAssigneeRule `gorm:"type:varchar(20);not null;default:'NONE'" json:"assignee_rule"`
+	AssigneeID   *uuid.UUID                `gorm:"type:uuid" json:"assignee_id"` // Set only with the USER rule
+	CreatedBy    uuid.UUID                 `gorm:"type:uuid;not null" json:"created_by"`
+}
+
+func (BoardTemplate) TableName() string {
+	return "board_templates"
+}
+
+const (
+	// MaxBoardTemplateNameLength is the maximum length of a board template name in characters
+	MaxBoardTemplateNameLength = 100
+
+	// MaxBoardTemplateTitlePatternLength is the maximum length of a title pattern in characters
+	MaxBoardTemplateTitlePatternLength = 200
+
+	// MaxBoardTemplateDescriptionLength is the maximum length of a template description (board content) in characters
+	MaxBoardTemplateDescriptionLength = 5000
+
+	// MaxBoardTemplateChecklistItems is the maximum number of checklist items a template creates
+	MaxBoardTemplateChecklistItems = 50
+
+	// Title pattern placeholders
+	BoardTemplateTitlePlaceholder = "{title}"
+	BoardTemplateDatePlaceholder  = "{date}"
+)
+
+// NewBoardTemplate creates an empty template of the project: no preset values, no checklist and no assignee
+func NewBoardTemplate(projectID uuid.UUID, name string, createdBy uuid.UUID) (*BoardTemplate, error) {
+	template := &BoardTemplate{
+		ProjectID:    projectID,
+		FieldValues:  "{}",
+		Checklist:    "[]",
+		AssigneeRule: BoardTemplateAssigneeNone,
+		CreatedBy:    createdBy,
+	}
+	if err := template.UpdateName(name); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// ==================== Rich Domain Model - Business Methods ====================
+
+// UpdateName changes the template name with validation
+func (t *BoardTemplate) UpdateName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return NewValidationError("name", "템플릿 이름은 필수입니다")
+	}
+	if utf8.RuneCountInString(name) > MaxBoardTemplateNameLength {
+		return NewValidationError("name", "템플릿 이름은 100자를 초과할 수 없습니다")
+	}
+	t.Name = name
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// UpdateTitlePattern changes the title pattern; an empty pattern uses the requested title as is
+func (t *BoardTemplate) UpdateTitlePattern(pattern string) error {
+	pattern = strings.TrimSpace(pattern)
+	if utf8.RuneCountInString(pattern) > MaxBoardTemplateTitlePatternLength {
+		return NewValidationError("titlePattern", "제목 패턴은 200자를 초과할 수 없습니다")
+	}
+	t.TitlePattern = pattern
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// UpdateDescription changes the description body given to new boards
+func (t *BoardTemplate) UpdateDescription(description string) error {
+	if utf8.RuneCountInString(description) > MaxBoardTemplateDescriptionLength {
+		return NewValidationError("description", "템플릿 본문은 5000자를 초과할 수 없습니다")
+	}
+	t.Description = description
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetFieldValues replaces the preset field values (keys are field IDs)
+// The values are validated against the project fields by the service
+func (t *BoardTemplate) SetFieldValues(values map[string]interface{}) error {
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return NewValidationError("fieldValues", "필드 값을 저장할 수 없습니다")
+	}
+	t.FieldValues = string(encoded)
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// DecodeFieldValues returns the preset field values by field ID
+func (t *BoardTemplate) DecodeFieldValues() (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if t.FieldValues == "" {
+		return values, nil
+	}
+	if err := json.Unmarshal([]byte(t.FieldValues), &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// SetChecklist replaces the checklist items with validation; blank items are dropped
+func (t *BoardTemplate) SetChecklist(items []string) error {
+	contents := make([]string, 0, len(items))
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if utf8.RuneCountInString(item) > MaxChecklistContentLength {
+			return NewValidationError("checklist", "체크리스트 항목은 500자를 초과할 수 없습니다")
+		}
+		contents = append(contents, item)
+	}
+	if len(contents) > MaxBoardTemplateChecklistItems {
+		return NewValidationError("checklist", "체크리스트 항목은 50개까지 지정할 수 있습니다")
+	}
+
+	encoded, err := json.Marshal(contents)
+	if err != nil {
+		return NewValidationError("checklist", "체크리스트를 저장할 수 없습니다")
+	}
+	t.Checklist = string(encoded)
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// DecodeChecklist returns the checklist item contents in order
+func (t *BoardTemplate) DecodeChecklist() ([]string, error) {
+	items := []string{}
+	if t.Checklist == "" {
+		return items, nil
+	}
+	if err := json.Unmarshal([]byte(t.Checklist), &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// SetAssigneeRule changes the default assignee rule; the USER rule needs an assignee
+func (t *BoardTemplate) SetAssigneeRule(rule BoardTemplateAssigneeRule, assigneeID *uuid.UUID) error {
+	switch rule {
+	case BoardTemplateAssigneeNone, BoardTemplateAssigneeCreator:
+		assigneeID = nil
+	case BoardTemplateAssigneeUser:
+		if assigneeID == nil {
+			return NewValidationError("assigneeId", "담당자 규칙이 USER이면 담당자가 필요합니다")
+		}
+	default:
+		return NewValidationError("assigneeRule", "담당자 규칙은 NONE, CREATOR, USER 중 하나여야 합니다")
+	}
+	t.AssigneeRule = rule
+	t.AssigneeID = assigneeID
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// DefaultAssignee returns the assignee of a board created by the given user, or nil for no assignee
+func (t *BoardTemplate) DefaultAssignee(creatorID uuid.UUID) *uuid.UUID {
+	switch t.AssigneeRule {
+	case BoardTemplateAssigneeCreator:
+		return &creatorID
+	case BoardTemplateAssigneeUser:
+		return t.AssigneeID
+	default:
+		return nil
+	}
+}
+
+// RenderTitle builds the title of a new board: {title} is replaced by the requested title and {date} by the day (YYYY-MM-DD)
+// An empty pattern, or a pattern without {title} when a title is requested, gives the requested title
+func (t *BoardTemplate) RenderTitle(title string, now time.Time) string {
+	title = strings.TrimSpace(title)
+	if t.TitlePattern == "" || (title != "" && !strings.Contains(t.TitlePattern, BoardTemplateTitlePlaceholder)) {
+		return title
+	}
+	rendered := strings.ReplaceAll(t.TitlePattern, BoardTemplateTitlePlaceholder, title)
+	rendered = strings.ReplaceAll(rendered, BoardTemplateDatePlaceholder, now.Format("2006-01-02"))
+	return strings.TrimSpace(rendered)
+}
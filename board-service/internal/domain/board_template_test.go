@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoardTemplate_RenderTitle(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	template, err := NewBoardTemplate(uuid.New(), "Bug report", uuid.New())
+	require.NoError(t, err)
+
+	assert.Equal(t, "Login fails", template.RenderTitle(" Login fails ", now), "no pattern")
+
+	require.NoError(t, template.UpdateTitlePattern("[BUG] {title}"))
+	assert.Equal(t, "[BUG] Login fails", template.RenderTitle("Login fails", now))
+
+	require.NoError(t, template.UpdateTitlePattern("Release {date}"))
+	assert.Equal(t, "Release 2026-10-16", template.RenderTitle("", now))
+	assert.Equal(t, "Hotfix", template.RenderTitle("Hotfix", now), "a requested title wins over a pattern without {title}")
+
+	assert.Error(t, template.UpdateTitlePattern(strings.Repeat("t", MaxBoardTemplateTitlePatternLength+1)))
+}
+
+func TestBoardTemplate_SetChecklist(t *testing.T) {
+	template, err := NewBoardTemplate(uuid.New(), "Release", uuid.New())
+	require.NoError(t, err)
+
+	require.NoError(t, template.SetChecklist([]string{" Tag ", "", "Deploy"}))
+	items, err := template.DecodeChecklist()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Tag", "Deploy"}, items)
+
+	tooMany := make([]string, MaxBoardTemplateChecklistItems+1)
+	for i := range tooMany {
+		tooMany[i] = "item"
+	}
+	assert.Error(t, template.SetChecklist(tooMany))
+	assert.Error(t, template.SetChecklist([]string{strings.Repeat("c", MaxChecklistContentLength+1)}))
+}
+
+func TestBoardTemplate_AssigneeRule(t *testing.T) {
+	creatorID, assigneeID := uuid.New(), uuid.New()
+	template, err := NewBoardTemplate(uuid.New(), "Bug report", uuid.New())
+	require.NoError(t, err)
+	assert.Nil(t, template.DefaultAssignee(creatorID))
+
+	require.NoError(t, template.SetAssigneeRule(BoardTemplateAssigneeCreator, &assigneeID))
+	assert.Nil(t, template.AssigneeID, "only the USER rule keeps an assignee")
+	assert.Equal(t, &creatorID, template.DefaultAssignee(creatorID))
+
+	assert.Error(t, template.SetAssigneeRule(BoardTemplateAssigneeUser, nil))
+	require.NoError(t, template.SetAssigneeRule(BoardTemplateAssigneeUser, &assigneeID))
+	assert.Equal(t, &assigneeID, template.DefaultAssignee(creatorID))
+
+	assert.Error(t, template.SetAssigneeRule("ANYONE", nil))
+}
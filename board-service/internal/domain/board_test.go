@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoard_SetParent(t *testing.T) {
+	projectID := uuid.New()
+	board := &Board{BaseModel: BaseModel{ID: uuid.New()}, ProjectID: projectID}
+	parent := &Board{BaseModel: BaseModel{ID: uuid.New()}, ProjectID: projectID}
+
+	require.NoError(t, board.SetParent(parent))
+	assert.True(t, board.IsSubtask())
+	assert.Equal(t, parent.ID, *board.ParentBoardID)
+
+	assert.Error(t, board.SetParent(board), "a board cannot be its own parent")
+	assert.Error(t, board.SetParent(&Board{BaseModel: BaseModel{ID: uuid.New()}, ProjectID: uuid.New()}), "parent of another project")
+
+	board.ClearParent()
+	assert.False(t, board.IsSubtask())
+}
+
+func TestBoard_Assignees(t *testing.T) {
+	assigneeID, participantID := uuid.New(), uuid.New()
+	board := &Board{AssigneeID: &assigneeID, ParticipantIDs: []uuid.UUID{participantID, assigneeID, participantID}}
+	assert.Equal(t, []uuid.UUID{assigneeID, participantID}, board.Assignees())
+	assert.Empty(t, (&Board{}).Assignees())
+}
+
+func TestBoardReminder_IsKeyedByDueDate(t *testing.T) {
+	now := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	dueDate := now.Add(2 * time.Hour)
+	board := &Board{BaseModel: BaseModel{ID: uuid.New()}, DueDate: &dueDate}
+	assert.False(t, board.IsOverdueAt(now))
+	assert.True(t, board.IsOverdueAt(now.Add(3*time.Hour)))
+
+	dueSoon := NewBoardReminder(board, BoardReminderDueSoon, 24*time.Hour)
+	assert.Equal(t, 1440, dueSoon.LeadMinutes)
+	assert.Equal(t, dueDate, dueSoon.DueDate)
+	assert.Equal(t, NotificationDueSoon, dueSoon.NotificationType())
+	assert.Equal(t, NotificationOverdue, NewBoardReminder(board, BoardReminderOverdue, 0).NotificationType())
+}
@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// BoardWatcher subscribes a user to the board-level events of a board (comments, field and due date changes)
+// The author, the assignee and commenters watch a board automatically; unwatching removes the row
+type BoardWatcher struct {
+	BaseModel
+	BoardID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_board_watcher" json:"board_id"`
+	UserID  uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_board_watcher;index" json:"user_id"`
+}
+
+func (BoardWatcher) TableName() string {
+	return "board_watchers"
+}
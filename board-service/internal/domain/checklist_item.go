@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// ChecklistItem is a small step of a board, ordered by a fractional index (see util.GeneratePositionBetween)
+type ChecklistItem struct {
+	BaseModel
+	BoardID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"board_id"`
+	Content    string     `gorm:"type:varchar(500);not null" json:"content"`
+	Position   string     `gorm:"type:varchar(255);not null" json:"position"` // Fractional index (e.g., "a0", "a0V", "a1")
+	IsChecked  bool       `gorm:"not null;default:false" json:"is_checked"`
+	CheckedBy  *uuid.UUID `gorm:"type:uuid" json:"checked_by"`
+	CheckedAt  *time.Time `json:"checked_at"`
+	AssigneeID *uuid.UUID `gorm:"type:uuid;index" json:"assignee_id"`
+	DueDate    *time.Time `json:"due_date"`
+	CreatedBy  uuid.UUID  `gorm:"type:uuid;not null" json:"created_by"`
+}
+
+func (ChecklistItem) TableName() string {
+	return "checklist_items"
+}
+
+// MaxChecklistContentLength is the maximum length of a checklist item in characters
+const MaxChecklistContentLength = 500
+
+// NewChecklistItem creates an unchecked item at the given position
+func NewChecklistItem(boardID uuid.UUID, content, position string, createdBy uuid.UUID) (*ChecklistItem, error) {
+	item := &ChecklistItem{
+		BoardID:   boardID,
+		Position:  position,
+		CreatedBy: createdBy,
+	}
+	if err := item.UpdateContent(content); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// ==================== Rich Domain Model - Business Methods ====================
+
+// UpdateContent updates the item text with validation
+func (i *ChecklistItem) UpdateContent(content string) error {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return NewValidationError("content", "체크리스트 항목 내용은 필수입니다")
+	}
+	if utf8.RuneCountInString(content) > MaxChecklistContentLength {
+		return NewValidationError("content", "체크리스트 항목은 500자를 초과할 수 없습니다")
+	}
+	i.Content = content
+	i.UpdatedAt = time.Now()
+	return nil
+}
+
+// Check marks the item as done by the given user
+func (i *ChecklistItem) Check(userID uuid.UUID) {
+	if i.IsChecked {
+		return
+	}
+	now := time.Now()
+	i.IsChecked = true
+	i.CheckedBy = &userID
+	i.CheckedAt = &now
+	i.UpdatedAt = now
+}
+
+// Uncheck marks the item as not done
+func (i *ChecklistItem) Uncheck() {
+	i.IsChecked = false
+	i.CheckedBy = nil
+	i.CheckedAt = nil
+	i.UpdatedAt = time.Now()
+}
+
+// Assign assigns the item to a user
+func (i *ChecklistItem) Assign(userID uuid.UUID) {
+	i.AssigneeID = &userID
+	i.UpdatedAt = time.Now()
+}
+
+// Unassign removes the assignee from the item
+func (i *ChecklistItem) Unassign() {
+	i.AssigneeID = nil
+	i.UpdatedAt = time.Now()
+}
+
+// SetDueDate sets the due date of the item (nil clears it)
+func (i *ChecklistItem) SetDueDate(dueDate *time.Time) {
+	i.DueDate = dueDate
+	i.UpdatedAt = time.Now()
+}
+
+// MoveTo places the item at a new position in the checklist
+func (i *ChecklistItem) MoveTo(position string) {
+	i.Position = position
+	i.UpdatedAt = time.Now()
+}
+
+// ==================== Progress ====================
+
+// ChecklistCount is the number of items and checked items of one board's checklist
+type ChecklistCount struct {
+	Total   int
+	Checked int
+}
+
+// BoardProgress is the completion of a board: its own checklist plus the checklists of its direct sub-tasks
+type BoardProgress struct {
+	ChecklistTotal   int
+	ChecklistChecked int
+	SubtaskCount     int
+}
+
+// Add counts the checklist of the board itself or of one of its sub-tasks
+func (p *BoardProgress) Add(count ChecklistCount) {
+	p.ChecklistTotal += count.Total
+	p.ChecklistChecked += count.Checked
+}
+
+// Percent returns the share of checked items, rounded down (0 when there is nothing to check)
+func (p BoardProgress) Percent() int {
+	if p.ChecklistTotal == 0 {
+		return 0
+	}
+	return p.ChecklistChecked * 100 / p.ChecklistTotal
+}
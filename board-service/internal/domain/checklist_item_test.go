@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewChecklistItem_ValidatesContent(t *testing.T) {
+	item, err := NewChecklistItem(uuid.New(), "  Write tests  ", "a0", uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, "Write tests", item.Content)
+	assert.False(t, item.IsChecked)
+
+	_, err = NewChecklistItem(uuid.New(), "   ", "a0", uuid.New())
+	assert.Error(t, err)
+
+	_, err = NewChecklistItem(uuid.New(), strings.Repeat("가", MaxChecklistContentLength+1), "a0", uuid.New())
+	assert.Error(t, err)
+}
+
+func TestChecklistItem_CheckAndUncheck(t *testing.T) {
+	item, err := NewChecklistItem(uuid.New(), "Review", "a0", uuid.New())
+	require.NoError(t, err)
+	userID := uuid.New()
+
+	item.Check(userID)
+	require.True(t, item.IsChecked)
+	assert.Equal(t, userID, *item.CheckedBy)
+	checkedAt := item.CheckedAt
+	require.NotNil(t, checkedAt)
+
+	// Checking again keeps the first checker
+	item.Check(uuid.New())
+	assert.Equal(t, userID, *item.CheckedBy)
+	assert.Equal(t, checkedAt, item.CheckedAt)
+
+	item.Uncheck()
+	assert.False(t, item.IsChecked)
+	assert.Nil(t, item.CheckedBy)
+	assert.Nil(t, item.CheckedAt)
+}
+
+func TestBoardProgress_Percent(t *testing.T) {
+	progress := BoardProgress{}
+	assert.Equal(t, 0, progress.Percent(), "nothing to check")
+
+	progress.Add(ChecklistCount{Total: 2, Checked: 1})
+	progress.Add(ChecklistCount{Total: 1, Checked: 0})
+	assert.Equal(t, 3, progress.ChecklistTotal)
+	assert.Equal(t, 1, progress.ChecklistChecked)
+	assert.Equal(t, 33, progress.Percent(), "rounded down")
+
+	progress.Add(ChecklistCount{Total: 1, Checked: 1})
+	assert.Equal(t, 50, progress.Percent())
+}
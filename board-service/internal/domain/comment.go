@@ -1,18 +1,22 @@
 package domain
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 // Comment represents a comment on a Board card.
+// Replies point to their parent comment; top-level comments have Depth 0.
 type Comment struct {
-	BaseModel // ID, CreatedAt, UpdatedAt, IsDeleted 포함
-	Content   string    `gorm:"type:text;not null"`
-	UserID    uuid.UUID `gorm:"type:uuid;not null;index"`
-	BoardID   uuid.UUID `gorm:"type:uuid;not null;index"`
-	Board     Board     `gorm:"foreignKey:BoardID"`
+	BaseModel                  // ID, CreatedAt, UpdatedAt, IsDeleted 포함
+	Content         string     `gorm:"type:text;not null"`
+	UserID          uuid.UUID  `gorm:"type:uuid;not null;index"`
+	BoardID         uuid.UUID  `gorm:"type:uuid;not null;index"`
+	ParentCommentID *uuid.UUID `gorm:"type:uuid;index"`
+	Depth           int        `gorm:"not null;default:0"`
+	Board           Board      `gorm:"foreignKey:BoardID"`
 }
 
 // TableName specifies the table name for the Comment model.
@@ -32,6 +36,28 @@ func (c *Comment) BelongsToBoard(boardID uuid.UUID) bool {
 	return c.BoardID == boardID
 }
 
+// IsReply returns true if the comment is a reply to another comment
+func (c *Comment) IsReply() bool {
+	return c.ParentCommentID != nil
+}
+
+// NewReply creates a reply to the comment
+// Replies are limited to maxDepth levels below a top-level comment
+func (c *Comment) NewReply(userID uuid.UUID, content string, maxDepth int) (*Comment, error) {
+	if c.Depth+1 > maxDepth {
+		return nil, NewValidationError("parentCommentId", fmt.Sprintf("답글은 %d단계까지만 작성할 수 있습니다", maxDepth))
+	}
+
+	parentID := c.ID
+	return &Comment{
+		Content:         content,
+		UserID:          userID,
+		BoardID:         c.BoardID,
+		ParentCommentID: &parentID,
+		Depth:           c.Depth + 1,
+	}, nil
+}
+
 // UpdateContent updates the comment content with validation
 func (c *Comment) UpdateContent(content string) error {
 	if content == "" {
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// maxReactionEmojiLength limits an emoji in runes (long enough for ZWJ family and flag sequences)
+const maxReactionEmojiLength = 32
+
+// CommentReaction is one user's emoji reaction on a comment
+// A user reacts with the same emoji at most once; reacting again removes it (toggle).
+// Reactions are deleted outright when toggled off, so there is no is_deleted flag.
+type CommentReaction struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CommentID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_comment_reaction" json:"comment_id"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_comment_reaction" json:"user_id"`
+	Emoji     string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_comment_reaction" json:"emoji"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (CommentReaction) TableName() string {
+	return "comment_reactions"
+}
+
+// BeforeCreate is a GORM hook that generates UUID before creating a record
+func (r *CommentReaction) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// NewCommentReaction creates a reaction after validating the emoji
+func NewCommentReaction(commentID, userID uuid.UUID, emoji string) (*CommentReaction, error) {
+	emoji, err := NormalizeReactionEmoji(emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CommentReaction{
+		CommentID: commentID,
+		UserID:    userID,
+		Emoji:     emoji,
+	}, nil
+}
+
+// NormalizeReactionEmoji trims the emoji and checks that it is a Unicode emoji
+// (optionally a ZWJ, skin tone, flag or keycap sequence) and not arbitrary text
+func NormalizeReactionEmoji(emoji string) (string, error) {
+	emoji = strings.TrimSpace(emoji)
+	if emoji == "" {
+		return "", NewValidationError("emoji", "이모지는 필수입니다")
+	}
+	if utf8.RuneCountInString(emoji) > maxReactionEmojiLength || !isEmojiSequence(emoji) {
+		return "", NewValidationError("emoji", "올바르지 않은 이모지입니다")
+	}
+	return emoji, nil
+}
+
+// isEmojiSequence reports whether every code point belongs to an emoji sequence
+func isEmojiSequence(s string) bool {
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case isEmojiBase(r):
+		case isKeycapBase(r):
+			// 1️⃣, #️⃣: the character must be followed by an optional VS16 and U+20E3
+			j := i + 1
+			if j < len(runes) && runes[j] == 0xFE0F {
+				j++
+			}
+			if j >= len(runes) || runes[j] != 0x20E3 {
+				return false
+			}
+			i = j
+		case i > 0 && isEmojiModifier(r):
+			// ZWJ, variation selectors and tags only extend a preceding emoji
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isEmojiBase reports whether r is a pictograph that can start an emoji
+func isEmojiBase(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF: // Pictographs, emoticons, transport, flags (regional indicators), skin tones
+		return true
+	case r >= 0x2600 && r <= 0x27BF: // Miscellaneous symbols and dingbats (☀, ✅, ❤)
+		return true
+	case r >= 0x2190 && r <= 0x21FF, r >= 0x2300 && r <= 0x23FF, r >= 0x25A0 && r <= 0x25FF, r >= 0x2B00 && r <= 0x2BFF:
+		return true // Arrows, technical (⌛, ⏰), geometric shapes (▶), ⭐ and ⭕
+	}
+
+	switch r {
+	case 0x00A9, 0x00AE, 0x203C, 0x2049, 0x2122, 0x2139, 0x2934, 0x2935, 0x3030, 0x303D, 0x3297, 0x3299:
+		return true
+	}
+	return false
+}
+
+func isKeycapBase(r rune) bool {
+	return (r >= '0' && r <= '9') || r == '#' || r == '*'
+}
+
+func isEmojiModifier(r rune) bool {
+	return r == 0x200D || r == 0xFE0E || r == 0xFE0F || (r >= 0xE0020 && r <= 0xE007F)
+}
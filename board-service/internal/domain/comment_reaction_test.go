@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeReactionEmoji_AcceptsEmojiSequences(t *testing.T) {
+	for _, emoji := range []string{
+		"👍",
+		"❤️",      // Heart with VS16
+		"👍🏽",      // Skin tone modifier
+		"👩‍💻",     // ZWJ sequence
+		"🇰🇷",      // Flag (regional indicators)
+		"1️⃣",     // Keycap
+		"🏴󠁧󠁢󠁳󠁣󠁴󠁿", // Tag sequence
+		"⭐",
+	} {
+		normalized, err := NormalizeReactionEmoji(" " + emoji + " ")
+		assert.NoError(t, err, emoji)
+		assert.Equal(t, emoji, normalized)
+	}
+}
+
+func TestNormalizeReactionEmoji_RejectsText(t *testing.T) {
+	for _, input := range []string{
+		"",
+		"lol",
+		":thumbsup:",
+		"👍 👍",
+		"👍a",
+		"1",
+		"‍👍", // A modifier cannot start the emoji
+		"<script>",
+	} {
+		_, err := NormalizeReactionEmoji(input)
+		assert.Error(t, err, input)
+	}
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// mentionPattern matches "@" followed by a user ID or a handle
+// The "@" must start the text or follow a character that cannot be part of an email address
+var mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_.@-])@([\p{L}\p{N}_][\p{L}\p{N}_.-]*)`)
+
+// Mentions are the users referenced in a comment or board description
+type Mentions struct {
+	UserIDs []uuid.UUID // "@<user id>", inserted by the clients' member autocomplete
+	Handles []string    // "@<display name without spaces>", lowercased
+}
+
+// IsEmpty returns true if nobody is mentioned
+func (m Mentions) IsEmpty() bool {
+	return len(m.UserIDs) == 0 && len(m.Handles) == 0
+}
+
+// ParseMentions returns the distinct mentions in the text, in order of first appearance
+func ParseMentions(text string) Mentions {
+	var mentions Mentions
+	seenIDs := make(map[uuid.UUID]bool)
+	seenHandles := make(map[string]bool)
+
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		// A mention at the end of a sentence is followed by punctuation, not part of the handle
+		token := strings.TrimRight(match[1], ".-")
+
+		if userID, err := uuid.Parse(token); err == nil {
+			if !seenIDs[userID] {
+				seenIDs[userID] = true
+				mentions.UserIDs = append(mentions.UserIDs, userID)
+			}
+			continue
+		}
+
+		handle := NormalizeMentionHandle(token)
+		if handle != "" && !seenHandles[handle] {
+			seenHandles[handle] = true
+			mentions.Handles = append(mentions.Handles, handle)
+		}
+	}
+	return mentions
+}
+
+// NewMentions returns the mentions in text that were not already in previous
+// Editing a comment or description only notifies the users added by the edit
+func NewMentions(previous, text string) Mentions {
+	before := ParseMentions(previous)
+	beforeIDs := make(map[uuid.UUID]bool, len(before.UserIDs))
+	for _, userID := range before.UserIDs {
+		beforeIDs[userID] = true
+	}
+	beforeHandles := make(map[string]bool, len(before.Handles))
+	for _, handle := range before.Handles {
+		beforeHandles[handle] = true
+	}
+
+	var added Mentions
+	current := ParseMentions(text)
+	for _, userID := range current.UserIDs {
+		if !beforeIDs[userID] {
+			added.UserIDs = append(added.UserIDs, userID)
+		}
+	}
+	for _, handle := range current.Handles {
+		if !beforeHandles[handle] {
+			added.Handles = append(added.Handles, handle)
+		}
+	}
+	return added
+}
+
+// NormalizeMentionHandle returns the handle a display name is mentioned by
+// Handles are compared case-insensitively and without spaces ("Jane Doe" → "janedoe")
+func NormalizeMentionHandle(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), ""))
+}
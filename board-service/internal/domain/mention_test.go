@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMentions_UserIDsAndHandles(t *testing.T) {
+	// Given: A comment mentioning a user ID and a handle
+	userID := uuid.New()
+	text := "@" + userID.String() + " 확인 부탁드려요, cc @JaneDoe"
+
+	// When
+	mentions := ParseMentions(text)
+
+	// Then
+	assert.Equal(t, []uuid.UUID{userID}, mentions.UserIDs)
+	assert.Equal(t, []string{"janedoe"}, mentions.Handles)
+	assert.False(t, mentions.IsEmpty())
+}
+
+func TestParseMentions_Deduplicates(t *testing.T) {
+	// Given: The same users mentioned several times, with different casing
+	userID := uuid.New()
+	text := "@bob @" + userID.String() + " @Bob @" + userID.String() + " @alice"
+
+	// When
+	mentions := ParseMentions(text)
+
+	// Then: Each user appears once, in order of first appearance
+	assert.Equal(t, []uuid.UUID{userID}, mentions.UserIDs)
+	assert.Equal(t, []string{"bob", "alice"}, mentions.Handles)
+}
+
+func TestParseMentions_TrailingPunctuation(t *testing.T) {
+	// When: A mention ends a sentence
+	mentions := ParseMentions("끝나면 알려주세요 @bob. 그리고 @홍길동!")
+
+	// Then: The punctuation is not part of the handle
+	assert.Equal(t, []string{"bob", "홍길동"}, mentions.Handles)
+}
+
+func TestParseMentions_IgnoresEmailAddresses(t *testing.T) {
+	// When: The text contains an email address and a bare "@"
+	mentions := ParseMentions("메일은 bob@example.com 으로 보내주세요 @ 감사합니다")
+
+	// Then
+	assert.True(t, mentions.IsEmpty())
+}
+
+func TestParseMentions_Empty(t *testing.T) {
+	assert.True(t, ParseMentions("").IsEmpty())
+	assert.True(t, ParseMentions("멘션 없는 댓글").IsEmpty())
+}
+
+func TestNewMentions_OnlyAddedMentions(t *testing.T) {
+	// Given: An edit that keeps @bob and adds @alice and a user ID
+	userID := uuid.New()
+	previous := "@bob 확인 부탁드려요"
+	text := "@bob @alice @" + userID.String() + " 확인 부탁드려요"
+
+	// When
+	added := NewMentions(previous, text)
+
+	// Then
+	assert.Equal(t, []uuid.UUID{userID}, added.UserIDs)
+	assert.Equal(t, []string{"alice"}, added.Handles)
+}
+
+func TestNewMentions_Unchanged(t *testing.T) {
+	text := "@bob 확인 부탁드려요"
+
+	assert.True(t, NewMentions(text, text+" (수정)").IsEmpty())
+}
+
+func TestNormalizeMentionHandle(t *testing.T) {
+	assert.Equal(t, "janedoe", NormalizeMentionHandle("Jane Doe"))
+	assert.Equal(t, "홍길동", NormalizeMentionHandle(" 홍 길동 "))
+}
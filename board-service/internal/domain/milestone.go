@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Milestone is a release target of a project, such as a version
+// Boards join a milestone through Board.MilestoneID; a project can have any number of open milestones
+type Milestone struct {
+	BaseModel
+	ProjectID   uuid.UUID  `gorm:"type:uuid;not null;index" json:"project_id"`
+	Name        string     `gorm:"type:varchar(100);not null" json:"name"`
+	Description string     `gorm:"type:text" json:"description"`
+	TargetDate  *time.Time `gorm:"type:date" json:"target_date"` // Planned release day (midnight UTC)
+	Released    bool       `gorm:"not null;default:false;index" json:"released"`
+	ReleasedAt  *time.Time `json:"released_at"`
+	CreatedBy   uuid.UUID  `gorm:"type:uuid;not null" json:"created_by"`
+}
+
+func (Milestone) TableName() string {
+	return "milestones"
+}
+
+const (
+	// MaxMilestoneNameLength is the maximum length of a milestone name in characters
+	MaxMilestoneNameLength = 100
+
+	// MaxMilestoneDescriptionLength is the maximum length of a milestone description in characters
+	MaxMilestoneDescriptionLength = 2000
+)
+
+// NewMilestone creates an unreleased milestone of the project
+func NewMilestone(projectID uuid.UUID, name, description string, targetDate *time.Time, createdBy uuid.UUID) (*Milestone, error) {
+	milestone := &Milestone{
+		ProjectID: projectID,
+		CreatedBy: createdBy,
+	}
+	if err := milestone.UpdateName(name); err != nil {
+		return nil, err
+	}
+	if err := milestone.UpdateDescription(description); err != nil {
+		return nil, err
+	}
+	milestone.SetTargetDate(targetDate)
+	return milestone, nil
+}
+
+// ==================== Rich Domain Model - Business Methods ====================
+
+// UpdateName changes the milestone name with validation
+func (m *Milestone) UpdateName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return NewValidationError("name", "마일스톤 이름은 필수입니다")
+	}
+	if utf8.RuneCountInString(name) > MaxMilestoneNameLength {
+		return NewValidationError("name", "마일스톤 이름은 100자를 초과할 수 없습니다")
+	}
+	m.Name = name
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+// UpdateDescription changes the milestone description with validation
+func (m *Milestone) UpdateDescription(description string) error {
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > MaxMilestoneDescriptionLength {
+		return NewValidationError("description", "마일스톤 설명은 2000자를 초과할 수 없습니다")
+	}
+	m.Description = description
+	m.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetTargetDate replaces the planned release day (nil clears it)
+func (m *Milestone) SetTargetDate(targetDate *time.Time) {
+	if targetDate != nil {
+		day := TruncateToDay(*targetDate)
+		targetDate = &day
+	}
+	m.TargetDate = targetDate
+	m.UpdatedAt = time.Now()
+}
+
+// Release marks the milestone as released; releasing it again keeps the first release time
+func (m *Milestone) Release(now time.Time) {
+	if m.Released {
+		return
+	}
+	m.Released = true
+	m.ReleasedAt = &now
+	m.UpdatedAt = now
+}
+
+// Unrelease reopens a released milestone
+func (m *Milestone) Unrelease() {
+	m.Released = false
+	m.ReleasedAt = nil
+	m.UpdatedAt = time.Now()
+}
+
+// IsPastTarget returns true if the target date is before the day of now
+func (m *Milestone) IsPastTarget(now time.Time) bool {
+	return m.TargetDate != nil && m.TargetDate.Before(TruncateToDay(now))
+}
+
+// IsOverdue returns true for an unreleased milestone that passed its target date with open boards
+func (m *Milestone) IsOverdue(now time.Time, openBoards int) bool {
+	return !m.Released && openBoards > 0 && m.IsPastTarget(now)
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMilestone_ValidatesName(t *testing.T) {
+	target := time.Date(2026, 6, 30, 15, 0, 0, 0, time.UTC)
+
+	milestone, err := NewMilestone(uuid.New(), "  v1.0  ", "First release", &target, uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, "v1.0", milestone.Name)
+	assert.False(t, milestone.Released)
+	assert.Equal(t, time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC), *milestone.TargetDate, "time of day is dropped")
+
+	_, err = NewMilestone(uuid.New(), " ", "", nil, uuid.New())
+	assert.Error(t, err)
+
+	_, err = NewMilestone(uuid.New(), strings.Repeat("v", MaxMilestoneNameLength+1), "", nil, uuid.New())
+	assert.Error(t, err)
+}
+
+func TestMilestone_IsOverdue(t *testing.T) {
+	target := time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC)
+	milestone, err := NewMilestone(uuid.New(), "v1.0", "", &target, uuid.New())
+	require.NoError(t, err)
+
+	assert.False(t, milestone.IsOverdue(target.Add(20*time.Hour), 3), "the target day itself is not overdue")
+
+	dayAfter := target.Add(24 * time.Hour)
+	assert.True(t, milestone.IsOverdue(dayAfter, 3))
+	assert.False(t, milestone.IsOverdue(dayAfter, 0), "all boards are done")
+
+	milestone.Release(dayAfter)
+	assert.False(t, milestone.IsOverdue(dayAfter, 3), "released milestones are never overdue")
+	milestone.Release(dayAfter.Add(time.Hour))
+	assert.Equal(t, dayAfter, *milestone.ReleasedAt, "releasing again keeps the first release time")
+
+	milestone.Unrelease()
+	assert.Nil(t, milestone.ReleasedAt)
+	milestone.SetTargetDate(nil)
+	assert.False(t, milestone.IsOverdue(dayAfter, 3), "milestones without a target date are never overdue")
+}
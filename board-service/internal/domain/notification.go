@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// NotificationType represents why a user received a notification
+type NotificationType string
+
+const (
+	NotificationMention        NotificationType = "MENTION"          // Mentioned in a comment or board description
+	NotificationAssigned       NotificationType = "ASSIGNED"         // Became the assignee of a board
+	NotificationComment        NotificationType = "COMMENT"          // New comment on a watched board
+	NotificationFieldChanged   NotificationType = "FIELD_CHANGED"    // Custom field value changed on a watched board
+	NotificationDueDateChanged NotificationType = "DUE_DATE_CHANGED" // Due date set, moved or cleared on a watched board
+	NotificationDueSoon        NotificationType = "DUE_SOON"         // Due date of an assigned or watched board is near
+	NotificationOverdue        NotificationType = "OVERDUE"          // Due date of an assigned or watched board has passed
+)
+
+// maxNotificationPreviewLength limits the stored excerpt of the triggering text in runes
+const maxNotificationPreviewLength = 200
+
+// Notification is an inbox entry for a single recipient
+// BoardTitle and Preview are snapshots taken when the notification was created
+type Notification struct {
+	BaseModel
+	UserID     uuid.UUID        `gorm:"type:uuid;not null;index:idx_notifications_user_read" json:"user_id"` // Recipient
+	ProjectID  uuid.UUID        `gorm:"type:uuid;not null;index" json:"project_id"`
+	BoardID    uuid.UUID        `gorm:"type:uuid;not null;index" json:"board_id"`
+	CommentID  *uuid.UUID       `gorm:"type:uuid" json:"comment_id"` // Comment notifications and mentions in comments only
+	ActorID    uuid.UUID        `gorm:"type:uuid;not null" json:"actor_id"`
+	Type       NotificationType `gorm:"type:varchar(50);not null" json:"type"`
+	BoardTitle string           `gorm:"type:varchar(255)" json:"board_title"`
+	Preview    string           `gorm:"type:text" json:"preview"`
+	ReadAt     *time.Time       `gorm:"index:idx_notifications_user_read" json:"read_at"`
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+// ==================== Rich Domain Model - Business Methods ====================
+
+// NewNotification creates an unread notification about the given board
+func NewNotification(recipientID uuid.UUID, board *Board, actorID uuid.UUID, notificationType NotificationType) Notification {
+	return Notification{
+		UserID:     recipientID,
+		ProjectID:  board.ProjectID,
+		BoardID:    board.ID,
+		ActorID:    actorID,
+		Type:       notificationType,
+		BoardTitle: board.Title,
+	}
+}
+
+// SetComment links the notification to a comment and keeps an excerpt of its content
+func (n *Notification) SetComment(comment *Comment) {
+	commentID := comment.ID
+	n.CommentID = &commentID
+	n.SetPreview(comment.Content)
+}
+
+// SetPreview keeps an excerpt of the text that triggered the notification
+func (n *Notification) SetPreview(text string) {
+	if utf8.RuneCountInString(text) > maxNotificationPreviewLength {
+		text = string([]rune(text)[:maxNotificationPreviewLength]) + "…"
+	}
+	n.Preview = text
+}
+
+// IsRead returns true if the recipient has read the notification
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
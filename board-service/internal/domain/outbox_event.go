@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxEvent is a domain event stored in the same transaction as the change that produced it
+// The outbox relay publishes unpublished rows afterwards, so a crash between commit and
+// publish delays the event instead of losing it
+type OutboxEvent struct {
+	BaseModel
+	EventID     string     `gorm:"type:varchar(64);not null;uniqueIndex" json:"event_id"`
+	EventType   string     `gorm:"type:varchar(64);not null" json:"event_type"`
+	ProjectID   uuid.UUID  `gorm:"type:uuid;not null;index" json:"project_id"`
+	Payload     string     `gorm:"type:text;not null" json:"payload"` // JSON encoded event
+	PublishedAt *time.Time `gorm:"index" json:"published_at"`         // NULL until the relay has published it
+	Attempts    int        `gorm:"not null;default:0" json:"attempts"`
+	LastError   string     `gorm:"type:text" json:"last_error"`
+	LockedUntil *time.Time `json:"locked_until"` // Set while a relay is publishing the event; other relays skip it until then
+}
+
+func (OutboxEvent) TableName() string {
+	return "outbox_events"
+}
+
+// ==================== Rich Domain Model - Business Methods ====================
+
+// IsPublished returns true once the relay has handed the event to the sink
+func (e *OutboxEvent) IsPublished() bool {
+	return e.PublishedAt != nil
+}
+
+// RecordFailure records a failed publish; the relay retries it on the next poll
+func (e *OutboxEvent) RecordFailure(errMsg string) {
+	e.Attempts++
+	e.LastError = errMsg
+}
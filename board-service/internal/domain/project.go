@@ -1,7 +1,11 @@
 package domain
 
 import (
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/google/uuid"
 )
@@ -13,6 +17,13 @@ type Project struct {
 	Description string    `gorm:"type:text" json:"description"`
 	OwnerID     uuid.UUID `gorm:"type:uuid;not null;index" json:"owner_id"`
 	IsPublic    bool      `gorm:"default:false" json:"is_public"`
+
+	// Board keys (e.g. "WEB-123")
+	Key           string `gorm:"type:varchar(10);not null;uniqueIndex" json:"key"` // Immutable; unique across all projects, including deleted ones
+	BoardSequence int    `gorm:"not null;default:0" json:"board_sequence"`         // Number of the last board created in the project
+
+	// IANA time zone of schedules such as recurring boards (e.g. "Asia/Seoul")
+	TimeZone string `gorm:"type:varchar(64);not null;default:'UTC'" json:"time_zone"`
 }
 
 func (Project) TableName() string {
@@ -62,6 +73,32 @@ func (p *Project) UpdateDescription(description string) {
 	p.UpdatedAt = time.Now()
 }
 
+// SetTimeZone changes the project time zone to a valid IANA name (e.g. "Asia/Seoul")
+func (p *Project) SetTimeZone(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || name == "Local" {
+		return NewValidationError("timeZone", "시간대는 IANA 형식이어야 합니다 (예: Asia/Seoul)")
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return NewValidationError("timeZone", "알 수 없는 시간대입니다: "+name)
+	}
+	p.TimeZone = name
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// Location returns the project time zone, UTC if none or an unknown one is set
+func (p *Project) Location() *time.Location {
+	if p.TimeZone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(p.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
 // TransferOwnership transfers the project ownership to another user
 func (p *Project) TransferOwnership(newOwnerID uuid.UUID) {
 	p.OwnerID = newOwnerID
@@ -78,3 +115,78 @@ func (p *Project) MarkAsDeleted() {
 	p.IsDeleted = true
 	p.UpdatedAt = time.Now()
 }
+
+// ==================== Project Key ====================
+
+const (
+	MinProjectKeyLength = 2
+	MaxProjectKeyLength = 10
+
+	// defaultProjectKey is used when no key can be derived from the project name (e.g. a Korean name)
+	defaultProjectKey = "PRJ"
+)
+
+var projectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,9}$`)
+
+// NormalizeProjectKey trims and upper-cases a user-supplied key
+func NormalizeProjectKey(key string) string {
+	return strings.ToUpper(strings.TrimSpace(key))
+}
+
+// ValidateProjectKey checks that the key is 2-10 uppercase letters and digits, starting with a letter
+func ValidateProjectKey(key string) error {
+	if !projectKeyPattern.MatchString(key) {
+		return NewValidationError("key", "프로젝트 키는 영문 대문자로 시작하는 2~10자의 영문 대문자 또는 숫자여야 합니다")
+	}
+	return nil
+}
+
+// DeriveProjectKey suggests a key from the ASCII words of the project name
+// "Web Frontend" becomes "WF", "Website" becomes "WEBS"; names without ASCII letters get "PRJ"
+func DeriveProjectKey(name string) string {
+	words := strings.FieldsFunc(strings.ToUpper(name), func(r rune) bool {
+		return r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r))
+	})
+	for len(words) > 0 && !unicode.IsLetter(rune(words[0][0])) {
+		words = words[1:]
+	}
+
+	var key string
+	switch {
+	case len(words) >= 2:
+		for _, word := range words {
+			if len(key) == 4 {
+				break
+			}
+			key += word[:1]
+		}
+	case len(words) == 1:
+		key = words[0]
+		if len(key) > 4 {
+			key = key[:4]
+		}
+	}
+
+	if ValidateProjectKey(key) != nil {
+		return defaultProjectKey
+	}
+	return key
+}
+
+// ProjectKeyCandidate returns the n-th candidate for a derived key that is already taken
+// The first candidate is the key itself, then KEY2, KEY3, ... truncated to the maximum length
+func ProjectKeyCandidate(key string, n int) string {
+	if n <= 1 {
+		return key
+	}
+	suffix := strconv.Itoa(n)
+	if len(key)+len(suffix) > MaxProjectKeyLength {
+		key = key[:MaxProjectKeyLength-len(suffix)]
+	}
+	return key + suffix
+}
+
+// BoardKey returns the human-readable key of the project's board with the given number
+func (p *Project) BoardKey(number int) string {
+	return p.Key + "-" + strconv.Itoa(number)
+}
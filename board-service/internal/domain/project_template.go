@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// ProjectTemplate is a reusable project structure of a workspace
+// It keeps the fields, options and shared views of a project, and optionally sample boards, as a JSON snapshot
+type ProjectTemplate struct {
+	BaseModel
+	WorkspaceID     uuid.UUID  `gorm:"type:uuid;not null;index" json:"workspace_id"`
+	Name            string     `gorm:"type:varchar(100);not null" json:"name"`
+	Description     string     `gorm:"type:text" json:"description"`
+	Content         string     `gorm:"type:jsonb;not null;default:'{}'" json:"content"` // ProjectTemplateContent as JSON
+	SourceProjectID *uuid.UUID `gorm:"type:uuid" json:"source_project_id"`              // Project the template was created from
+	CreatedBy       uuid.UUID  `gorm:"type:uuid;not null" json:"created_by"`
+}
+
+func (ProjectTemplate) TableName() string {
+	return "project_templates"
+}
+
+const (
+	// MaxProjectTemplateNameLength is the maximum length of a template name in characters
+	MaxProjectTemplateNameLength = 100
+
+	// MaxProjectTemplateBoards is the maximum number of sample boards a template keeps
+	MaxProjectTemplateBoards = 200
+)
+
+// ProjectTemplateContent is the structure a template (or a project clone) creates in a new project
+// Refs are opaque identifiers inside the content (the IDs of the source project); views and boards
+// point at fields and options through them, and they are replaced by new IDs when the content is applied
+type ProjectTemplateContent struct {
+	Fields []TemplateField `json:"fields"`
+	Views  []TemplateView  `json:"views"`
+	Boards []TemplateBoard `json:"boards,omitempty"`
+}
+
+type TemplateField struct {
+	Ref             string           `json:"ref"`
+	Name            string           `json:"name"`
+	FieldType       FieldType        `json:"fieldType"`
+	Description     string           `json:"description"`
+	DisplayOrder    int              `json:"displayOrder"`
+	IsRequired      bool             `json:"isRequired"`
+	IsSystemDefault bool             `json:"isSystemDefault"`
+	Config          string           `json:"config"`
+	CanEditRoles    *string          `json:"canEditRoles,omitempty"`
+	Options         []TemplateOption `json:"options,omitempty"`
+}
+
+type TemplateOption struct {
+	Ref          string `json:"ref"`
+	Label        string `json:"label"`
+	Color        string `json:"color"`
+	Description  string `json:"description"`
+	DisplayOrder int    `json:"displayOrder"`
+}
+
+// TemplateView is a shared saved view; filter keys and values, the sort field and the group by field may be field or option refs
+type TemplateView struct {
+	Name            string  `json:"name"`
+	Description     string  `json:"description"`
+	IsDefault       bool    `json:"isDefault"`
+	Filters         string  `json:"filters"`
+	SortBy          *string `json:"sortBy,omitempty"`
+	SortDirection   string  `json:"sortDirection"`
+	GroupByFieldRef *string `json:"groupByFieldRef,omitempty"`
+}
+
+// TemplateBoard is a sample board; user values (assignee, participants, user fields) are not kept
+type TemplateBoard struct {
+	Ref         string               `json:"ref"`
+	Title       string               `json:"title"`
+	Description string               `json:"description"`
+	ParentRef   *string              `json:"parentRef,omitempty"`
+	Values      []TemplateFieldValue `json:"values,omitempty"`
+}
+
+type TemplateFieldValue struct {
+	FieldRef     string     `json:"fieldRef"`
+	ValueText    *string    `json:"valueText,omitempty"`
+	ValueNumber  *float64   `json:"valueNumber,omitempty"`
+	ValueDate    *time.Time `json:"valueDate,omitempty"`
+	ValueBoolean *bool      `json:"valueBoolean,omitempty"`
+	OptionRef    *string    `json:"optionRef,omitempty"`
+	DisplayOrder int        `json:"displayOrder"`
+}
+
+// NewProjectTemplate creates a template of the workspace from the content
+func NewProjectTemplate(workspaceID uuid.UUID, name, description string, content *ProjectTemplateContent, sourceProjectID *uuid.UUID, createdBy uuid.UUID) (*ProjectTemplate, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, NewValidationError("name", "템플릿 이름은 필수입니다")
+	}
+	if utf8.RuneCountInString(name) > MaxProjectTemplateNameLength {
+		return nil, NewValidationError("name", "템플릿 이름은 100자를 초과할 수 없습니다")
+	}
+	if len(content.Boards) > MaxProjectTemplateBoards {
+		return nil, NewValidationError("boards", "템플릿에는 보드를 200개까지 포함할 수 있습니다")
+	}
+
+	encoded, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	return &ProjectTemplate{
+		WorkspaceID:     workspaceID,
+		Name:            name,
+		Description:     strings.TrimSpace(description),
+		Content:         string(encoded),
+		SourceProjectID: sourceProjectID,
+		CreatedBy:       createdBy,
+	}, nil
+}
+
+// DecodeContent parses the structure kept by the template
+func (t *ProjectTemplate) DecodeContent() (*ProjectTemplateContent, error) {
+	var content ProjectTemplateContent
+	if err := json.Unmarshal([]byte(t.Content), &content); err != nil {
+		return nil, err
+	}
+	return &content, nil
+}
+
+// DefaultProjectTemplateContent is the structure of a project created without a template:
+// the system default Stage, Role and Importance fields
+func DefaultProjectTemplateContent() *ProjectTemplateContent {
+	field := func(ref, name, description string, order int, required bool, options ...TemplateOption) TemplateField {
+		for i := range options {
+			options[i].Ref = ref + ":" + options[i].Label
+			options[i].DisplayOrder = i
+		}
+		return TemplateField{
+			Ref:             ref,
+			Name:            name,
+			FieldType:       FieldTypeSingleSelect,
+			Description:     description,
+			DisplayOrder:    order,
+			IsRequired:      required,
+			IsSystemDefault: true,
+			Config:          "{}",
+			Options:         options,
+		}
+	}
+
+	return &ProjectTemplateContent{
+		Fields: []TemplateField{
+			field("stage", "Stage", "작업 진행 단계", 0, true,
+				TemplateOption{Label: "대기", Color: "#F59E0B"},
+				TemplateOption{Label: "진행중", Color: "#3B82F6"},
+				TemplateOption{Label: "완료", Color: "#10B981"},
+			),
+			field("role", "Role", "담당 역할", 1, false,
+				TemplateOption{Label: "프론트엔드", Color: "#EC4899"},
+				TemplateOption{Label: "백엔드", Color: "#8B5CF6"},
+				TemplateOption{Label: "디자인", Color: "#F97316"},
+			),
+			field("importance", "Importance", "작업 중요도", 2, false,
+				TemplateOption{Label: "낮음", Color: "#94A3B8"},
+				TemplateOption{Label: "보통", Color: "#FBBF24"},
+				TemplateOption{Label: "높음", Color: "#EF4444"},
+			),
+		},
+		Views: []TemplateView{},
+	}
+}
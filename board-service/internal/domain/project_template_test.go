@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProjectTemplate_EncodesContent(t *testing.T) {
+	projectID := uuid.New()
+	content := DefaultProjectTemplateContent()
+
+	template, err := NewProjectTemplate(uuid.New(), "  Scrum  ", "", content, &projectID, uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, "Scrum", template.Name)
+
+	decoded, err := template.DecodeContent()
+	require.NoError(t, err)
+	assert.Equal(t, content, decoded)
+
+	_, err = NewProjectTemplate(uuid.New(), " ", "", content, nil, uuid.New())
+	assert.Error(t, err)
+
+	_, err = NewProjectTemplate(uuid.New(), strings.Repeat("t", MaxProjectTemplateNameLength+1), "", content, nil, uuid.New())
+	assert.Error(t, err)
+
+	content.Boards = make([]TemplateBoard, MaxProjectTemplateBoards+1)
+	_, err = NewProjectTemplate(uuid.New(), "Scrum", "", content, nil, uuid.New())
+	assert.Error(t, err)
+}
+
+func TestDefaultProjectTemplateContent_SystemFields(t *testing.T) {
+	content := DefaultProjectTemplateContent()
+
+	require.Len(t, content.Fields, 3)
+	stage := content.Fields[0]
+	assert.Equal(t, "Stage", stage.Name)
+	assert.True(t, stage.IsRequired)
+	assert.True(t, stage.IsSystemDefault)
+	require.Len(t, stage.Options, 3)
+	assert.Equal(t, "완료", stage.Options[2].Label)
+	assert.Equal(t, 2, stage.Options[2].DisplayOrder)
+	assert.NotEqual(t, stage.Options[0].Ref, content.Fields[1].Options[0].Ref, "option refs are unique across fields")
+}
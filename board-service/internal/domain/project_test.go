@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeriveProjectKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"Web Frontend", "WF"},
+		{"website", "WEBS"},
+		{"Mobile App Backend Service Team", "MABS"},
+		{"2025 Roadmap", "ROAD"},
+		{"웹 프론트엔드", "PRJ"},
+		{"X", "PRJ"},
+		{"", "PRJ"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, DeriveProjectKey(tt.name), tt.name)
+	}
+}
+
+func TestValidateProjectKey(t *testing.T) {
+	assert.NoError(t, ValidateProjectKey("WEB"))
+	assert.NoError(t, ValidateProjectKey("A1"))
+	assert.NoError(t, ValidateProjectKey("ABCDEFGHIJ"))
+
+	assert.Error(t, ValidateProjectKey("W"))
+	assert.Error(t, ValidateProjectKey("ABCDEFGHIJK"))
+	assert.Error(t, ValidateProjectKey("1WEB"))
+	assert.Error(t, ValidateProjectKey("web"))
+	assert.Error(t, ValidateProjectKey("WE-B"))
+}
+
+func TestProjectKeyCandidate(t *testing.T) {
+	assert.Equal(t, "WEB", ProjectKeyCandidate("WEB", 1))
+	assert.Equal(t, "WEB2", ProjectKeyCandidate("WEB", 2))
+	assert.Equal(t, "ABCDEFGH12", ProjectKeyCandidate("ABCDEFGHIJ", 12))
+}
+
+func TestBoardAssignNumber(t *testing.T) {
+	// Given
+	project := &Project{Key: "WEB"}
+	board := &Board{}
+
+	// When
+	board.AssignNumber(project, 123)
+
+	// Then
+	assert.Equal(t, 123, board.Number)
+	assert.Equal(t, "WEB-123", board.Key)
+	assert.Equal(t, "WEB-12", NormalizeBoardKey(" web-12 "))
+}
@@ -11,9 +11,9 @@ type SavedView struct {
 	Description    string     `gorm:"type:text" json:"description"`
 	IsDefault      bool       `gorm:"default:false;index" json:"is_default"`
 	IsShared       bool       `gorm:"default:true" json:"is_shared"` // Default: team-shared (most common use case)
-	Filters        string     `gorm:"type:text;default:'{}'" json:"filters"`       // JSON stored as string
-	SortBy         *string    `gorm:"type:varchar(255)" json:"sort_by"`
-	SortDirection  string     `gorm:"type:varchar(4);default:'asc'" json:"sort_direction"` // 'asc' or 'desc'
+	Filters        string     `gorm:"type:text;default:'{}'" json:"filters"`       // FilterNode tree as JSON
+	SortBy         *string    `gorm:"type:text" json:"sort_by"`                            // ViewSortKey list as JSON
+	SortDirection  string     `gorm:"type:varchar(4);default:'asc'" json:"sort_direction"` // Direction of the first sort key: 'asc' or 'desc'
 	GroupByFieldID *uuid.UUID `gorm:"type:uuid" json:"group_by_field_id"`
 }
 
@@ -21,11 +21,11 @@ func (SavedView) TableName() string {
 	return "saved_views"
 }
 
-// ViewFilters represents filter configuration
-// This is parsed from/to the Filters JSON string
+// ViewFilters is the flat filter format saved before filter trees: conditions by field, all of them ANDed
+// ParseViewFilters reads it as an "and" group of FilterNode conditions
 type ViewFilters map[string]FilterCondition
 
 type FilterCondition struct {
-	Operator string      `json:"operator"` // 'eq', 'ne', 'in', 'not_in', 'contains', 'gt', 'gte', 'lt', 'lte', 'is_null', 'is_not_null'
+	Operator string      `json:"operator"` // 'eq', 'ne', 'in', 'not_in', 'contains', 'gt', 'gte', 'lt', 'lte', 'between', 'is_null', 'is_not_null'
 	Value    interface{} `json:"value"`
 }
@@ -0,0 +1,215 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// SprintState is the lifecycle state of a sprint: planned → active → closed
+type SprintState string
+
+const (
+	SprintStatePlanned SprintState = "planned"
+	SprintStateActive  SprintState = "active"
+	SprintStateClosed  SprintState = "closed"
+)
+
+// IsValid returns true for a known sprint state
+func (s SprintState) IsValid() bool {
+	switch s {
+	case SprintStatePlanned, SprintStateActive, SprintStateClosed:
+		return true
+	}
+	return false
+}
+
+// Sprint is an iteration of a project; a project has at most one active sprint
+// Boards join a sprint through Board.SprintID, and SprintBoard keeps the scope history for the sprint report
+type Sprint struct {
+	BaseModel
+	ProjectID uuid.UUID   `gorm:"type:uuid;not null;index" json:"project_id"`
+	Name      string      `gorm:"type:varchar(100);not null" json:"name"`
+	Goal      string      `gorm:"type:text" json:"goal"`
+	StartDate *time.Time  `gorm:"type:date" json:"start_date"` // Planned first day (midnight UTC)
+	EndDate   *time.Time  `gorm:"type:date" json:"end_date"`   // Planned last day (midnight UTC)
+	State     SprintState `gorm:"type:varchar(20);not null;default:'planned';index" json:"state"`
+	StartedAt *time.Time  `json:"started_at"`
+	ClosedAt  *time.Time  `json:"closed_at"`
+	CreatedBy uuid.UUID   `gorm:"type:uuid;not null" json:"created_by"`
+}
+
+func (Sprint) TableName() string {
+	return "sprints"
+}
+
+const (
+	// MaxSprintNameLength is the maximum length of a sprint name in characters
+	MaxSprintNameLength = 100
+
+	// MaxSprintGoalLength is the maximum length of a sprint goal in characters
+	MaxSprintGoalLength = 2000
+)
+
+// NewSprint creates a planned sprint of the project
+func NewSprint(projectID uuid.UUID, name, goal string, startDate, endDate *time.Time, createdBy uuid.UUID) (*Sprint, error) {
+	sprint := &Sprint{
+		ProjectID: projectID,
+		State:     SprintStatePlanned,
+		CreatedBy: createdBy,
+	}
+	if err := sprint.UpdateName(name); err != nil {
+		return nil, err
+	}
+	if err := sprint.UpdateGoal(goal); err != nil {
+		return nil, err
+	}
+	if err := sprint.SetDates(startDate, endDate); err != nil {
+		return nil, err
+	}
+	return sprint, nil
+}
+
+// ==================== Rich Domain Model - Business Methods ====================
+
+// UpdateName changes the sprint name with validation
+func (s *Sprint) UpdateName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return NewValidationError("name", "스프린트 이름은 필수입니다")
+	}
+	if utf8.RuneCountInString(name) > MaxSprintNameLength {
+		return NewValidationError("name", "스프린트 이름은 100자를 초과할 수 없습니다")
+	}
+	s.Name = name
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+// UpdateGoal changes the sprint goal with validation
+func (s *Sprint) UpdateGoal(goal string) error {
+	goal = strings.TrimSpace(goal)
+	if utf8.RuneCountInString(goal) > MaxSprintGoalLength {
+		return NewValidationError("goal", "스프린트 목표는 2000자를 초과할 수 없습니다")
+	}
+	s.Goal = goal
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetDates replaces the planned first and last day of the sprint (nil clears a date)
+func (s *Sprint) SetDates(startDate, endDate *time.Time) error {
+	if startDate != nil {
+		day := TruncateToDay(*startDate)
+		startDate = &day
+	}
+	if endDate != nil {
+		day := TruncateToDay(*endDate)
+		endDate = &day
+	}
+	if startDate != nil && endDate != nil && startDate.After(*endDate) {
+		return NewValidationError("endDate", "스프린트 종료일은 시작일보다 빠를 수 없습니다")
+	}
+	s.StartDate = startDate
+	s.EndDate = endDate
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+// Start makes a planned sprint active; a missing start date becomes the day it started
+func (s *Sprint) Start(now time.Time) error {
+	if s.State != SprintStatePlanned {
+		return NewInvalidStateError("계획된 스프린트만 시작할 수 있습니다")
+	}
+	if s.StartDate == nil {
+		day := TruncateToDay(now)
+		s.StartDate = &day
+	}
+	if s.EndDate != nil && s.StartDate.After(*s.EndDate) {
+		return NewValidationError("endDate", "스프린트 종료일은 시작일보다 빠를 수 없습니다")
+	}
+	s.State = SprintStateActive
+	s.StartedAt = &now
+	s.UpdatedAt = now
+	return nil
+}
+
+// Close ends an active sprint
+func (s *Sprint) Close(now time.Time) error {
+	if s.State != SprintStateActive {
+		return NewInvalidStateError("진행 중인 스프린트만 종료할 수 있습니다")
+	}
+	s.State = SprintStateClosed
+	s.ClosedAt = &now
+	s.UpdatedAt = now
+	return nil
+}
+
+// IsActive returns true while the sprint is running
+func (s *Sprint) IsActive() bool {
+	return s.State == SprintStateActive
+}
+
+// IsClosed returns true once the sprint has ended
+func (s *Sprint) IsClosed() bool {
+	return s.State == SprintStateClosed
+}
+
+// SprintBoard records that a board was part of a sprint, for the committed/added/removed scope of the sprint report
+// There is one row per sprint and board; a board that leaves and rejoins the sprint reuses it
+type SprintBoard struct {
+	BaseModel
+	SprintID    uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_sprint_boards_sprint_board,priority:1" json:"sprint_id"`
+	BoardID     uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_sprint_boards_sprint_board,priority:2;index" json:"board_id"`
+	AddedAt     time.Time  `gorm:"not null" json:"added_at"`
+	RemovedAt   *time.Time `json:"removed_at"`                                 // Left the sprint before it closed
+	Committed   bool       `gorm:"not null;default:false" json:"committed"`    // In the sprint when it started
+	Completed   bool       `gorm:"not null;default:false" json:"completed"`    // In a finished stage when the sprint closed
+	CarriedOver bool       `gorm:"not null;default:false" json:"carried_over"` // Unfinished and moved to the next sprint when it closed
+}
+
+func (SprintBoard) TableName() string {
+	return "sprint_boards"
+}
+
+// NewSprintBoard records a board joining the sprint
+func NewSprintBoard(sprintID, boardID uuid.UUID, now time.Time) *SprintBoard {
+	return &SprintBoard{
+		SprintID: sprintID,
+		BoardID:  boardID,
+		AddedAt:  now,
+	}
+}
+
+// Rejoin records a board coming back to the sprint it left
+// Boards committed at the start stay committed; others count as added at the time they rejoined
+func (b *SprintBoard) Rejoin(now time.Time) {
+	if !b.Committed {
+		b.AddedAt = now
+	}
+	b.RemovedAt = nil
+	b.UpdatedAt = now
+}
+
+// Leave records a board leaving the sprint
+func (b *SprintBoard) Leave(now time.Time) {
+	b.RemovedAt = &now
+	b.UpdatedAt = now
+}
+
+// IsInSprint returns true if the board has not left the sprint
+func (b *SprintBoard) IsInSprint() bool {
+	return b.RemovedAt == nil
+}
+
+// IsAddedAfter returns true if the board joined the sprint after it started
+func (b *SprintBoard) IsAddedAfter(startedAt time.Time) bool {
+	return !b.Committed && b.AddedAt.After(startedAt)
+}
+
+// IsRemovedAfter returns true if the board left the sprint after it started
+func (b *SprintBoard) IsRemovedAfter(startedAt time.Time) bool {
+	return b.RemovedAt != nil && b.RemovedAt.After(startedAt)
+}
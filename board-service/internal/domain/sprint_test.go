@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSprint_ValidatesNameAndDates(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 3, 13, 18, 0, 0, 0, time.UTC)
+
+	sprint, err := NewSprint(uuid.New(), "  Sprint 1  ", "Ship search", &start, &end, uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, "Sprint 1", sprint.Name)
+	assert.Equal(t, SprintStatePlanned, sprint.State)
+	assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), *sprint.StartDate, "time of day is dropped")
+
+	_, err = NewSprint(uuid.New(), " ", "", nil, nil, uuid.New())
+	assert.Error(t, err)
+
+	_, err = NewSprint(uuid.New(), "Sprint 1", "", &end, &start, uuid.New())
+	assert.Error(t, err, "end before start")
+}
+
+func TestSprint_Lifecycle(t *testing.T) {
+	sprint, err := NewSprint(uuid.New(), "Sprint 1", "", nil, nil, uuid.New())
+	require.NoError(t, err)
+	now := time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)
+
+	assert.Error(t, sprint.Close(now), "a planned sprint cannot be closed")
+
+	require.NoError(t, sprint.Start(now))
+	assert.True(t, sprint.IsActive())
+	assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), *sprint.StartDate, "start date defaults to the day it started")
+	assert.Error(t, sprint.Start(now), "an active sprint cannot be started again")
+
+	require.NoError(t, sprint.Close(now.Add(time.Hour)))
+	assert.True(t, sprint.IsClosed())
+	assert.Equal(t, now.Add(time.Hour), *sprint.ClosedAt)
+}
+
+func TestSprintBoard_Scope(t *testing.T) {
+	startedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	committed := NewSprintBoard(uuid.New(), uuid.New(), startedAt.Add(-time.Hour))
+	committed.Committed = true
+	assert.False(t, committed.IsAddedAfter(startedAt))
+
+	committed.Leave(startedAt.Add(time.Hour))
+	assert.True(t, committed.IsRemovedAfter(startedAt))
+	committed.Rejoin(startedAt.Add(2 * time.Hour))
+	assert.True(t, committed.IsInSprint())
+	assert.False(t, committed.IsAddedAfter(startedAt), "committed boards stay committed when they come back")
+
+	added := NewSprintBoard(uuid.New(), uuid.New(), startedAt.Add(time.Hour))
+	assert.True(t, added.IsAddedAfter(startedAt))
+	assert.False(t, added.IsRemovedAfter(startedAt))
+}
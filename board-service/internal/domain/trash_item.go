@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrashItemType identifies what kind of entity a trash item refers to
+type TrashItemType string
+
+const (
+	TrashItemBoard   TrashItemType = "board"
+	TrashItemComment TrashItemType = "comment"
+	TrashItemProject TrashItemType = "project"
+)
+
+// maxTrashTitleLength limits the title snapshot (comments use their content)
+const maxTrashTitleLength = 100
+
+// TrashItem records a soft-deleted board, comment or project so that it can be listed,
+// restored or purged. Rows deleted together with the item (e.g. a board's comments) are
+// recognized by an updated_at at or after DeletedAt.
+type TrashItem struct {
+	BaseModel
+	ProjectID uuid.UUID     `gorm:"type:uuid;not null;index" json:"project_id"`
+	ItemType  TrashItemType `gorm:"type:varchar(20);not null;uniqueIndex:idx_trash_item" json:"item_type"`
+	ItemID    uuid.UUID     `gorm:"type:uuid;not null;uniqueIndex:idx_trash_item" json:"item_id"`
+	Title     string        `gorm:"type:varchar(255)" json:"title"` // Board title, comment excerpt or project name at deletion time
+	DeletedBy uuid.UUID     `gorm:"type:uuid;not null" json:"deleted_by"`
+	DeletedAt time.Time     `gorm:"not null;index" json:"deleted_at"`
+}
+
+func (TrashItem) TableName() string {
+	return "trash_items"
+}
+
+// NewTrashItem creates a trash item for an entity deleted now
+// DeletedAt is truncated to the database precision so that rows updated by the same
+// deletion never compare as older than the item itself
+func NewTrashItem(itemType TrashItemType, itemID, projectID, deletedBy uuid.UUID, title string) *TrashItem {
+	runes := []rune(title)
+	if len(runes) > maxTrashTitleLength {
+		title = string(runes[:maxTrashTitleLength])
+	}
+
+	return &TrashItem{
+		ProjectID: projectID,
+		ItemType:  itemType,
+		ItemID:    itemID,
+		Title:     title,
+		DeletedBy: deletedBy,
+		DeletedAt: time.Now().Truncate(time.Microsecond),
+	}
+}
+
+// ==================== Rich Domain Model - Business Methods ====================
+
+// PurgeAt returns when the retention job permanently deletes the item
+func (t *TrashItem) PurgeAt(retention time.Duration) time.Time {
+	return t.DeletedAt.Add(retention)
+}
+
+// BelongsToProject returns true if the item was deleted from the given project
+func (t *TrashItem) BelongsToProject(projectID uuid.UUID) bool {
+	return t.ProjectID == projectID
+}
@@ -0,0 +1,248 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Filter groups combine the conditions of a view filter tree
+const (
+	FilterGroupAnd = "and"
+	FilterGroupOr  = "or"
+	FilterGroupNot = "not" // Negates its single condition
+)
+
+// Filter operators; which of them a condition may use depends on the type of its field
+const (
+	FilterOpEq        = "eq"
+	FilterOpNe        = "ne"
+	FilterOpIn        = "in"
+	FilterOpNotIn     = "not_in"
+	FilterOpContains  = "contains"
+	FilterOpGt        = "gt"
+	FilterOpGte       = "gte"
+	FilterOpLt        = "lt"
+	FilterOpLte       = "lte"
+	FilterOpBetween   = "between" // Value is [from, to], both inclusive
+	FilterOpIsNull    = "is_null"
+	FilterOpIsNotNull = "is_not_null"
+)
+
+const (
+	MaxFilterDepth      = 5   // Nesting levels of groups
+	MaxFilterConditions = 50  // Conditions in a tree
+	MaxFilterValues     = 100 // Values of an in/not_in condition
+)
+
+var filterOperators = map[string]bool{
+	FilterOpEq: true, FilterOpNe: true, FilterOpIn: true, FilterOpNotIn: true, FilterOpContains: true,
+	FilterOpGt: true, FilterOpGte: true, FilterOpLt: true, FilterOpLte: true, FilterOpBetween: true,
+	FilterOpIsNull: true, FilterOpIsNotNull: true,
+}
+
+// FilterNode is a node of the filter tree stored in SavedView.Filters
+// A group node has Group and Conditions, a condition node has Field, Operator and Value:
+//
+//	{"group": "or", "conditions": [
+//	  {"field": "<fieldId>", "operator": "gte", "value": 3},
+//	  {"group": "not", "conditions": [{"field": "title", "operator": "contains", "value": "WIP"}]}
+//	]}
+type FilterNode struct {
+	Group      string       `json:"group,omitempty"`
+	Conditions []FilterNode `json:"conditions,omitempty"`
+	Field      string       `json:"field,omitempty"`
+	Operator   string       `json:"operator,omitempty"`
+	Value      interface{}  `json:"value,omitempty"`
+}
+
+// IsGroup returns true if the node combines other nodes
+func (n *FilterNode) IsGroup() bool {
+	return n.Group != ""
+}
+
+// ParseViewFilters parses the filters of a view
+// Filters saved before filter trees (a flat ViewFilters map) are read as an "and" group,
+// and empty filters return nil
+func ParseViewFilters(data []byte) (*FilterNode, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) || bytes.Equal(data, []byte("{}")) {
+		return nil, nil
+	}
+
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, NewValidationError("filters", "필터는 JSON 객체여야 합니다")
+	}
+	if IsFilterTree(data) {
+		var root FilterNode
+		if err := json.Unmarshal(data, &root); err != nil {
+			return nil, NewValidationError("filters", "필터 트리 형식이 올바르지 않습니다")
+		}
+		return &root, nil
+	}
+
+	var flat ViewFilters
+	if err := json.Unmarshal(data, &flat); err != nil {
+		return nil, NewValidationError("filters", "필터 조건은 operator와 value로 이루어져야 합니다")
+	}
+	fields := make([]string, 0, len(flat))
+	for field := range flat {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	root := &FilterNode{Group: FilterGroupAnd, Conditions: make([]FilterNode, 0, len(fields))}
+	for _, field := range fields {
+		condition := flat[field]
+		root.Conditions = append(root.Conditions, FilterNode{Field: field, Operator: condition.Operator, Value: condition.Value})
+	}
+	return root, nil
+}
+
+// IsFilterTree returns true if the filters are a FilterNode tree rather than flat ViewFilters
+// Flat filters are keyed by field ID or built-in field name, never by "group" or "field"
+func IsFilterTree(data []byte) bool {
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return false
+	}
+	_, group := keys["group"]
+	_, field := keys["field"]
+	return group || field
+}
+
+// Walk calls visit for the node and every node below it
+func (n *FilterNode) Walk(visit func(node *FilterNode)) {
+	visit(n)
+	for i := range n.Conditions {
+		n.Conditions[i].Walk(visit)
+	}
+}
+
+// Validate checks the shape of the tree; the field types and values are checked when it is compiled
+func (n *FilterNode) Validate() error {
+	count := 0
+	return n.validate(1, &count)
+}
+
+func (n *FilterNode) validate(depth int, count *int) error {
+	if !n.IsGroup() {
+		*count++
+		if *count > MaxFilterConditions {
+			return NewValidationError("filters", fmt.Sprintf("필터 조건은 %d개를 넘을 수 없습니다", MaxFilterConditions))
+		}
+		if n.Field == "" {
+			return NewValidationError("filters", "필터 조건에 field가 없습니다")
+		}
+		if !filterOperators[n.Operator] {
+			return NewValidationError("filters", fmt.Sprintf("지원하지 않는 필터 연산자입니다: %s", n.Operator))
+		}
+		return nil
+	}
+
+	if depth > MaxFilterDepth {
+		return NewValidationError("filters", fmt.Sprintf("필터 그룹은 %d단계까지 중첩할 수 있습니다", MaxFilterDepth))
+	}
+	if n.Field != "" || n.Operator != "" {
+		return NewValidationError("filters", "필터 그룹에는 field나 operator를 지정할 수 없습니다")
+	}
+	switch n.Group {
+	case FilterGroupAnd, FilterGroupOr:
+		if len(n.Conditions) == 0 {
+			return NewValidationError("filters", "필터 그룹에 조건이 없습니다")
+		}
+	case FilterGroupNot:
+		if len(n.Conditions) != 1 {
+			return NewValidationError("filters", "not 그룹에는 조건이 하나만 있어야 합니다")
+		}
+	default:
+		return NewValidationError("filters", fmt.Sprintf("필터 그룹은 and, or, not 중 하나여야 합니다: %s", n.Group))
+	}
+	for i := range n.Conditions {
+		if err := n.Conditions[i].validate(depth+1, count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ==================== Filter Dates ====================
+
+// FilterDate is the time span a date filter value stands for: [Start, End)
+// A date-time value is an instant, Start and End are then equal
+type FilterDate struct {
+	Start time.Time
+	End   time.Time
+}
+
+// IsInstant returns true if the value was a date-time rather than a day or a relative range
+func (d FilterDate) IsInstant() bool {
+	return d.Start.Equal(d.End)
+}
+
+var relativeDays = regexp.MustCompile(`^(last|next)_(\d{1,3})_days$`)
+
+// ParseFilterDate resolves a date filter value in the given location
+// Values are a day (2006-01-02), a date-time (RFC 3339) or a relative range:
+// today, yesterday, tomorrow, this_week, last_week, next_week (weeks start on Monday),
+// this_month, last_month, next_month, last_N_days (the N days up to today) and next_N_days (today and the N-1 days after)
+func ParseFilterDate(value interface{}, now time.Time, loc *time.Location) (FilterDate, error) {
+	text, ok := value.(string)
+	if !ok {
+		return FilterDate{}, NewValidationError("filters", "날짜 필터 값은 문자열이어야 합니다")
+	}
+	if instant, err := time.Parse(time.RFC3339, text); err == nil {
+		return FilterDate{Start: instant, End: instant}, nil
+	}
+	if day, err := time.ParseInLocation("2006-01-02", text, loc); err == nil {
+		return FilterDate{Start: day, End: day.AddDate(0, 0, 1)}, nil
+	}
+
+	local := now.In(loc)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	days := func(from, count int) FilterDate {
+		return FilterDate{Start: today.AddDate(0, 0, from), End: today.AddDate(0, 0, from+count)}
+	}
+	monday := -((int(today.Weekday()) + 6) % 7)
+	month := func(offset int) FilterDate {
+		first := time.Date(local.Year(), local.Month()+time.Month(offset), 1, 0, 0, 0, 0, loc)
+		return FilterDate{Start: first, End: first.AddDate(0, 1, 0)}
+	}
+
+	switch text {
+	case "today":
+		return days(0, 1), nil
+	case "yesterday":
+		return days(-1, 1), nil
+	case "tomorrow":
+		return days(1, 1), nil
+	case "this_week":
+		return days(monday, 7), nil
+	case "last_week":
+		return days(monday-7, 7), nil
+	case "next_week":
+		return days(monday+7, 7), nil
+	case "this_month":
+		return month(0), nil
+	case "last_month":
+		return month(-1), nil
+	case "next_month":
+		return month(1), nil
+	}
+	if match := relativeDays.FindStringSubmatch(text); match != nil {
+		count, _ := strconv.Atoi(match[2])
+		if count < 1 {
+			return FilterDate{}, NewValidationError("filters", "상대 기간은 1일 이상이어야 합니다")
+		}
+		if match[1] == "last" {
+			return days(1-count, count), nil
+		}
+		return days(0, count), nil
+	}
+	return FilterDate{}, NewValidationError("filters", fmt.Sprintf("날짜 필터 값을 해석할 수 없습니다: %s", text))
+}
@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseViewFilters_ReadsFlatFiltersAsAndGroup(t *testing.T) {
+	root, err := ParseViewFilters([]byte(`{"title": {"operator": "contains", "value": "login"}, "blocked": {"operator": "eq", "value": true}}`))
+	require.NoError(t, err)
+	require.NotNil(t, root)
+	assert.Equal(t, FilterGroupAnd, root.Group)
+	require.Len(t, root.Conditions, 2)
+	assert.Equal(t, FilterNode{Field: "blocked", Operator: "eq", Value: true}, root.Conditions[0], "sorted by field")
+	assert.Equal(t, FilterNode{Field: "title", Operator: "contains", Value: "login"}, root.Conditions[1])
+
+	for _, empty := range []string{"", "{}", "null", "  "} {
+		root, err := ParseViewFilters([]byte(empty))
+		require.NoError(t, err)
+		assert.Nil(t, root, "%q", empty)
+	}
+}
+
+func TestParseViewFilters_Tree(t *testing.T) {
+	root, err := ParseViewFilters([]byte(`{"group": "or", "conditions": [
+		{"field": "title", "operator": "contains", "value": "login"},
+		{"group": "not", "conditions": [{"field": "dueDate", "operator": "is_null"}]}
+	]}`))
+	require.NoError(t, err)
+	require.NoError(t, root.Validate())
+	assert.Equal(t, FilterGroupOr, root.Group)
+	require.Len(t, root.Conditions, 2)
+	assert.True(t, root.Conditions[1].IsGroup())
+	assert.Equal(t, "dueDate", root.Conditions[1].Conditions[0].Field)
+
+	// A single condition is a tree as well
+	root, err = ParseViewFilters([]byte(`{"field": "title", "operator": "eq", "value": "Login"}`))
+	require.NoError(t, err)
+	assert.False(t, root.IsGroup())
+
+	_, err = ParseViewFilters([]byte(`[1, 2]`))
+	assert.Error(t, err)
+}
+
+func TestFilterNode_Validate(t *testing.T) {
+	condition := FilterNode{Field: "title", Operator: "eq", Value: "Login"}
+	nested := condition
+	for i := 0; i < MaxFilterDepth+1; i++ {
+		nested = FilterNode{Group: FilterGroupAnd, Conditions: []FilterNode{nested}}
+	}
+	tooMany := FilterNode{Group: FilterGroupOr}
+	for i := 0; i <= MaxFilterConditions; i++ {
+		tooMany.Conditions = append(tooMany.Conditions, condition)
+	}
+
+	tests := []struct {
+		name string
+		node FilterNode
+	}{
+		{"unknown group", FilterNode{Group: "xor", Conditions: []FilterNode{condition}}},
+		{"empty group", FilterNode{Group: FilterGroupAnd}},
+		{"not with two conditions", FilterNode{Group: FilterGroupNot, Conditions: []FilterNode{condition, condition}}},
+		{"group with a field", FilterNode{Group: FilterGroupAnd, Field: "title", Conditions: []FilterNode{condition}}},
+		{"condition without field", FilterNode{Operator: "eq", Value: "Login"}},
+		{"unknown operator", FilterNode{Field: "title", Operator: "like", Value: "Login"}},
+		{"too deep", nested},
+		{"too many conditions", tooMany},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.node.Validate()
+			require.Error(t, err)
+			assert.Equal(t, ErrCodeValidation, err.(*DomainError).Code)
+		})
+	}
+
+	assert.NoError(t, (&FilterNode{Group: FilterGroupNot, Conditions: []FilterNode{condition}}).Validate())
+}
+
+func TestParseFilterDate(t *testing.T) {
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	require.NoError(t, err)
+	// Wednesday 2030-01-09 01:00 in Seoul
+	now := time.Date(2030, 1, 8, 16, 0, 0, 0, time.UTC)
+	local := func(month time.Month, day int) time.Time {
+		return time.Date(2030, month, day, 0, 0, 0, 0, seoul)
+	}
+
+	tests := []struct {
+		value      string
+		start, end time.Time
+	}{
+		{"2030-01-20", local(1, 20), local(1, 21)},
+		{"today", local(1, 9), local(1, 10)},
+		{"yesterday", local(1, 8), local(1, 9)},
+		{"tomorrow", local(1, 10), local(1, 11)},
+		{"this_week", local(1, 7), local(1, 14)},
+		{"last_week", local(12, 31).AddDate(-1, 0, 0), local(1, 7)},
+		{"next_week", local(1, 14), local(1, 21)},
+		{"this_month", local(1, 1), local(2, 1)},
+		{"last_month", local(12, 1).AddDate(-1, 0, 0), local(1, 1)},
+		{"next_month", local(2, 1), local(3, 1)},
+		{"next_7_days", local(1, 9), local(1, 16)},
+		{"last_7_days", local(1, 3), local(1, 10)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			date, err := ParseFilterDate(tt.value, now, seoul)
+			require.NoError(t, err)
+			assert.True(t, tt.start.Equal(date.Start), "start %s", date.Start)
+			assert.True(t, tt.end.Equal(date.End), "end %s", date.End)
+			assert.False(t, date.IsInstant())
+		})
+	}
+
+	instant, err := ParseFilterDate("2030-01-20T09:30:00+09:00", now, seoul)
+	require.NoError(t, err)
+	assert.True(t, instant.IsInstant())
+	assert.True(t, time.Date(2030, 1, 20, 0, 30, 0, 0, time.UTC).Equal(instant.Start))
+
+	for _, invalid := range []interface{}{"next_0_days", "someday", "2030-13-01", 20300120} {
+		_, err := ParseFilterDate(invalid, now, seoul)
+		require.Error(t, err, "%v", invalid)
+		assert.True(t, strings.Contains(err.Error(), "VALIDATION_ERROR"))
+	}
+}
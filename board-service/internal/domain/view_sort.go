@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+
+	SortNullsFirst = "first"
+	SortNullsLast  = "last" // Default for both directions
+
+	MaxViewSortKeys = 3
+)
+
+// ViewSortKey is one key of the sort spec stored in SavedView.SortBy
+// Field is a built-in field name or a custom field ID
+type ViewSortKey struct {
+	Field     string `json:"field"`
+	Direction string `json:"direction"`
+	Nulls     string `json:"nulls"`
+}
+
+// ParseViewSort reads the sort spec of a view: a JSON array of keys, or a single field name
+// saved before multi-key sorting, sorted in the view's SortDirection
+func ParseViewSort(sortBy *string, direction string) ([]ViewSortKey, error) {
+	if sortBy == nil || strings.TrimSpace(*sortBy) == "" {
+		return nil, nil
+	}
+	spec := strings.TrimSpace(*sortBy)
+	if !strings.HasPrefix(spec, "[") {
+		return NormalizeViewSort([]ViewSortKey{{Field: spec, Direction: direction}})
+	}
+
+	var keys []ViewSortKey
+	if err := json.Unmarshal([]byte(spec), &keys); err != nil {
+		return nil, NewValidationError("sort", "정렬 형식이 올바르지 않습니다")
+	}
+	return NormalizeViewSort(keys)
+}
+
+// NormalizeViewSort validates sort keys and fills in the default direction (asc) and nulls placement (last)
+// Whether a field can be sorted by is checked when the spec is compiled
+func NormalizeViewSort(keys []ViewSortKey) ([]ViewSortKey, error) {
+	if len(keys) > MaxViewSortKeys {
+		return nil, NewValidationError("sort", fmt.Sprintf("정렬 기준은 %d개까지 지정할 수 있습니다", MaxViewSortKeys))
+	}
+
+	normalized := make([]ViewSortKey, 0, len(keys))
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		key.Field = strings.TrimSpace(key.Field)
+		key.Direction = strings.ToLower(key.Direction)
+		key.Nulls = strings.ToLower(key.Nulls)
+		if key.Field == "" {
+			return nil, NewValidationError("sort", "정렬 필드가 없습니다")
+		}
+		if seen[key.Field] {
+			return nil, NewValidationError("sort", fmt.Sprintf("정렬 필드가 중복되었습니다: %s", key.Field))
+		}
+		seen[key.Field] = true
+
+		switch key.Direction {
+		case "":
+			key.Direction = SortAsc
+		case SortAsc, SortDesc:
+		default:
+			return nil, NewValidationError("sort", "정렬 방향은 asc 또는 desc여야 합니다")
+		}
+		switch key.Nulls {
+		case "":
+			key.Nulls = SortNullsLast
+		case SortNullsFirst, SortNullsLast:
+		default:
+			return nil, NewValidationError("sort", "nulls는 first 또는 last여야 합니다")
+		}
+		normalized = append(normalized, key)
+	}
+	return normalized, nil
+}
+
+// EncodeViewSort returns the sort spec as stored in SavedView.SortBy, nil without keys
+func EncodeViewSort(keys []ViewSortKey) *string {
+	if len(keys) == 0 {
+		return nil
+	}
+	encoded, _ := json.Marshal(keys)
+	spec := string(encoded)
+	return &spec
+}
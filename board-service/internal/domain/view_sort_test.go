@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseViewSort(t *testing.T) {
+	spec := `[{"field": "dueDate", "direction": "DESC", "nulls": "first"}, {"field": "title"}]`
+	keys, err := ParseViewSort(&spec, "asc")
+	require.NoError(t, err)
+	assert.Equal(t, []ViewSortKey{
+		{Field: "dueDate", Direction: SortDesc, Nulls: SortNullsFirst},
+		{Field: "title", Direction: SortAsc, Nulls: SortNullsLast},
+	}, keys)
+
+	// Views saved before multi-key sorting hold a single field name
+	legacy := "created_at"
+	keys, err = ParseViewSort(&legacy, "desc")
+	require.NoError(t, err)
+	assert.Equal(t, []ViewSortKey{{Field: "created_at", Direction: SortDesc, Nulls: SortNullsLast}}, keys)
+
+	empty := " "
+	for _, sortBy := range []*string{nil, &empty} {
+		keys, err := ParseViewSort(sortBy, "asc")
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	}
+
+	broken := `[{"field": 1}]`
+	_, err = ParseViewSort(&broken, "asc")
+	assert.Error(t, err)
+}
+
+func TestNormalizeViewSort_RejectsInvalidKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []ViewSortKey
+	}{
+		{"too many keys", []ViewSortKey{{Field: "title"}, {Field: "dueDate"}, {Field: "createdAt"}, {Field: "updatedAt"}}},
+		{"duplicate field", []ViewSortKey{{Field: "title"}, {Field: "title", Direction: SortDesc}}},
+		{"missing field", []ViewSortKey{{Field: " "}}},
+		{"unknown direction", []ViewSortKey{{Field: "title", Direction: "up"}}},
+		{"unknown nulls", []ViewSortKey{{Field: "title", Nulls: "middle"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NormalizeViewSort(tt.keys)
+			require.Error(t, err)
+			assert.Equal(t, ErrCodeValidation, err.(*DomainError).Code)
+		})
+	}
+}
+
+func TestEncodeViewSort(t *testing.T) {
+	assert.Nil(t, EncodeViewSort(nil))
+
+	encoded := EncodeViewSort([]ViewSortKey{{Field: "title", Direction: SortAsc, Nulls: SortNullsLast}})
+	require.NotNil(t, encoded)
+	assert.JSONEq(t, `[{"field": "title", "direction": "asc", "nulls": "last"}]`, *encoded)
+}
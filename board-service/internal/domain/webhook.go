@@ -0,0 +1,207 @@
+package domain
+
+import (
+	"encoding/json"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Webhook is a project's subscription that forwards selected events to an external URL
+type Webhook struct {
+	BaseModel
+	ProjectID   uuid.UUID `gorm:"type:uuid;not null;index" json:"project_id"`
+	URL         string    `gorm:"type:varchar(2048);not null" json:"url"`
+	Secret      string    `gorm:"type:varchar(128);not null" json:"-"`                // HMAC-SHA256 signing key
+	EventTypes  string    `gorm:"type:text;not null;default:'[]'" json:"event_types"` // JSON array of subscribed event types
+	Description string    `gorm:"type:text" json:"description"`
+	IsActive    bool      `gorm:"default:true" json:"is_active"`
+	CreatedBy   uuid.UUID `gorm:"type:uuid;not null" json:"created_by"`
+}
+
+func (Webhook) TableName() string {
+	return "project_webhooks"
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+// WebhookDelivery is one event to be delivered to one webhook
+// The worker retries it until it succeeds or runs out of attempts.
+// An event has at most one original delivery per webhook, so a relay retry that
+// publishes the event again does not enqueue it twice; redeliveries are exempt.
+type WebhookDelivery struct {
+	BaseModel
+	WebhookID       uuid.UUID             `gorm:"type:uuid;not null;index;uniqueIndex:idx_webhook_deliveries_webhook_event,priority:1,where:redelivered_from IS NULL" json:"webhook_id"`
+	ProjectID       uuid.UUID             `gorm:"type:uuid;not null;index" json:"project_id"`
+	EventID         string                `gorm:"type:varchar(64);not null;uniqueIndex:idx_webhook_deliveries_webhook_event,priority:2" json:"event_id"`
+	EventType       string                `gorm:"type:varchar(64);not null" json:"event_type"`
+	Payload         string                `gorm:"type:text;not null" json:"payload"`
+	Status          WebhookDeliveryStatus `gorm:"type:varchar(20);not null;default:'PENDING';index" json:"status"`
+	AttemptCount    int                   `gorm:"not null;default:0" json:"attempt_count"`
+	NextAttemptAt   *time.Time            `gorm:"index" json:"next_attempt_at"`
+	LastAttemptAt   *time.Time            `json:"last_attempt_at"`
+	LastStatusCode  *int                  `json:"last_status_code"`
+	LastError       string                `gorm:"type:text" json:"last_error"`
+	RedeliveredFrom *uuid.UUID            `gorm:"type:uuid" json:"redelivered_from"` // Original delivery when created by "redeliver"
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// WebhookDeliveryAttempt records a single HTTP request made for a delivery
+type WebhookDeliveryAttempt struct {
+	BaseModel
+	DeliveryID    uuid.UUID `gorm:"type:uuid;not null;index" json:"delivery_id"`
+	AttemptNumber int       `gorm:"not null" json:"attempt_number"`
+	StatusCode    *int      `json:"status_code"`
+	ResponseBody  string    `gorm:"type:text" json:"response_body"` // Truncated
+	Error         string    `gorm:"type:text" json:"error"`
+	DurationMs    int64     `gorm:"not null;default:0" json:"duration_ms"`
+	Succeeded     bool      `gorm:"not null;default:false" json:"succeeded"`
+}
+
+func (WebhookDeliveryAttempt) TableName() string {
+	return "webhook_delivery_attempts"
+}
+
+// ==================== Rich Domain Model - Business Methods ====================
+
+// ValidateURL checks that the webhook target is an absolute http(s) URL outside the internal network
+// Host names are resolved only when delivering; the delivery worker re-checks the dialed address
+func (w *Webhook) ValidateURL() error {
+	parsed, err := url.Parse(w.URL)
+	if err != nil || parsed.Hostname() == "" {
+		return NewValidationError("url", "유효한 URL이 아닙니다")
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return NewValidationError("url", "http 또는 https URL만 사용할 수 있습니다")
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(parsed.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return NewValidationError("url", "내부 네트워크 주소로는 전송할 수 없습니다")
+	}
+	if ip := net.ParseIP(host); ip != nil && IsInternalIP(ip) {
+		return NewValidationError("url", "내부 네트워크 주소로는 전송할 수 없습니다")
+	}
+	return nil
+}
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), not covered by net.IP.IsPrivate
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// IsInternalIP reports whether a webhook must not be delivered to ip:
+// loopback, private (RFC 1918, fc00::/7), shared, link-local (including the
+// 169.254.169.254 metadata endpoint), multicast and unspecified addresses
+func IsInternalIP(ip net.IP) bool {
+	return ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		ip.IsUnspecified() ||
+		sharedAddressSpace.Contains(ip)
+}
+
+// GetEventTypes returns the subscribed event types
+func (w *Webhook) GetEventTypes() []string {
+	var types []string
+	if w.EventTypes == "" {
+		return types
+	}
+	if err := json.Unmarshal([]byte(w.EventTypes), &types); err != nil {
+		return []string{}
+	}
+	return types
+}
+
+// SetEventTypes replaces the subscribed event types
+func (w *Webhook) SetEventTypes(types []string) {
+	if types == nil {
+		types = []string{}
+	}
+	encoded, _ := json.Marshal(types)
+	w.EventTypes = string(encoded)
+}
+
+// Subscribes returns true if the webhook is active and subscribed to the event type
+func (w *Webhook) Subscribes(eventType string) bool {
+	if !w.IsActive {
+		return false
+	}
+	for _, t := range w.GetEventTypes() {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// NewDelivery creates a pending delivery of an event payload to this webhook
+func (w *Webhook) NewDelivery(eventID, eventType, payload string) *WebhookDelivery {
+	now := time.Now()
+	return &WebhookDelivery{
+		WebhookID:     w.ID,
+		ProjectID:     w.ProjectID,
+		EventID:       eventID,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        WebhookDeliveryPending,
+		NextAttemptAt: &now,
+	}
+}
+
+// IsFinished returns true if no further attempts will be made
+func (d *WebhookDelivery) IsFinished() bool {
+	return d.Status != WebhookDeliveryPending
+}
+
+// Redeliver creates a fresh pending copy of this delivery
+func (d *WebhookDelivery) Redeliver() *WebhookDelivery {
+	now := time.Now()
+	originalID := d.ID
+	return &WebhookDelivery{
+		WebhookID:       d.WebhookID,
+		ProjectID:       d.ProjectID,
+		EventID:         d.EventID,
+		EventType:       d.EventType,
+		Payload:         d.Payload,
+		Status:          WebhookDeliveryPending,
+		NextAttemptAt:   &now,
+		RedeliveredFrom: &originalID,
+	}
+}
+
+// RecordSuccess marks the delivery as delivered
+func (d *WebhookDelivery) RecordSuccess(statusCode int, at time.Time) {
+	d.AttemptCount++
+	d.Status = WebhookDeliverySucceeded
+	d.LastAttemptAt = &at
+	d.LastStatusCode = &statusCode
+	d.LastError = ""
+	d.NextAttemptAt = nil
+}
+
+// RecordFailure records a failed attempt
+// If nextAttemptAt is nil the delivery has no retries left and is marked FAILED
+func (d *WebhookDelivery) RecordFailure(statusCode *int, errMsg string, at time.Time, nextAttemptAt *time.Time) {
+	d.AttemptCount++
+	d.LastAttemptAt = &at
+	d.LastStatusCode = statusCode
+	d.LastError = errMsg
+	d.NextAttemptAt = nextAttemptAt
+	if nextAttemptAt == nil {
+		d.Status = WebhookDeliveryFailed
+	}
+}
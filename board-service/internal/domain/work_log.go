@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// WorkLog is time a user spent on a board on one day
+type WorkLog struct {
+	BaseModel
+	BoardID         uuid.UUID `gorm:"type:uuid;not null;index" json:"board_id"`
+	UserID          uuid.UUID `gorm:"type:uuid;not null;index" json:"user_id"`
+	DurationMinutes int       `gorm:"not null" json:"duration_minutes"`
+	WorkDate        time.Time `gorm:"type:date;not null;index" json:"work_date"` // Day the work was done (midnight UTC)
+	Note            string    `gorm:"type:varchar(1000)" json:"note"`
+}
+
+func (WorkLog) TableName() string {
+	return "work_logs"
+}
+
+const (
+	// MaxWorkLogMinutes is the longest duration of a single work log (one day)
+	MaxWorkLogMinutes = 24 * 60
+
+	// MaxWorkLogNoteLength is the maximum length of a work log note in characters
+	MaxWorkLogNoteLength = 1000
+
+	// MaxEstimateMinutes is the largest original or remaining estimate of a board (100000 hours)
+	MaxEstimateMinutes = 100000 * 60
+)
+
+// NewWorkLog creates a work log of the given user on the board
+func NewWorkLog(boardID, userID uuid.UUID, durationMinutes int, workDate time.Time, note string) (*WorkLog, error) {
+	workLog := &WorkLog{
+		BoardID: boardID,
+		UserID:  userID,
+	}
+	if err := workLog.UpdateDuration(durationMinutes); err != nil {
+		return nil, err
+	}
+	workLog.UpdateWorkDate(workDate)
+	if err := workLog.UpdateNote(note); err != nil {
+		return nil, err
+	}
+	return workLog, nil
+}
+
+// ==================== Rich Domain Model - Business Methods ====================
+
+// UpdateDuration changes the logged time with validation
+func (w *WorkLog) UpdateDuration(durationMinutes int) error {
+	if durationMinutes < 1 || durationMinutes > MaxWorkLogMinutes {
+		return NewValidationError("durationMinutes", "작업 시간은 1분 이상 24시간 이하여야 합니다")
+	}
+	w.DurationMinutes = durationMinutes
+	w.UpdatedAt = time.Now()
+	return nil
+}
+
+// UpdateWorkDate changes the day of the work; the time of day is dropped
+func (w *WorkLog) UpdateWorkDate(workDate time.Time) {
+	w.WorkDate = TruncateToDay(workDate)
+	w.UpdatedAt = time.Now()
+}
+
+// UpdateNote changes the note with validation
+func (w *WorkLog) UpdateNote(note string) error {
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > MaxWorkLogNoteLength {
+		return NewValidationError("note", "작업 메모는 1000자를 초과할 수 없습니다")
+	}
+	w.Note = note
+	w.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsLoggedBy returns true if the work was logged by the given user
+func (w *WorkLog) IsLoggedBy(userID uuid.UUID) bool {
+	return w.UserID == userID
+}
+
+// TruncateToDay returns midnight UTC of the calendar day of t
+func TruncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWorkLog_ValidatesDurationAndNote(t *testing.T) {
+	workDate := time.Date(2026, 3, 14, 18, 30, 0, 0, time.UTC)
+	workLog, err := NewWorkLog(uuid.New(), uuid.New(), 90, workDate, "  Pairing  ")
+	require.NoError(t, err)
+	assert.Equal(t, 90, workLog.DurationMinutes)
+	assert.Equal(t, "Pairing", workLog.Note)
+	assert.Equal(t, time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC), workLog.WorkDate, "time of day is dropped")
+
+	_, err = NewWorkLog(uuid.New(), uuid.New(), 0, workDate, "")
+	assert.Error(t, err)
+
+	_, err = NewWorkLog(uuid.New(), uuid.New(), MaxWorkLogMinutes+1, workDate, "")
+	assert.Error(t, err)
+
+	_, err = NewWorkLog(uuid.New(), uuid.New(), 30, workDate, strings.Repeat("가", MaxWorkLogNoteLength+1))
+	assert.Error(t, err)
+}
+
+func TestBoard_SetEstimates(t *testing.T) {
+	board := &Board{}
+	original, remaining := 120, 45
+
+	require.NoError(t, board.SetEstimates(&original, &remaining))
+	assert.Equal(t, 120, *board.OriginalEstimateMinutes)
+	assert.Equal(t, 45, *board.RemainingEstimateMinutes)
+
+	// nil clears an estimate
+	require.NoError(t, board.SetEstimates(nil, &remaining))
+	assert.Nil(t, board.OriginalEstimateMinutes)
+
+	negative, tooLarge := -1, MaxEstimateMinutes+1
+	assert.Error(t, board.SetEstimates(&negative, nil))
+	assert.Error(t, board.SetEstimates(nil, &tooLarge))
+	assert.Equal(t, 45, *board.RemainingEstimateMinutes, "estimates unchanged after a validation error")
+}
@@ -0,0 +1,69 @@
+package dto
+
+// ==================== Request DTOs ====================
+
+// AnalyticsRangeRequest is the day range of a project analytics report (YYYY-MM-DD, inclusive, UTC)
+// Without from and to the report covers the last 30 days up to today
+type AnalyticsRangeRequest struct {
+	From string `form:"from"`
+	To   string `form:"to"`
+}
+
+// ==================== Response DTOs ====================
+
+// AnalyticsStage is an option of the project's Stage field, in display order
+type AnalyticsStage struct {
+	OptionID string `json:"optionId"`
+	Label    string `json:"label"`
+	Color    string `json:"color"`
+}
+
+// CumulativeFlowDay is the number of boards in each Stage option at the end of a day
+type CumulativeFlowDay struct {
+	Date    string         `json:"date"`    // YYYY-MM-DD
+	Counts  map[string]int `json:"counts"`  // Option ID → number of boards
+	NoStage int            `json:"noStage"` // Boards without a Stage value
+}
+
+type CumulativeFlowResponse struct {
+	From   string              `json:"from"`
+	To     string              `json:"to"`
+	Stages []AnalyticsStage    `json:"stages"`
+	Days   []CumulativeFlowDay `json:"days"`
+}
+
+// BurndownDay is the number of boards at the end of a day; open boards are those not in the completed Stage option
+type BurndownDay struct {
+	Date      string `json:"date"` // YYYY-MM-DD
+	Total     int    `json:"total"`
+	Completed int    `json:"completed"`
+	Open      int    `json:"open"`
+}
+
+type BurndownResponse struct {
+	From              string        `json:"from"`
+	To                string        `json:"to"`
+	CompletedOptionID string        `json:"completedOptionId"`
+	Days              []BurndownDay `json:"days"`
+}
+
+// TimePercentiles summarizes durations in hours (nearest rank, rounded to 0.1 hour)
+// The percentiles are null when no board was completed in the range
+type TimePercentiles struct {
+	Count    int      `json:"count"`
+	P50Hours *float64 `json:"p50Hours"`
+	P85Hours *float64 `json:"p85Hours"`
+	P95Hours *float64 `json:"p95Hours"`
+}
+
+// CycleTimeResponse covers the boards completed in the range
+// Lead time runs from the creation of a board to its completion, cycle time from its first move to the
+// in-progress Stage option to its completion (boards that skipped the in-progress option are left out)
+type CycleTimeResponse struct {
+	From               string          `json:"from"`
+	To                 string          `json:"to"`
+	InProgressOptionID *string         `json:"inProgressOptionId"`
+	CompletedOptionID  string          `json:"completedOptionId"`
+	LeadTime           TimePercentiles `json:"leadTime"`
+	CycleTime          TimePercentiles `json:"cycleTime"`
+}
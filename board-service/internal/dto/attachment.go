@@ -0,0 +1,42 @@
+package dto
+
+import "time"
+
+// ==================== Request DTOs ====================
+
+// UploadAttachmentRequest holds the form fields sent with the file (multipart field "file")
+// With a commentId the file is attached to that comment of the board
+type UploadAttachmentRequest struct {
+	CommentID string `form:"commentId" binding:"omitempty,uuid"`
+}
+
+// GetAttachmentsRequest filters the attachments of a board
+type GetAttachmentsRequest struct {
+	CommentID string `form:"commentId" binding:"omitempty,uuid"`
+}
+
+// DownloadAttachmentRequest is the query of a signed download link
+type DownloadAttachmentRequest struct {
+	Expires   int64  `form:"expires" binding:"required"`
+	Signature string `form:"signature" binding:"required"`
+}
+
+// ==================== Response DTOs ====================
+
+type AttachmentResponse struct {
+	AttachmentID string    `json:"attachmentId"`
+	BoardID      string    `json:"boardId"`
+	CommentID    *string   `json:"commentId"`
+	FileName     string    `json:"fileName"`
+	ContentType  string    `json:"contentType"`
+	Size         int64     `json:"size"`     // Bytes
+	Checksum     string    `json:"checksum"` // Hex-encoded SHA-256
+	UploadedBy   string    `json:"uploadedBy"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// AttachmentDownloadResponse is a download link that works without a token until it expires
+type AttachmentDownloadResponse struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
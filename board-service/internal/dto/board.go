@@ -6,17 +6,19 @@ import "time"
 
 type CreateBoardRequest struct {
 	ProjectID    string   `json:"projectId" binding:"required,uuid"`
-	Title        string   `json:"title" binding:"required,min=1,max=200"`
-	Content      string   `json:"content" binding:"max=5000"`
+	Title        string   `json:"title" binding:"required_without=TemplateID,max=200"` // Optional with a template (fills {title} of its pattern)
+	Content      string   `json:"content" binding:"max=5000"` // The template description if empty
 
 	// Legacy fields (deprecated - use custom fields instead)
 	StageID      *string  `json:"stageId" binding:"omitempty,uuid"`
 	ImportanceID *string  `json:"importanceId" binding:"omitempty,uuid"`
 	RoleID       *string  `json:"roleId" binding:"omitempty,uuid"` // Changed: single value
 
-	AssigneeID   *string  `json:"assigneeId" binding:"omitempty,uuid"` // Single assignee (creator/owner feel)
+	AssigneeID   *string  `json:"assigneeId" binding:"omitempty,uuid"` // Single assignee (creator/owner feel); the template rule if empty
 	ParticipantIDs []string `json:"participantIds" binding:"omitempty,dive,uuid"` // New: multiple participants
 	DueDate      *string  `json:"dueDate" binding:"omitempty"` // ISO 8601 format
+	ParentBoardID *string `json:"parentBoardId" binding:"omitempty,uuid"` // Create as a sub-task of this board
+	TemplateID   *string  `json:"templateId" binding:"omitempty,uuid"` // Board template of the project (field values, checklist, assignee rule)
 }
 
 type UpdateBoardRequest struct {
@@ -33,15 +35,30 @@ type UpdateBoardRequest struct {
 	DueDate      *string  `json:"dueDate" binding:"omitempty"`
 }
 
+// SetParentBoardRequest makes the board a sub-task of another board of the project
+// A null parentBoardId makes it a top-level board again
+type SetParentBoardRequest struct {
+	ParentBoardID *string `json:"parentBoardId" binding:"omitempty,uuid"`
+}
+
+// MoveBoardToProjectRequest moves a board to another project of the same workspace
+type MoveBoardToProjectRequest struct {
+	ProjectID string `json:"projectId" binding:"required,uuid"`
+}
+
 type GetBoardsRequest struct {
-	ProjectID    string `form:"projectId" binding:"required,uuid"`
-	StageID      string `form:"stageId"`       // Filter: by stage
-	RoleID       string `form:"roleId"`        // Filter: by role
-	ImportanceID string `form:"importanceId"`  // Filter: by importance
-	AssigneeID   string `form:"assigneeId"`    // Filter: by assignee
-	AuthorID     string `form:"authorId"`      // Filter: by author
-	Page         int    `form:"page" binding:"omitempty,min=1"`
-	Limit        int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	ProjectID     string `form:"projectId" binding:"required,uuid"`
+	StageID       string `form:"stageId"`       // Filter: by stage
+	RoleID        string `form:"roleId"`        // Filter: by role
+	ImportanceID  string `form:"importanceId"`  // Filter: by importance
+	AssigneeID    string `form:"assigneeId"`    // Filter: by assignee
+	AuthorID      string `form:"authorId"`      // Filter: by author
+	ParentBoardID string `form:"parentBoardId"` // Filter: sub-tasks of a board
+	HideSubtasks  bool   `form:"hideSubtasks"`  // Filter: top-level boards only
+	Page          int    `form:"page" binding:"omitempty,min=1"`
+	Limit         int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor        string `form:"cursor"`       // next_cursor or prev_cursor of a previous page, replaces page
+	IncludeTotal  *bool  `form:"includeTotal"` // Default: true without a cursor, false with one
 }
 
 // ==================== Response DTOs ====================
@@ -49,6 +66,8 @@ type GetBoardsRequest struct {
 type BoardResponse struct {
 	ID            string                     `json:"boardId"`
 	ProjectID     string                     `json:"projectId"`
+	Key           string                     `json:"key"`    // Human-readable key, e.g. "WEB-123"
+	Number        int                        `json:"number"` // Board number in the project
 	Title         string                     `json:"title"`
 	Content       string                     `json:"content"`
 	Assignee      *UserInfo                  `json:"assignee"`      // Single assignee (creator/owner feel)
@@ -60,6 +79,13 @@ type BoardResponse struct {
 	CustomFields  map[string]interface{}     `json:"customFields,omitempty"`  // Parsed custom_fields_cache (legacy)
 	FieldValues   []FieldValueWithInfo       `json:"fieldValues,omitempty"`   // Field values with field metadata
 	Position      string                     `json:"position,omitempty"`       // Board position in view
+	IsBlocked     bool                       `json:"isBlocked"`                // Blocked by a board that is not deleted
+	Relations     *BoardRelationsResponse    `json:"relations,omitempty"`      // Single board responses only
+	ParentBoardID *string                    `json:"parentBoardId"`            // Set for sub-tasks
+	SprintID      *string                    `json:"sprintId"`                 // Null for boards in the backlog
+	MilestoneID   *string                    `json:"milestoneId"`              // Null for boards without a milestone
+	Progress      *BoardProgressResponse     `json:"progress,omitempty"`       // Checklist completion including sub-tasks
+	TimeTracking  *BoardTimeTrackingResponse `json:"timeTracking,omitempty"`   // Estimates and logged time
 }
 
 type UserInfo struct {
@@ -69,11 +95,14 @@ type UserInfo struct {
 	IsActive bool   `json:"isActive"`
 }
 
+// PaginatedBoardsResponse is a page of boards, read by page number or by cursor
 type PaginatedBoardsResponse struct {
-	Boards []BoardResponse `json:"boards"`
-	Total  int64           `json:"total"`
-	Page   int             `json:"page"`
-	Limit  int             `json:"limit"`
+	Boards     []BoardResponse `json:"boards"`
+	Total      *int64          `json:"total,omitempty"` // Only if counted
+	Page       int             `json:"page,omitempty"`  // Not set for pages read by cursor
+	Limit      int             `json:"limit"`
+	NextCursor *string         `json:"next_cursor"` // Null on the last page
+	PrevCursor *string         `json:"prev_cursor"` // Null on the first page
 }
 
 // MoveBoardRequest represents a request to move a board to a different column/group
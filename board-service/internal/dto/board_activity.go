@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+// ==================== Request DTOs ====================
+
+type GetBoardActivitiesRequest struct {
+	Page  int `form:"page" binding:"omitempty,min=1"`
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// ==================== Response DTOs ====================
+
+type BoardActivityResponse struct {
+	ID        string      `json:"activityId"`
+	BoardID   string      `json:"boardId"`
+	Actor     UserInfo    `json:"actor"`
+	Action    string      `json:"action"`              // BOARD_UPDATED, FIELD_VALUE_CHANGED, BOARD_MOVED, COMMENT_ADDED, ...
+	Field     string      `json:"field,omitempty"`     // title, description, assignee, participants, due_date, custom_field, comment
+	FieldID   *string     `json:"fieldId,omitempty"`   // Custom field ID
+	FieldName string      `json:"fieldName,omitempty"` // Custom field name
+	CommentID *string     `json:"commentId,omitempty"`
+	OldValue  interface{} `json:"oldValue"`
+	NewValue  interface{} `json:"newValue"`
+	CreatedAt time.Time   `json:"createdAt"`
+}
+
+type PaginatedBoardActivitiesResponse struct {
+	Activities []BoardActivityResponse `json:"activities"`
+	Total      int64                   `json:"total"`
+	Page       int                     `json:"page"`
+	Limit      int                     `json:"limit"`
+}
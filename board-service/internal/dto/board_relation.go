@@ -0,0 +1,39 @@
+package dto
+
+import "time"
+
+// ==================== Request DTOs ====================
+
+// CreateBoardRelationRequest links the board in the path to another board
+// Type is BLOCKS, BLOCKED_BY, RELATES, DUPLICATES or DUPLICATED_BY, read from the path board's side
+type CreateBoardRelationRequest struct {
+	TargetBoardID string `json:"targetBoardId" binding:"required,uuid"`
+	Type          string `json:"type" binding:"required"`
+}
+
+// ==================== Response DTOs ====================
+
+// RelatedBoardResponse is the board at the other end of a relation
+type RelatedBoardResponse struct {
+	BoardID   string `json:"boardId"`
+	ProjectID string `json:"projectId"`
+	Key       string `json:"key"`
+	Title     string `json:"title"`
+}
+
+type BoardRelationResponse struct {
+	RelationID string               `json:"relationId"`
+	Type       string               `json:"type"` // Seen from the board the relation is listed under (e.g. BLOCKED_BY)
+	Board      RelatedBoardResponse `json:"board"`
+	CreatedBy  string               `json:"createdBy"`
+	CreatedAt  time.Time            `json:"createdAt"`
+}
+
+// BoardRelationsResponse groups the relations of a board by direction
+// Outgoing relations were created from this board (BLOCKS, DUPLICATES, RELATES),
+// incoming ones from the other board (BLOCKED_BY, DUPLICATED_BY, RELATES)
+type BoardRelationsResponse struct {
+	Outgoing  []BoardRelationResponse `json:"outgoing"`
+	Incoming  []BoardRelationResponse `json:"incoming"`
+	IsBlocked bool                    `json:"isBlocked"`
+}
@@ -0,0 +1,88 @@
+package dto
+
+import "time"
+
+// ==================== Request DTOs ====================
+
+// CreateBoardTemplateRequest creates a board template of the project
+// fieldValues maps field IDs to values in the format of the field-values API (arrays for multi_select and multi_user)
+type CreateBoardTemplateRequest struct {
+	Name         string                 `json:"name" binding:"required,max=100"`
+	TitlePattern string                 `json:"titlePattern" binding:"max=200"` // e.g. "[BUG] {title}" or "Release {date}"
+	Description  string                 `json:"description" binding:"max=5000"`
+	FieldValues  map[string]interface{} `json:"fieldValues"`
+	Checklist    []string               `json:"checklist" binding:"omitempty,max=50,dive,max=500"`
+	AssigneeRule string                 `json:"assigneeRule" binding:"omitempty,oneof=NONE CREATOR USER"` // NONE if empty
+	AssigneeID   *string                `json:"assigneeId" binding:"omitempty,uuid"`                      // Required with the USER rule
+}
+
+// UpdateBoardTemplateRequest changes the given attributes of a board template
+// A missing fieldValues or checklist is kept and an empty object or array clears it
+type UpdateBoardTemplateRequest struct {
+	Name         *string                `json:"name" binding:"omitempty,max=100"`
+	TitlePattern *string                `json:"titlePattern" binding:"omitempty,max=200"`
+	Description  *string                `json:"description" binding:"omitempty,max=5000"`
+	FieldValues  map[string]interface{} `json:"fieldValues"`
+	Checklist    []string               `json:"checklist" binding:"omitempty,max=50,dive,max=500"`
+	AssigneeRule *string                `json:"assigneeRule" binding:"omitempty,oneof=NONE CREATOR USER"`
+	AssigneeID   *string                `json:"assigneeId" binding:"omitempty,uuid"`
+}
+
+// DuplicateBoardRequest copies a board; the title defaults to "<title> (사본)"
+type DuplicateBoardRequest struct {
+	Title            *string `json:"title" binding:"omitempty,min=1,max=200"`
+	IncludeChecklist bool    `json:"includeChecklist"` // Copy the checklist items as unchecked items
+}
+
+// SetBoardRecurrenceRequest creates or replaces the recurrence rule of a board template
+// Times and days are read in the project time zone; dates are YYYY-MM-DD
+type SetBoardRecurrenceRequest struct {
+	Frequency      string   `json:"frequency" binding:"required,oneof=DAILY WEEKLY MONTHLY"`
+	Interval       int      `json:"interval" binding:"omitempty,min=1,max=31"`                          // Every n days, weeks or months; 1 if empty
+	Weekdays       []string `json:"weekdays" binding:"omitempty,max=7,dive,oneof=MO TU WE TH FR SA SU"` // WEEKLY; the weekday of startsOn if empty
+	MonthDay       int      `json:"monthDay" binding:"omitempty,min=1,max=31"`                          // MONTHLY; the last day in shorter months
+	TimeOfDay      string   `json:"timeOfDay" binding:"omitempty,len=5"`                                // HH:MM; 09:00 if empty
+	StartsOn       *string  `json:"startsOn"`                                                           // Today if empty
+	EndsOn         *string  `json:"endsOn"`                                                             // Last day, inclusive
+	MaxOccurrences *int     `json:"maxOccurrences" binding:"omitempty,min=1,max=1000"`                  // Number of boards to create
+}
+
+// ==================== Response DTOs ====================
+
+type BoardTemplateResponse struct {
+	TemplateID   string                 `json:"templateId"`
+	ProjectID    string                 `json:"projectId"`
+	Name         string                 `json:"name"`
+	TitlePattern string                 `json:"titlePattern"`
+	Description  string                 `json:"description"`
+	FieldValues  map[string]interface{} `json:"fieldValues"`
+	Checklist    []string               `json:"checklist"`
+	AssigneeRule string                 `json:"assigneeRule"`
+	AssigneeID   *string                `json:"assigneeId"`
+	CreatedBy    string                 `json:"createdBy"`
+	CreatedAt    time.Time              `json:"createdAt"`
+	UpdatedAt    time.Time              `json:"updatedAt"`
+}
+
+type BoardRecurrenceResponse struct {
+	RecurrenceID    string     `json:"recurrenceId"`
+	TemplateID      string     `json:"templateId"`
+	TemplateName    string     `json:"templateName"`
+	ProjectID       string     `json:"projectId"`
+	Frequency       string     `json:"frequency"`
+	Interval        int        `json:"interval"`
+	Weekdays        []string   `json:"weekdays"`
+	MonthDay        int        `json:"monthDay,omitempty"`
+	TimeOfDay       string     `json:"timeOfDay"`
+	TimeZone        string     `json:"timeZone"` // Project time zone the rule is read in
+	StartsOn        string     `json:"startsOn"`
+	EndsOn          *string    `json:"endsOn"`
+	MaxOccurrences  *int       `json:"maxOccurrences"`
+	OccurrenceCount int        `json:"occurrenceCount"`
+	NextRunAt       *time.Time `json:"nextRunAt"` // Null once the rule has ended
+	LastRunAt       *time.Time `json:"lastRunAt"`
+	LastBoardID     *string    `json:"lastBoardId"`
+	CreatedBy       string     `json:"createdBy"` // Author of the created boards
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
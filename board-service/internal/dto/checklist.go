@@ -0,0 +1,58 @@
+package dto
+
+import "time"
+
+// ==================== Request DTOs ====================
+
+// CreateChecklistItemRequest appends an item to the checklist of a board
+type CreateChecklistItemRequest struct {
+	Content    string  `json:"content" binding:"required,min=1,max=500"`
+	AssigneeID *string `json:"assigneeId" binding:"omitempty,uuid"`
+	DueDate    *string `json:"dueDate" binding:"omitempty"` // ISO 8601 format
+}
+
+// UpdateChecklistItemRequest changes the given attributes of an item
+// An empty assigneeId or dueDate clears the value
+type UpdateChecklistItemRequest struct {
+	Content    *string `json:"content" binding:"omitempty,min=1,max=500"`
+	IsChecked  *bool   `json:"isChecked"`
+	AssigneeID *string `json:"assigneeId" binding:"omitempty,uuid"`
+	DueDate    *string `json:"dueDate"`
+}
+
+// MoveChecklistItemRequest places an item right after another item of the same checklist
+// A null afterItemId moves the item to the top
+type MoveChecklistItemRequest struct {
+	AfterItemID *string `json:"afterItemId" binding:"omitempty,uuid"`
+}
+
+// ==================== Response DTOs ====================
+
+type ChecklistItemResponse struct {
+	ItemID     string     `json:"itemId"`
+	BoardID    string     `json:"boardId"`
+	Content    string     `json:"content"`
+	Position   string     `json:"position"` // Fractional index, items are returned in this order
+	IsChecked  bool       `json:"isChecked"`
+	CheckedBy  *string    `json:"checkedBy"`
+	CheckedAt  *time.Time `json:"checkedAt"`
+	AssigneeID *string    `json:"assigneeId"`
+	DueDate    *time.Time `json:"dueDate"`
+	CreatedBy  string     `json:"createdBy"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+// BoardProgressResponse is the completion of a board
+// Checklist counts include the checklists of the board's direct sub-tasks
+type BoardProgressResponse struct {
+	ChecklistTotal   int `json:"checklistTotal"`
+	ChecklistChecked int `json:"checklistChecked"`
+	SubtaskCount     int `json:"subtaskCount"`
+	Percent          int `json:"percent"` // 0-100, rounded down
+}
+
+type ChecklistResponse struct {
+	Items    []ChecklistItemResponse `json:"items"`
+	Progress BoardProgressResponse   `json:"progress"`
+}
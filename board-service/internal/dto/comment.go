@@ -7,9 +7,11 @@ import (
 )
 
 // CreateCommentRequest defines the structure for creating a new comment.
+// Set ParentCommentID to reply to a comment of the same board.
 type CreateCommentRequest struct {
-	BoardID uuid.UUID `json:"boardId" binding:"required"`
-	Content  string    `json:"content" binding:"required"`
+	BoardID         uuid.UUID  `json:"boardId" binding:"required"`
+	Content         string     `json:"content" binding:"required"`
+	ParentCommentID *uuid.UUID `json:"parentCommentId,omitempty"`
 }
 
 // UpdateCommentRequest defines the structure for updating a comment.
@@ -17,13 +19,55 @@ type UpdateCommentRequest struct {
 	Content string `json:"content" binding:"required"`
 }
 
+// GetCommentRepliesRequest defines the pagination of a comment thread.
+type GetCommentRepliesRequest struct {
+	Page         int    `form:"page" binding:"omitempty,min=1"`
+	Limit        int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor       string `form:"cursor"`       // next_cursor or prev_cursor of a previous page, replaces page
+	IncludeTotal *bool  `form:"includeTotal"` // Default: true without a cursor, false with one
+}
+
+// ToggleCommentReactionRequest defines the structure for adding or removing a reaction.
+type ToggleCommentReactionRequest struct {
+	Emoji string `json:"emoji" binding:"required"`
+}
+
 // CommentResponse defines the structure for a comment response.
 type CommentResponse struct {
-	ID          uuid.UUID `json:"commentId"`
-	UserID      uuid.UUID `json:"userId"`
-	UserName    string    `json:"userName"`
-	UserAvatar  string    `json:"userAvatar"`
-	Content     string    `json:"content"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+	ID              uuid.UUID                 `json:"commentId"`
+	UserID          uuid.UUID                 `json:"userId"`
+	UserName        string                    `json:"userName"`
+	UserAvatar      string                    `json:"userAvatar"`
+	Content         string                    `json:"content"`
+	ParentCommentID *uuid.UUID                `json:"parentCommentId,omitempty"`
+	Depth           int                       `json:"depth"`      // 0 for top-level comments
+	ReplyCount      int64                     `json:"replyCount"` // Direct replies only
+	Reactions       []CommentReactionResponse `json:"reactions"`
+	CreatedAt       time.Time                 `json:"createdAt"`
+	UpdatedAt       time.Time                 `json:"updatedAt"`
+}
+
+// CommentReactionResponse is the aggregated count of one emoji on a comment.
+type CommentReactionResponse struct {
+	Emoji       string `json:"emoji"`
+	Count       int64  `json:"count"`
+	ReactedByMe bool   `json:"reactedByMe"`
+}
+
+// ToggleCommentReactionResponse reports the state of a reaction after a toggle.
+type ToggleCommentReactionResponse struct {
+	CommentID uuid.UUID `json:"commentId"`
+	Emoji     string    `json:"emoji"`
+	Reacted   bool      `json:"reacted"` // false if the toggle removed the reaction
+	Count     int64     `json:"count"`
+}
+
+// PaginatedCommentsResponse defines one page of replies in a comment thread.
+type PaginatedCommentsResponse struct {
+	Comments   []CommentResponse `json:"comments"`
+	Total      *int64            `json:"total,omitempty"` // Omitted for cursor pages unless includeTotal is set
+	Page       int               `json:"page,omitempty"`  // Only for pages read by number
+	Limit      int               `json:"limit"`
+	NextCursor *string           `json:"next_cursor"`
+	PrevCursor *string           `json:"prev_cursor"`
 }
@@ -150,11 +150,21 @@ type CreateViewRequest struct {
 	IsDefault      bool                   `json:"isDefault"`                  // Default: false (only one default view per project)
 	IsShared       *bool                  `json:"isShared"`                   // Default: true if nil (team-shared view, most common)
 	Filters        map[string]interface{} `json:"filters"`
+	Sort           []ViewSortKey          `json:"sort" binding:"omitempty,max=3,dive"` // Takes precedence over sortBy/sortDirection
 	SortBy         string                 `json:"sortBy" binding:"omitempty"`
 	SortDirection  string                 `json:"sortDirection" binding:"omitempty,oneof=asc desc"`
 	GroupByFieldID string                 `json:"groupByFieldId" binding:"omitempty,uuid"`
 }
 
+// ViewSortKey is one key of a view's sort
+// Field is a built-in field (title, createdAt, updatedAt, dueDate, assignee, originalEstimate, remainingEstimate, timeSpent)
+// or a custom field ID
+type ViewSortKey struct {
+	Field     string `json:"field" binding:"required,max=255"`
+	Direction string `json:"direction" binding:"omitempty,oneof=asc desc"` // Default: asc
+	Nulls     string `json:"nulls" binding:"omitempty,oneof=first last"`   // Default: last
+}
+
 // UpdateViewRequest represents a request to update a saved view
 type UpdateViewRequest struct {
 	Name           string                 `json:"name" binding:"omitempty,min=1,max=255"`
@@ -162,6 +172,7 @@ type UpdateViewRequest struct {
 	IsDefault      *bool                  `json:"isDefault"`
 	IsShared       *bool                  `json:"isShared"`
 	Filters        map[string]interface{} `json:"filters"`
+	Sort           []ViewSortKey          `json:"sort" binding:"omitempty,max=3,dive"` // Unchanged if omitted, [] removes the sort
 	SortBy         *string                `json:"sortBy"`
 	SortDirection  string                 `json:"sortDirection" binding:"omitempty,oneof=asc desc"`
 	GroupByFieldID *string                `json:"groupByFieldId" binding:"omitempty,uuid"`
@@ -177,25 +188,30 @@ type ViewResponse struct {
 	IsDefault      bool                   `json:"isDefault"`
 	IsShared       bool                   `json:"isShared"`
 	Filters        map[string]interface{} `json:"filters"`
-	SortBy         string                 `json:"sortBy"`
-	SortDirection  string                 `json:"sortDirection"`
+	Sort           []ViewSortKey          `json:"sort"`
+	SortBy         string                 `json:"sortBy"`        // Field of the first sort key
+	SortDirection  string                 `json:"sortDirection"` // Direction of the first sort key
 	GroupByFieldID string                 `json:"groupByFieldId"`
 	CreatedAt      time.Time              `json:"createdAt"`
 	UpdatedAt      time.Time              `json:"updatedAt"`
 }
 
 // ApplyViewRequest represents a request to apply a view and get filtered boards
+// Without a cursor the page is read by page number and carries a next_cursor for the following pages
 type ApplyViewRequest struct {
-	ViewID string `form:"viewId" binding:"required,uuid"`
-	Page   int    `form:"page" binding:"omitempty,min=1"`
-	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Page         int    `form:"page" binding:"omitempty,min=1"`
+	Limit        int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor       string `form:"cursor"`       // next_cursor or prev_cursor of a previous page, replaces page
+	IncludeTotal *bool  `form:"includeTotal"` // Default: true without a cursor, false with one
 }
 
 // GroupedBoardsResponse represents boards grouped by a field
 type GroupedBoardsResponse struct {
 	GroupByField FieldResponse              `json:"groupByField"`
 	Groups       []BoardGroup               `json:"groups"`
-	Total        int64                      `json:"total"`
+	Total        *int64                     `json:"total,omitempty"` // Only if counted
+	NextCursor   *string                    `json:"next_cursor"`
+	PrevCursor   *string                    `json:"prev_cursor"`
 }
 
 type BoardGroup struct {
@@ -102,12 +102,26 @@ func (m *BoardMapper) ToResponseWithUserMap(
 	response := &BoardResponse{
 		ID:        board.ID.String(),
 		ProjectID: board.ProjectID.String(),
+		Key:       board.Key,
+		Number:    board.Number,
 		Title:     board.Title,
 		Content:   board.Description,
 		DueDate:   board.DueDate,
 		CreatedAt: board.CreatedAt,
 		UpdatedAt: board.UpdatedAt,
 	}
+	if board.ParentBoardID != nil {
+		parentID := board.ParentBoardID.String()
+		response.ParentBoardID = &parentID
+	}
+	if board.SprintID != nil {
+		sprintID := board.SprintID.String()
+		response.SprintID = &sprintID
+	}
+	if board.MilestoneID != nil {
+		milestoneID := board.MilestoneID.String()
+		response.MilestoneID = &milestoneID
+	}
 
 	// Parse CustomFieldsCache (JSONB)
 	if board.CustomFieldsCache != "" && board.CustomFieldsCache != "{}" {
@@ -0,0 +1,81 @@
+package dto
+
+import "time"
+
+// ==================== Request DTOs ====================
+
+// CreateMilestoneRequest creates an unreleased milestone (targetDate is YYYY-MM-DD)
+type CreateMilestoneRequest struct {
+	Name        string  `json:"name" binding:"required,max=100"`
+	Description string  `json:"description" binding:"max=2000"`
+	TargetDate  *string `json:"targetDate"`
+}
+
+// UpdateMilestoneRequest changes the given attributes of a milestone
+// A missing target date is kept and an empty string clears it; released releases or reopens the milestone
+type UpdateMilestoneRequest struct {
+	Name        *string `json:"name" binding:"omitempty,max=100"`
+	Description *string `json:"description" binding:"omitempty,max=2000"`
+	TargetDate  *string `json:"targetDate"`
+	Released    *bool   `json:"released"`
+}
+
+type GetMilestonesRequest struct {
+	Released *bool `form:"released"`
+}
+
+// SetBoardMilestoneRequest targets the board at a milestone; a null milestoneId removes it from its milestone
+type SetBoardMilestoneRequest struct {
+	MilestoneID *string `json:"milestoneId" binding:"omitempty,uuid"`
+}
+
+// ==================== Response DTOs ====================
+
+type MilestoneResponse struct {
+	MilestoneID    string     `json:"milestoneId"`
+	ProjectID      string     `json:"projectId"`
+	Name           string     `json:"name"`
+	Description    string     `json:"description"`
+	TargetDate     *string    `json:"targetDate"` // YYYY-MM-DD
+	Released       bool       `json:"released"`
+	ReleasedAt     *time.Time `json:"releasedAt"`
+	IsOverdue      bool       `json:"isOverdue"`      // Unreleased, past the target date and with open boards
+	BoardCount     int        `json:"boardCount"`     // Boards targeted at the milestone
+	CompletedCount int        `json:"completedCount"` // Boards in the completed Stage option
+	CreatedBy      string     `json:"createdBy"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+}
+
+// MilestoneStageProgress is the number of boards of the milestone in one Stage option
+type MilestoneStageProgress struct {
+	OptionID   string `json:"optionId"`
+	Label      string `json:"label"`
+	Color      string `json:"color"`
+	BoardCount int    `json:"boardCount"`
+	Completed  bool   `json:"completed"` // The option that counts as done
+}
+
+// MilestoneBoard is an open board of a milestone that passed its deadline
+type MilestoneBoard struct {
+	BoardID    string     `json:"boardId"`
+	Key        string     `json:"key"`
+	Title      string     `json:"title"`
+	AssigneeID *string    `json:"assigneeId"`
+	DueDate    *time.Time `json:"dueDate"` // Null when the milestone's target date applies
+}
+
+// MilestoneDetailResponse is a milestone with its progress by Stage option and its overdue boards
+type MilestoneDetailResponse struct {
+	MilestoneResponse
+	PercentComplete int                      `json:"percentComplete"` // Completed boards out of all boards (0 without boards)
+	Stages          []MilestoneStageProgress `json:"stages"`
+	NoStageCount    int                      `json:"noStageCount"` // Boards without a Stage value
+	OverdueBoards   []MilestoneBoard         `json:"overdueBoards"`
+}
+
+// BoardMilestoneResponse is the milestone a board is targeted at
+type BoardMilestoneResponse struct {
+	BoardID     string  `json:"boardId"`
+	MilestoneID *string `json:"milestoneId"` // Null for boards without a milestone
+}
@@ -0,0 +1,69 @@
+package dto
+
+import "time"
+
+// ==================== Request DTOs ====================
+
+type GetNotificationsRequest struct {
+	UnreadOnly bool `form:"unreadOnly"`
+	Page       int  `form:"page" binding:"omitempty,min=1"`
+	Limit      int  `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+type GetWatchedBoardsRequest struct {
+	Page  int `form:"page" binding:"omitempty,min=1"`
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// ==================== Response DTOs ====================
+
+type NotificationResponse struct {
+	ID         string     `json:"notificationId"`
+	Type       string     `json:"type"` // MENTION, ASSIGNED, COMMENT, FIELD_CHANGED, DUE_DATE_CHANGED
+	ProjectID  string     `json:"projectId"`
+	BoardID    string     `json:"boardId"`
+	BoardTitle string     `json:"boardTitle"`
+	CommentID  *string    `json:"commentId,omitempty"`
+	Actor      UserInfo   `json:"actor"`
+	Preview    string     `json:"preview,omitempty"` // Comment or description excerpt, changed field name or new due date
+	IsRead     bool       `json:"isRead"`
+	ReadAt     *time.Time `json:"readAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+type PaginatedNotificationsResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	UnreadCount   int64                  `json:"unreadCount"`
+	Total         int64                  `json:"total"`
+	Page          int                    `json:"page"`
+	Limit         int                    `json:"limit"`
+}
+
+type UnreadNotificationCountResponse struct {
+	UnreadCount int64 `json:"unreadCount"`
+}
+
+type MarkAllNotificationsReadResponse struct {
+	Updated int64 `json:"updated"`
+}
+
+type BoardWatchResponse struct {
+	BoardID    string `json:"boardId"`
+	IsWatching bool   `json:"isWatching"`
+}
+
+type WatchedBoardResponse struct {
+	BoardID    string     `json:"boardId"`
+	ProjectID  string     `json:"projectId"`
+	Title      string     `json:"title"`
+	AssigneeID *string    `json:"assigneeId,omitempty"`
+	DueDate    *time.Time `json:"dueDate,omitempty"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+type PaginatedWatchedBoardsResponse struct {
+	Boards []WatchedBoardResponse `json:"boards"`
+	Total  int64                  `json:"total"`
+	Page   int                    `json:"page"`
+	Limit  int                    `json:"limit"`
+}
@@ -5,14 +5,18 @@ import "time"
 // Request DTOs
 
 type CreateProjectRequest struct {
-	WorkspaceID string `json:"workspaceId" binding:"required,uuid"`
-	Name        string `json:"name" binding:"required,min=2,max=100"`
-	Description string `json:"description" binding:"max=500"`
+	WorkspaceID string  `json:"workspaceId" binding:"required,uuid"`
+	Name        string  `json:"name" binding:"required,min=2,max=100"`
+	Description string  `json:"description" binding:"max=500"`
+	Key         string  `json:"key" binding:"omitempty,min=2,max=10"` // Board key prefix (e.g. "WEB"); derived from the name if empty
+	TemplateID  *string `json:"templateId" binding:"omitempty,uuid"`  // Project template of the workspace; the default fields if empty
+	TimeZone    string  `json:"timeZone" binding:"omitempty,max=64"`  // IANA time zone of schedules (e.g. "Asia/Seoul"); UTC if empty
 }
 
 type UpdateProjectRequest struct {
 	Name        string `json:"name" binding:"omitempty,min=2,max=100"`
 	Description string `json:"description" binding:"omitempty,max=500"`
+	TimeZone    string `json:"timeZone" binding:"omitempty,max=64"` // Reschedules the recurring boards of the project
 }
 
 type SearchProjectsRequest struct {
@@ -40,11 +44,13 @@ type ProjectResponse struct {
 	ID          string    `json:"projectId"`
 	WorkspaceID string    `json:"workspaceId"`
 	Name        string    `json:"name"`
+	Key         string    `json:"key"`
 	Description string    `json:"description"`
 	OwnerID     string    `json:"ownerId"`
 	OwnerName   string    `json:"ownerName"`
 	OwnerEmail  string    `json:"ownerEmail"`
 	IsPublic    bool      `json:"isPublic"`
+	TimeZone    string    `json:"timeZone"`
 	CreatedAt   time.Time `json:"createdAt"`
 	UpdatedAt   time.Time `json:"updatedAt"`
 }
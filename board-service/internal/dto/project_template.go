@@ -0,0 +1,49 @@
+package dto
+
+import "time"
+
+// Request DTOs
+
+type CreateProjectTemplateRequest struct {
+	Name          string `json:"name" binding:"required,max=100"`
+	Description   string `json:"description" binding:"max=500"`
+	IncludeBoards bool   `json:"includeBoards"` // Keep the boards of the project (up to 200) as sample boards
+}
+
+type CloneProjectRequest struct {
+	Name          string `json:"name" binding:"required,min=2,max=100"`
+	Description   string `json:"description" binding:"max=500"`
+	Key           string `json:"key" binding:"omitempty,min=2,max=10"` // Board key prefix of the clone; derived from the name if empty
+	IncludeBoards bool   `json:"includeBoards"`                        // Copy the boards and their field values
+}
+
+// Response DTOs
+
+type ProjectTemplateResponse struct {
+	TemplateID      string    `json:"templateId"`
+	WorkspaceID     string    `json:"workspaceId"`
+	Name            string    `json:"name"`
+	Description     string    `json:"description"`
+	SourceProjectID *string   `json:"sourceProjectId"`
+	FieldCount      int       `json:"fieldCount"`
+	ViewCount       int       `json:"viewCount"`
+	BoardCount      int       `json:"boardCount"`
+	CreatedBy       string    `json:"createdBy"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+type ProjectTemplateField struct {
+	Name            string   `json:"name"`
+	FieldType       string   `json:"fieldType"`
+	IsRequired      bool     `json:"isRequired"`
+	IsSystemDefault bool     `json:"isSystemDefault"`
+	Options         []string `json:"options"` // Option labels in display order
+}
+
+type ProjectTemplateDetailResponse struct {
+	ProjectTemplateResponse
+	Fields []ProjectTemplateField `json:"fields"`
+	Views  []string               `json:"views"`  // Saved view names
+	Boards []string               `json:"boards"` // Sample board titles
+}
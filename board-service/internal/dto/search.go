@@ -0,0 +1,33 @@
+package dto
+
+// ==================== Request DTOs ====================
+
+type SearchRequest struct {
+	Query      string `form:"q" binding:"required,max=200"`
+	ProjectID  string `form:"projectId" binding:"omitempty,uuid"`
+	AssigneeID string `form:"assigneeId" binding:"omitempty,uuid"`
+	StageID    string `form:"stageId" binding:"omitempty,uuid"` // Option of the project's Stage field
+	Type       string `form:"type" binding:"omitempty,oneof=board comment"`
+	Page       int    `form:"page" binding:"omitempty,min=1"`
+	Limit      int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// ==================== Response DTOs ====================
+
+type SearchResultResponse struct {
+	Type      string  `json:"type"` // board or comment
+	ProjectID string  `json:"projectId"`
+	BoardID   string  `json:"boardId"`
+	BoardKey  string  `json:"boardKey"`
+	CommentID *string `json:"commentId,omitempty"`
+	Title     string  `json:"title"`   // Board title, HTML-escaped with matches in <mark>
+	Snippet   string  `json:"snippet"` // Excerpt of the description and text fields, or of the comment, HTML-escaped with matches in <mark>
+	Score     float64 `json:"score"`
+}
+
+type SearchResponse struct {
+	Results []SearchResultResponse `json:"results"`
+	Total   int64                  `json:"total"`
+	Page    int                    `json:"page"`
+	Limit   int                    `json:"limit"`
+}
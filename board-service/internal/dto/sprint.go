@@ -0,0 +1,98 @@
+package dto
+
+import "time"
+
+// ==================== Request DTOs ====================
+
+// CreateSprintRequest creates a planned sprint (dates are YYYY-MM-DD)
+type CreateSprintRequest struct {
+	Name      string  `json:"name" binding:"required,max=100"`
+	Goal      string  `json:"goal" binding:"max=2000"`
+	StartDate *string `json:"startDate"`
+	EndDate   *string `json:"endDate"`
+}
+
+// UpdateSprintRequest changes the given attributes of a sprint that is not closed
+// A missing date is kept and an empty string clears it
+type UpdateSprintRequest struct {
+	Name      *string `json:"name" binding:"omitempty,max=100"`
+	Goal      *string `json:"goal" binding:"omitempty,max=2000"`
+	StartDate *string `json:"startDate"`
+	EndDate   *string `json:"endDate"`
+}
+
+type GetSprintsRequest struct {
+	State string `form:"state" binding:"omitempty,oneof=planned active closed"`
+}
+
+// CloseSprintRequest closes the active sprint
+// Unfinished boards move to nextSprintId (a planned sprint of the project) or, without it, back to the backlog
+// completedOptionIds are the Stage options that count as finished (default: the last Stage option)
+type CloseSprintRequest struct {
+	NextSprintID       *string  `json:"nextSprintId" binding:"omitempty,uuid"`
+	CompletedOptionIDs []string `json:"completedOptionIds" binding:"omitempty,dive,uuid"`
+}
+
+// SetBoardSprintRequest plans the board in a sprint; a null sprintId moves it back to the backlog
+type SetBoardSprintRequest struct {
+	SprintID *string `json:"sprintId" binding:"omitempty,uuid"`
+}
+
+// ==================== Response DTOs ====================
+
+type SprintResponse struct {
+	SprintID   string     `json:"sprintId"`
+	ProjectID  string     `json:"projectId"`
+	Name       string     `json:"name"`
+	Goal       string     `json:"goal"`
+	StartDate  *string    `json:"startDate"` // YYYY-MM-DD
+	EndDate    *string    `json:"endDate"`   // YYYY-MM-DD
+	State      string     `json:"state"`     // planned, active or closed
+	StartedAt  *time.Time `json:"startedAt"`
+	ClosedAt   *time.Time `json:"closedAt"`
+	BoardCount int        `json:"boardCount"` // Boards currently in the sprint
+	CreatedBy  string     `json:"createdBy"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+// CloseSprintResponse is the closed sprint with the boards that were finished or moved on
+type CloseSprintResponse struct {
+	Sprint            SprintResponse `json:"sprint"`
+	CompletedBoardIDs []string       `json:"completedBoardIds"`
+	MovedBoardIDs     []string       `json:"movedBoardIds"` // Unfinished boards moved to the next sprint or the backlog
+	NextSprintID      *string        `json:"nextSprintId"`
+}
+
+// BoardSprintResponse is the sprint a board is planned in
+type BoardSprintResponse struct {
+	BoardID  string  `json:"boardId"`
+	SprintID *string `json:"sprintId"` // Null for the backlog
+}
+
+// SprintReportBoard is how one board took part in a sprint
+type SprintReportBoard struct {
+	BoardID     string    `json:"boardId"`
+	Key         string    `json:"key"`
+	Title       string    `json:"title"`
+	AddedAt     time.Time `json:"addedAt"`
+	Committed   bool      `json:"committed"`   // In the sprint when it started
+	Added       bool      `json:"added"`       // Added after the sprint started
+	Removed     bool      `json:"removed"`     // Removed before the sprint closed
+	Completed   bool      `json:"completed"`   // Finished (at close for closed sprints, now for the active sprint)
+	CarriedOver bool      `json:"carriedOver"` // Unfinished and moved to the next sprint at close
+}
+
+// SprintReportResponse compares the committed scope of a started sprint with what was completed
+type SprintReportResponse struct {
+	Sprint                  SprintResponse      `json:"sprint"`
+	CommittedCount          int                 `json:"committedCount"`
+	CommittedCompletedCount int                 `json:"committedCompletedCount"`
+	AddedCount              int                 `json:"addedCount"` // Scope added mid-sprint
+	AddedCompletedCount     int                 `json:"addedCompletedCount"`
+	RemovedCount            int                 `json:"removedCount"`
+	CompletedCount          int                 `json:"completedCount"`
+	IncompleteCount         int                 `json:"incompleteCount"` // In the sprint and not finished
+	CarriedOverCount        int                 `json:"carriedOverCount"`
+	Boards                  []SprintReportBoard `json:"boards"`
+}
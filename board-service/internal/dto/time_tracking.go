@@ -0,0 +1,109 @@
+package dto
+
+import "time"
+
+// ==================== Request DTOs ====================
+
+// UpdateEstimateRequest replaces the estimates of a board (in minutes)
+// A missing or null value clears the estimate
+type UpdateEstimateRequest struct {
+	OriginalEstimateMinutes  *int `json:"originalEstimateMinutes" binding:"omitempty,min=0"`
+	RemainingEstimateMinutes *int `json:"remainingEstimateMinutes" binding:"omitempty,min=0"`
+}
+
+// CreateWorkLogRequest logs time the current user spent on a board
+type CreateWorkLogRequest struct {
+	DurationMinutes int    `json:"durationMinutes" binding:"required,min=1,max=1440"`
+	WorkDate        string `json:"workDate" binding:"required"` // YYYY-MM-DD
+	Note            string `json:"note" binding:"max=1000"`
+}
+
+// UpdateWorkLogRequest changes the given attributes of a work log
+type UpdateWorkLogRequest struct {
+	DurationMinutes *int    `json:"durationMinutes" binding:"omitempty,min=1,max=1440"`
+	WorkDate        *string `json:"workDate"` // YYYY-MM-DD
+	Note            *string `json:"note" binding:"omitempty,max=1000"`
+}
+
+// GetProjectWorkLogsRequest lists the work logs of a project
+// from and to limit the work date (YYYY-MM-DD, inclusive)
+type GetProjectWorkLogsRequest struct {
+	From       string `form:"from"`
+	To         string `form:"to"`
+	UserID     string `form:"userId" binding:"omitempty,uuid"`
+	AssigneeID string `form:"assigneeId" binding:"omitempty,uuid"`
+	Page       int    `form:"page" binding:"omitempty,min=1"`
+	Limit      int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// GetTimeReportRequest summarizes the time tracking of a project
+// from and to limit the work date of the logged time (YYYY-MM-DD, inclusive); estimates are not dated
+type GetTimeReportRequest struct {
+	From       string `form:"from"`
+	To         string `form:"to"`
+	AssigneeID string `form:"assigneeId" binding:"omitempty,uuid"`
+}
+
+// ==================== Response DTOs ====================
+
+// BoardTimeTrackingResponse is the estimates and the logged time of a board in minutes
+type BoardTimeTrackingResponse struct {
+	OriginalEstimateMinutes  *int `json:"originalEstimateMinutes"`
+	RemainingEstimateMinutes *int `json:"remainingEstimateMinutes"`
+	TimeSpentMinutes         int  `json:"timeSpentMinutes"` // Sum of the board's work logs
+}
+
+type WorkLogResponse struct {
+	WorkLogID       string    `json:"workLogId"`
+	BoardID         string    `json:"boardId"`
+	UserID          string    `json:"userId"`
+	DurationMinutes int       `json:"durationMinutes"`
+	WorkDate        string    `json:"workDate"` // YYYY-MM-DD
+	Note            string    `json:"note"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+type BoardWorkLogsResponse struct {
+	WorkLogs     []WorkLogResponse         `json:"workLogs"`
+	TimeTracking BoardTimeTrackingResponse `json:"timeTracking"`
+}
+
+type PaginatedWorkLogsResponse struct {
+	WorkLogs []WorkLogResponse `json:"workLogs"`
+	Total    int64             `json:"total"`
+	Page     int               `json:"page"`
+	Limit    int               `json:"limit"`
+}
+
+// AssigneeTimeReport is the logged time and the estimates of the boards of one assignee
+type AssigneeTimeReport struct {
+	AssigneeID               *string `json:"assigneeId"` // null for unassigned boards
+	TimeSpentMinutes         int     `json:"timeSpentMinutes"`
+	OriginalEstimateMinutes  int     `json:"originalEstimateMinutes"`
+	RemainingEstimateMinutes int     `json:"remainingEstimateMinutes"`
+	EstimatedBoardCount      int     `json:"estimatedBoardCount"` // Boards with an original or remaining estimate
+}
+
+// UserTimeReport is the time logged by one user
+type UserTimeReport struct {
+	UserID           string `json:"userId"`
+	TimeSpentMinutes int    `json:"timeSpentMinutes"`
+}
+
+// DateTimeReport is the time logged on one day
+type DateTimeReport struct {
+	Date             string `json:"date"` // YYYY-MM-DD
+	TimeSpentMinutes int    `json:"timeSpentMinutes"`
+}
+
+type TimeReportResponse struct {
+	From                     *string              `json:"from"`
+	To                       *string              `json:"to"`
+	TimeSpentMinutes         int                  `json:"timeSpentMinutes"`
+	OriginalEstimateMinutes  int                  `json:"originalEstimateMinutes"`
+	RemainingEstimateMinutes int                  `json:"remainingEstimateMinutes"`
+	ByAssignee               []AssigneeTimeReport `json:"byAssignee"`
+	ByUser                   []UserTimeReport     `json:"byUser"`
+	ByDate                   []DateTimeReport     `json:"byDate"` // Oldest first
+}
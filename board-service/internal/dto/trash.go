@@ -0,0 +1,36 @@
+package dto
+
+import "time"
+
+// ==================== Request DTOs ====================
+
+type GetTrashRequest struct {
+	Type  string `form:"type" binding:"omitempty,oneof=board comment"` // Empty lists both
+	Page  int    `form:"page" binding:"omitempty,min=1"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+type GetTrashedProjectsRequest struct {
+	Page  int `form:"page" binding:"omitempty,min=1"`
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// ==================== Response DTOs ====================
+
+type TrashItemResponse struct {
+	ID        string     `json:"trashId"`
+	ItemType  string     `json:"itemType"` // board, comment, project
+	ItemID    string     `json:"itemId"`
+	ProjectID string     `json:"projectId"`
+	Title     string     `json:"title"` // Board title, comment excerpt or project name
+	DeletedBy string     `json:"deletedBy"`
+	DeletedAt time.Time  `json:"deletedAt"`
+	PurgeAt   *time.Time `json:"purgeAt,omitempty"` // Omitted when automatic purging is disabled
+}
+
+type PaginatedTrashItemsResponse struct {
+	Items []TrashItemResponse `json:"items"`
+	Total int64               `json:"total"`
+	Page  int                 `json:"page"`
+	Limit int                 `json:"limit"`
+}
@@ -0,0 +1,72 @@
+package dto
+
+import "time"
+
+// ==================== Request DTOs ====================
+
+type CreateWebhookRequest struct {
+	URL         string   `json:"url" binding:"required,url,max=2048"`
+	Events      []string `json:"events" binding:"required,min=1,dive,required"` // board.created, board.moved, field.value_changed, comment.created, member.joined
+	Description string   `json:"description" binding:"max=500"`
+}
+
+type UpdateWebhookRequest struct {
+	URL         *string  `json:"url" binding:"omitempty,url,max=2048"`
+	Events      []string `json:"events" binding:"omitempty,min=1,dive,required"`
+	Description *string  `json:"description" binding:"omitempty,max=500"`
+	IsActive    *bool    `json:"isActive"`
+}
+
+type GetWebhookDeliveriesRequest struct {
+	Page  int `form:"page" binding:"omitempty,min=1"`
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// ==================== Response DTOs ====================
+
+type WebhookResponse struct {
+	ID          string    `json:"webhookId"`
+	ProjectID   string    `json:"projectId"`
+	URL         string    `json:"url"`
+	Events      []string  `json:"events"`
+	Description string    `json:"description"`
+	IsActive    bool      `json:"isActive"`
+	Secret      string    `json:"secret,omitempty"` // Only returned when the webhook is created
+	CreatedBy   string    `json:"createdBy"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID              string                           `json:"deliveryId"`
+	WebhookID       string                           `json:"webhookId"`
+	EventID         string                           `json:"eventId"`
+	EventType       string                           `json:"eventType"`
+	Status          string                           `json:"status"` // PENDING, SUCCEEDED, FAILED
+	AttemptCount    int                              `json:"attemptCount"`
+	NextAttemptAt   *time.Time                       `json:"nextAttemptAt,omitempty"`
+	LastAttemptAt   *time.Time                       `json:"lastAttemptAt,omitempty"`
+	LastStatusCode  *int                             `json:"lastStatusCode,omitempty"`
+	LastError       string                           `json:"lastError,omitempty"`
+	RedeliveredFrom *string                          `json:"redeliveredFrom,omitempty"`
+	Payload         string                           `json:"payload,omitempty"`  // Only in delivery detail
+	Attempts        []WebhookDeliveryAttemptResponse `json:"attempts,omitempty"` // Only in delivery detail
+	CreatedAt       time.Time                        `json:"createdAt"`
+}
+
+type WebhookDeliveryAttemptResponse struct {
+	AttemptNumber int       `json:"attemptNumber"`
+	StatusCode    *int      `json:"statusCode,omitempty"`
+	ResponseBody  string    `json:"responseBody,omitempty"`
+	Error         string    `json:"error,omitempty"`
+	DurationMs    int64     `json:"durationMs"`
+	Succeeded     bool      `json:"succeeded"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type PaginatedWebhookDeliveriesResponse struct {
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+	Total      int64                     `json:"total"`
+	Page       int                       `json:"page"`
+	Limit      int                       `json:"limit"`
+}
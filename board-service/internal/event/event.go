@@ -0,0 +1,130 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Type identifies what changed in a project
+type Type string
+
+const (
+	// Board events
+	BoardCreated      Type = "board.created"
+	BoardUpdated      Type = "board.updated"
+	BoardDeleted      Type = "board.deleted"
+	BoardMoved        Type = "board.moved"
+	BoardOrderChanged Type = "board.order_changed"
+	BoardRestored     Type = "board.restored"
+
+	// Checklist item added, changed, reordered or removed (data.action)
+	BoardChecklistChanged Type = "board.checklist_changed"
+
+	// Attachment events (files of the board and of its comments)
+	BoardAttachmentAdded   Type = "board.attachment_added"
+	BoardAttachmentRemoved Type = "board.attachment_removed"
+
+	// Estimates changed or work log added, changed or removed (data.action)
+	BoardTimeTrackingChanged Type = "board.time_tracking_changed"
+
+	// Board planned in a sprint or moved back to the backlog
+	BoardSprintChanged Type = "board.sprint_changed"
+
+	// Board targeted at a milestone or removed from it
+	BoardMilestoneChanged Type = "board.milestone_changed"
+
+	// Board relation events, published to the projects of both boards
+	BoardRelationAdded   Type = "board.relation_added"
+	BoardRelationRemoved Type = "board.relation_removed"
+
+	// Comment events
+	CommentCreated  Type = "comment.created"
+	CommentUpdated  Type = "comment.updated"
+	CommentDeleted  Type = "comment.deleted"
+	CommentRestored Type = "comment.restored"
+	CommentReacted  Type = "comment.reacted"
+
+	// Field events (definitions, options and board values)
+	FieldCreated       Type = "field.created"
+	FieldUpdated       Type = "field.updated"
+	FieldDeleted       Type = "field.deleted"
+	FieldOptionChanged Type = "field.option_changed"
+	FieldValueChanged  Type = "field.value_changed"
+
+	// View events
+	ViewCreated Type = "view.created"
+	ViewUpdated Type = "view.updated"
+	ViewDeleted Type = "view.deleted"
+
+	// Sprint events (closing a sprint carries the boards moved to the next sprint in data)
+	SprintCreated Type = "sprint.created"
+	SprintUpdated Type = "sprint.updated"
+	SprintStarted Type = "sprint.started"
+	SprintClosed  Type = "sprint.closed"
+	SprintDeleted Type = "sprint.deleted"
+
+	// Milestone events (releasing or reopening a milestone is an update)
+	MilestoneCreated Type = "milestone.created"
+	MilestoneUpdated Type = "milestone.updated"
+	MilestoneDeleted Type = "milestone.deleted"
+
+	// Board template events
+	BoardTemplateCreated Type = "board_template.created"
+	BoardTemplateUpdated Type = "board_template.updated"
+	BoardTemplateDeleted Type = "board_template.deleted"
+
+	// Board recurrence events (boards created on schedule are published as board.created)
+	BoardRecurrenceUpdated Type = "board_recurrence.updated"
+	BoardRecurrenceDeleted Type = "board_recurrence.deleted"
+
+	// Member events
+	MemberJoined Type = "member.joined"
+
+	// Project events
+	ProjectDeleted  Type = "project.deleted"
+	ProjectRestored Type = "project.restored"
+)
+
+// Event is a change notification scoped to a single project
+// It is serialized as JSON both on the Redis channel and on the SSE stream
+type Event struct {
+	ID         string      `json:"eventId"`
+	Type       Type        `json:"type"`
+	ProjectID  string      `json:"projectId"`
+	BoardID    string      `json:"boardId,omitempty"`
+	ActorID    string      `json:"actorId"`
+	Data       interface{} `json:"data,omitempty"`
+	OccurredAt time.Time   `json:"occurredAt"`
+}
+
+// New creates an event with a fresh ID and timestamp
+func New(eventType Type, projectID, actorID uuid.UUID, data interface{}) Event {
+	return Event{
+		ID:         uuid.New().String(),
+		Type:       eventType,
+		ProjectID:  projectID.String(),
+		ActorID:    actorID.String(),
+		Data:       data,
+		OccurredAt: time.Now(),
+	}
+}
+
+// NewBoardEvent creates an event that concerns a specific board
+func NewBoardEvent(eventType Type, projectID, boardID, actorID uuid.UUID, data interface{}) Event {
+	evt := New(eventType, projectID, actorID, data)
+	evt.BoardID = boardID.String()
+	return evt
+}
+
+// Publisher delivers events to every board-service replica
+type Publisher interface {
+	Publish(ctx context.Context, evt Event) error
+}
+
+// Subscriber streams events of a project
+// The returned channel is closed once ctx is cancelled or the subscription fails
+type Subscriber interface {
+	Subscribe(ctx context.Context, projectID uuid.UUID) (<-chan Event, error)
+}
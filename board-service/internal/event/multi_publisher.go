@@ -0,0 +1,27 @@
+package event
+
+import (
+	"context"
+	"errors"
+)
+
+// multiPublisher fans an event out to several publishers (e.g. Redis pub/sub and webhooks)
+type multiPublisher struct {
+	publishers []Publisher
+}
+
+// NewMultiPublisher returns a Publisher that publishes to every given publisher
+// All publishers are called even if one fails; their errors are joined
+func NewMultiPublisher(publishers ...Publisher) Publisher {
+	return &multiPublisher{publishers: publishers}
+}
+
+func (m *multiPublisher) Publish(ctx context.Context, evt Event) error {
+	var errs []error
+	for _, p := range m.publishers {
+		if err := p.Publish(ctx, evt); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,89 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// subscriberBufferSize bounds how many events a slow SSE client can lag behind
+const subscriberBufferSize = 64
+
+// RedisBroker fans out project events through Redis pub/sub
+// Every replica publishes to and subscribes from the same channels,
+// so a client connected to any replica receives changes made on all of them
+type RedisBroker struct {
+	client *redis.Client
+	logger *zap.Logger
+}
+
+// NewRedisBroker creates a Redis pub/sub backed event broker
+func NewRedisBroker(client *redis.Client, logger *zap.Logger) *RedisBroker {
+	return &RedisBroker{client: client, logger: logger}
+}
+
+func (b *RedisBroker) Publish(ctx context.Context, evt Event) error {
+	payload, err := json.Marshal(evt)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	if err := b.client.Publish(ctx, ProjectChannel(evt.ProjectID), payload).Err(); err != nil {
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+	return nil
+}
+
+func (b *RedisBroker) Subscribe(ctx context.Context, projectID uuid.UUID) (<-chan Event, error) {
+	pubsub := b.client.Subscribe(ctx, ProjectChannel(projectID.String()))
+
+	// Wait for the subscription to be confirmed so that errors surface to the caller
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, fmt.Errorf("failed to subscribe project events: %w", err)
+	}
+
+	events := make(chan Event, subscriberBufferSize)
+	go func() {
+		defer close(events)
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				var evt Event
+				if err := json.Unmarshal([]byte(msg.Payload), &evt); err != nil {
+					b.logger.Warn("Failed to decode project event", zap.String("channel", msg.Channel), zap.Error(err))
+					continue
+				}
+
+				select {
+				case events <- evt:
+				default:
+					// Never block the Redis reader on a slow client
+					b.logger.Warn("Dropping project event for slow subscriber",
+						zap.String("project_id", evt.ProjectID),
+						zap.String("event_type", string(evt.Type)))
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// ProjectChannel returns the Redis channel name for a project's events
+func ProjectChannel(projectID string) string {
+	return fmt.Sprintf("project:%s:events", projectID)
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AnalyticsHandler struct {
+	service service.AnalyticsService
+}
+
+func NewAnalyticsHandler(service service.AnalyticsService) *AnalyticsHandler {
+	return &AnalyticsHandler{service: service}
+}
+
+// GetCumulativeFlow godoc
+// @Summary      Get cumulative flow
+// @Description  Count the boards in each Stage option at the end of every day of the range (project member only)
+// @Tags         analytics
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        from query string false "First day (YYYY-MM-DD, default 30 days before to)"
+// @Param        to query string false "Last day (YYYY-MM-DD, default today)"
+// @Success      200 {object} dto.SuccessResponse{data=dto.CumulativeFlowResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/analytics/cumulative-flow [get]
+// @Security     BearerAuth
+func (h *AnalyticsHandler) GetCumulativeFlow(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	var req dto.AnalyticsRangeRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	flow, err := h.service.GetCumulativeFlow(userID, projectID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, flow)
+}
+
+// GetBurndown godoc
+// @Summary      Get burndown
+// @Description  Count the total, completed and open boards at the end of every day of the range (project member only)
+// @Tags         analytics
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        from query string false "First day (YYYY-MM-DD, default 30 days before to)"
+// @Param        to query string false "Last day (YYYY-MM-DD, default today)"
+// @Success      200 {object} dto.SuccessResponse{data=dto.BurndownResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/analytics/burndown [get]
+// @Security     BearerAuth
+func (h *AnalyticsHandler) GetBurndown(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	var req dto.AnalyticsRangeRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	burndown, err := h.service.GetBurndown(userID, projectID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, burndown)
+}
+
+// GetCycleTime godoc
+// @Summary      Get lead and cycle time
+// @Description  Get p50/p85/p95 lead and cycle times in hours of the boards completed in the range; cycle time runs from the first move to 진행중 until 완료 (project member only)
+// @Tags         analytics
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        from query string false "First day (YYYY-MM-DD, default 30 days before to)"
+// @Param        to query string false "Last day (YYYY-MM-DD, default today)"
+// @Success      200 {object} dto.SuccessResponse{data=dto.CycleTimeResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/analytics/cycle-time [get]
+// @Security     BearerAuth
+func (h *AnalyticsHandler) GetCycleTime(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	var req dto.AnalyticsRangeRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	report, err := h.service.GetCycleTime(userID, projectID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, report)
+}
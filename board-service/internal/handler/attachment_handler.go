@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AttachmentHandler struct {
+	service service.AttachmentService
+}
+
+func NewAttachmentHandler(service service.AttachmentService) *AttachmentHandler {
+	return &AttachmentHandler{service: service}
+}
+
+// UploadAttachment godoc
+// @Summary      Upload attachment
+// @Description  Attach a file to a board or, with commentId, to one of its comments (project member only). The type is detected from the content and must be allowed; identical content is stored once
+// @Tags         attachments
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        file formData file true "File"
+// @Param        commentId formData string false "Comment ID"
+// @Success      201 {object} dto.SuccessResponse{data=dto.AttachmentResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Failure      413 {object} dto.ErrorResponse
+// @Failure      415 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/attachments [post]
+// @Security     BearerAuth
+func (h *AttachmentHandler) UploadAttachment(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	var req dto.UploadAttachmentRequest
+	if err := c.ShouldBind(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+	header, err := c.FormFile("file")
+	if err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "첨부할 파일이 없습니다", 400))
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "첨부 파일을 읽을 수 없습니다", 400))
+		return
+	}
+	defer file.Close()
+
+	attachment, err := h.service.UploadAttachment(userID, boardID, &req, header.Filename, file)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusCreated, attachment)
+}
+
+// GetAttachments godoc
+// @Summary      Get board attachments
+// @Description  Get the files of a board and of its comments, newest first (project member only)
+// @Tags         attachments
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        commentId query string false "Only the files of this comment"
+// @Success      200 {object} dto.SuccessResponse{data=[]dto.AttachmentResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/attachments [get]
+// @Security     BearerAuth
+func (h *AttachmentHandler) GetAttachments(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	var req dto.GetAttachmentsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	attachments, err := h.service.GetAttachments(userID, boardID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, attachments)
+}
+
+// GetDownloadURL godoc
+// @Summary      Get attachment download link
+// @Description  Get a signed link to the file that can be opened without a token until it expires (project member only)
+// @Tags         attachments
+// @Produce      json
+// @Param        attachmentId path string true "Attachment ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.AttachmentDownloadResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/attachments/{attachmentId}/url [get]
+// @Security     BearerAuth
+func (h *AttachmentHandler) GetDownloadURL(c *gin.Context) {
+	userID := c.GetString("user_id")
+	attachmentID := c.Param("attachmentId")
+
+	link, err := h.service.GetDownloadURL(userID, attachmentID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, link)
+}
+
+// DownloadAttachment godoc
+// @Summary      Download attachment
+// @Description  Download a file with a signed link from the url endpoint (no token required)
+// @Tags         attachments
+// @Produce      octet-stream
+// @Param        attachmentId path string true "Attachment ID"
+// @Param        expires query int true "Expiry of the link (Unix seconds)"
+// @Param        signature query string true "Signature of the link"
+// @Success      200 {file} file
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/attachments/{attachmentId}/download [get]
+func (h *AttachmentHandler) DownloadAttachment(c *gin.Context) {
+	attachmentID := c.Param("attachmentId")
+
+	var req dto.DownloadAttachmentRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	attachment, content, err := h.service.OpenDownload(attachmentID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+	defer content.Close()
+
+	c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, content, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}),
+		"X-Content-Type-Options": "nosniff",
+		"Cache-Control":          "private, no-store",
+	})
+}
+
+// DeleteAttachment godoc
+// @Summary      Delete attachment
+// @Description  Delete a file (uploader or ADMIN+). The stored content is removed once no other attachment uses it
+// @Tags         attachments
+// @Produce      json
+// @Param        attachmentId path string true "Attachment ID"
+// @Success      200 {object} dto.SuccessResponse{data=object{message=string}}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/attachments/{attachmentId} [delete]
+// @Security     BearerAuth
+func (h *AttachmentHandler) DeleteAttachment(c *gin.Context) {
+	userID := c.GetString("user_id")
+	attachmentID := c.Param("attachmentId")
+
+	if err := h.service.DeleteAttachment(userID, attachmentID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, gin.H{"message": "첨부 파일이 삭제되었습니다"})
+}
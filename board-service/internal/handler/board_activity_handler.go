@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BoardActivityHandler struct {
+	service service.BoardActivityService
+}
+
+func NewBoardActivityHandler(service service.BoardActivityService) *BoardActivityHandler {
+	return &BoardActivityHandler{service: service}
+}
+
+// GetBoardActivities godoc
+// @Summary      Get board activity history
+// @Description  Get the change history of a board (who changed what, with old/new values), newest first (project member only)
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        page query int false "Page number (default: 1)"
+// @Param        limit query int false "Items per page (default: 20, max: 100)"
+// @Success      200 {object} dto.SuccessResponse{data=dto.PaginatedBoardActivitiesResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/activity [get]
+// @Security     BearerAuth
+func (h *BoardActivityHandler) GetBoardActivities(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	var req dto.GetBoardActivitiesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	activities, err := h.service.GetBoardActivities(userID, boardID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, activities)
+}
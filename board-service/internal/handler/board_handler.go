@@ -19,7 +19,7 @@ func NewBoardHandler(service service.BoardService) *BoardHandler {
 
 // CreateBoard godoc
 // @Summary      Create board
-// @Description  Create a new board card (task/issue) in a project
+// @Description  Create a new board card (task/issue) in a project, optionally from a board template of the project (title pattern, description, assignee rule, field values and checklist)
 // @Tags         boards
 // @Accept       json
 // @Produce      json
@@ -85,6 +85,35 @@ func (h *BoardHandler) GetBoard(c *gin.Context) {
 	dto.Success(c, board)
 }
 
+// GetBoardByKey godoc
+// @Summary      Get board by key
+// @Description  Get a board by its human-readable key such as WEB-123 (case-insensitive, project member only). Keys a board had before moving to another project still resolve to it
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        key path string true "Board key (e.g. WEB-123)"
+// @Success      200 {object} dto.SuccessResponse{data=dto.BoardResponse}
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/by-key/{key} [get]
+// @Security     BearerAuth
+func (h *BoardHandler) GetBoardByKey(c *gin.Context) {
+	userID := c.GetString("user_id")
+	key := c.Param("key")
+
+	board, err := h.service.GetBoardByKey(key, userID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, board)
+}
+
 // GetBoards godoc
 // @Summary      Get boards
 // @Description  Get boards for a project with optional filters
@@ -97,8 +126,12 @@ func (h *BoardHandler) GetBoard(c *gin.Context) {
 // @Param        importanceId query string false "Filter by Importance ID"
 // @Param        assigneeId query string false "Filter by Assignee ID"
 // @Param        authorId query string false "Filter by Author ID"
+// @Param        parentBoardId query string false "Filter by parent board (sub-tasks of the board)"
+// @Param        hideSubtasks query bool false "Only top-level boards"
 // @Param        page query int false "Page number (default: 1)"
 // @Param        limit query int false "Items per page (default: 20, max: 100)"
+// @Param        cursor query string false "next_cursor or prev_cursor of a previous page (replaces page)"
+// @Param        includeTotal query bool false "Count the matching boards (default: true without a cursor)"
 // @Success      200 {object} dto.SuccessResponse{data=dto.PaginatedBoardsResponse}
 // @Failure      400 {object} dto.ErrorResponse
 // @Failure      403 {object} dto.ErrorResponse
@@ -244,3 +277,116 @@ func (h *BoardHandler) MoveBoard(c *gin.Context) {
 
 	dto.Success(c, response)
 }
+
+// MoveBoardToProject godoc
+// @Summary      Move board to another project
+// @Description  Move a board to another project of the same workspace (author or ADMIN+, member of the target project). The board gets a new key; the old key keeps resolving to it. Custom field values are cleared and users who are not members of the target project are unassigned
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        request body dto.MoveBoardToProjectRequest true "Target project"
+// @Success      200 {object} dto.SuccessResponse{data=dto.BoardResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/project [put]
+// @Security     BearerAuth
+func (h *BoardHandler) MoveBoardToProject(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	var req dto.MoveBoardToProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	board, err := h.service.MoveBoardToProject(boardID, req.ProjectID, userID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, board)
+}
+
+// SetParentBoard godoc
+// @Summary      Set parent board
+// @Description  Make a board a sub-task of another board of the same project, or a top-level board again with a null parentBoardId (author or ADMIN+). A board cannot become a sub-task of one of its own sub-tasks
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        request body dto.SetParentBoardRequest true "Parent board"
+// @Success      200 {object} dto.SuccessResponse{data=dto.BoardResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/parent [put]
+// @Security     BearerAuth
+func (h *BoardHandler) SetParentBoard(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	var req dto.SetParentBoardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	board, err := h.service.SetParentBoard(boardID, userID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, board)
+}
+
+// DuplicateBoard godoc
+// @Summary      Duplicate board
+// @Description  Copy a board in its project with its description, assignee, participants, due date, parent, milestone, estimates and field values, and optionally its checklist as unchecked items (project member only). The copy starts in the backlog
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        request body dto.DuplicateBoardRequest false "Copy options"
+// @Success      201 {object} dto.SuccessResponse{data=dto.BoardResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/duplicate [post]
+// @Security     BearerAuth
+func (h *BoardHandler) DuplicateBoard(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	var req dto.DuplicateBoardRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+			return
+		}
+	}
+
+	board, err := h.service.DuplicateBoard(boardID, userID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusCreated, board)
+}
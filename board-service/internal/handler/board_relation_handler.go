@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BoardRelationHandler struct {
+	service service.BoardRelationService
+}
+
+func NewBoardRelationHandler(service service.BoardRelationService) *BoardRelationHandler {
+	return &BoardRelationHandler{service: service}
+}
+
+// GetBoardRelations godoc
+// @Summary      Get board relations
+// @Description  Get the outgoing and incoming relations of a board and whether it is blocked (project member only)
+// @Tags         boards
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.BoardRelationsResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/relations [get]
+// @Security     BearerAuth
+func (h *BoardRelationHandler) GetBoardRelations(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	relations, err := h.service.GetRelations(userID, boardID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, relations)
+}
+
+// CreateBoardRelation godoc
+// @Summary      Add board relation
+// @Description  Link a board to another board of the same workspace (BLOCKS, BLOCKED_BY, RELATES, DUPLICATES, DUPLICATED_BY). Blocking cycles are rejected; the user must be a member of both projects
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        request body dto.CreateBoardRelationRequest true "Relation"
+// @Success      201 {object} dto.SuccessResponse{data=dto.BoardRelationResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Failure      409 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/relations [post]
+// @Security     BearerAuth
+func (h *BoardRelationHandler) CreateBoardRelation(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	var req dto.CreateBoardRelationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	relation, err := h.service.CreateRelation(userID, boardID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusCreated, relation)
+}
+
+// DeleteBoardRelation godoc
+// @Summary      Remove board relation
+// @Description  Remove a relation of a board (the user must be a member of both projects)
+// @Tags         boards
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        relationId path string true "Relation ID"
+// @Success      200 {object} dto.SuccessResponse{data=object{message=string}}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/relations/{relationId} [delete]
+// @Security     BearerAuth
+func (h *BoardRelationHandler) DeleteBoardRelation(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+	relationID := c.Param("relationId")
+
+	if err := h.service.DeleteRelation(userID, boardID, relationID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, gin.H{"message": "보드 관계가 삭제되었습니다"})
+}
@@ -0,0 +1,298 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BoardTemplateHandler struct {
+	service service.BoardTemplateService
+}
+
+func NewBoardTemplateHandler(service service.BoardTemplateService) *BoardTemplateHandler {
+	return &BoardTemplateHandler{service: service}
+}
+
+// CreateBoardTemplate godoc
+// @Summary      Create board template
+// @Description  Create a board template in a project: title pattern ({title}, {date}), description, preset field values, checklist and default assignee rule (project member only)
+// @Tags         board-templates
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        request body dto.CreateBoardTemplateRequest true "Board template"
+// @Success      201 {object} dto.SuccessResponse{data=dto.BoardTemplateResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/board-templates [post]
+// @Security     BearerAuth
+func (h *BoardTemplateHandler) CreateBoardTemplate(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	var req dto.CreateBoardTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	template, err := h.service.CreateTemplate(userID, projectID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusCreated, template)
+}
+
+// GetBoardTemplates godoc
+// @Summary      Get board templates
+// @Description  Get the board templates of a project ordered by name (project member only)
+// @Tags         board-templates
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Success      200 {object} dto.SuccessResponse{data=[]dto.BoardTemplateResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/board-templates [get]
+// @Security     BearerAuth
+func (h *BoardTemplateHandler) GetBoardTemplates(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	templates, err := h.service.GetTemplates(userID, projectID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, templates)
+}
+
+// GetBoardTemplate godoc
+// @Summary      Get board template
+// @Description  Get a board template (project member only)
+// @Tags         board-templates
+// @Produce      json
+// @Param        templateId path string true "Template ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.BoardTemplateResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/board-templates/{templateId} [get]
+// @Security     BearerAuth
+func (h *BoardTemplateHandler) GetBoardTemplate(c *gin.Context) {
+	userID := c.GetString("user_id")
+	templateID := c.Param("templateId")
+
+	template, err := h.service.GetTemplate(userID, templateID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, template)
+}
+
+// UpdateBoardTemplate godoc
+// @Summary      Update board template
+// @Description  Change the given attributes of a board template (template creator or ADMIN+)
+// @Tags         board-templates
+// @Accept       json
+// @Produce      json
+// @Param        templateId path string true "Template ID"
+// @Param        request body dto.UpdateBoardTemplateRequest true "Changes"
+// @Success      200 {object} dto.SuccessResponse{data=dto.BoardTemplateResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/board-templates/{templateId} [patch]
+// @Security     BearerAuth
+func (h *BoardTemplateHandler) UpdateBoardTemplate(c *gin.Context) {
+	userID := c.GetString("user_id")
+	templateID := c.Param("templateId")
+
+	var req dto.UpdateBoardTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	template, err := h.service.UpdateTemplate(userID, templateID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, template)
+}
+
+// DeleteBoardTemplate godoc
+// @Summary      Delete board template
+// @Description  Delete a board template; boards created from it are kept (template creator or ADMIN+)
+// @Tags         board-templates
+// @Produce      json
+// @Param        templateId path string true "Template ID"
+// @Success      200 {object} dto.SuccessResponse{data=object{message=string}}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/board-templates/{templateId} [delete]
+// @Security     BearerAuth
+func (h *BoardTemplateHandler) DeleteBoardTemplate(c *gin.Context) {
+	userID := c.GetString("user_id")
+	templateID := c.Param("templateId")
+
+	if err := h.service.DeleteTemplate(userID, templateID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, gin.H{"message": "보드 템플릿이 삭제되었습니다"})
+}
+
+// SetBoardRecurrence godoc
+// @Summary      Set board template recurrence
+// @Description  Create or replace the schedule creating boards from a template: DAILY, WEEKLY on weekdays or MONTHLY on a day, every interval, at timeOfDay in the project time zone, until endsOn or maxOccurrences (template creator or ADMIN+; the caller becomes the author of the boards)
+// @Tags         board-templates
+// @Accept       json
+// @Produce      json
+// @Param        templateId path string true "Template ID"
+// @Param        request body dto.SetBoardRecurrenceRequest true "Recurrence rule"
+// @Success      200 {object} dto.SuccessResponse{data=dto.BoardRecurrenceResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/board-templates/{templateId}/recurrence [put]
+// @Security     BearerAuth
+func (h *BoardTemplateHandler) SetBoardRecurrence(c *gin.Context) {
+	userID := c.GetString("user_id")
+	templateID := c.Param("templateId")
+
+	var req dto.SetBoardRecurrenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	recurrence, err := h.service.SetRecurrence(userID, templateID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, recurrence)
+}
+
+// GetBoardRecurrence godoc
+// @Summary      Get board template recurrence
+// @Description  Get the schedule of a template with its next run (project member only)
+// @Tags         board-templates
+// @Produce      json
+// @Param        templateId path string true "Template ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.BoardRecurrenceResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/board-templates/{templateId}/recurrence [get]
+// @Security     BearerAuth
+func (h *BoardTemplateHandler) GetBoardRecurrence(c *gin.Context) {
+	userID := c.GetString("user_id")
+	templateID := c.Param("templateId")
+
+	recurrence, err := h.service.GetRecurrence(userID, templateID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, recurrence)
+}
+
+// DeleteBoardRecurrence godoc
+// @Summary      Delete board template recurrence
+// @Description  Stop creating boards from a template; created boards are kept (template creator or ADMIN+)
+// @Tags         board-templates
+// @Produce      json
+// @Param        templateId path string true "Template ID"
+// @Success      200 {object} dto.SuccessResponse{data=object{message=string}}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/board-templates/{templateId}/recurrence [delete]
+// @Security     BearerAuth
+func (h *BoardTemplateHandler) DeleteBoardRecurrence(c *gin.Context) {
+	userID := c.GetString("user_id")
+	templateID := c.Param("templateId")
+
+	if err := h.service.DeleteRecurrence(userID, templateID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, gin.H{"message": "반복 규칙이 삭제되었습니다"})
+}
+
+// GetProjectBoardRecurrences godoc
+// @Summary      Get board recurrences of a project
+// @Description  Get the recurring board templates of a project, the next to run first and ended rules last (project member only)
+// @Tags         board-templates
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Success      200 {object} dto.SuccessResponse{data=[]dto.BoardRecurrenceResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/board-recurrences [get]
+// @Security     BearerAuth
+func (h *BoardTemplateHandler) GetProjectBoardRecurrences(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	recurrences, err := h.service.GetProjectRecurrences(userID, projectID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, recurrences)
+}
@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ChecklistHandler struct {
+	service service.ChecklistService
+}
+
+func NewChecklistHandler(service service.ChecklistService) *ChecklistHandler {
+	return &ChecklistHandler{service: service}
+}
+
+// GetChecklist godoc
+// @Summary      Get board checklist
+// @Description  Get the checklist items of a board in display order with the board's progress (project member only)
+// @Tags         checklists
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.ChecklistResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/checklist [get]
+// @Security     BearerAuth
+func (h *ChecklistHandler) GetChecklist(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	checklist, err := h.service.GetChecklist(userID, boardID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, checklist)
+}
+
+// CreateChecklistItem godoc
+// @Summary      Add checklist item
+// @Description  Append an item to the checklist of a board, optionally with an assignee and a due date (project member only)
+// @Tags         checklists
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        request body dto.CreateChecklistItemRequest true "Checklist item"
+// @Success      201 {object} dto.SuccessResponse{data=dto.ChecklistItemResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/checklist [post]
+// @Security     BearerAuth
+func (h *ChecklistHandler) CreateChecklistItem(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	var req dto.CreateChecklistItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	item, err := h.service.CreateItem(userID, boardID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusCreated, item)
+}
+
+// UpdateChecklistItem godoc
+// @Summary      Update checklist item
+// @Description  Change the content, checked state, assignee or due date of a checklist item. An empty assigneeId or dueDate clears it (project member only)
+// @Tags         checklists
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        itemId path string true "Checklist item ID"
+// @Param        request body dto.UpdateChecklistItemRequest true "Changes"
+// @Success      200 {object} dto.SuccessResponse{data=dto.ChecklistItemResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/checklist/{itemId} [patch]
+// @Security     BearerAuth
+func (h *ChecklistHandler) UpdateChecklistItem(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+	itemID := c.Param("itemId")
+
+	var req dto.UpdateChecklistItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	item, err := h.service.UpdateItem(userID, boardID, itemID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, item)
+}
+
+// MoveChecklistItem godoc
+// @Summary      Reorder checklist item
+// @Description  Move a checklist item right after another item, or to the top with a null afterItemId (project member only)
+// @Tags         checklists
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        itemId path string true "Checklist item ID"
+// @Param        request body dto.MoveChecklistItemRequest true "Target position"
+// @Success      200 {object} dto.SuccessResponse{data=dto.ChecklistItemResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/checklist/{itemId}/move [put]
+// @Security     BearerAuth
+func (h *ChecklistHandler) MoveChecklistItem(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+	itemID := c.Param("itemId")
+
+	var req dto.MoveChecklistItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	item, err := h.service.MoveItem(userID, boardID, itemID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, item)
+}
+
+// DeleteChecklistItem godoc
+// @Summary      Delete checklist item
+// @Description  Remove an item from the checklist of a board (project member only)
+// @Tags         checklists
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        itemId path string true "Checklist item ID"
+// @Success      200 {object} dto.SuccessResponse{data=object{message=string}}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/checklist/{itemId} [delete]
+// @Security     BearerAuth
+func (h *ChecklistHandler) DeleteChecklistItem(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+	itemID := c.Param("itemId")
+
+	if err := h.service.DeleteItem(userID, boardID, itemID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, gin.H{"message": "체크리스트 항목이 삭제되었습니다"})
+}
@@ -22,7 +22,7 @@ func NewCommentHandler(cs service.CommentService) *CommentHandler {
 
 // CreateComment godoc
 // @Summary      Create comment
-// @Description  Create a new comment on a board
+// @Description  Create a new comment on a board, or a reply when parentCommentId is set (COMMENT_MAX_THREAD_DEPTH levels at most)
 // @Tags         comments
 // @Accept       json
 // @Produce      json
@@ -62,7 +62,7 @@ func (h *CommentHandler) CreateComment(c *gin.Context) {
 
 // GetCommentsByBoardID godoc
 // @Summary      Get comments by board
-// @Description  Get all comments for a specific board (project member only)
+// @Description  Get the top-level comments of a board with their reply counts and reactions. Replies are loaded per thread (project member only)
 // @Tags         comments
 // @Accept       json
 // @Produce      json
@@ -155,7 +155,7 @@ func (h *CommentHandler) UpdateComment(c *gin.Context) {
 
 // DeleteComment godoc
 // @Summary      Delete comment
-// @Description  Delete a comment (soft delete, author only)
+// @Description  Delete a comment and its replies (moved to the project trash, author or project admin). A comment with replies by other users can only be deleted by a project admin
 // @Tags         comments
 // @Accept       json
 // @Produce      json
@@ -164,6 +164,7 @@ func (h *CommentHandler) UpdateComment(c *gin.Context) {
 // @Failure      400 {object} dto.ErrorResponse
 // @Failure      403 {object} dto.ErrorResponse
 // @Failure      404 {object} dto.ErrorResponse
+// @Failure      409 {object} dto.ErrorResponse "Other users replied to the comment"
 // @Router       /api/comments/{commentId} [delete]
 // @Security     BearerAuth
 func (h *CommentHandler) DeleteComment(c *gin.Context) {
@@ -191,3 +192,99 @@ func (h *CommentHandler) DeleteComment(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// GetCommentReplies godoc
+// @Summary      Get comment replies
+// @Description  Get the direct replies to a comment, oldest first (project member only)
+// @Tags         comments
+// @Produce      json
+// @Param        commentId path string true "Comment ID"
+// @Param        page query int false "Page number (default: 1)"
+// @Param        limit query int false "Items per page (default: 20, max: 100)"
+// @Param        cursor query string false "next_cursor or prev_cursor of a previous page (replaces page)"
+// @Param        includeTotal query bool false "Count the replies (default: true without a cursor)"
+// @Success      200 {object} dto.SuccessResponse{data=dto.PaginatedCommentsResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/comments/{commentId}/replies [get]
+// @Security     BearerAuth
+func (h *CommentHandler) GetCommentReplies(c *gin.Context) {
+	userIDStr := c.GetString("user_id")
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		dto.Error(c, apperrors.New(apperrors.ErrCodeBadRequest, "Invalid user ID format", http.StatusBadRequest))
+		return
+	}
+
+	commentID, err := uuid.Parse(c.Param("commentId"))
+	if err != nil {
+		dto.Error(c, apperrors.New(apperrors.ErrCodeBadRequest, "Invalid comment ID format", http.StatusBadRequest))
+		return
+	}
+
+	var req dto.GetCommentRepliesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "Invalid query parameters", http.StatusBadRequest))
+		return
+	}
+
+	resp, err := h.commentService.GetCommentReplies(c.Request.Context(), commentID, req, userID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, resp)
+}
+
+// ToggleReaction godoc
+// @Summary      Toggle comment reaction
+// @Description  Add an emoji reaction to a comment, or remove it if the user already reacted with that emoji (project member only)
+// @Tags         comments
+// @Accept       json
+// @Produce      json
+// @Param        commentId path string true "Comment ID"
+// @Param        request body dto.ToggleCommentReactionRequest true "Emoji"
+// @Success      200 {object} dto.SuccessResponse{data=dto.ToggleCommentReactionResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/comments/{commentId}/reactions [post]
+// @Security     BearerAuth
+func (h *CommentHandler) ToggleReaction(c *gin.Context) {
+	userIDStr := c.GetString("user_id")
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		dto.Error(c, apperrors.New(apperrors.ErrCodeBadRequest, "Invalid user ID format", http.StatusBadRequest))
+		return
+	}
+
+	commentID, err := uuid.Parse(c.Param("commentId"))
+	if err != nil {
+		dto.Error(c, apperrors.New(apperrors.ErrCodeBadRequest, "Invalid comment ID format", http.StatusBadRequest))
+		return
+	}
+
+	var req dto.ToggleCommentReactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "Invalid request body", http.StatusBadRequest))
+		return
+	}
+
+	resp, err := h.commentService.ToggleReaction(c.Request.Context(), commentID, req, userID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, resp)
+}
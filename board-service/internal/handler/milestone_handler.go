@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MilestoneHandler struct {
+	service service.MilestoneService
+}
+
+func NewMilestoneHandler(service service.MilestoneService) *MilestoneHandler {
+	return &MilestoneHandler{service: service}
+}
+
+// CreateMilestone godoc
+// @Summary      Create milestone
+// @Description  Create an unreleased milestone (release version) in a project (ADMIN+ only)
+// @Tags         milestones
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        request body dto.CreateMilestoneRequest true "Milestone"
+// @Success      201 {object} dto.SuccessResponse{data=dto.MilestoneResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/milestones [post]
+// @Security     BearerAuth
+func (h *MilestoneHandler) CreateMilestone(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	var req dto.CreateMilestoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	milestone, err := h.service.CreateMilestone(userID, projectID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusCreated, milestone)
+}
+
+// GetMilestones godoc
+// @Summary      Get project milestones
+// @Description  Get the milestones of a project with their board counts: unreleased milestones first, then by target date (project member only)
+// @Tags         milestones
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        released query bool false "Only released or only unreleased milestones"
+// @Success      200 {object} dto.SuccessResponse{data=[]dto.MilestoneResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/milestones [get]
+// @Security     BearerAuth
+func (h *MilestoneHandler) GetMilestones(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	var req dto.GetMilestonesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	milestones, err := h.service.GetMilestones(userID, projectID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, milestones)
+}
+
+// GetMilestone godoc
+// @Summary      Get milestone
+// @Description  Get a milestone with its progress by Stage option and its overdue boards (project member only)
+// @Tags         milestones
+// @Produce      json
+// @Param        milestoneId path string true "Milestone ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.MilestoneDetailResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/milestones/{milestoneId} [get]
+// @Security     BearerAuth
+func (h *MilestoneHandler) GetMilestone(c *gin.Context) {
+	userID := c.GetString("user_id")
+	milestoneID := c.Param("milestoneId")
+
+	milestone, err := h.service.GetMilestone(userID, milestoneID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, milestone)
+}
+
+// UpdateMilestone godoc
+// @Summary      Update milestone
+// @Description  Change the name, description or target date of a milestone, or release or reopen it (ADMIN+ only)
+// @Tags         milestones
+// @Accept       json
+// @Produce      json
+// @Param        milestoneId path string true "Milestone ID"
+// @Param        request body dto.UpdateMilestoneRequest true "Changes"
+// @Success      200 {object} dto.SuccessResponse{data=dto.MilestoneResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/milestones/{milestoneId} [patch]
+// @Security     BearerAuth
+func (h *MilestoneHandler) UpdateMilestone(c *gin.Context) {
+	userID := c.GetString("user_id")
+	milestoneID := c.Param("milestoneId")
+
+	var req dto.UpdateMilestoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	milestone, err := h.service.UpdateMilestone(userID, milestoneID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, milestone)
+}
+
+// DeleteMilestone godoc
+// @Summary      Delete milestone
+// @Description  Delete a milestone; its boards are kept without a milestone (ADMIN+ only)
+// @Tags         milestones
+// @Produce      json
+// @Param        milestoneId path string true "Milestone ID"
+// @Success      200 {object} dto.SuccessResponse{data=object{message=string}}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/milestones/{milestoneId} [delete]
+// @Security     BearerAuth
+func (h *MilestoneHandler) DeleteMilestone(c *gin.Context) {
+	userID := c.GetString("user_id")
+	milestoneID := c.Param("milestoneId")
+
+	if err := h.service.DeleteMilestone(userID, milestoneID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, gin.H{"message": "마일스톤이 삭제되었습니다"})
+}
+
+// SetBoardMilestone godoc
+// @Summary      Set board milestone
+// @Description  Target a board at an unreleased milestone of its project, or remove it from its milestone with a null milestoneId (project member only)
+// @Tags         milestones
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        request body dto.SetBoardMilestoneRequest true "Milestone"
+// @Success      200 {object} dto.SuccessResponse{data=dto.BoardMilestoneResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Failure      409 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/milestone [put]
+// @Security     BearerAuth
+func (h *MilestoneHandler) SetBoardMilestone(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	var req dto.SetBoardMilestoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	result, err := h.service.SetBoardMilestone(userID, boardID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, result)
+}
@@ -0,0 +1,250 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationHandler struct {
+	service service.NotificationService
+}
+
+func NewNotificationHandler(service service.NotificationService) *NotificationHandler {
+	return &NotificationHandler{service: service}
+}
+
+// ==================== Inbox ====================
+
+// GetNotifications godoc
+// @Summary      List my notifications
+// @Description  Get the current user's notifications (mentions, assignments, comments on watched boards), newest first
+// @Tags         notifications
+// @Produce      json
+// @Param        unreadOnly query bool false "Only unread notifications"
+// @Param        page query int false "Page number (default: 1)"
+// @Param        limit query int false "Items per page (default: 20, max: 100)"
+// @Success      200 {object} dto.SuccessResponse{data=dto.PaginatedNotificationsResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Router       /api/notifications [get]
+// @Security     BearerAuth
+func (h *NotificationHandler) GetNotifications(c *gin.Context) {
+	userID := c.GetString("user_id")
+
+	var req dto.GetNotificationsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	notifications, err := h.service.GetNotifications(userID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, notifications)
+}
+
+// GetUnreadCount godoc
+// @Summary      Count unread notifications
+// @Description  Get the number of unread notifications of the current user
+// @Tags         notifications
+// @Produce      json
+// @Success      200 {object} dto.SuccessResponse{data=dto.UnreadNotificationCountResponse}
+// @Router       /api/notifications/unread-count [get]
+// @Security     BearerAuth
+func (h *NotificationHandler) GetUnreadCount(c *gin.Context) {
+	userID := c.GetString("user_id")
+
+	count, err := h.service.GetUnreadCount(userID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, count)
+}
+
+// MarkRead godoc
+// @Summary      Mark notification as read
+// @Description  Mark one of the current user's notifications as read
+// @Tags         notifications
+// @Produce      json
+// @Param        notificationId path string true "Notification ID"
+// @Success      200 {object} dto.SuccessResponse{data=object{message=string}}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/notifications/{notificationId}/read [patch]
+// @Security     BearerAuth
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	userID := c.GetString("user_id")
+	notificationID := c.Param("notificationId")
+
+	if err := h.service.MarkRead(userID, notificationID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, gin.H{"message": "읽음 처리되었습니다"})
+}
+
+// MarkAllRead godoc
+// @Summary      Mark all notifications as read
+// @Description  Mark every unread notification of the current user as read
+// @Tags         notifications
+// @Produce      json
+// @Success      200 {object} dto.SuccessResponse{data=dto.MarkAllNotificationsReadResponse}
+// @Router       /api/notifications/read-all [post]
+// @Security     BearerAuth
+func (h *NotificationHandler) MarkAllRead(c *gin.Context) {
+	userID := c.GetString("user_id")
+
+	result, err := h.service.MarkAllRead(userID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// ==================== Board Watch ====================
+
+// GetBoardWatch godoc
+// @Summary      Get board watch state
+// @Description  Check whether the current user watches a board
+// @Tags         notifications
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.BoardWatchResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/watch [get]
+// @Security     BearerAuth
+func (h *NotificationHandler) GetBoardWatch(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	watch, err := h.service.GetBoardWatch(userID, boardID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, watch)
+}
+
+// WatchBoard godoc
+// @Summary      Watch board
+// @Description  Subscribe the current user to comments, field changes and due date changes of a board
+// @Tags         notifications
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.BoardWatchResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/watch [post]
+// @Security     BearerAuth
+func (h *NotificationHandler) WatchBoard(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	watch, err := h.service.WatchBoard(userID, boardID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, watch)
+}
+
+// UnwatchBoard godoc
+// @Summary      Unwatch board
+// @Description  Unsubscribe the current user from a board
+// @Tags         notifications
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.BoardWatchResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/watch [delete]
+// @Security     BearerAuth
+func (h *NotificationHandler) UnwatchBoard(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	watch, err := h.service.UnwatchBoard(userID, boardID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, watch)
+}
+
+// GetWatchedBoards godoc
+// @Summary      List my watched boards
+// @Description  Get the boards the current user watches, most recently watched first
+// @Tags         notifications
+// @Produce      json
+// @Param        page query int false "Page number (default: 1)"
+// @Param        limit query int false "Items per page (default: 20, max: 100)"
+// @Success      200 {object} dto.SuccessResponse{data=dto.PaginatedWatchedBoardsResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Router       /api/boards/watched [get]
+// @Security     BearerAuth
+func (h *NotificationHandler) GetWatchedBoards(c *gin.Context) {
+	userID := c.GetString("user_id")
+
+	var req dto.GetWatchedBoardsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	boards, err := h.service.GetWatchedBoards(userID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, boards)
+}
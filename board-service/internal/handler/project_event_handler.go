@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// sseHeartbeatInterval keeps idle connections open through proxies and load balancers
+const sseHeartbeatInterval = 25 * time.Second
+
+type ProjectEventHandler struct {
+	service   service.ProjectEventService
+	closed    chan struct{} // Closed on server shutdown to end every open stream
+	closeOnce sync.Once
+}
+
+func NewProjectEventHandler(service service.ProjectEventService) *ProjectEventHandler {
+	return &ProjectEventHandler{service: service, closed: make(chan struct{})}
+}
+
+// CloseStreams ends every open event stream
+// Streams only end when the client leaves, so http.Server.Shutdown would wait for them
+// until its deadline; register this with http.Server.RegisterOnShutdown
+func (h *ProjectEventHandler) CloseStreams() {
+	h.closeOnce.Do(func() { close(h.closed) })
+}
+
+// StreamProjectEvents godoc
+// @Summary      Stream project events
+// @Description  Server-Sent Events stream of board, comment, field and view changes in a project (project member only)
+// @Description  Each SSE message uses the event type (e.g. board.moved) as its event name and the event JSON as data
+// @Tags         projects
+// @Produce      text/event-stream
+// @Param        projectId path string true "Project ID"
+// @Success      200 {string} string "text/event-stream"
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/events [get]
+// @Security     BearerAuth
+func (h *ProjectEventHandler) StreamProjectEvents(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	ctx := c.Request.Context()
+	events, err := h.service.SubscribeProjectEvents(ctx, userID, projectID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // Disable nginx response buffering
+
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	// Send an initial comment so the client knows the stream is open
+	c.Writer.WriteString(": connected\n\n")
+	c.Writer.Flush()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-h.closed:
+			return false
+		case evt, ok := <-events:
+			if !ok {
+				return false
+			}
+			c.SSEvent(string(evt.Type), evt)
+			return true
+		case <-heartbeat.C:
+			c.SSEvent("heartbeat", gin.H{"time": time.Now()})
+			return true
+		}
+	})
+}
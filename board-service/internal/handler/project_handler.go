@@ -19,7 +19,7 @@ func NewProjectHandler(service service.ProjectService) *ProjectHandler {
 
 // CreateProject godoc
 // @Summary      Create project
-// @Description  Create a new project in a workspace (workspace member only)
+// @Description  Create a new project in a workspace from a project template of the workspace, or with the default Stage, Role and Importance fields (workspace member only)
 // @Tags         projects
 // @Accept       json
 // @Produce      json
@@ -171,7 +171,7 @@ func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 
 // DeleteProject godoc
 // @Summary      Delete project
-// @Description  Soft delete a project (OWNER only)
+// @Description  Delete a project with all boards, fields, views and members (OWNER only). Soft or permanent deletion follows PROJECT_DELETION_MODE
 // @Tags         projects
 // @Accept       json
 // @Produce      json
@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateTemplate godoc
+// @Summary      Create project template
+// @Description  Keep the fields, options and shared views of a project, and optionally up to 200 boards, as a template of its workspace (ADMIN+ only)
+// @Tags         project-templates
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        request body dto.CreateProjectTemplateRequest true "Template"
+// @Success      201 {object} dto.SuccessResponse{data=dto.ProjectTemplateResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/templates [post]
+// @Security     BearerAuth
+func (h *ProjectHandler) CreateTemplate(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	var req dto.CreateProjectTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	template, err := h.service.CreateTemplate(projectID, userID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusCreated, template)
+}
+
+// CloneProject godoc
+// @Summary      Clone project
+// @Description  Create a project with the fields, options and shared views of a project and, optionally, copies of its boards and field values (ADMIN+ of the source project only)
+// @Tags         project-templates
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Source project ID"
+// @Param        request body dto.CloneProjectRequest true "New project"
+// @Success      201 {object} dto.SuccessResponse{data=dto.ProjectResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Failure      409 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/clone [post]
+// @Security     BearerAuth
+func (h *ProjectHandler) CloneProject(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	token := c.GetString("token")
+	if token == "" {
+		dto.Error(c, apperrors.ErrMissingToken)
+		return
+	}
+
+	var req dto.CloneProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	project, err := h.service.CloneProject(projectID, userID, token, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusCreated, project)
+}
+
+// GetTemplates godoc
+// @Summary      Get project templates
+// @Description  Get the project templates of a workspace (workspace member only)
+// @Tags         project-templates
+// @Produce      json
+// @Param        workspaceId query string true "Workspace ID"
+// @Success      200 {object} dto.SuccessResponse{data=[]dto.ProjectTemplateResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/project-templates [get]
+// @Security     BearerAuth
+func (h *ProjectHandler) GetTemplates(c *gin.Context) {
+	userID := c.GetString("user_id")
+	workspaceID := c.Query("workspaceId")
+
+	if workspaceID == "" {
+		dto.Error(c, apperrors.New(apperrors.ErrCodeBadRequest, "workspaceId가 필요합니다", 400))
+		return
+	}
+
+	token := c.GetString("token")
+	if token == "" {
+		dto.Error(c, apperrors.ErrMissingToken)
+		return
+	}
+
+	templates, err := h.service.GetTemplates(workspaceID, userID, token)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, templates)
+}
+
+// GetTemplate godoc
+// @Summary      Get project template
+// @Description  Get a project template with the fields, views and sample boards it creates (workspace member only)
+// @Tags         project-templates
+// @Produce      json
+// @Param        templateId path string true "Template ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.ProjectTemplateDetailResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/project-templates/{templateId} [get]
+// @Security     BearerAuth
+func (h *ProjectHandler) GetTemplate(c *gin.Context) {
+	userID := c.GetString("user_id")
+	templateID := c.Param("templateId")
+
+	token := c.GetString("token")
+	if token == "" {
+		dto.Error(c, apperrors.ErrMissingToken)
+		return
+	}
+
+	template, err := h.service.GetTemplate(templateID, userID, token)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, template)
+}
+
+// DeleteTemplate godoc
+// @Summary      Delete project template
+// @Description  Delete a project template; projects created from it are kept (template creator only)
+// @Tags         project-templates
+// @Produce      json
+// @Param        templateId path string true "Template ID"
+// @Success      200 {object} dto.SuccessResponse{data=object{message=string}}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/project-templates/{templateId} [delete]
+// @Security     BearerAuth
+func (h *ProjectHandler) DeleteTemplate(c *gin.Context) {
+	userID := c.GetString("user_id")
+	templateID := c.Param("templateId")
+
+	token := c.GetString("token")
+	if token == "" {
+		dto.Error(c, apperrors.ErrMissingToken)
+		return
+	}
+
+	if err := h.service.DeleteTemplate(templateID, userID, token); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, gin.H{"message": "템플릿이 삭제되었습니다"})
+}
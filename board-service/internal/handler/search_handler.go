@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SearchHandler struct {
+	service service.SearchService
+}
+
+func NewSearchHandler(service service.SearchService) *SearchHandler {
+	return &SearchHandler{service: service}
+}
+
+// Search godoc
+// @Summary      Search boards and comments
+// @Description  Full-text search over board titles, descriptions, text custom field values and comments in the projects the user is a member of, best match first.
+// @Description  Words match as prefixes; parts of words and similar spellings also match. Titles and snippets are HTML-escaped with the matches in <mark>.
+// @Tags         search
+// @Produce      json
+// @Param        q query string true "Search text (max 200 characters)"
+// @Param        projectId query string false "Only this project (member only)"
+// @Param        assigneeId query string false "Only boards of this assignee, and their comments"
+// @Param        stageId query string false "Only boards in this Stage option, and their comments"
+// @Param        type query string false "Only boards or only comments" Enums(board, comment)
+// @Param        page query int false "Page number (default: 1)"
+// @Param        limit query int false "Items per page (default: 20, max: 100)"
+// @Success      200 {object} dto.SuccessResponse{data=dto.SearchResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/search [get]
+// @Security     BearerAuth
+func (h *SearchHandler) Search(c *gin.Context) {
+	userID := c.GetString("user_id")
+
+	var req dto.SearchRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	results, err := h.service.Search(userID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, results)
+}
@@ -0,0 +1,323 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SprintHandler struct {
+	service service.SprintService
+}
+
+func NewSprintHandler(service service.SprintService) *SprintHandler {
+	return &SprintHandler{service: service}
+}
+
+// CreateSprint godoc
+// @Summary      Create sprint
+// @Description  Create a planned sprint in a project (ADMIN+ only)
+// @Tags         sprints
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        request body dto.CreateSprintRequest true "Sprint"
+// @Success      201 {object} dto.SuccessResponse{data=dto.SprintResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/sprints [post]
+// @Security     BearerAuth
+func (h *SprintHandler) CreateSprint(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	var req dto.CreateSprintRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	sprint, err := h.service.CreateSprint(userID, projectID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusCreated, sprint)
+}
+
+// GetSprints godoc
+// @Summary      Get project sprints
+// @Description  Get the sprints of a project: the active sprint first, then planned and closed sprints by start date (project member only)
+// @Tags         sprints
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        state query string false "Sprint state" Enums(planned, active, closed)
+// @Success      200 {object} dto.SuccessResponse{data=[]dto.SprintResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/sprints [get]
+// @Security     BearerAuth
+func (h *SprintHandler) GetSprints(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	var req dto.GetSprintsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	sprints, err := h.service.GetSprints(userID, projectID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, sprints)
+}
+
+// GetSprint godoc
+// @Summary      Get sprint
+// @Description  Get a sprint with the number of boards in it (project member only)
+// @Tags         sprints
+// @Produce      json
+// @Param        sprintId path string true "Sprint ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.SprintResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/sprints/{sprintId} [get]
+// @Security     BearerAuth
+func (h *SprintHandler) GetSprint(c *gin.Context) {
+	userID := c.GetString("user_id")
+	sprintID := c.Param("sprintId")
+
+	sprint, err := h.service.GetSprint(userID, sprintID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, sprint)
+}
+
+// UpdateSprint godoc
+// @Summary      Update sprint
+// @Description  Change the name, goal or dates of a sprint that is not closed (ADMIN+ only)
+// @Tags         sprints
+// @Accept       json
+// @Produce      json
+// @Param        sprintId path string true "Sprint ID"
+// @Param        request body dto.UpdateSprintRequest true "Changes"
+// @Success      200 {object} dto.SuccessResponse{data=dto.SprintResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Failure      409 {object} dto.ErrorResponse
+// @Router       /api/sprints/{sprintId} [patch]
+// @Security     BearerAuth
+func (h *SprintHandler) UpdateSprint(c *gin.Context) {
+	userID := c.GetString("user_id")
+	sprintID := c.Param("sprintId")
+
+	var req dto.UpdateSprintRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	sprint, err := h.service.UpdateSprint(userID, sprintID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, sprint)
+}
+
+// DeleteSprint godoc
+// @Summary      Delete sprint
+// @Description  Delete a sprint that is not running; its boards go back to the backlog (ADMIN+ only)
+// @Tags         sprints
+// @Produce      json
+// @Param        sprintId path string true "Sprint ID"
+// @Success      200 {object} dto.SuccessResponse{data=object{message=string}}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Failure      409 {object} dto.ErrorResponse
+// @Router       /api/sprints/{sprintId} [delete]
+// @Security     BearerAuth
+func (h *SprintHandler) DeleteSprint(c *gin.Context) {
+	userID := c.GetString("user_id")
+	sprintID := c.Param("sprintId")
+
+	if err := h.service.DeleteSprint(userID, sprintID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, gin.H{"message": "스프린트가 삭제되었습니다"})
+}
+
+// StartSprint godoc
+// @Summary      Start sprint
+// @Description  Make a planned sprint the active sprint of its project; the boards in it become the committed scope (ADMIN+ only)
+// @Tags         sprints
+// @Produce      json
+// @Param        sprintId path string true "Sprint ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.SprintResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Failure      409 {object} dto.ErrorResponse
+// @Router       /api/sprints/{sprintId}/start [post]
+// @Security     BearerAuth
+func (h *SprintHandler) StartSprint(c *gin.Context) {
+	userID := c.GetString("user_id")
+	sprintID := c.Param("sprintId")
+
+	sprint, err := h.service.StartSprint(userID, sprintID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, sprint)
+}
+
+// CloseSprint godoc
+// @Summary      Close sprint
+// @Description  Close the active sprint; boards not in a finished Stage option move to the next sprint or back to the backlog (ADMIN+ only)
+// @Tags         sprints
+// @Accept       json
+// @Produce      json
+// @Param        sprintId path string true "Sprint ID"
+// @Param        request body dto.CloseSprintRequest false "Next sprint and finished Stage options"
+// @Success      200 {object} dto.SuccessResponse{data=dto.CloseSprintResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Failure      409 {object} dto.ErrorResponse
+// @Router       /api/sprints/{sprintId}/close [post]
+// @Security     BearerAuth
+func (h *SprintHandler) CloseSprint(c *gin.Context) {
+	userID := c.GetString("user_id")
+	sprintID := c.Param("sprintId")
+
+	var req dto.CloseSprintRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+			return
+		}
+	}
+
+	result, err := h.service.CloseSprint(userID, sprintID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// GetSprintReport godoc
+// @Summary      Get sprint report
+// @Description  Compare the committed scope of a started sprint with what was completed, including scope added or removed mid-sprint (project member only)
+// @Tags         sprints
+// @Produce      json
+// @Param        sprintId path string true "Sprint ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.SprintReportResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Failure      409 {object} dto.ErrorResponse
+// @Router       /api/sprints/{sprintId}/report [get]
+// @Security     BearerAuth
+func (h *SprintHandler) GetSprintReport(c *gin.Context) {
+	userID := c.GetString("user_id")
+	sprintID := c.Param("sprintId")
+
+	report, err := h.service.GetSprintReport(userID, sprintID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, report)
+}
+
+// SetBoardSprint godoc
+// @Summary      Set board sprint
+// @Description  Plan a board in a sprint of its project that is not closed, or move it back to the backlog with a null sprintId (project member only)
+// @Tags         sprints
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        request body dto.SetBoardSprintRequest true "Sprint"
+// @Success      200 {object} dto.SuccessResponse{data=dto.BoardSprintResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Failure      409 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/sprint [put]
+// @Security     BearerAuth
+func (h *SprintHandler) SetBoardSprint(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	var req dto.SetBoardSprintRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	result, err := h.service.SetBoardSprint(userID, boardID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, result)
+}
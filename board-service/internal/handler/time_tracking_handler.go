@@ -0,0 +1,267 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TimeTrackingHandler struct {
+	service service.TimeTrackingService
+}
+
+func NewTimeTrackingHandler(service service.TimeTrackingService) *TimeTrackingHandler {
+	return &TimeTrackingHandler{service: service}
+}
+
+// UpdateEstimate godoc
+// @Summary      Update board estimates
+// @Description  Replace the original and remaining estimates of a board in minutes; a missing value clears the estimate (project member only)
+// @Tags         time-tracking
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        request body dto.UpdateEstimateRequest true "Estimates"
+// @Success      200 {object} dto.SuccessResponse{data=dto.BoardTimeTrackingResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/estimate [put]
+// @Security     BearerAuth
+func (h *TimeTrackingHandler) UpdateEstimate(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	var req dto.UpdateEstimateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	timeTracking, err := h.service.UpdateEstimate(userID, boardID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, timeTracking)
+}
+
+// GetWorkLogs godoc
+// @Summary      Get board work logs
+// @Description  Get the work logs of a board, latest work date first, with its estimates and logged time (project member only)
+// @Tags         time-tracking
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.BoardWorkLogsResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/worklogs [get]
+// @Security     BearerAuth
+func (h *TimeTrackingHandler) GetWorkLogs(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	workLogs, err := h.service.GetWorkLogs(userID, boardID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, workLogs)
+}
+
+// CreateWorkLog godoc
+// @Summary      Log work
+// @Description  Log time the current user spent on a board on one day (project member only)
+// @Tags         time-tracking
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        request body dto.CreateWorkLogRequest true "Work log"
+// @Success      201 {object} dto.SuccessResponse{data=dto.WorkLogResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/worklogs [post]
+// @Security     BearerAuth
+func (h *TimeTrackingHandler) CreateWorkLog(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+
+	var req dto.CreateWorkLogRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	workLog, err := h.service.CreateWorkLog(userID, boardID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusCreated, workLog)
+}
+
+// UpdateWorkLog godoc
+// @Summary      Update work log
+// @Description  Change the duration, work date or note of a work log (logged by the user or ADMIN+)
+// @Tags         time-tracking
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        workLogId path string true "Work log ID"
+// @Param        request body dto.UpdateWorkLogRequest true "Changes"
+// @Success      200 {object} dto.SuccessResponse{data=dto.WorkLogResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/worklogs/{workLogId} [patch]
+// @Security     BearerAuth
+func (h *TimeTrackingHandler) UpdateWorkLog(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+	workLogID := c.Param("workLogId")
+
+	var req dto.UpdateWorkLogRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	workLog, err := h.service.UpdateWorkLog(userID, boardID, workLogID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, workLog)
+}
+
+// DeleteWorkLog godoc
+// @Summary      Delete work log
+// @Description  Delete a work log (logged by the user or ADMIN+)
+// @Tags         time-tracking
+// @Produce      json
+// @Param        boardId path string true "Board ID"
+// @Param        workLogId path string true "Work log ID"
+// @Success      200 {object} dto.SuccessResponse{data=object{message=string}}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/boards/{boardId}/worklogs/{workLogId} [delete]
+// @Security     BearerAuth
+func (h *TimeTrackingHandler) DeleteWorkLog(c *gin.Context) {
+	userID := c.GetString("user_id")
+	boardID := c.Param("boardId")
+	workLogID := c.Param("workLogId")
+
+	if err := h.service.DeleteWorkLog(userID, boardID, workLogID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, gin.H{"message": "작업 시간 기록이 삭제되었습니다"})
+}
+
+// GetProjectWorkLogs godoc
+// @Summary      Get project work logs
+// @Description  Get the work logs of a project's boards, latest work date first, filtered by work date range, user or board assignee (project member only)
+// @Tags         time-tracking
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        from query string false "First work date (YYYY-MM-DD)"
+// @Param        to query string false "Last work date (YYYY-MM-DD)"
+// @Param        userId query string false "Logged by this user"
+// @Param        assigneeId query string false "Boards of this assignee"
+// @Param        page query int false "Page number" default(1)
+// @Param        limit query int false "Items per page" default(20)
+// @Success      200 {object} dto.SuccessResponse{data=dto.PaginatedWorkLogsResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/worklogs [get]
+// @Security     BearerAuth
+func (h *TimeTrackingHandler) GetProjectWorkLogs(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	var req dto.GetProjectWorkLogsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	workLogs, err := h.service.GetProjectWorkLogs(userID, projectID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, workLogs)
+}
+
+// GetTimeReport godoc
+// @Summary      Get project time report
+// @Description  Sum the logged time of a project per board assignee, user and day, with the estimates per assignee (project member only). The date range only applies to the logged time
+// @Tags         time-tracking
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        from query string false "First work date (YYYY-MM-DD)"
+// @Param        to query string false "Last work date (YYYY-MM-DD)"
+// @Param        assigneeId query string false "Boards of this assignee"
+// @Success      200 {object} dto.SuccessResponse{data=dto.TimeReportResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/time-report [get]
+// @Security     BearerAuth
+func (h *TimeTrackingHandler) GetTimeReport(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	var req dto.GetTimeReportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	report, err := h.service.GetTimeReport(userID, projectID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, report)
+}
@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TrashHandler struct {
+	service service.TrashService
+}
+
+func NewTrashHandler(service service.TrashService) *TrashHandler {
+	return &TrashHandler{service: service}
+}
+
+// ==================== Project Trash (Boards, Comments) ====================
+
+// GetProjectTrash godoc
+// @Summary      List project trash
+// @Description  Get deleted boards and comments of a project, newest first (project member only)
+// @Tags         trash
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        type query string false "Item type filter" Enums(board, comment)
+// @Param        page query int false "Page number (default: 1)"
+// @Param        limit query int false "Items per page (default: 20, max: 100)"
+// @Success      200 {object} dto.SuccessResponse{data=dto.PaginatedTrashItemsResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/trash [get]
+// @Security     BearerAuth
+func (h *TrashHandler) GetProjectTrash(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	var req dto.GetTrashRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	items, err := h.service.GetProjectTrash(userID, projectID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, items)
+}
+
+// RestoreTrashItem godoc
+// @Summary      Restore trash item
+// @Description  Restore a deleted board (with the comments and field values deleted with it) or comment. Allowed for whoever deleted it and project admins
+// @Tags         trash
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        trashId path string true "Trash item ID"
+// @Success      200 {object} dto.SuccessResponse{data=object{message=string}}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Failure      409 {object} dto.ErrorResponse "The comment's board is still in the trash"
+// @Router       /api/projects/{projectId}/trash/{trashId}/restore [post]
+// @Security     BearerAuth
+func (h *TrashHandler) RestoreTrashItem(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+	trashID := c.Param("trashId")
+
+	if err := h.service.RestoreItem(userID, projectID, trashID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, gin.H{"message": "복원되었습니다"})
+}
+
+// PurgeTrashItem godoc
+// @Summary      Permanently delete trash item
+// @Description  Permanently delete a board or comment from the trash. This cannot be undone (project admin only)
+// @Tags         trash
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        trashId path string true "Trash item ID"
+// @Success      200 {object} dto.SuccessResponse{data=object{message=string}}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/trash/{trashId} [delete]
+// @Security     BearerAuth
+func (h *TrashHandler) PurgeTrashItem(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+	trashID := c.Param("trashId")
+
+	if err := h.service.PurgeItem(userID, projectID, trashID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, gin.H{"message": "영구 삭제되었습니다"})
+}
+
+// ==================== Project Trash ====================
+
+// GetTrashedProjects godoc
+// @Summary      List deleted projects
+// @Description  Get the deleted projects owned by the current user, newest first
+// @Tags         trash
+// @Produce      json
+// @Param        page query int false "Page number (default: 1)"
+// @Param        limit query int false "Items per page (default: 20, max: 100)"
+// @Success      200 {object} dto.SuccessResponse{data=dto.PaginatedTrashItemsResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Router       /api/trash/projects [get]
+// @Security     BearerAuth
+func (h *TrashHandler) GetTrashedProjects(c *gin.Context) {
+	userID := c.GetString("user_id")
+
+	var req dto.GetTrashedProjectsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	items, err := h.service.GetTrashedProjects(userID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, items)
+}
+
+// RestoreProject godoc
+// @Summary      Restore deleted project
+// @Description  Restore a deleted project with the data deleted together with it. Boards and comments deleted earlier stay in the project trash (project owner only)
+// @Tags         trash
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Success      200 {object} dto.SuccessResponse{data=object{message=string}}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/trash/projects/{projectId}/restore [post]
+// @Security     BearerAuth
+func (h *TrashHandler) RestoreProject(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	if err := h.service.RestoreProject(userID, projectID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, gin.H{"message": "프로젝트가 복원되었습니다"})
+}
+
+// PurgeProject godoc
+// @Summary      Permanently delete project
+// @Description  Permanently delete a project in the trash with all of its data. This cannot be undone (project owner only)
+// @Tags         trash
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Success      200 {object} dto.SuccessResponse{data=object{message=string}}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/trash/projects/{projectId} [delete]
+// @Security     BearerAuth
+func (h *TrashHandler) PurgeProject(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	if err := h.service.PurgeProject(userID, projectID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, gin.H{"message": "프로젝트가 영구 삭제되었습니다"})
+}
@@ -6,7 +6,6 @@ import (
 	"board-service/internal/middleware"
 	"board-service/internal/service"
 	"net/http"
-	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
@@ -203,6 +202,8 @@ func (h *ViewHandler) DeleteView(c *gin.Context) {
 // @Param viewId path string true "View ID"
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
+// @Param cursor query string false "next_cursor or prev_cursor of a previous page (replaces page)"
+// @Param includeTotal query bool false "Count the matching boards (default: true without a cursor)"
 // @Success 200 {object} dto.SuccessResponse{data=object}
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 401 {object} dto.ErrorResponse
@@ -215,11 +216,13 @@ func (h *ViewHandler) ApplyView(c *gin.Context) {
 	userID := c.GetString(middleware.UserIDKey)
 	viewID := c.Param("viewId")
 
-	// Get pagination params
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	var req dto.ApplyViewRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값이 유효하지 않습니다", 400))
+		return
+	}
 
-	result, err := h.viewService.ApplyView(userID, viewID, page, limit)
+	result, err := h.viewService.ApplyView(userID, viewID, req)
 	if err != nil {
 		if appErr, ok := err.(*apperrors.AppError); ok {
 			dto.Error(c, appErr)
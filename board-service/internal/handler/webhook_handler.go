@@ -0,0 +1,294 @@
+package handler
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/dto"
+	"board-service/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookHandler struct {
+	service service.WebhookService
+}
+
+func NewWebhookHandler(service service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{service: service}
+}
+
+// ==================== Webhook CRUD ====================
+
+// CreateWebhook godoc
+// @Summary      Create project webhook
+// @Description  Register an outgoing webhook for project events. The signing secret is only returned in this response (project admin only)
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        request body dto.CreateWebhookRequest true "Webhook creation request"
+// @Success      201 {object} dto.SuccessResponse{data=dto.WebhookResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/webhooks [post]
+// @Security     BearerAuth
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	var req dto.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	webhook, err := h.service.CreateWebhook(userID, projectID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusCreated, webhook)
+}
+
+// GetWebhooks godoc
+// @Summary      List project webhooks
+// @Description  Get all webhooks registered for a project (project admin only)
+// @Tags         webhooks
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Success      200 {object} dto.SuccessResponse{data=[]dto.WebhookResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/webhooks [get]
+// @Security     BearerAuth
+func (h *WebhookHandler) GetWebhooks(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+
+	webhooks, err := h.service.GetWebhooks(userID, projectID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, webhooks)
+}
+
+// GetWebhook godoc
+// @Summary      Get project webhook
+// @Description  Get a single webhook of a project (project admin only)
+// @Tags         webhooks
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        webhookId path string true "Webhook ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.WebhookResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/webhooks/{webhookId} [get]
+// @Security     BearerAuth
+func (h *WebhookHandler) GetWebhook(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+	webhookID := c.Param("webhookId")
+
+	webhook, err := h.service.GetWebhook(userID, projectID, webhookID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, webhook)
+}
+
+// UpdateWebhook godoc
+// @Summary      Update project webhook
+// @Description  Change the URL, subscribed events, description or active state of a webhook (project admin only)
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        webhookId path string true "Webhook ID"
+// @Param        request body dto.UpdateWebhookRequest true "Webhook update request"
+// @Success      200 {object} dto.SuccessResponse{data=dto.WebhookResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/webhooks/{webhookId} [patch]
+// @Security     BearerAuth
+func (h *WebhookHandler) UpdateWebhook(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+	webhookID := c.Param("webhookId")
+
+	var req dto.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	webhook, err := h.service.UpdateWebhook(userID, projectID, webhookID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, webhook)
+}
+
+// DeleteWebhook godoc
+// @Summary      Delete project webhook
+// @Description  Delete a webhook. Pending deliveries are not sent anymore (project admin only)
+// @Tags         webhooks
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        webhookId path string true "Webhook ID"
+// @Success      200 {object} dto.SuccessResponse{data=object{message=string}}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/webhooks/{webhookId} [delete]
+// @Security     BearerAuth
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+	webhookID := c.Param("webhookId")
+
+	if err := h.service.DeleteWebhook(userID, projectID, webhookID); err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, gin.H{"message": "Webhook이 삭제되었습니다"})
+}
+
+// ==================== Deliveries ====================
+
+// GetWebhookDeliveries godoc
+// @Summary      List webhook deliveries
+// @Description  Get the delivery log of a webhook, newest first (project admin only)
+// @Tags         webhooks
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        webhookId path string true "Webhook ID"
+// @Param        page query int false "Page number (default: 1)"
+// @Param        limit query int false "Items per page (default: 20, max: 100)"
+// @Success      200 {object} dto.SuccessResponse{data=dto.PaginatedWebhookDeliveriesResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/webhooks/{webhookId}/deliveries [get]
+// @Security     BearerAuth
+func (h *WebhookHandler) GetWebhookDeliveries(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+	webhookID := c.Param("webhookId")
+
+	var req dto.GetWebhookDeliveriesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.Error(c, apperrors.Wrap(err, apperrors.ErrCodeValidation, "입력값 검증 실패", 400))
+		return
+	}
+
+	deliveries, err := h.service.GetDeliveries(userID, projectID, webhookID, &req)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, deliveries)
+}
+
+// GetWebhookDelivery godoc
+// @Summary      Get webhook delivery
+// @Description  Get a delivery with its payload and every attempt (status code, response, error) (project admin only)
+// @Tags         webhooks
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        webhookId path string true "Webhook ID"
+// @Param        deliveryId path string true "Delivery ID"
+// @Success      200 {object} dto.SuccessResponse{data=dto.WebhookDeliveryResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/webhooks/{webhookId}/deliveries/{deliveryId} [get]
+// @Security     BearerAuth
+func (h *WebhookHandler) GetWebhookDelivery(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+	webhookID := c.Param("webhookId")
+	deliveryID := c.Param("deliveryId")
+
+	delivery, err := h.service.GetDelivery(userID, projectID, webhookID, deliveryID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.Success(c, delivery)
+}
+
+// RedeliverWebhookDelivery godoc
+// @Summary      Redeliver webhook delivery
+// @Description  Queue a new delivery with the same payload as a finished delivery (project admin only)
+// @Tags         webhooks
+// @Produce      json
+// @Param        projectId path string true "Project ID"
+// @Param        webhookId path string true "Webhook ID"
+// @Param        deliveryId path string true "Delivery ID"
+// @Success      201 {object} dto.SuccessResponse{data=dto.WebhookDeliveryResponse}
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      404 {object} dto.ErrorResponse
+// @Failure      409 {object} dto.ErrorResponse
+// @Router       /api/projects/{projectId}/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver [post]
+// @Security     BearerAuth
+func (h *WebhookHandler) RedeliverWebhookDelivery(c *gin.Context) {
+	userID := c.GetString("user_id")
+	projectID := c.Param("projectId")
+	webhookID := c.Param("webhookId")
+	deliveryID := c.Param("deliveryId")
+
+	delivery, err := h.service.RedeliverDelivery(userID, projectID, webhookID, deliveryID)
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			dto.Error(c, appErr)
+		} else {
+			dto.Error(c, apperrors.ErrInternalServer)
+		}
+		return
+	}
+
+	dto.SuccessWithStatus(c, http.StatusCreated, delivery)
+}
@@ -0,0 +1,174 @@
+package outbox
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// RelayConfig controls how often and how much the relay publishes
+type RelayConfig struct {
+	PollInterval    time.Duration // How often to look for unpublished events
+	BatchSize       int           // Max events claimed and published per poll
+	ClaimLease      time.Duration // How long claimed events are hidden from other relays while publishing
+	MaxAttempts     int           // Events that failed this often are left for inspection
+	Retention       time.Duration // Published events older than this are deleted
+	CleanupInterval time.Duration // How often published events are cleaned up
+}
+
+// DefaultRelayConfig returns the configuration used when nothing is overridden
+func DefaultRelayConfig() RelayConfig {
+	return RelayConfig{
+		PollInterval:    500 * time.Millisecond,
+		BatchSize:       100,
+		ClaimLease:      30 * time.Second,
+		MaxAttempts:     10,
+		Retention:       72 * time.Hour,
+		CleanupInterval: 1 * time.Hour,
+	}
+}
+
+// Relay publishes events written to the outbox table to a Sink
+// Each batch is claimed with a short lease (see OutboxRepository.ClaimUnpublished), so every
+// replica can run a relay without publishing the same row concurrently and without holding
+// row locks while the sinks are called. Delivery is at least once: an event whose lease
+// expires before it is marked published is published again, so sinks must tolerate duplicates.
+type Relay struct {
+	db     *gorm.DB
+	sink   Sink
+	config RelayConfig
+	logger *zap.Logger
+	now    func() time.Time
+}
+
+// NewRelay creates an outbox relay
+func NewRelay(db *gorm.DB, sink Sink, config RelayConfig, logger *zap.Logger) *Relay {
+	return &Relay{
+		db:     db,
+		sink:   sink,
+		config: config,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Run publishes outbox events until ctx is cancelled
+func (r *Relay) Run(ctx context.Context) {
+	r.logger.Info("Outbox relay started", zap.Duration("poll_interval", r.config.PollInterval))
+
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+	cleanup := time.NewTicker(r.config.CleanupInterval)
+	defer cleanup.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("Outbox relay stopped")
+			return
+		case <-ticker.C:
+			// Keep draining while full batches come back
+			for {
+				published, err := r.RelayOnce(ctx)
+				if err != nil {
+					r.logger.Error("Failed to relay outbox events", zap.Error(err))
+					break
+				}
+				if published < r.config.BatchSize || ctx.Err() != nil {
+					break
+				}
+			}
+		case <-cleanup.C:
+			if _, err := r.Cleanup(); err != nil {
+				r.logger.Warn("Failed to clean up outbox events", zap.Error(err))
+			}
+		}
+	}
+}
+
+// RelayOnce publishes one batch of unpublished events in order and returns how many were published
+// Publishing stops at the first sink failure so that later events do not overtake it
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	repo := repository.NewOutboxRepository(r.db)
+
+	rows, err := repo.ClaimUnpublished(r.now(), r.config.ClaimLease, r.config.MaxAttempts, r.config.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	// Stop publishing once the lease runs out; another relay may claim the events after that
+	publishCtx, cancel := context.WithTimeout(ctx, r.config.ClaimLease)
+	defer cancel()
+
+	publishedIDs := make([]uuid.UUID, 0, len(rows))
+	for i := range rows {
+		row := &rows[i]
+
+		var evt event.Event
+		if err := json.Unmarshal([]byte(row.Payload), &evt); err != nil {
+			// A payload that cannot be decoded will never succeed; skip it after recording why
+			r.recordFailure(repo, row, fmt.Sprintf("invalid payload: %v", err))
+			continue
+		}
+
+		if err := r.sink.Publish(publishCtx, evt); err != nil {
+			r.recordFailure(repo, row, err.Error())
+			r.releaseClaims(repo, rows[i+1:])
+			break
+		}
+		publishedIDs = append(publishedIDs, row.ID)
+	}
+
+	if err := repo.MarkPublished(publishedIDs, r.now()); err != nil {
+		return 0, fmt.Errorf("failed to mark outbox events as published: %w", err)
+	}
+
+	return len(publishedIDs), nil
+}
+
+// Cleanup deletes published events older than the retention period
+func (r *Relay) Cleanup() (int64, error) {
+	return repository.NewOutboxRepository(r.db).DeletePublishedBefore(r.now().Add(-r.config.Retention))
+}
+
+func (r *Relay) recordFailure(repo repository.OutboxRepository, row *domain.OutboxEvent, errMsg string) {
+	row.RecordFailure(errMsg)
+	if err := repo.RecordFailure(row); err != nil {
+		r.logger.Warn("Failed to record outbox failure", zap.Error(err), zap.String("event_id", row.EventID))
+		return
+	}
+
+	r.logger.Warn("Failed to publish outbox event",
+		zap.String("event_id", row.EventID),
+		zap.String("event_type", row.EventType),
+		zap.Int("attempts", row.Attempts),
+		zap.String("error", errMsg),
+	)
+}
+
+// releaseClaims lets the next poll pick up events that were claimed but not attempted
+func (r *Relay) releaseClaims(repo repository.OutboxRepository, rows []domain.OutboxEvent) {
+	if len(rows) == 0 {
+		return
+	}
+
+	ids := make([]uuid.UUID, len(rows))
+	for i := range rows {
+		ids[i] = rows[i].ID
+	}
+	if err := repo.ReleaseClaims(ids); err != nil {
+		// The lease expires on its own; the events are only delayed
+		r.logger.Warn("Failed to release outbox claims", zap.Error(err), zap.Int("count", len(ids)))
+	}
+}
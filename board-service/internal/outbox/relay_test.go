@@ -0,0 +1,221 @@
+package outbox
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// outboxTableSQL mirrors the migration; AutoMigrate cannot be used with SQLite
+// because of the gen_random_uuid() default of BaseModel
+const outboxTableSQL = `CREATE TABLE outbox_events (
+	id TEXT PRIMARY KEY,
+	event_id TEXT NOT NULL UNIQUE,
+	event_type TEXT NOT NULL,
+	project_id TEXT NOT NULL,
+	payload TEXT NOT NULL,
+	published_at DATETIME,
+	attempts INTEGER NOT NULL DEFAULT 0,
+	last_error TEXT,
+	locked_until DATETIME,
+	created_at DATETIME,
+	updated_at DATETIME,
+	is_deleted BOOLEAN DEFAULT false
+)`
+
+func setupRelayTest(t *testing.T) (*gorm.DB, *MemorySink, *Relay) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+
+	// In-memory SQLite databases are per connection
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	require.NoError(t, db.Exec(outboxTableSQL).Error)
+
+	sink := NewMemorySink()
+	config := DefaultRelayConfig()
+	config.MaxAttempts = 3
+	relay := NewRelay(db, sink, config, zap.NewNop())
+
+	return db, sink, relay
+}
+
+func findOutboxEvent(t *testing.T, db *gorm.DB, eventID string) *domain.OutboxEvent {
+	var row domain.OutboxEvent
+	require.NoError(t, db.Where("event_id = ?", eventID).First(&row).Error)
+	return &row
+}
+
+func TestRelay_PublishesCommittedEventsInOrder(t *testing.T) {
+	db, sink, relay := setupRelayTest(t)
+
+	projectID := uuid.New()
+	first := event.New(event.BoardCreated, projectID, uuid.New(), map[string]string{"title": "first"})
+	second := event.New(event.BoardMoved, projectID, uuid.New(), nil)
+
+	writer := repository.NewOutboxRepository(db)
+	require.NoError(t, writer.Write(first))
+	time.Sleep(10 * time.Millisecond) // created_at orders the batch
+	require.NoError(t, writer.Write(second))
+
+	published, err := relay.RelayOnce(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, published)
+	events := sink.Events()
+	require.Len(t, events, 2)
+	assert.Equal(t, first.ID, events[0].ID)
+	assert.Equal(t, second.ID, events[1].ID)
+	assert.True(t, findOutboxEvent(t, db, first.ID).IsPublished())
+
+	// Published events are not sent again
+	published, err = relay.RelayOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, published)
+	assert.Len(t, sink.Events(), 2)
+}
+
+func TestRelay_RolledBackEventsAreNeverPublished(t *testing.T) {
+	db, sink, relay := setupRelayTest(t)
+
+	evt := event.New(event.BoardDeleted, uuid.New(), uuid.New(), nil)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := repository.NewOutboxRepository(tx).Write(evt); err != nil {
+			return err
+		}
+		return errors.New("domain change failed")
+	})
+	require.Error(t, err)
+
+	published, err := relay.RelayOnce(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, published)
+	assert.Empty(t, sink.Events())
+}
+
+func TestRelay_SinkFailureIsRetried(t *testing.T) {
+	db, sink, relay := setupRelayTest(t)
+
+	evt := event.New(event.CommentCreated, uuid.New(), uuid.New(), nil)
+	require.NoError(t, repository.NewOutboxRepository(db).Write(evt))
+
+	// Sink is down: the event stays unpublished and the failure is recorded
+	sink.FailWith(errors.New("redis unavailable"))
+	published, err := relay.RelayOnce(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, published)
+	row := findOutboxEvent(t, db, evt.ID)
+	assert.False(t, row.IsPublished())
+	assert.Equal(t, 1, row.Attempts)
+	assert.Equal(t, "redis unavailable", row.LastError)
+
+	// Sink is back: the event is published on the next poll
+	sink.FailWith(nil)
+	published, err = relay.RelayOnce(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, published)
+	assert.True(t, findOutboxEvent(t, db, evt.ID).IsPublished())
+}
+
+func TestRelay_GivesUpAfterMaxAttempts(t *testing.T) {
+	db, sink, relay := setupRelayTest(t)
+
+	evt := event.New(event.FieldCreated, uuid.New(), uuid.New(), nil)
+	require.NoError(t, repository.NewOutboxRepository(db).Write(evt))
+
+	sink.FailWith(errors.New("rejected"))
+	for i := 0; i < 5; i++ {
+		_, err := relay.RelayOnce(context.Background())
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 3, findOutboxEvent(t, db, evt.ID).Attempts)
+}
+
+func TestRelay_CleanupRemovesOldPublishedEvents(t *testing.T) {
+	db, _, relay := setupRelayTest(t)
+
+	evt := event.New(event.ViewCreated, uuid.New(), uuid.New(), nil)
+	require.NoError(t, repository.NewOutboxRepository(db).Write(evt))
+	_, err := relay.RelayOnce(context.Background())
+	require.NoError(t, err)
+
+	relay.now = func() time.Time { return time.Now().Add(relay.config.Retention + time.Hour) }
+	deleted, err := relay.Cleanup()
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+}
+
+func TestRelay_SkipsEventsClaimedByAnotherRelay(t *testing.T) {
+	db, sink, relay := setupRelayTest(t)
+
+	evt := event.New(event.BoardCreated, uuid.New(), uuid.New(), nil)
+	repo := repository.NewOutboxRepository(db)
+	require.NoError(t, repo.Write(evt))
+
+	// Another replica claimed the event and is still publishing it
+	now := time.Now()
+	claimed, err := repo.ClaimUnpublished(now, relay.config.ClaimLease, relay.config.MaxAttempts, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+
+	relay.now = func() time.Time { return now.Add(time.Second) }
+	published, err := relay.RelayOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, published)
+	assert.Empty(t, sink.Events())
+
+	// That replica died: once the lease expires the event is published here
+	relay.now = func() time.Time { return now.Add(relay.config.ClaimLease + time.Second) }
+	published, err = relay.RelayOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, published)
+	row := findOutboxEvent(t, db, evt.ID)
+	assert.True(t, row.IsPublished())
+	assert.Nil(t, row.LockedUntil)
+}
+
+func TestRelay_SinkFailureReleasesRemainingClaims(t *testing.T) {
+	db, sink, relay := setupRelayTest(t)
+
+	repo := repository.NewOutboxRepository(db)
+	first := event.New(event.BoardCreated, uuid.New(), uuid.New(), nil)
+	second := event.New(event.BoardMoved, uuid.New(), uuid.New(), nil)
+	require.NoError(t, repo.Write(first))
+	time.Sleep(10 * time.Millisecond) // created_at orders the batch
+	require.NoError(t, repo.Write(second))
+
+	sink.FailWith(errors.New("redis unavailable"))
+	_, err := relay.RelayOnce(context.Background())
+	require.NoError(t, err)
+
+	// Neither event stays leased, so the next poll retries both in order
+	assert.Nil(t, findOutboxEvent(t, db, first.ID).LockedUntil)
+	untried := findOutboxEvent(t, db, second.ID)
+	assert.Nil(t, untried.LockedUntil)
+	assert.Zero(t, untried.Attempts)
+
+	sink.FailWith(nil)
+	published, err := relay.RelayOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, published)
+}
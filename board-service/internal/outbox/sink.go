@@ -0,0 +1,103 @@
+package outbox
+
+import (
+	"board-service/internal/event"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Sink receives events published by the Relay
+// Any event.Publisher (e.g. the Redis pub/sub broker or the webhook dispatcher) is a Sink.
+// Delivery is at-least-once: an event is published again if the relay fails before
+// marking it, so consumers should de-duplicate by event ID.
+type Sink interface {
+	Publish(ctx context.Context, evt event.Event) error
+}
+
+// ==================== Redis Streams Sink ====================
+
+// RedisStreamSink appends events to a Redis stream (XADD) for durable downstream consumers
+type RedisStreamSink struct {
+	client *redis.Client
+	stream string
+	maxLen int64
+}
+
+// NewRedisStreamSink creates a sink that appends to stream, trimming it to about maxLen entries
+// A maxLen of 0 disables trimming
+func NewRedisStreamSink(client *redis.Client, stream string, maxLen int64) *RedisStreamSink {
+	return &RedisStreamSink{client: client, stream: stream, maxLen: maxLen}
+}
+
+func (s *RedisStreamSink) Publish(ctx context.Context, evt event.Event) error {
+	payload, err := json.Marshal(evt)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	args := &redis.XAddArgs{
+		Stream: s.stream,
+		Values: map[string]interface{}{
+			"eventId":   evt.ID,
+			"type":      string(evt.Type),
+			"projectId": evt.ProjectID,
+			"payload":   payload,
+		},
+	}
+	if s.maxLen > 0 {
+		args.MaxLen = s.maxLen
+		args.Approx = true
+	}
+
+	if err := s.client.XAdd(ctx, args).Err(); err != nil {
+		return fmt.Errorf("failed to append event to stream %s: %w", s.stream, err)
+	}
+	return nil
+}
+
+// ==================== In-Memory Sink ====================
+
+// MemorySink keeps published events in memory; intended for tests
+type MemorySink struct {
+	mu     sync.Mutex
+	events []event.Event
+	err    error
+}
+
+// NewMemorySink creates an empty in-memory sink
+func NewMemorySink() *MemorySink {
+	return &MemorySink{}
+}
+
+func (s *MemorySink) Publish(ctx context.Context, evt event.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return s.err
+	}
+	s.events = append(s.events, evt)
+	return nil
+}
+
+// Events returns a copy of the events published so far
+func (s *MemorySink) Events() []event.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	events := make([]event.Event, len(s.events))
+	copy(events, s.events)
+	return events
+}
+
+// FailWith makes every following Publish return err; pass nil to recover
+func (s *MemorySink) FailWith(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.err = err
+}
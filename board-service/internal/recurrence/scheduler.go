@@ -0,0 +1,75 @@
+package recurrence
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultPollInterval is how often the scheduler looks for due recurrence rules
+	DefaultPollInterval = time.Minute
+
+	// DefaultBatchSize is the maximum number of boards created per poll
+	DefaultBatchSize = 50
+)
+
+// Creator creates the boards of the recurrence rules that are due
+// Implemented by service.BoardService
+type Creator interface {
+	CreateDueRecurringBoards(now time.Time, limit int) (int, error)
+}
+
+// Scheduler periodically creates the boards of recurring board templates in the background
+// Every replica can run a Scheduler: each occurrence is created under a row lock together
+// with advancing the rule (see BoardRecurrenceRepository.LockDue), so it is created once
+type Scheduler struct {
+	creator   Creator
+	interval  time.Duration
+	batchSize int
+	logger    *zap.Logger
+	now       func() time.Time
+}
+
+// NewScheduler creates a scheduler that runs every interval
+func NewScheduler(creator Creator, interval time.Duration, batchSize int, logger *zap.Logger) *Scheduler {
+	return &Scheduler{
+		creator:   creator,
+		interval:  interval,
+		batchSize: batchSize,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// Run creates due boards until ctx is cancelled
+func (s *Scheduler) Run(ctx context.Context) {
+	s.logger.Info("Board recurrence scheduler started", zap.Duration("interval", s.interval))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Board recurrence scheduler stopped")
+			return
+		case <-ticker.C:
+			s.RunOnce()
+		}
+	}
+}
+
+// RunOnce creates the boards that are currently due and returns how many were created
+func (s *Scheduler) RunOnce() int {
+	created, err := s.creator.CreateDueRecurringBoards(s.now(), s.batchSize)
+	if err != nil {
+		s.logger.Error("Failed to create recurring boards", zap.Error(err))
+		return 0
+	}
+	if created > 0 {
+		s.logger.Info("Created recurring boards", zap.Int("count", created))
+	}
+	return created
+}
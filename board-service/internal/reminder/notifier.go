@@ -0,0 +1,166 @@
+package reminder
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Message is a plain text message to one or more recipients
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Notifier delivers reminder messages outside the application (e.g. by email)
+// A message that fails is returned as an error so the caller can retry it on the next run
+type Notifier interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// ==================== SMTP Notifier ====================
+
+// SMTPConfig is the mail server the SMTPNotifier submits messages to
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string // Empty disables authentication
+	Password string
+	From     string
+}
+
+// SMTPNotifier sends messages through an SMTP server
+// STARTTLS is used when the server offers it; authentication requires TLS unless the server is local
+type SMTPNotifier struct {
+	config SMTPConfig
+	now    func() time.Time
+}
+
+// NewSMTPNotifier creates a notifier that submits messages to the configured server
+func NewSMTPNotifier(config SMTPConfig) *SMTPNotifier {
+	return &SMTPNotifier{config: config, now: time.Now}
+}
+
+func (n *SMTPNotifier) Send(ctx context.Context, msg Message) error {
+	if len(msg.To) == 0 {
+		return nil
+	}
+
+	body, err := n.compose(msg)
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(n.config.Host, strconv.Itoa(n.config.Port))
+	var auth smtp.Auth
+	if n.config.Username != "" {
+		auth = smtp.PlainAuth("", n.config.Username, n.config.Password, n.config.Host)
+	}
+	if err := smtp.SendMail(addr, auth, n.config.From, msg.To, body); err != nil {
+		return fmt.Errorf("failed to send mail to %s: %w", strings.Join(msg.To, ", "), err)
+	}
+	return nil
+}
+
+// compose builds an RFC 5322 message with a UTF-8 subject and a quoted-printable body
+func (n *SMTPNotifier) compose(msg Message) ([]byte, error) {
+	var buf bytes.Buffer
+	headers := []string{
+		"From: " + n.config.From,
+		"To: " + strings.Join(msg.To, ", "),
+		"Subject: " + mime.QEncoding.Encode("utf-8", msg.Subject),
+		"Date: " + n.now().Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"Content-Transfer-Encoding: quoted-printable",
+	}
+	for _, header := range headers {
+		if strings.ContainsAny(header, "\r\n") {
+			return nil, fmt.Errorf("invalid mail header %q", header)
+		}
+		buf.WriteString(header + "\r\n")
+	}
+	buf.WriteString("\r\n")
+
+	writer := quotedprintable.NewWriter(&buf)
+	if _, err := writer.Write([]byte(strings.ReplaceAll(msg.Body, "\n", "\r\n"))); err != nil {
+		return nil, fmt.Errorf("failed to encode mail body: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode mail body: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// ==================== Log Notifier ====================
+
+// LogNotifier only logs messages; used when no SMTP server is configured
+type LogNotifier struct {
+	logger *zap.Logger
+}
+
+// NewLogNotifier creates a notifier that logs every message
+func NewLogNotifier(logger *zap.Logger) *LogNotifier {
+	return &LogNotifier{logger: logger}
+}
+
+func (n *LogNotifier) Send(ctx context.Context, msg Message) error {
+	n.logger.Info("Reminder message (SMTP not configured)",
+		zap.Strings("to", msg.To),
+		zap.String("subject", msg.Subject))
+	return nil
+}
+
+// ==================== In-Memory Notifier ====================
+
+// MemoryNotifier keeps sent messages in memory; intended for tests
+type MemoryNotifier struct {
+	mu       sync.Mutex
+	messages []Message
+	err      error
+}
+
+// NewMemoryNotifier creates an empty in-memory notifier
+func NewMemoryNotifier() *MemoryNotifier {
+	return &MemoryNotifier{}
+}
+
+func (n *MemoryNotifier) Send(ctx context.Context, msg Message) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.err != nil {
+		return n.err
+	}
+	n.messages = append(n.messages, msg)
+	return nil
+}
+
+// Messages returns a copy of the messages sent so far
+func (n *MemoryNotifier) Messages() []Message {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	messages := make([]Message, len(n.messages))
+	copy(messages, n.messages)
+	return messages
+}
+
+// FailWith makes every following Send return err; pass nil to recover
+func (n *MemoryNotifier) FailWith(err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.err = err
+}
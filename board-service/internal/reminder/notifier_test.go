@@ -0,0 +1,186 @@
+package reminder
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// smtpSink is a minimal local SMTP server that keeps the messages it receives
+type smtpSink struct {
+	listener net.Listener
+	messages chan sinkMessage
+}
+
+type sinkMessage struct {
+	from string
+	to   []string
+	data string
+}
+
+func newSMTPSink(t *testing.T) *smtpSink {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	sink := &smtpSink{listener: listener, messages: make(chan sinkMessage, 10)}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go sink.serve(conn)
+		}
+	}()
+	return sink
+}
+
+func (s *smtpSink) serve(conn net.Conn) {
+	defer conn.Close()
+	text := textproto.NewConn(conn)
+	text.PrintfLine("220 localhost ESMTP sink")
+
+	var msg sinkMessage
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			text.PrintfLine("250-localhost")
+			text.PrintfLine("250 8BITMIME")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			msg = sinkMessage{from: sinkAddress(line)}
+			text.PrintfLine("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			msg.to = append(msg.to, sinkAddress(line))
+			text.PrintfLine("250 OK")
+		case command == "DATA":
+			text.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := io.ReadAll(text.DotReader())
+			if err != nil {
+				return
+			}
+			msg.data = string(data)
+			s.messages <- msg
+			text.PrintfLine("250 OK")
+		case command == "QUIT":
+			text.PrintfLine("221 Bye")
+			return
+		default:
+			text.PrintfLine("250 OK")
+		}
+	}
+}
+
+// sinkAddress returns the address between the angle brackets of a MAIL FROM or RCPT TO command
+func sinkAddress(line string) string {
+	start, end := strings.Index(line, "<"), strings.Index(line, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	return line[start+1 : end]
+}
+
+func (s *smtpSink) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpSink) next(t *testing.T) sinkMessage {
+	select {
+	case msg := <-s.messages:
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("no message received by the SMTP sink")
+		return sinkMessage{}
+	}
+}
+
+func TestSMTPNotifier_SendsToLocalSink(t *testing.T) {
+	sink := newSMTPSink(t)
+	notifier := NewSMTPNotifier(SMTPConfig{Host: "127.0.0.1", Port: sink.port(), From: "no-reply@wealist.com"})
+
+	err := notifier.Send(context.Background(), Message{
+		To:      []string{"dev@example.com"},
+		Subject: "[weAlist] 마감일 알림 요약",
+		Body:    "- [마감 임박] WEB-1 Login\n- [마감 지남] WEB-2 Signup\n",
+	})
+	require.NoError(t, err)
+
+	msg := sink.next(t)
+	assert.Equal(t, "no-reply@wealist.com", msg.from)
+	assert.Equal(t, []string{"dev@example.com"}, msg.to)
+
+	reader := textproto.NewReader(bufio.NewReader(strings.NewReader(msg.data)))
+	header, err := reader.ReadMIMEHeader()
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "[weAlist] 마감일 알림 요약", subject)
+	assert.Equal(t, "text/plain; charset=UTF-8", header.Get("Content-Type"))
+
+	body, err := io.ReadAll(quotedprintable.NewReader(reader.R))
+	require.NoError(t, err)
+	assert.Equal(t, "- [마감 임박] WEB-1 Login\n- [마감 지남] WEB-2 Signup\n", string(body), "the sink reads lines without CR")
+}
+
+func TestSMTPNotifier_ReportsUnreachableServer(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	notifier := NewSMTPNotifier(SMTPConfig{Host: "127.0.0.1", Port: port, From: "no-reply@wealist.com"})
+	assert.Error(t, notifier.Send(context.Background(), Message{To: []string{"dev@example.com"}, Subject: "s", Body: "b"}))
+	assert.Error(t, notifier.Send(context.Background(), Message{To: []string{"dev@example.com"}, Subject: "s\r\nBcc: x@example.com", Body: "b"}),
+		"header injection is rejected")
+}
+
+// ==================== Scheduler Tests ====================
+
+type fakeSender struct {
+	reminders, digests int
+	err                error
+	calls              []time.Time
+}
+
+func (f *fakeSender) SendDueReminders(now time.Time) (int, error) {
+	f.calls = append(f.calls, now)
+	return f.reminders, f.err
+}
+
+func (f *fakeSender) SendDigests(now time.Time) (int, error) {
+	return f.digests, nil
+}
+
+func TestScheduler_RunOnce(t *testing.T) {
+	now := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	sender := &fakeSender{reminders: 3, digests: 1}
+	scheduler := NewScheduler(sender, time.Minute, zap.NewNop())
+	scheduler.now = func() time.Time { return now }
+
+	reminders, digests := scheduler.RunOnce()
+	assert.Equal(t, 3, reminders)
+	assert.Equal(t, 1, digests)
+	assert.Equal(t, []time.Time{now}, sender.calls)
+
+	// A failing reminder pass does not skip the digests
+	sender.err = errors.New("database unavailable")
+	_, digests = scheduler.RunOnce()
+	assert.Equal(t, 1, digests)
+}
@@ -0,0 +1,108 @@
+package reminder
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultPollInterval is how often the scheduler looks for due reminders and digests
+	DefaultPollInterval = time.Minute
+
+	// DefaultBatchSize is the maximum number of boards (or digest recipients) handled per poll
+	DefaultBatchSize = 200
+
+	// DefaultDigestHour is the local hour the daily digest is sent at
+	DefaultDigestHour = 9
+
+	// OverdueLookback bounds how long after its due date a board still gets an overdue reminder,
+	// so boards that were already overdue when reminders were enabled are not all reminded at once
+	OverdueLookback = 24 * time.Hour
+)
+
+// DefaultLeadTimes are how long before the due date boards are reminded
+var DefaultLeadTimes = []time.Duration{24 * time.Hour, time.Hour}
+
+// Config configures when reminders and digests are sent
+type Config struct {
+	LeadTimes      []time.Duration // Longest first; a board is reminded once per lead time it enters
+	DigestHour     int             // Local hour (0-23) of the daily digest
+	DigestLocation *time.Location  // Time zone of the digest hour and day
+	BatchSize      int
+}
+
+// DefaultConfig returns the default lead times and a 09:00 UTC digest
+func DefaultConfig() Config {
+	return Config{
+		LeadTimes:      DefaultLeadTimes,
+		DigestHour:     DefaultDigestHour,
+		DigestLocation: time.UTC,
+		BatchSize:      DefaultBatchSize,
+	}
+}
+
+// Sender sends the due date reminders and daily digests that are due
+// Implemented by service.ReminderService
+type Sender interface {
+	SendDueReminders(now time.Time) (int, error)
+	SendDigests(now time.Time) (int, error)
+}
+
+// Scheduler periodically sends due date reminders and daily digests in the background
+// Every replica can run a Scheduler: each reminder and digest is claimed with a unique marker row
+// in the same transaction that delivers it, so it is sent once
+type Scheduler struct {
+	sender   Sender
+	interval time.Duration
+	logger   *zap.Logger
+	now      func() time.Time
+}
+
+// NewScheduler creates a scheduler that runs every interval
+func NewScheduler(sender Sender, interval time.Duration, logger *zap.Logger) *Scheduler {
+	return &Scheduler{
+		sender:   sender,
+		interval: interval,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// Run sends reminders and digests until ctx is cancelled
+func (s *Scheduler) Run(ctx context.Context) {
+	s.logger.Info("Reminder scheduler started", zap.Duration("interval", s.interval))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Reminder scheduler stopped")
+			return
+		case <-ticker.C:
+			s.RunOnce()
+		}
+	}
+}
+
+// RunOnce sends the reminders and digests that are currently due and returns how many were sent
+func (s *Scheduler) RunOnce() (reminders int, digests int) {
+	now := s.now()
+
+	reminders, err := s.sender.SendDueReminders(now)
+	if err != nil {
+		s.logger.Error("Failed to send due date reminders", zap.Error(err))
+	}
+	digests, err = s.sender.SendDigests(now)
+	if err != nil {
+		s.logger.Error("Failed to send reminder digests", zap.Error(err))
+	}
+
+	if reminders > 0 || digests > 0 {
+		s.logger.Info("Sent reminders", zap.Int("reminders", reminders), zap.Int("digests", digests))
+	}
+	return reminders, digests
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AttachmentRepository는 보드와 댓글의 첨부 파일 메타데이터를 관리합니다
+// 파일 내용은 storage.Storage에 체크섬 단위로 한 번만 저장되므로,
+// 메타데이터를 삭제한 뒤 같은 StorageKey를 참조하는 첨부 파일이 없을 때만 내용을 지웁니다
+// 내용을 재사용하는 업로드와 내용 삭제는 StorageKey 잠금(attachment_blobs)으로 직렬화됩니다
+type AttachmentRepository interface {
+	Create(attachment *domain.Attachment) error
+	FindByID(id uuid.UUID) (*domain.Attachment, error)
+	FindByBoard(boardID uuid.UUID, commentID *uuid.UUID) ([]domain.Attachment, error)
+	Delete(id uuid.UUID) error
+
+	// Shared content
+	CountByStorageKey(storageKey string) (int64, error)
+	// LockStorageKey locks the stored content until the surrounding transaction ends
+	LockStorageKey(storageKey string) error
+	// WithStorageKeyLock runs fn in a transaction that holds the lock of the stored content
+	WithStorageKeyLock(storageKey string, fn func(repo AttachmentRepository) error) error
+
+	// Purge (storage keys of the attachments removed with a board, a comment thread or a project)
+	FindStorageKeysByBoard(boardID uuid.UUID) ([]string, error)
+	FindStorageKeysByCommentThread(commentID uuid.UUID) ([]string, error)
+	FindStorageKeysByProject(projectID uuid.UUID) ([]string, error)
+}
+
+type attachmentRepository struct {
+	db *gorm.DB
+}
+
+// NewAttachmentRepository는 새로운 AttachmentRepository를 생성합니다
+func NewAttachmentRepository(db *gorm.DB) AttachmentRepository {
+	return &attachmentRepository{db: db}
+}
+
+func (r *attachmentRepository) Create(attachment *domain.Attachment) error {
+	return r.db.Create(attachment).Error
+}
+
+func (r *attachmentRepository) FindByID(id uuid.UUID) (*domain.Attachment, error) {
+	var attachment domain.Attachment
+	if err := r.db.Where("id = ?", id).First(&attachment).Error; err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+// FindByBoard returns the attachments of the board, newest first
+// With a comment ID only the files of that comment are returned; files of deleted comments are hidden
+func (r *attachmentRepository) FindByBoard(boardID uuid.UUID, commentID *uuid.UUID) ([]domain.Attachment, error) {
+	query := r.db.Where("board_id = ?", boardID)
+	if commentID != nil {
+		query = query.Where("comment_id = ?", *commentID)
+	}
+	deletedComments := r.db.Model(&domain.Comment{}).Select("id").Where("board_id = ? AND is_deleted = ?", boardID, true)
+
+	var attachments []domain.Attachment
+	err := query.Where("comment_id IS NULL OR comment_id NOT IN (?)", deletedComments).
+		Order("created_at DESC").
+		Find(&attachments).Error
+	return attachments, err
+}
+
+// Delete removes the attachment; attachments are not soft deleted
+func (r *attachmentRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&domain.Attachment{}).Error
+}
+
+// CountByStorageKey returns how many attachments share the stored content
+func (r *attachmentRepository) CountByStorageKey(storageKey string) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.Attachment{}).Where("storage_key = ?", storageKey).Count(&count).Error
+	return count, err
+}
+
+// LockStorageKey creates the lock row of the content on first use and locks it with FOR UPDATE
+// Without a surrounding transaction the lock is released right away
+func (r *attachmentRepository) LockStorageKey(storageKey string) error {
+	blob := domain.AttachmentBlob{StorageKey: storageKey}
+	if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&blob).Error; err != nil {
+		return err
+	}
+	return r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("storage_key = ?", storageKey).
+		First(&blob).Error
+}
+
+func (r *attachmentRepository) WithStorageKeyLock(storageKey string, fn func(repo AttachmentRepository) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		repo := &attachmentRepository{db: tx}
+		if err := repo.LockStorageKey(storageKey); err != nil {
+			return err
+		}
+		return fn(repo)
+	})
+}
+
+func (r *attachmentRepository) FindStorageKeysByBoard(boardID uuid.UUID) ([]string, error) {
+	var keys []string
+	err := r.db.Model(&domain.Attachment{}).Distinct("storage_key").
+		Where("board_id = ?", boardID).
+		Pluck("storage_key", &keys).Error
+	return keys, err
+}
+
+func (r *attachmentRepository) FindStorageKeysByCommentThread(commentID uuid.UUID) ([]string, error) {
+	ids, err := findCommentThreadIDs(r.db, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	err = r.db.Model(&domain.Attachment{}).Distinct("storage_key").
+		Where("comment_id IN ?", ids).
+		Pluck("storage_key", &keys).Error
+	return keys, err
+}
+
+func (r *attachmentRepository) FindStorageKeysByProject(projectID uuid.UUID) ([]string, error) {
+	boards := r.db.Model(&domain.Board{}).Select("id").Where("project_id = ?", projectID)
+
+	var keys []string
+	err := r.db.Model(&domain.Attachment{}).Distinct("storage_key").
+		Where("board_id IN (?)", boards).
+		Pluck("storage_key", &keys).Error
+	return keys, err
+}
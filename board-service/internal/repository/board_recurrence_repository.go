@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BoardRecurrenceRepository는 보드 템플릿의 반복 규칙과 다음 생성 시각을 관리합니다
+type BoardRecurrenceRepository interface {
+	Create(recurrence *domain.BoardRecurrence) error
+	FindByTemplate(templateID uuid.UUID) (*domain.BoardRecurrence, error)
+	FindByProject(projectID uuid.UUID) ([]domain.BoardRecurrence, error)
+	Update(recurrence *domain.BoardRecurrence) error
+	DeleteByTemplate(templateID uuid.UUID) error
+
+	// FindDue returns rules whose next run is due, oldest first, without locking them
+	FindDue(now time.Time, limit int) ([]domain.BoardRecurrence, error)
+	// LockDue locks a rule that is still due with FOR UPDATE SKIP LOCKED inside a transaction
+	// gorm.ErrRecordNotFound means another scheduler holds the rule or already advanced it
+	LockDue(id uuid.UUID, now time.Time) (*domain.BoardRecurrence, error)
+}
+
+type boardRecurrenceRepository struct {
+	db *gorm.DB
+}
+
+// NewBoardRecurrenceRepository는 새로운 BoardRecurrenceRepository를 생성합니다
+func NewBoardRecurrenceRepository(db *gorm.DB) BoardRecurrenceRepository {
+	return &boardRecurrenceRepository{db: db}
+}
+
+func (r *boardRecurrenceRepository) Create(recurrence *domain.BoardRecurrence) error {
+	return r.db.Create(recurrence).Error
+}
+
+func (r *boardRecurrenceRepository) FindByTemplate(templateID uuid.UUID) (*domain.BoardRecurrence, error) {
+	var recurrence domain.BoardRecurrence
+	if err := r.db.Where("template_id = ? AND is_deleted = ?", templateID, false).First(&recurrence).Error; err != nil {
+		return nil, err
+	}
+	return &recurrence, nil
+}
+
+// FindByProject returns the rules of the project, the next to run first and ended rules last
+func (r *boardRecurrenceRepository) FindByProject(projectID uuid.UUID) ([]domain.BoardRecurrence, error) {
+	var recurrences []domain.BoardRecurrence
+	err := r.db.Where("project_id = ? AND is_deleted = ?", projectID, false).
+		Order("next_run_at IS NULL, next_run_at ASC, created_at ASC").
+		Find(&recurrences).Error
+	return recurrences, err
+}
+
+func (r *boardRecurrenceRepository) Update(recurrence *domain.BoardRecurrence) error {
+	return r.db.Save(recurrence).Error
+}
+
+// DeleteByTemplate soft deletes the rule of a template
+func (r *boardRecurrenceRepository) DeleteByTemplate(templateID uuid.UUID) error {
+	return r.db.Model(&domain.BoardRecurrence{}).
+		Where("template_id = ? AND is_deleted = ?", templateID, false).
+		Update("is_deleted", true).Error
+}
+
+func (r *boardRecurrenceRepository) FindDue(now time.Time, limit int) ([]domain.BoardRecurrence, error) {
+	var recurrences []domain.BoardRecurrence
+	err := r.db.Where("next_run_at <= ? AND is_deleted = ?", now, false).
+		Order("next_run_at ASC, id ASC").
+		Limit(limit).
+		Find(&recurrences).Error
+	return recurrences, err
+}
+
+func (r *boardRecurrenceRepository) LockDue(id uuid.UUID, now time.Time) (*domain.BoardRecurrence, error) {
+	var recurrence domain.BoardRecurrence
+	err := r.db.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("id = ? AND next_run_at <= ? AND is_deleted = ?", id, now, false).
+		First(&recurrence).Error
+	if err != nil {
+		return nil, err
+	}
+	return &recurrence, nil
+}
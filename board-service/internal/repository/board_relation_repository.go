@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// BoardRelationRepository는 보드 간 관계(BLOCKS, RELATES, DUPLICATES)를 관리합니다
+// 관계는 삭제된 보드와의 관계도 보존하며 (휴지통 복원 시 그대로 복구), 조회 시에만 제외합니다
+type BoardRelationRepository interface {
+	Create(relation *domain.BoardRelation) error
+	FindByID(id uuid.UUID) (*domain.BoardRelation, error)
+	Delete(id uuid.UUID) error
+
+	FindByBoard(boardID uuid.UUID) ([]domain.BoardRelation, error)
+	FindBetween(boardA, boardB uuid.UUID, relationType domain.BoardRelationType) ([]domain.BoardRelation, error)
+
+	// Blocking graph
+	FindBlockedByAny(boardIDs []uuid.UUID) ([]uuid.UUID, error)
+	FilterBlocked(boardIDs []uuid.UUID) ([]uuid.UUID, error)
+}
+
+type boardRelationRepository struct {
+	db *gorm.DB
+}
+
+// NewBoardRelationRepository는 새로운 BoardRelationRepository를 생성합니다
+func NewBoardRelationRepository(db *gorm.DB) BoardRelationRepository {
+	return &boardRelationRepository{db: db}
+}
+
+// BlockedBoardIDs selects the IDs of boards blocked by at least one board that is not deleted
+// It is used as a subquery by the "blocked" view filter and FilterBlocked
+func BlockedBoardIDs(db *gorm.DB) *gorm.DB {
+	return db.Model(&domain.BoardRelation{}).
+		Select("board_relations.target_board_id").
+		Joins("JOIN boards blockers ON blockers.id = board_relations.source_board_id AND blockers.is_deleted = ?", false).
+		Where("board_relations.type = ?", domain.BoardRelationBlocks)
+}
+
+func (r *boardRelationRepository) Create(relation *domain.BoardRelation) error {
+	return r.db.Create(relation).Error
+}
+
+func (r *boardRelationRepository) FindByID(id uuid.UUID) (*domain.BoardRelation, error) {
+	var relation domain.BoardRelation
+	if err := r.db.Where("id = ?", id).First(&relation).Error; err != nil {
+		return nil, err
+	}
+	return &relation, nil
+}
+
+// Delete removes the relation; relations are not soft deleted so the pair can be linked again
+func (r *boardRelationRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&domain.BoardRelation{}).Error
+}
+
+// FindByBoard returns the relations of the board whose other end is not deleted, oldest first
+// Both boards are preloaded so that responses can show the key and title of the related board
+func (r *boardRelationRepository) FindByBoard(boardID uuid.UUID) ([]domain.BoardRelation, error) {
+	var relations []domain.BoardRelation
+	err := r.db.
+		Joins("JOIN boards sources ON sources.id = board_relations.source_board_id AND sources.is_deleted = ?", false).
+		Joins("JOIN boards targets ON targets.id = board_relations.target_board_id AND targets.is_deleted = ?", false).
+		Where("board_relations.source_board_id = ? OR board_relations.target_board_id = ?", boardID, boardID).
+		Preload("SourceBoard").
+		Preload("TargetBoard").
+		Order("board_relations.created_at ASC, board_relations.id ASC").
+		Find(&relations).Error
+	return relations, err
+}
+
+// FindBetween returns the relations of the given type between the two boards, in either direction
+func (r *boardRelationRepository) FindBetween(boardA, boardB uuid.UUID, relationType domain.BoardRelationType) ([]domain.BoardRelation, error) {
+	var relations []domain.BoardRelation
+	err := r.db.
+		Where("type = ?", relationType).
+		Where("(source_board_id = ? AND target_board_id = ?) OR (source_board_id = ? AND target_board_id = ?)", boardA, boardB, boardB, boardA).
+		Find(&relations).Error
+	return relations, err
+}
+
+// FindBlockedByAny returns the boards directly blocked by any of the given boards
+// Relations of deleted boards are included because a restored board brings its relations back
+func (r *boardRelationRepository) FindBlockedByAny(boardIDs []uuid.UUID) ([]uuid.UUID, error) {
+	var blockedIDs []uuid.UUID
+	if len(boardIDs) == 0 {
+		return blockedIDs, nil
+	}
+	err := r.db.Model(&domain.BoardRelation{}).
+		Where("type = ? AND source_board_id IN ?", domain.BoardRelationBlocks, boardIDs).
+		Distinct().
+		Pluck("target_board_id", &blockedIDs).Error
+	return blockedIDs, err
+}
+
+// FilterBlocked returns the given boards that are blocked by a board that is not deleted
+func (r *boardRelationRepository) FilterBlocked(boardIDs []uuid.UUID) ([]uuid.UUID, error) {
+	var blockedIDs []uuid.UUID
+	if len(boardIDs) == 0 {
+		return blockedIDs, nil
+	}
+	err := BlockedBoardIDs(r.db).
+		Where("board_relations.target_board_id IN ?", boardIDs).
+		Distinct().
+		Pluck("board_relations.target_board_id", &blockedIDs).Error
+	return blockedIDs, err
+}
@@ -1,7 +1,9 @@
 package repository
 
 import (
+	"board-service/internal/common/pagination"
 	"board-service/internal/domain"
+	"errors"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -11,14 +13,30 @@ type BoardRepository interface {
 	// CRUD
 	Create(board *domain.Board) error
 	FindByID(id uuid.UUID) (*domain.Board, error)
+	FindByKey(key string) (*domain.Board, error)
 	FindByProject(projectID uuid.UUID, filters BoardFilters, page, limit int) ([]domain.Board, int64, error)
+	FindPageByProject(projectID uuid.UUID, filters BoardFilters, cursor *pagination.Cursor, limit int) (*KeysetPage[domain.Board], error)
+	CountByProject(projectID uuid.UUID, filters BoardFilters) (int64, error)
 	Update(board *domain.Board) error
 	Delete(id uuid.UUID) error
+
+	// Key Alias
+	CreateKeyAlias(alias *domain.BoardKeyAlias) error
+
+	// Sub-tasks
+	FindParentID(id uuid.UUID) (*uuid.UUID, error)
+	FindChildIDs(parentIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
+	DetachChildren(parentID uuid.UUID) error
+
+	// Time tracking
+	UpdateEstimates(board *domain.Board) error
 }
 
 type BoardFilters struct {
-	AssigneeID uuid.UUID
-	AuthorID   uuid.UUID
+	AssigneeID   uuid.UUID
+	AuthorID     uuid.UUID
+	ParentID     uuid.UUID // Sub-tasks of the given board only
+	TopLevelOnly bool      // Hide sub-tasks (boards whose parent is not deleted)
 	// Custom field filtering is now done via JSONB queries in ViewService
 	// using custom_fields_cache column with GIN index
 }
@@ -31,6 +49,12 @@ func NewBoardRepository(db *gorm.DB) BoardRepository {
 	return &boardRepository{db: db}
 }
 
+// ActiveBoardIDs selects the IDs of boards that are not deleted
+// Sub-task filters use it so that a board whose parent is in the trash is listed as a top-level board
+func ActiveBoardIDs(db *gorm.DB) *gorm.DB {
+	return db.Model(&domain.Board{}).Select("id").Where("is_deleted = ?", false)
+}
+
 // ==================== CRUD ====================
 
 func (r *boardRepository) Create(board *domain.Board) error {
@@ -45,10 +69,65 @@ func (r *boardRepository) FindByID(id uuid.UUID) (*domain.Board, error) {
 	return &board, nil
 }
 
+// FindByKey finds a board by its current key or, if it moved between projects, by a previous key
+func (r *boardRepository) FindByKey(key string) (*domain.Board, error) {
+	var board domain.Board
+	err := r.db.Where("key = ? AND is_deleted = ?", key, false).First(&board).Error
+	if err == nil {
+		return &board, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	aliases := r.db.Model(&domain.BoardKeyAlias{}).Select("board_id").Where("key = ?", key)
+	if err := r.db.Where("id IN (?) AND is_deleted = ?", aliases, false).First(&board).Error; err != nil {
+		return nil, err
+	}
+	return &board, nil
+}
+
+// boardKeyset is the order of board lists: the newest boards first
+var boardKeyset = Keyset{Keys: []KeysetKey{{Expr: "created_at", Desc: true}}, IDDesc: true}
+
+// BoardCursor returns the cursor of a board in a board list
+func BoardCursor(board *domain.Board, backward bool) *pagination.Cursor {
+	return &pagination.Cursor{Values: []interface{}{board.CreatedAt}, ID: board.ID.String(), Backward: backward}
+}
+
 func (r *boardRepository) FindByProject(projectID uuid.UUID, filters BoardFilters, page, limit int) ([]domain.Board, int64, error) {
 	var boards []domain.Board
 	var total int64
 
+	query := r.projectQuery(projectID, filters)
+
+	// Total count
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Pagination
+	offset := (page - 1) * limit
+	if err := query.Clauses(boardKeyset.OrderBy()).Offset(offset).Limit(limit).Find(&boards).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return boards, total, nil
+}
+
+// FindPageByProject returns the boards after (or before) the cursor; the first page has no cursor
+func (r *boardRepository) FindPageByProject(projectID uuid.UUID, filters BoardFilters, cursor *pagination.Cursor, limit int) (*KeysetPage[domain.Board], error) {
+	return FindKeysetPage(r.projectQuery(projectID, filters), boardKeyset, cursor, limit, func(board *domain.Board) uuid.UUID { return board.ID })
+}
+
+func (r *boardRepository) CountByProject(projectID uuid.UUID, filters BoardFilters) (int64, error) {
+	var total int64
+	err := r.projectQuery(projectID, filters).Count(&total).Error
+	return total, err
+}
+
+// projectQuery selects the boards of a project that match the filters
+func (r *boardRepository) projectQuery(projectID uuid.UUID, filters BoardFilters) *gorm.DB {
 	query := r.db.Model(&domain.Board{}).Where("project_id = ? AND is_deleted = ?", projectID, false)
 
 	// Apply basic filters (Assignee, Author)
@@ -58,23 +137,18 @@ func (r *boardRepository) FindByProject(projectID uuid.UUID, filters BoardFilter
 	if filters.AuthorID != uuid.Nil {
 		query = query.Where("created_by = ?", filters.AuthorID)
 	}
+	if filters.ParentID != uuid.Nil {
+		query = query.Where("parent_board_id = ?", filters.ParentID)
+	}
+	if filters.TopLevelOnly {
+		query = query.Where("(parent_board_id IS NULL OR parent_board_id NOT IN (?))", ActiveBoardIDs(r.db))
+	}
 
 	// Note: Custom field filtering (stage, role, importance, etc.) is now done
 	// via ViewService using JSONB queries on custom_fields_cache column
 	// Example: WHERE custom_fields_cache->>'field-id' = 'value'
 
-	// Total count
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	// Pagination
-	offset := (page - 1) * limit
-	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&boards).Error; err != nil {
-		return nil, 0, err
-	}
-
-	return boards, total, nil
+	return query.Session(&gorm.Session{})
 }
 
 func (r *boardRepository) Update(board *domain.Board) error {
@@ -85,3 +159,57 @@ func (r *boardRepository) Delete(id uuid.UUID) error {
 	// Soft delete
 	return r.db.Model(&domain.Board{}).Where("id = ?", id).Update("is_deleted", true).Error
 }
+
+// ==================== Key Alias ====================
+
+func (r *boardRepository) CreateKeyAlias(alias *domain.BoardKeyAlias) error {
+	return r.db.Create(alias).Error
+}
+
+// ==================== Sub-tasks ====================
+
+// FindParentID returns the parent of the board, or nil for a top-level board
+// Deleted boards are included so that a restored board cannot close a loop in the hierarchy
+func (r *boardRepository) FindParentID(id uuid.UUID) (*uuid.UUID, error) {
+	var board domain.Board
+	if err := r.db.Select("id", "parent_board_id").Where("id = ?", id).First(&board).Error; err != nil {
+		return nil, err
+	}
+	return board.ParentBoardID, nil
+}
+
+// FindChildIDs returns the sub-tasks of each given board that are not deleted
+func (r *boardRepository) FindChildIDs(parentIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	result := make(map[uuid.UUID][]uuid.UUID)
+	if len(parentIDs) == 0 {
+		return result, nil
+	}
+
+	var children []domain.Board
+	err := r.db.Select("id", "parent_board_id").
+		Where("parent_board_id IN ? AND is_deleted = ?", parentIDs, false).
+		Find(&children).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range children {
+		result[*child.ParentBoardID] = append(result[*child.ParentBoardID], child.ID)
+	}
+	return result, nil
+}
+
+// DetachChildren makes the sub-tasks of the board top-level boards, including deleted ones
+func (r *boardRepository) DetachChildren(parentID uuid.UUID) error {
+	return r.db.Model(&domain.Board{}).Where("parent_board_id = ?", parentID).Update("parent_board_id", nil).Error
+}
+
+// ==================== Time Tracking ====================
+
+// UpdateEstimates saves only the estimates of the board, so concurrent edits of other attributes are kept
+func (r *boardRepository) UpdateEstimates(board *domain.Board) error {
+	return r.db.Model(&domain.Board{}).Where("id = ?", board.ID).Updates(map[string]interface{}{
+		"original_estimate_minutes":  board.OriginalEstimateMinutes,
+		"remaining_estimate_minutes": board.RemainingEstimateMinutes,
+		"updated_at":                 board.UpdatedAt,
+	}).Error
+}
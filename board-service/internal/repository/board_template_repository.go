@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// BoardTemplateRepository는 프로젝트의 보드 템플릿(제목 패턴, 본문, 필드 값, 체크리스트, 담당자 규칙)을 관리합니다
+type BoardTemplateRepository interface {
+	Create(template *domain.BoardTemplate) error
+	FindByID(id uuid.UUID) (*domain.BoardTemplate, error)
+	FindByProject(projectID uuid.UUID) ([]domain.BoardTemplate, error)
+	Update(template *domain.BoardTemplate) error
+	Delete(id uuid.UUID) error
+}
+
+type boardTemplateRepository struct {
+	db *gorm.DB
+}
+
+// NewBoardTemplateRepository는 새로운 BoardTemplateRepository를 생성합니다
+func NewBoardTemplateRepository(db *gorm.DB) BoardTemplateRepository {
+	return &boardTemplateRepository{db: db}
+}
+
+func (r *boardTemplateRepository) Create(template *domain.BoardTemplate) error {
+	return r.db.Create(template).Error
+}
+
+func (r *boardTemplateRepository) FindByID(id uuid.UUID) (*domain.BoardTemplate, error) {
+	var template domain.BoardTemplate
+	if err := r.db.Where("id = ? AND is_deleted = ?", id, false).First(&template).Error; err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// FindByProject returns the templates of the project ordered by name
+func (r *boardTemplateRepository) FindByProject(projectID uuid.UUID) ([]domain.BoardTemplate, error) {
+	var templates []domain.BoardTemplate
+	err := r.db.Where("project_id = ? AND is_deleted = ?", projectID, false).
+		Order("name ASC, created_at ASC").
+		Find(&templates).Error
+	return templates, err
+}
+
+func (r *boardTemplateRepository) Update(template *domain.BoardTemplate) error {
+	return r.db.Save(template).Error
+}
+
+func (r *boardTemplateRepository) Delete(id uuid.UUID) error {
+	// Soft delete
+	return r.db.Model(&domain.BoardTemplate{}).Where("id = ?", id).Update("is_deleted", true).Error
+}
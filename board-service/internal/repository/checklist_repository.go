@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ChecklistRepository는 보드의 체크리스트 항목을 관리합니다
+// 항목은 보드와 함께 휴지통으로 이동하며 (보드 복원 시 그대로 복구), 영구 삭제 시에만 지워집니다
+type ChecklistRepository interface {
+	Create(item *domain.ChecklistItem) error
+	FindByID(id uuid.UUID) (*domain.ChecklistItem, error)
+	FindByBoard(boardID uuid.UUID) ([]domain.ChecklistItem, error)
+	Update(item *domain.ChecklistItem) error
+	Delete(id uuid.UUID) error
+
+	// Progress
+	CountByBoards(boardIDs []uuid.UUID) (map[uuid.UUID]domain.ChecklistCount, error)
+}
+
+type checklistRepository struct {
+	db *gorm.DB
+}
+
+// NewChecklistRepository는 새로운 ChecklistRepository를 생성합니다
+func NewChecklistRepository(db *gorm.DB) ChecklistRepository {
+	return &checklistRepository{db: db}
+}
+
+func (r *checklistRepository) Create(item *domain.ChecklistItem) error {
+	return r.db.Create(item).Error
+}
+
+func (r *checklistRepository) FindByID(id uuid.UUID) (*domain.ChecklistItem, error) {
+	var item domain.ChecklistItem
+	if err := r.db.Where("id = ?", id).First(&item).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// FindByBoard returns the checklist of the board in display order
+func (r *checklistRepository) FindByBoard(boardID uuid.UUID) ([]domain.ChecklistItem, error) {
+	var items []domain.ChecklistItem
+	err := r.db.Where("board_id = ?", boardID).
+		Order("position ASC, created_at ASC"). // Fractional indexing: 사전순 정렬
+		Find(&items).Error
+	return items, err
+}
+
+func (r *checklistRepository) Update(item *domain.ChecklistItem) error {
+	return r.db.Save(item).Error
+}
+
+// Delete removes the item; checklist items are not soft deleted
+func (r *checklistRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&domain.ChecklistItem{}).Error
+}
+
+// CountByBoards returns the number of items and checked items of each board's checklist
+// Boards without a checklist are not in the map
+func (r *checklistRepository) CountByBoards(boardIDs []uuid.UUID) (map[uuid.UUID]domain.ChecklistCount, error) {
+	result := make(map[uuid.UUID]domain.ChecklistCount)
+	if len(boardIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		BoardID uuid.UUID
+		Total   int
+		Checked int
+	}
+	err := r.db.Model(&domain.ChecklistItem{}).
+		Select("board_id, COUNT(*) AS total, SUM(CASE WHEN is_checked THEN 1 ELSE 0 END) AS checked").
+		Where("board_id IN ?", boardIDs).
+		Group("board_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		result[row.BoardID] = domain.ChecklistCount{Total: row.Total, Checked: row.Checked}
+	}
+	return result, nil
+}
@@ -1,11 +1,21 @@
 package repository
 
 import (
+	"board-service/internal/common/pagination"
 	"board-service/internal/domain"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// CommentReactionSummary is the aggregated count of one emoji on a comment.
+type CommentReactionSummary struct {
+	CommentID   uuid.UUID
+	Emoji       string
+	Count       int64
+	ReactedByMe bool // Whether the requesting user reacted with this emoji
+}
+
 // CommentRepository defines the interface for comment data operations.
 type CommentRepository interface {
 	Create(comment *domain.Comment) error
@@ -13,6 +23,19 @@ type CommentRepository interface {
 	FindByBoardID(boardID uuid.UUID) ([]domain.Comment, error)
 	Update(comment *domain.Comment) error
 	Delete(id uuid.UUID) error
+
+	// Threads
+	FindRootsByBoardID(boardID uuid.UUID) ([]domain.Comment, error)
+	FindReplies(parentID uuid.UUID, page, limit int) ([]domain.Comment, int64, error)
+	FindRepliesPage(parentID uuid.UUID, cursor *pagination.Cursor, limit int) (*KeysetPage[domain.Comment], error)
+	CountReplies(parentIDs []uuid.UUID) (map[uuid.UUID]int64, error)
+	HasRepliesByOthers(commentID, authorID uuid.UUID) (bool, error)
+
+	// Reactions
+	AddReaction(reaction *domain.CommentReaction) (bool, error)
+	RemoveReaction(commentID, userID uuid.UUID, emoji string) (bool, error)
+	CountReactions(commentID uuid.UUID, emoji string) (int64, error)
+	FindReactionSummaries(commentIDs []uuid.UUID, userID uuid.UUID) ([]CommentReactionSummary, error)
 }
 
 type commentRepository struct {
@@ -32,14 +55,14 @@ func (r *commentRepository) Create(comment *domain.Comment) error {
 // FindByID retrieves a comment by its ID.
 func (r *commentRepository) FindByID(id uuid.UUID) (*domain.Comment, error) {
 	var comment domain.Comment
-	err := r.db.First(&comment, "id = ?", id).Error
+	err := r.db.First(&comment, "id = ? AND is_deleted = ?", id, false).Error
 	return &comment, err
 }
 
-// FindByBoardID retrieves all comments for a given Board ID.
+// FindByBoardID retrieves all comments for a given Board ID, including replies.
 func (r *commentRepository) FindByBoardID(boardID uuid.UUID) ([]domain.Comment, error) {
 	var comments []domain.Comment
-	err := r.db.Where("board_id = ?", boardID).Order("created_at asc").Find(&comments).Error
+	err := r.db.Where("board_id = ? AND is_deleted = ?", boardID, false).Order("created_at asc").Find(&comments).Error
 	return comments, err
 }
 
@@ -48,7 +71,157 @@ func (r *commentRepository) Update(comment *domain.Comment) error {
 	return r.db.Save(comment).Error
 }
 
-// Delete removes a comment from the database.
+// Delete soft-deletes a comment and its replies so that they can be restored from the trash.
+// Replies that were already deleted keep their own deletion time.
 func (r *commentRepository) Delete(id uuid.UUID) error {
-	return r.db.Delete(&domain.Comment{}, "id = ?", id).Error
+	ids, err := findCommentThreadIDs(r.db, id)
+	if err != nil {
+		return err
+	}
+	return r.db.Model(&domain.Comment{}).
+		Where("id IN ? AND is_deleted = ?", ids, false).
+		Update("is_deleted", true).Error
+}
+
+// ==================== Threads ====================
+
+// FindRootsByBoardID retrieves the top-level comments of a board, oldest first.
+func (r *commentRepository) FindRootsByBoardID(boardID uuid.UUID) ([]domain.Comment, error) {
+	var comments []domain.Comment
+	err := r.db.Where("board_id = ? AND parent_comment_id IS NULL AND is_deleted = ?", boardID, false).
+		Order("created_at asc").
+		Find(&comments).Error
+	return comments, err
+}
+
+// FindReplies retrieves one page of the direct replies to a comment, oldest first.
+func (r *commentRepository) FindReplies(parentID uuid.UUID, page, limit int) ([]domain.Comment, int64, error) {
+	var comments []domain.Comment
+	var total int64
+
+	query := r.repliesQuery(parentID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Clauses(replyKeyset.OrderBy()).Offset(offset).Limit(limit).Find(&comments).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+// replyKeyset is the order of a comment thread: the oldest replies first.
+var replyKeyset = Keyset{Keys: []KeysetKey{{Expr: "created_at"}}}
+
+// ReplyCursor returns the cursor of a reply in its comment thread.
+func ReplyCursor(comment *domain.Comment, backward bool) *pagination.Cursor {
+	return &pagination.Cursor{Values: []interface{}{comment.CreatedAt}, ID: comment.ID.String(), Backward: backward}
+}
+
+// FindRepliesPage retrieves the direct replies after (or before) the cursor; the first page has no cursor.
+func (r *commentRepository) FindRepliesPage(parentID uuid.UUID, cursor *pagination.Cursor, limit int) (*KeysetPage[domain.Comment], error) {
+	return FindKeysetPage(r.repliesQuery(parentID), replyKeyset, cursor, limit, func(comment *domain.Comment) uuid.UUID { return comment.ID })
+}
+
+// repliesQuery selects the direct replies to a comment that are not deleted.
+func (r *commentRepository) repliesQuery(parentID uuid.UUID) *gorm.DB {
+	return r.db.Model(&domain.Comment{}).Where("parent_comment_id = ? AND is_deleted = ?", parentID, false).Session(&gorm.Session{})
+}
+
+// CountReplies returns the number of direct replies for each of the given comments.
+func (r *commentRepository) CountReplies(parentIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	counts := make(map[uuid.UUID]int64, len(parentIDs))
+	if len(parentIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		ParentCommentID uuid.UUID
+		Count           int64
+	}
+	err := r.db.Model(&domain.Comment{}).
+		Select("parent_comment_id, COUNT(*) AS count").
+		Where("parent_comment_id IN ? AND is_deleted = ?", parentIDs, false).
+		Group("parent_comment_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.ParentCommentID] = row.Count
+	}
+	return counts, nil
+}
+
+// HasRepliesByOthers reports whether the comment has live replies, at any depth, written by someone other than authorID.
+func (r *commentRepository) HasRepliesByOthers(commentID, authorID uuid.UUID) (bool, error) {
+	ids, err := findCommentThreadIDs(r.db, commentID)
+	if err != nil {
+		return false, err
+	}
+
+	var count int64
+	err = r.db.Model(&domain.Comment{}).
+		Where("id IN ? AND id <> ? AND user_id <> ? AND is_deleted = ?", ids, commentID, authorID, false).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// findCommentThreadIDs returns the ID of a comment and of all its replies at any depth,
+// including replies that are already deleted.
+func findCommentThreadIDs(db *gorm.DB, commentID uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := db.Raw(`WITH RECURSIVE thread(id) AS (
+		SELECT id FROM comments WHERE id = ?
+		UNION ALL
+		SELECT c.id FROM comments c JOIN thread t ON c.parent_comment_id = t.id
+	) SELECT id FROM thread`, commentID).Scan(&ids).Error
+	return ids, err
+}
+
+// ==================== Reactions ====================
+
+// AddReaction adds a reaction and reports whether it was inserted.
+// A concurrent request that already added the same reaction is not an error (ON CONFLICT DO NOTHING).
+func (r *commentRepository) AddReaction(reaction *domain.CommentReaction) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "comment_id"}, {Name: "user_id"}, {Name: "emoji"}},
+		DoNothing: true,
+	}).Create(reaction)
+	return result.RowsAffected > 0, result.Error
+}
+
+// RemoveReaction deletes a user's reaction permanently and reports whether it existed.
+func (r *commentRepository) RemoveReaction(commentID, userID uuid.UUID, emoji string) (bool, error) {
+	result := r.db.Where("comment_id = ? AND user_id = ? AND emoji = ?", commentID, userID, emoji).
+		Delete(&domain.CommentReaction{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// CountReactions returns how many users reacted to a comment with the given emoji.
+func (r *commentRepository) CountReactions(commentID uuid.UUID, emoji string) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.CommentReaction{}).Where("comment_id = ? AND emoji = ?", commentID, emoji).Count(&count).Error
+	return count, err
+}
+
+// FindReactionSummaries aggregates the reactions of the given comments per emoji,
+// in the order each emoji was first used.
+func (r *commentRepository) FindReactionSummaries(commentIDs []uuid.UUID, userID uuid.UUID) ([]CommentReactionSummary, error) {
+	var summaries []CommentReactionSummary
+	if len(commentIDs) == 0 {
+		return summaries, nil
+	}
+
+	err := r.db.Model(&domain.CommentReaction{}).
+		Select("comment_id, emoji, COUNT(*) AS count, MAX(CASE WHEN user_id = ? THEN 1 ELSE 0 END) AS reacted_by_me", userID).
+		Where("comment_id IN ?", commentIDs).
+		Group("comment_id, emoji").
+		Order("MIN(created_at) asc").
+		Scan(&summaries).Error
+	return summaries, err
 }
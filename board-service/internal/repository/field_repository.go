@@ -38,6 +38,7 @@ type FieldRepository interface {
 	DeleteFieldValueByID(id uuid.UUID) error
 	BatchSetFieldValues(values []domain.BoardFieldValue) error
 	BatchDeleteFieldValues(boardID, fieldID uuid.UUID) error
+	DeleteFieldValuesByBoard(boardID uuid.UUID) error
 
 	// Cache update
 	UpdateBoardFieldCache(boardID uuid.UUID) (string, error)
@@ -183,6 +184,10 @@ func (r *fieldRepository) BatchDeleteFieldValues(boardID, fieldID uuid.UUID) err
 	return r.value.BatchDelete(boardID, fieldID)
 }
 
+func (r *fieldRepository) DeleteFieldValuesByBoard(boardID uuid.UUID) error {
+	return r.value.DeleteByBoard(boardID)
+}
+
 func (r *fieldRepository) UpdateBoardFieldCache(boardID uuid.UUID) (string, error) {
 	return r.value.UpdateBoardCache(boardID)
 }
@@ -18,6 +18,7 @@ type FieldValueRepository interface {
 	DeleteByID(id uuid.UUID) error
 	BatchSet(values []domain.BoardFieldValue) error
 	BatchDelete(boardID, fieldID uuid.UUID) error
+	DeleteByBoard(boardID uuid.UUID) error
 	UpdateBoardCache(boardID uuid.UUID) (string, error) // JSON 캐시 업데이트
 }
 
@@ -103,6 +104,13 @@ func (r *fieldValueRepository) BatchDelete(boardID, fieldID uuid.UUID) error {
 		Update("is_deleted", true).Error
 }
 
+// DeleteByBoard는 보드의 모든 필드 값을 soft delete합니다 (보드 삭제 시)
+func (r *fieldValueRepository) DeleteByBoard(boardID uuid.UUID) error {
+	return r.db.Model(&domain.BoardFieldValue{}).
+		Where("board_id = ? AND is_deleted = ?", boardID, false).
+		Update("is_deleted", true).Error
+}
+
 // UpdateBoardCache는 보드의 custom_fields_cache를 업데이트합니다
 func (r *fieldValueRepository) UpdateBoardCache(boardID uuid.UUID) (string, error) {
 	// 서비스 레이어에서 구현될 예정 (JSON 마샬링 필요)
@@ -22,6 +22,12 @@ package repository
 // - FieldValueRepository  : BoardFieldValue 엔티티 관리
 // - ViewRepository        : SavedView 엔티티 관리
 // - BoardOrderRepository  : UserBoardOrder 엔티티 관리
+// - BoardActivityRepository: BoardActivity 엔티티 관리 (보드 변경 이력)
+// - WebhookRepository     : Webhook 구독 및 전송 이력 관리
+// - OutboxRepository      : OutboxEvent 엔티티 관리 (트랜잭션 outbox)
+// - ProjectCascadeRepository: 프로젝트 삭제 시 하위 데이터 일괄 삭제
+// - TrashRepository       : TrashItem 엔티티 관리 (휴지통 복원, 영구 삭제)
+// - NotificationRepository: Notification, BoardWatcher 엔티티 관리 (알림함, 보드 구독)
 //
 // 각 인터페이스의 상세 정의는 해당 파일을 참조하세요:
 // - board_repository.go
@@ -34,6 +40,9 @@ package repository
 // - field_value_repository.go
 // - view_repository.go
 // - board_order_repository.go
+// - board_activity_repository.go
+// - webhook_repository.go
+// - outbox_repository.go
 //
 // ==================== 사용 예시 ====================
 //
@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"board-service/internal/common/pagination"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// KeysetKey is an expression a keyset-paginated list is sorted by
+// Expr must be a column or an expression built by the service, never user input; values go into Args
+type KeysetKey struct {
+	Expr       string
+	Args       []interface{}
+	Desc       bool
+	NullsFirst bool
+}
+
+// Keyset is the order of a keyset-paginated list: its keys, then the row ID
+type Keyset struct {
+	Keys   []KeysetKey
+	IDDesc bool
+}
+
+// KeysetPage is one page of a keyset-paginated list
+// Next and Prev are nil at the end and at the start of the list
+type KeysetPage[T any] struct {
+	Items []T
+	Next  *pagination.Cursor
+	Prev  *pagination.Cursor
+}
+
+// OrderBy returns the ORDER BY clause of the keyset
+func (k Keyset) OrderBy() clause.OrderBy {
+	parts := make([]string, 0, len(k.Keys)+1)
+	var args []interface{}
+	for _, key := range k.Keys {
+		nulls := " NULLS LAST"
+		if key.NullsFirst {
+			nulls = " NULLS FIRST"
+		}
+		parts = append(parts, key.Expr+direction(key.Desc)+nulls)
+		args = append(args, key.Args...)
+	}
+	parts = append(parts, "id"+direction(k.IDDesc))
+	return clause.OrderBy{Expression: clause.Expr{SQL: strings.Join(parts, ", "), Vars: args, WithoutParentheses: true}}
+}
+
+func direction(desc bool) string {
+	if desc {
+		return " DESC"
+	}
+	return " ASC"
+}
+
+// reversed returns the order that reads the list backwards
+func (k Keyset) reversed() Keyset {
+	keys := make([]KeysetKey, len(k.Keys))
+	for i, key := range k.Keys {
+		key.Desc, key.NullsFirst = !key.Desc, !key.NullsFirst
+		keys[i] = key
+	}
+	return Keyset{Keys: keys, IDDesc: !k.IDDesc}
+}
+
+// after returns the condition matching the rows that come after the cursor in this order
+// A NULL value sorts as the last (or first) value of its key, as in OrderBy
+func (k Keyset) after(cursor *pagination.Cursor, id uuid.UUID) (string, []interface{}) {
+	var alternatives []string
+	var args []interface{}
+	var equal []string
+	var equalArgs []interface{}
+
+	for i, key := range k.Keys {
+		value := cursor.Values[i]
+		var later string
+		var laterArgs []interface{}
+		comparison := " > ?"
+		if key.Desc {
+			comparison = " < ?"
+		}
+		switch {
+		case value == nil && key.NullsFirst:
+			later, laterArgs = key.Expr+" IS NOT NULL", key.Args
+		case value == nil:
+			// Nothing sorts after NULL in this key
+		case key.NullsFirst:
+			later, laterArgs = key.Expr+comparison, append(append([]interface{}{}, key.Args...), value)
+		default:
+			later = "(" + key.Expr + comparison + " OR " + key.Expr + " IS NULL)"
+			laterArgs = append(append(append([]interface{}{}, key.Args...), value), key.Args...)
+		}
+		if later != "" {
+			alternatives = append(alternatives, "("+strings.Join(append(append([]string{}, equal...), later), " AND ")+")")
+			args = append(append(args, equalArgs...), laterArgs...)
+		}
+
+		if value == nil {
+			equal = append(equal, key.Expr+" IS NULL")
+			equalArgs = append(equalArgs, key.Args...)
+		} else {
+			equal = append(equal, key.Expr+" = ?")
+			equalArgs = append(append(equalArgs, key.Args...), value)
+		}
+	}
+
+	idComparison := "id > ?"
+	if k.IDDesc {
+		idComparison = "id < ?"
+	}
+	alternatives = append(alternatives, "("+strings.Join(append(equal, idComparison), " AND ")+")")
+	args = append(append(args, equalArgs...), id)
+	return strings.Join(alternatives, " OR "), args
+}
+
+// FindKeysetPage reads the rows of query after the cursor, or before it for a backward cursor
+// query must not be ordered; rowID returns the ID of a row
+// The values of the first and last rows are read for their cursors
+func FindKeysetPage[T any](query *gorm.DB, keyset Keyset, cursor *pagination.Cursor, limit int, rowID func(*T) uuid.UUID) (*KeysetPage[T], error) {
+	base := query.Session(&gorm.Session{})
+	order := keyset
+	backward := cursor != nil && cursor.Backward
+	if backward {
+		order = keyset.reversed()
+	}
+
+	page := base
+	if cursor != nil {
+		id, err := uuid.Parse(cursor.ID)
+		if err != nil || len(cursor.Values) != len(keyset.Keys) {
+			return nil, pagination.ErrInvalidCursor
+		}
+		condition, args := order.after(cursor, id)
+		page = page.Where("("+condition+")", args...)
+	}
+
+	var rows []T
+	if err := page.Clauses(order.OrderBy()).Limit(limit + 1).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	more := len(rows) > limit
+	if more {
+		rows = rows[:limit]
+	}
+	if backward {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+
+	result := &KeysetPage[T]{Items: rows}
+	if len(rows) == 0 {
+		return result, nil
+	}
+	// Coming from a cursor, there are rows on its side of the page
+	hasNext, hasPrev := more, cursor != nil
+	if backward {
+		hasNext, hasPrev = true, more
+	}
+	var err error
+	if hasNext {
+		if result.Next, err = KeysetCursor(base, keyset, rowID(&rows[len(rows)-1]), false); err != nil {
+			return nil, err
+		}
+	}
+	if hasPrev {
+		if result.Prev, err = KeysetCursor(base, keyset, rowID(&rows[0]), true); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// KeysetCursor returns the cursor of a row of query
+func KeysetCursor(query *gorm.DB, keyset Keyset, id uuid.UUID, backward bool) (*pagination.Cursor, error) {
+	cursor := &pagination.Cursor{ID: id.String(), Backward: backward}
+	if len(keyset.Keys) == 0 {
+		return cursor, nil
+	}
+
+	exprs := make([]string, 0, len(keyset.Keys))
+	var args []interface{}
+	for _, key := range keyset.Keys {
+		exprs = append(exprs, key.Expr)
+		args = append(args, key.Args...)
+	}
+	values := make([]interface{}, len(keyset.Keys))
+	targets := make([]interface{}, len(values))
+	for i := range values {
+		targets[i] = &values[i]
+	}
+	row := query.Session(&gorm.Session{}).
+		Clauses(clause.Select{Expression: clause.Expr{SQL: strings.Join(exprs, ", "), Vars: args, WithoutParentheses: true}}).
+		Where("id = ?", id).
+		Row()
+	if err := row.Scan(targets...); err != nil {
+		return nil, err
+	}
+
+	for _, value := range values {
+		switch v := value.(type) {
+		case []byte:
+			value = string(v)
+		case [16]byte:
+			value = uuid.UUID(v).String()
+		}
+		cursor.Values = append(cursor.Values, value)
+	}
+	return cursor, nil
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// MilestoneRepository는 프로젝트의 마일스톤(릴리스 버전)과 보드의 마일스톤 배정을 관리합니다
+// 보드의 마일스톤은 boards.milestone_id이며, 삭제된 보드는 진행률에서 제외됩니다
+type MilestoneRepository interface {
+	Create(milestone *domain.Milestone) error
+	FindByID(id uuid.UUID) (*domain.Milestone, error)
+	FindByProject(projectID uuid.UUID, released *bool) ([]domain.Milestone, error)
+	Update(milestone *domain.Milestone) error
+	Delete(id uuid.UUID) error
+
+	// Boards of a milestone
+	FindBoards(milestoneID uuid.UUID) ([]domain.Board, error)
+	CountBoards(milestoneIDs []uuid.UUID) (map[uuid.UUID]int, error)
+	CountBoardsWithOption(milestoneIDs []uuid.UUID, fieldID, optionID uuid.UUID) (map[uuid.UUID]int, error)
+	AssignBoards(boardIDs []uuid.UUID, milestoneID *uuid.UUID) error
+	ClearBoards(milestoneID uuid.UUID) error
+}
+
+type milestoneRepository struct {
+	db *gorm.DB
+}
+
+// NewMilestoneRepository는 새로운 MilestoneRepository를 생성합니다
+func NewMilestoneRepository(db *gorm.DB) MilestoneRepository {
+	return &milestoneRepository{db: db}
+}
+
+func (r *milestoneRepository) Create(milestone *domain.Milestone) error {
+	return r.db.Create(milestone).Error
+}
+
+func (r *milestoneRepository) FindByID(id uuid.UUID) (*domain.Milestone, error) {
+	var milestone domain.Milestone
+	if err := r.db.Where("id = ? AND is_deleted = ?", id, false).First(&milestone).Error; err != nil {
+		return nil, err
+	}
+	return &milestone, nil
+}
+
+// FindByProject returns the milestones of the project: unreleased milestones first, then by target date
+func (r *milestoneRepository) FindByProject(projectID uuid.UUID, released *bool) ([]domain.Milestone, error) {
+	query := r.db.Where("project_id = ? AND is_deleted = ?", projectID, false)
+	if released != nil {
+		query = query.Where("released = ?", *released)
+	}
+
+	var milestones []domain.Milestone
+	err := query.
+		Order("released ASC").
+		Order("target_date IS NULL, target_date ASC, created_at ASC").
+		Find(&milestones).Error
+	return milestones, err
+}
+
+func (r *milestoneRepository) Update(milestone *domain.Milestone) error {
+	return r.db.Save(milestone).Error
+}
+
+func (r *milestoneRepository) Delete(id uuid.UUID) error {
+	// Soft delete
+	return r.db.Model(&domain.Milestone{}).Where("id = ?", id).Update("is_deleted", true).Error
+}
+
+// ==================== Boards of a milestone ====================
+
+// FindBoards returns the boards of the milestone that are not deleted, in board number order
+func (r *milestoneRepository) FindBoards(milestoneID uuid.UUID) ([]domain.Board, error) {
+	var boards []domain.Board
+	err := r.db.Where("milestone_id = ? AND is_deleted = ?", milestoneID, false).
+		Order("number ASC").
+		Find(&boards).Error
+	return boards, err
+}
+
+// CountBoards returns the number of boards that are not deleted in each milestone
+// Milestones without boards are not in the map
+func (r *milestoneRepository) CountBoards(milestoneIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	return r.countBoards(r.db.Model(&domain.Board{}), milestoneIDs)
+}
+
+// CountBoardsWithOption returns the number of boards in each milestone whose value of the single select field is the option
+func (r *milestoneRepository) CountBoardsWithOption(milestoneIDs []uuid.UUID, fieldID, optionID uuid.UUID) (map[uuid.UUID]int, error) {
+	withOption := r.db.Model(&domain.BoardFieldValue{}).Select("board_id").
+		Where("field_id = ? AND value_option_id = ? AND is_deleted = ?", fieldID, optionID, false)
+	return r.countBoards(r.db.Model(&domain.Board{}).Where("id IN (?)", withOption), milestoneIDs)
+}
+
+func (r *milestoneRepository) countBoards(query *gorm.DB, milestoneIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	result := make(map[uuid.UUID]int)
+	if len(milestoneIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		MilestoneID uuid.UUID
+		Total       int
+	}
+	err := query.
+		Select("milestone_id, COUNT(*) AS total").
+		Where("milestone_id IN ? AND is_deleted = ?", milestoneIDs, false).
+		Group("milestone_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		result[row.MilestoneID] = row.Total
+	}
+	return result, nil
+}
+
+// AssignBoards targets the boards at the milestone, or removes them from their milestone (nil)
+func (r *milestoneRepository) AssignBoards(boardIDs []uuid.UUID, milestoneID *uuid.UUID) error {
+	if len(boardIDs) == 0 {
+		return nil
+	}
+	return r.db.Model(&domain.Board{}).
+		Where("id IN ?", boardIDs).
+		Updates(map[string]interface{}{"milestone_id": milestoneID, "updated_at": time.Now()}).Error
+}
+
+// ClearBoards removes every board of the milestone, deleted ones included, from it
+func (r *milestoneRepository) ClearBoards(milestoneID uuid.UUID) error {
+	return r.db.Model(&domain.Board{}).
+		Where("milestone_id = ?", milestoneID).
+		Updates(map[string]interface{}{"milestone_id": nil, "updated_at": time.Now()}).Error
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NotificationRepository는 사용자 알림함과 보드 구독(watcher)을 관리합니다
+// 알림함 조회는 삭제된 보드(프로젝트 삭제 포함)의 알림을 제외합니다
+// 구독자 조회는 보드 프로젝트의 현재 멤버만 반환합니다 (프로젝트를 떠난 사용자는 알림 대상이 아님)
+type NotificationRepository interface {
+	// Notification
+	BatchCreate(notifications []domain.Notification) error
+	FindByUser(userID uuid.UUID, unreadOnly bool, page, limit int) ([]domain.Notification, int64, error)
+	CountUnread(userID uuid.UUID) (int64, error)
+	MarkRead(userID, notificationID uuid.UUID) error
+	MarkAllRead(userID uuid.UUID) (int64, error)
+
+	// Board Watcher
+	AddWatchers(boardID uuid.UUID, userIDs []uuid.UUID) error
+	RemoveWatcher(boardID, userID uuid.UUID) error
+	IsWatching(boardID, userID uuid.UUID) (bool, error)
+	FindWatcherIDs(boardID uuid.UUID) ([]uuid.UUID, error)
+	FindWatchedBoards(userID uuid.UUID, page, limit int) ([]domain.Board, int64, error)
+}
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepository는 새로운 NotificationRepository를 생성합니다
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+// ==================== Notification ====================
+
+func (r *notificationRepository) BatchCreate(notifications []domain.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	return r.db.Create(&notifications).Error
+}
+
+// FindByUser returns the user's notifications, newest first
+func (r *notificationRepository) FindByUser(userID uuid.UUID, unreadOnly bool, page, limit int) ([]domain.Notification, int64, error) {
+	var notifications []domain.Notification
+	var total int64
+
+	query := r.inbox(userID)
+	if unreadOnly {
+		query = query.Where("notifications.read_at IS NULL")
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Order("notifications.created_at DESC, notifications.id DESC").
+		Offset(offset).Limit(limit).Find(&notifications).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return notifications, total, nil
+}
+
+func (r *notificationRepository) CountUnread(userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.inbox(userID).Where("notifications.read_at IS NULL").Count(&count).Error
+	return count, err
+}
+
+// MarkRead marks one notification of the user as read
+// Returns gorm.ErrRecordNotFound if the notification does not belong to the user
+func (r *notificationRepository) MarkRead(userID, notificationID uuid.UUID) error {
+	var notification domain.Notification
+	if err := r.db.Where("id = ? AND user_id = ? AND is_deleted = ?", notificationID, userID, false).
+		First(&notification).Error; err != nil {
+		return err
+	}
+	if notification.IsRead() {
+		return nil
+	}
+
+	return r.db.Model(&domain.Notification{}).
+		Where("id = ?", notificationID).
+		Updates(map[string]interface{}{"read_at": time.Now(), "updated_at": time.Now()}).Error
+}
+
+// MarkAllRead marks every unread notification of the user as read and returns how many changed
+func (r *notificationRepository) MarkAllRead(userID uuid.UUID) (int64, error) {
+	tx := r.db.Model(&domain.Notification{}).
+		Where("user_id = ? AND read_at IS NULL AND is_deleted = ?", userID, false).
+		Updates(map[string]interface{}{"read_at": time.Now(), "updated_at": time.Now()})
+	return tx.RowsAffected, tx.Error
+}
+
+// inbox selects the user's notifications whose board still exists
+func (r *notificationRepository) inbox(userID uuid.UUID) *gorm.DB {
+	return r.db.Model(&domain.Notification{}).
+		Joins("JOIN boards ON boards.id = notifications.board_id AND boards.is_deleted = ?", false).
+		Where("notifications.user_id = ? AND notifications.is_deleted = ?", userID, false)
+}
+
+// ==================== Board Watcher ====================
+
+// AddWatchers subscribes the users to the board
+// Users who already watch the board are skipped, so concurrent auto-watches cannot fail on the unique index
+func (r *notificationRepository) AddWatchers(boardID uuid.UUID, userIDs []uuid.UUID) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	watchers := make([]domain.BoardWatcher, 0, len(userIDs))
+	for _, userID := range userIDs {
+		watchers = append(watchers, domain.BoardWatcher{BoardID: boardID, UserID: userID})
+	}
+
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "board_id"}, {Name: "user_id"}},
+		DoNothing: true,
+	}).Create(&watchers).Error
+}
+
+// RemoveWatcher unsubscribes the user from the board; unwatching a board that is not watched is a no-op
+func (r *notificationRepository) RemoveWatcher(boardID, userID uuid.UUID) error {
+	return r.db.Where("board_id = ? AND user_id = ?", boardID, userID).Delete(&domain.BoardWatcher{}).Error
+}
+
+func (r *notificationRepository) IsWatching(boardID, userID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.BoardWatcher{}).
+		Where("board_id = ? AND user_id = ?", boardID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// FindWatcherIDs returns the watchers of the board who are still members of its project, oldest first
+func (r *notificationRepository) FindWatcherIDs(boardID uuid.UUID) ([]uuid.UUID, error) {
+	var userIDs []uuid.UUID
+	err := r.db.Model(&domain.BoardWatcher{}).
+		Joins("JOIN boards ON boards.id = board_watchers.board_id").
+		Joins("JOIN project_members ON project_members.project_id = boards.project_id AND project_members.user_id = board_watchers.user_id AND project_members.is_deleted = ?", false).
+		Where("board_watchers.board_id = ?", boardID).
+		Order("board_watchers.created_at ASC").
+		Pluck("board_watchers.user_id", &userIDs).Error
+	return userIDs, err
+}
+
+// FindWatchedBoards returns the live boards the user watches in projects they are still a member of,
+// most recently watched first
+func (r *notificationRepository) FindWatchedBoards(userID uuid.UUID, page, limit int) ([]domain.Board, int64, error) {
+	var boards []domain.Board
+	var total int64
+
+	query := r.db.Model(&domain.Board{}).
+		Joins("JOIN board_watchers ON board_watchers.board_id = boards.id AND board_watchers.user_id = ?", userID).
+		Joins("JOIN project_members ON project_members.project_id = boards.project_id AND project_members.user_id = board_watchers.user_id AND project_members.is_deleted = ?", false).
+		Where("boards.is_deleted = ?", false)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Select("boards.*").
+		Order("board_watchers.created_at DESC, boards.id DESC").
+		Offset(offset).Limit(limit).Find(&boards).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return boards, total, nil
+}
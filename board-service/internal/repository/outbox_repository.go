@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/event"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OutboxWriter는 이벤트를 outbox_events 테이블에 기록합니다
+// 트랜잭션 DB로 생성하면 도메인 변경과 함께 커밋/롤백됩니다 (uow.Repositories.Outbox)
+type OutboxWriter interface {
+	Write(evt event.Event) error
+}
+
+// OutboxRepository는 outbox 기록과 relay의 발행 처리를 담당합니다
+type OutboxRepository interface {
+	OutboxWriter
+
+	// ClaimUnpublished leases the oldest unpublished events to the caller until now+lease
+	// Events leased by another relay are skipped; no row lock is held after it returns
+	ClaimUnpublished(now time.Time, lease time.Duration, maxAttempts, limit int) ([]domain.OutboxEvent, error)
+	MarkPublished(ids []uuid.UUID, at time.Time) error
+	RecordFailure(outboxEvent *domain.OutboxEvent) error
+	ReleaseClaims(ids []uuid.UUID) error
+	DeletePublishedBefore(before time.Time) (int64, error)
+}
+
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+// NewOutboxRepository는 새로운 OutboxRepository를 생성합니다
+func NewOutboxRepository(db *gorm.DB) OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+func (r *outboxRepository) Write(evt event.Event) error {
+	projectID, err := uuid.Parse(evt.ProjectID)
+	if err != nil {
+		return fmt.Errorf("invalid project id in event: %w", err)
+	}
+
+	payload, err := json.Marshal(evt)
+	if err != nil {
+		return fmt.Errorf("failed to marshal outbox event: %w", err)
+	}
+
+	return r.db.Create(&domain.OutboxEvent{
+		EventID:   evt.ID,
+		EventType: string(evt.Type),
+		ProjectID: projectID,
+		Payload:   string(payload),
+	}).Error
+}
+
+// ClaimUnpublished locks the oldest unpublished events with FOR UPDATE SKIP LOCKED and
+// sets their locked_until to now+lease in one short transaction. The relay publishes
+// them after the transaction commits, so no lock is held across network I/O; if the
+// relay dies mid-publish the lease expires and the events are claimed again.
+func (r *outboxRepository) ClaimUnpublished(now time.Time, lease time.Duration, maxAttempts, limit int) ([]domain.OutboxEvent, error) {
+	var events []domain.OutboxEvent
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("published_at IS NULL AND attempts < ? AND is_deleted = ?", maxAttempts, false).
+			Where("locked_until IS NULL OR locked_until <= ?", now).
+			Order("created_at ASC, id ASC").
+			Limit(limit).
+			Find(&events).Error; err != nil {
+			return err
+		}
+
+		if len(events) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(events))
+		for i, e := range events {
+			ids[i] = e.ID
+		}
+
+		return tx.Model(&domain.OutboxEvent{}).
+			Where("id IN ?", ids).
+			Update("locked_until", now.Add(lease)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *outboxRepository) MarkPublished(ids []uuid.UUID, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.Model(&domain.OutboxEvent{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{"published_at": at, "last_error": "", "locked_until": nil}).Error
+}
+
+// RecordFailure stores the failed attempt and releases the lease so the next poll retries it
+func (r *outboxRepository) RecordFailure(outboxEvent *domain.OutboxEvent) error {
+	outboxEvent.LockedUntil = nil
+	return r.db.Model(outboxEvent).
+		Updates(map[string]interface{}{"attempts": outboxEvent.Attempts, "last_error": outboxEvent.LastError, "locked_until": nil}).Error
+}
+
+// ReleaseClaims gives up the lease on events the relay claimed but did not try to publish
+func (r *outboxRepository) ReleaseClaims(ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.Model(&domain.OutboxEvent{}).
+		Where("id IN ? AND published_at IS NULL", ids).
+		Update("locked_until", nil).Error
+}
+
+// DeletePublishedBefore removes published events older than the retention period
+func (r *outboxRepository) DeletePublishedBefore(before time.Time) (int64, error) {
+	result := r.db.Where("published_at IS NOT NULL AND published_at < ?", before).
+		Delete(&domain.OutboxEvent{})
+	return result.RowsAffected, result.Error
+}
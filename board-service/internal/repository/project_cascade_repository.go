@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// ProjectCascadeTargets는 프로젝트 삭제 시 함께 정리되는 하위 엔티티의 ID 목록입니다
+// 커밋 이후 캐시 무효화에 사용됩니다
+type ProjectCascadeTargets struct {
+	BoardIDs []uuid.UUID
+	FieldIDs []uuid.UUID
+	ViewIDs  []uuid.UUID
+}
+
+// ProjectCascadeResult는 테이블별로 삭제된 row 수입니다
+type ProjectCascadeResult map[string]int64
+
+// ProjectCascadeRepository는 프로젝트와 모든 하위 데이터를 일괄 삭제합니다
+// 각 테이블을 subquery 기반의 단일 UPDATE/DELETE로 처리하므로 보드 수와 무관하게 쿼리 수가 일정합니다
+// 테이블은 항상 자식 → 부모 순서로 접근합니다 (동시 삭제 시 데드락 방지)
+// 트랜잭션으로 묶으려면 uow.Repositories.ProjectCascade를 사용하세요
+type ProjectCascadeRepository interface {
+	// FindCascadeTargets는 삭제 대상 보드/필드/뷰 ID를 조회합니다
+	FindCascadeTargets(projectID uuid.UUID) (*ProjectCascadeTargets, error)
+
+	// SoftDeleteProjectData는 프로젝트와 하위 데이터를 is_deleted = true로 표시합니다
+	// is_deleted 컬럼이 없는 보드 순서(user_board_order)는 그대로 두어 복원 시 사용자 정렬이 유지됩니다
+	SoftDeleteProjectData(projectID uuid.UUID) (ProjectCascadeResult, error)
+
+	// RestoreProjectData는 프로젝트와 함께 soft delete된 하위 데이터(updated_at >= deletedAt)를 복원합니다
+	// 프로젝트 삭제 전에 개별 삭제된 보드/댓글은 휴지통에 그대로 남습니다
+	RestoreProjectData(projectID uuid.UUID, deletedAt time.Time) (ProjectCascadeResult, error)
+
+	// PurgeProjectData는 프로젝트와 하위 데이터를 영구 삭제합니다 (활동 이력, 웹훅, 휴지통 항목 포함)
+	PurgeProjectData(projectID uuid.UUID) (ProjectCascadeResult, error)
+}
+
+type projectCascadeRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectCascadeRepository(db *gorm.DB) ProjectCascadeRepository {
+	return &projectCascadeRepository{db: db}
+}
+
+func (r *projectCascadeRepository) FindCascadeTargets(projectID uuid.UUID) (*ProjectCascadeTargets, error) {
+	targets := &ProjectCascadeTargets{}
+
+	if err := r.db.Model(&domain.Board{}).Where("project_id = ?", projectID).Pluck("id", &targets.BoardIDs).Error; err != nil {
+		return nil, err
+	}
+	if err := r.db.Model(&domain.ProjectField{}).Where("project_id = ?", projectID).Pluck("id", &targets.FieldIDs).Error; err != nil {
+		return nil, err
+	}
+	if err := r.db.Model(&domain.SavedView{}).Where("project_id = ?", projectID).Pluck("id", &targets.ViewIDs).Error; err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
+func (r *projectCascadeRepository) SoftDeleteProjectData(projectID uuid.UUID) (ProjectCascadeResult, error) {
+	result := ProjectCascadeResult{}
+
+	// Board orders have no is_deleted column; they are kept for a restore and only read
+	// through their (now deleted) views, so they are removed by PurgeProjectData only
+	for _, step := range r.softDeleteSteps(projectID) {
+		tx := r.db.Model(step.model).
+			Where(step.query, step.arg).
+			Where("is_deleted = ?", false).
+			Update("is_deleted", true)
+		if tx.Error != nil {
+			return nil, tx.Error
+		}
+		result[step.model.TableName()] = tx.RowsAffected
+	}
+
+	return result, nil
+}
+
+func (r *projectCascadeRepository) RestoreProjectData(projectID uuid.UUID, deletedAt time.Time) (ProjectCascadeResult, error) {
+	result := ProjectCascadeResult{}
+
+	for _, step := range r.softDeleteSteps(projectID) {
+		tx := r.db.Model(step.model).
+			Where(step.query, step.arg).
+			Where("is_deleted = ? AND updated_at >= ?", true, deletedAt).
+			Update("is_deleted", false)
+		if tx.Error != nil {
+			return nil, tx.Error
+		}
+		result[step.model.TableName()] = tx.RowsAffected
+	}
+
+	return result, nil
+}
+
+func (r *projectCascadeRepository) PurgeProjectData(projectID uuid.UUID) (ProjectCascadeResult, error) {
+	result := ProjectCascadeResult{}
+	boards := r.boardIDs(projectID)
+	fields := r.fieldIDs(projectID)
+	views := r.viewIDs(projectID)
+	deliveries := r.db.Model(&domain.WebhookDelivery{}).Select("id").Where("project_id = ?", projectID)
+	sprints := r.db.Model(&domain.Sprint{}).Select("id").Where("project_id = ?", projectID)
+
+	steps := []struct {
+		model schema.Tabler
+		query string
+		args  []interface{}
+	}{
+		{&domain.TrashItem{}, "project_id = ?", []interface{}{projectID}},
+		{&domain.WebhookDeliveryAttempt{}, "delivery_id IN (?)", []interface{}{deliveries}},
+		{&domain.WebhookDelivery{}, "project_id = ?", []interface{}{projectID}},
+		{&domain.Webhook{}, "project_id = ?", []interface{}{projectID}},
+		{&domain.BoardActivity{}, "project_id = ?", []interface{}{projectID}},
+		{&domain.Notification{}, "project_id = ?", []interface{}{projectID}},
+		{&domain.BoardWatcher{}, "board_id IN (?)", []interface{}{boards}},
+		{&domain.BoardReminder{}, "board_id IN (?)", []interface{}{boards}},
+		{&domain.BoardKeyAlias{}, "board_id IN (?)", []interface{}{boards}},
+		{&domain.BoardRelation{}, "source_board_id IN (?) OR target_board_id IN (?)", []interface{}{boards, boards}},
+		{&domain.ChecklistItem{}, "board_id IN (?)", []interface{}{boards}},
+		{&domain.Attachment{}, "board_id IN (?)", []interface{}{boards}},
+		{&domain.WorkLog{}, "board_id IN (?)", []interface{}{boards}},
+		{&domain.BoardStageChange{}, "project_id = ? OR board_id IN (?)", []interface{}{projectID, boards}},
+		{&domain.SprintBoard{}, "sprint_id IN (?) OR board_id IN (?)", []interface{}{sprints, boards}},
+		{&domain.CommentReaction{}, "comment_id IN (?)", []interface{}{r.db.Model(&domain.Comment{}).Select("id").Where("board_id IN (?)", boards)}},
+		{&domain.Comment{}, "board_id IN (?)", []interface{}{boards}},
+		{&domain.BoardFieldValue{}, "board_id IN (?)", []interface{}{boards}},
+		{&domain.UserBoardOrder{}, "view_id IN (?) OR board_id IN (?)", []interface{}{views, boards}},
+		{&domain.FieldOption{}, "field_id IN (?)", []interface{}{fields}},
+		{&domain.Board{}, "project_id = ?", []interface{}{projectID}},
+		{&domain.ProjectField{}, "project_id = ?", []interface{}{projectID}},
+		{&domain.SavedView{}, "project_id = ?", []interface{}{projectID}},
+		{&domain.Sprint{}, "project_id = ?", []interface{}{projectID}},
+		{&domain.Milestone{}, "project_id = ?", []interface{}{projectID}},
+		{&domain.BoardTemplate{}, "project_id = ?", []interface{}{projectID}},
+		{&domain.BoardRecurrence{}, "project_id = ?", []interface{}{projectID}},
+		{&domain.ProjectMember{}, "project_id = ?", []interface{}{projectID}},
+		{&domain.ProjectJoinRequest{}, "project_id = ?", []interface{}{projectID}},
+		{&domain.Project{}, "id = ?", []interface{}{projectID}},
+	}
+
+	for _, step := range steps {
+		tx := r.db.Where(step.query, step.args...).Delete(step.model)
+		if tx.Error != nil {
+			return nil, tx.Error
+		}
+		result[step.model.TableName()] = tx.RowsAffected
+	}
+
+	return result, nil
+}
+
+// softDeleteStep soft deletes (or restores) the rows of one table that belong to a project
+type softDeleteStep struct {
+	model schema.Tabler
+	query string
+	arg   interface{}
+}
+
+// Deleted projects stop sending webhooks because deliveries are only created for
+// webhooks that are not deleted
+func (r *projectCascadeRepository) softDeleteSteps(projectID uuid.UUID) []softDeleteStep {
+	boards := r.boardIDs(projectID)
+	return []softDeleteStep{
+		{&domain.Comment{}, "board_id IN (?)", boards},
+		{&domain.BoardFieldValue{}, "board_id IN (?)", boards},
+		{&domain.FieldOption{}, "field_id IN (?)", r.fieldIDs(projectID)},
+		{&domain.Board{}, "project_id = ?", projectID},
+		{&domain.ProjectField{}, "project_id = ?", projectID},
+		{&domain.SavedView{}, "project_id = ?", projectID},
+		{&domain.Sprint{}, "project_id = ?", projectID},
+		{&domain.Milestone{}, "project_id = ?", projectID},
+		{&domain.BoardTemplate{}, "project_id = ?", projectID},
+		{&domain.BoardRecurrence{}, "project_id = ?", projectID},
+		{&domain.ProjectMember{}, "project_id = ?", projectID},
+		{&domain.ProjectJoinRequest{}, "project_id = ?", projectID},
+		{&domain.Webhook{}, "project_id = ?", projectID},
+		{&domain.Project{}, "id = ?", projectID},
+	}
+}
+
+// ==================== Subqueries ====================
+// 이미 soft delete된 row도 포함해야 하위 데이터가 남지 않으므로 is_deleted 조건을 두지 않습니다
+
+func (r *projectCascadeRepository) boardIDs(projectID uuid.UUID) *gorm.DB {
+	return r.db.Model(&domain.Board{}).Select("id").Where("project_id = ?", projectID)
+}
+
+func (r *projectCascadeRepository) fieldIDs(projectID uuid.UUID) *gorm.DB {
+	return r.db.Model(&domain.ProjectField{}).Select("id").Where("project_id = ?", projectID)
+}
+
+func (r *projectCascadeRepository) viewIDs(projectID uuid.UUID) *gorm.DB {
+	return r.db.Model(&domain.SavedView{}).Select("id").Where("project_id = ?", projectID)
+}
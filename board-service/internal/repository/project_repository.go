@@ -17,6 +17,10 @@ type ProjectRepository interface {
 	Delete(id uuid.UUID) error
 	Search(workspaceID uuid.UUID, query string, page, limit int) ([]domain.Project, int64, error)
 
+	// Board Key
+	ExistsByKey(key string) (bool, error)
+	IncrementBoardSequence(projectID uuid.UUID) (*domain.Project, error)
+
 	// Join Request
 	CreateJoinRequest(req *domain.ProjectJoinRequest) error
 	FindJoinRequestByID(id uuid.UUID) (*domain.ProjectJoinRequest, error)
@@ -43,8 +47,13 @@ func NewProjectRepository(db *gorm.DB) ProjectRepository {
 
 // Project CRUD
 
+// Create inserts the project; a key that is already taken fails with gorm.ErrDuplicatedKey
 func (r *projectRepository) Create(project *domain.Project) error {
-	return r.db.Create(project).Error
+	err := r.db.Create(project).Error
+	if translator, ok := r.db.Dialector.(gorm.ErrorTranslator); ok && err != nil {
+		return translator.Translate(err)
+	}
+	return err
 }
 
 func (r *projectRepository) FindByID(id uuid.UUID) (*domain.Project, error) {
@@ -76,6 +85,31 @@ func (r *projectRepository) Delete(id uuid.UUID) error {
 		Update("is_deleted", true).Error
 }
 
+// ExistsByKey returns true if any project, including deleted ones, uses the key
+// Keys of deleted projects stay reserved because the project can be restored from the trash
+func (r *projectRepository) ExistsByKey(key string) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.Project{}).Where("key = ?", key).Count(&count).Error
+	return count > 0, err
+}
+
+// IncrementBoardSequence allocates the next board number of the project and returns the updated project
+// The UPDATE locks the project row until the surrounding transaction ends, so concurrent board creations
+// are serialized and a rolled back creation releases its number (no gaps); call it inside a transaction
+func (r *projectRepository) IncrementBoardSequence(projectID uuid.UUID) (*domain.Project, error) {
+	result := r.db.Model(&domain.Project{}).
+		Where("id = ? AND is_deleted = ?", projectID, false).
+		UpdateColumn("board_sequence", gorm.Expr("board_sequence + 1"))
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return r.FindByID(projectID)
+}
+
 func (r *projectRepository) Search(workspaceID uuid.UUID, query string, page, limit int) ([]domain.Project, int64, error) {
 	var projects []domain.Project
 	var total int64
@@ -170,7 +204,7 @@ func (r *projectRepository) FindMemberByID(id uuid.UUID) (*domain.ProjectMember,
 
 func (r *projectRepository) FindMembersByProject(projectID uuid.UUID) ([]domain.ProjectMember, error) {
 	var members []domain.ProjectMember
-	if err := r.db.Where("project_id = ?", projectID).
+	if err := r.db.Where("project_id = ? AND is_deleted = ?", projectID, false).
 		Order("joined_at ASC").
 		Find(&members).Error; err != nil {
 		return nil, err
@@ -181,7 +215,7 @@ func (r *projectRepository) FindMembersByProject(projectID uuid.UUID) ([]domain.
 func (r *projectRepository) FindMemberByUserAndProject(userID, projectID uuid.UUID) (*domain.ProjectMember, error) {
 	var member domain.ProjectMember
 	if err := r.db.Preload("Role").
-		Where("user_id = ? AND project_id = ?", userID, projectID).
+		Where("user_id = ? AND project_id = ? AND is_deleted = ?", userID, projectID, false).
 		First(&member).Error; err != nil {
 		return nil, err
 	}
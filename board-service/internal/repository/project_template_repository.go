@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ProjectTemplateRepository는 워크스페이스의 프로젝트 템플릿과 템플릿/복제의 원본이 되는 프로젝트 보드를 조회합니다
+// 템플릿은 워크스페이스에 속하므로 원본 프로젝트가 삭제되어도 유지됩니다
+type ProjectTemplateRepository interface {
+	Create(template *domain.ProjectTemplate) error
+	FindByID(id uuid.UUID) (*domain.ProjectTemplate, error)
+	FindByWorkspace(workspaceID uuid.UUID) ([]domain.ProjectTemplate, error)
+	Delete(id uuid.UUID) error
+
+	// Source project
+	FindProjectBoards(projectID uuid.UUID, limit int) ([]domain.Board, error)
+}
+
+type projectTemplateRepository struct {
+	db *gorm.DB
+}
+
+// NewProjectTemplateRepository는 새로운 ProjectTemplateRepository를 생성합니다
+func NewProjectTemplateRepository(db *gorm.DB) ProjectTemplateRepository {
+	return &projectTemplateRepository{db: db}
+}
+
+func (r *projectTemplateRepository) Create(template *domain.ProjectTemplate) error {
+	return r.db.Create(template).Error
+}
+
+func (r *projectTemplateRepository) FindByID(id uuid.UUID) (*domain.ProjectTemplate, error) {
+	var template domain.ProjectTemplate
+	if err := r.db.Where("id = ? AND is_deleted = ?", id, false).First(&template).Error; err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// FindByWorkspace returns the templates of the workspace by name
+func (r *projectTemplateRepository) FindByWorkspace(workspaceID uuid.UUID) ([]domain.ProjectTemplate, error) {
+	var templates []domain.ProjectTemplate
+	err := r.db.Where("workspace_id = ? AND is_deleted = ?", workspaceID, false).
+		Order("name ASC, created_at ASC").
+		Find(&templates).Error
+	return templates, err
+}
+
+func (r *projectTemplateRepository) Delete(id uuid.UUID) error {
+	// Soft delete
+	return r.db.Model(&domain.ProjectTemplate{}).Where("id = ?", id).Update("is_deleted", true).Error
+}
+
+// FindProjectBoards returns the boards of the project that are not deleted, in board number order
+// A positive limit returns at most limit + 1 boards so that the caller can tell that there are more
+func (r *projectTemplateRepository) FindProjectBoards(projectID uuid.UUID, limit int) ([]domain.Board, error) {
+	query := r.db.Where("project_id = ? AND is_deleted = ?", projectID, false).Order("number ASC")
+	if limit > 0 {
+		query = query.Limit(limit + 1)
+	}
+
+	var boards []domain.Board
+	err := query.Find(&boards).Error
+	return boards, err
+}
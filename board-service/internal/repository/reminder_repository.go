@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReminderDigestEntry is one board in the daily digest of a user
+type ReminderDigestEntry struct {
+	BoardID   uuid.UUID
+	ProjectID uuid.UUID
+	Key       string
+	Title     string
+	DueDate   *time.Time
+	Type      domain.NotificationType // DUE_SOON or OVERDUE
+}
+
+// ReminderRepository는 마감일 알림과 일일 요약의 발송 표식(marker)을 관리합니다
+// 표식은 유니크 인덱스로 중복 발송을 막으므로, 발송과 같은 트랜잭션에서 Claim해야 합니다
+type ReminderRepository interface {
+	// FindBoardsToRemind returns live boards of live projects due in (from, to] without the reminder yet, soonest first
+	FindBoardsToRemind(kind domain.BoardReminderKind, leadMinutes int, from, to time.Time, limit int) ([]domain.Board, error)
+	// ClaimReminder inserts the marker and returns false if the reminder was already sent
+	ClaimReminder(reminder *domain.BoardReminder) (bool, error)
+
+	// FindDigestRecipients returns users with due date notifications in [from, to) and no digest on digestDate
+	FindDigestRecipients(from, to, digestDate time.Time, limit int) ([]uuid.UUID, error)
+	// FindDigestEntries returns the live boards of the user's due date notifications in [from, to), soonest due first
+	FindDigestEntries(userID uuid.UUID, from, to time.Time) ([]ReminderDigestEntry, error)
+	// ClaimDigest inserts the marker and returns false if the digest was already sent
+	ClaimDigest(digest *domain.ReminderDigest) (bool, error)
+}
+
+type reminderRepository struct {
+	db *gorm.DB
+}
+
+// NewReminderRepository는 새로운 ReminderRepository를 생성합니다
+func NewReminderRepository(db *gorm.DB) ReminderRepository {
+	return &reminderRepository{db: db}
+}
+
+var dueDateNotificationTypes = []domain.NotificationType{domain.NotificationDueSoon, domain.NotificationOverdue}
+
+// ==================== Board Reminders ====================
+
+func (r *reminderRepository) FindBoardsToRemind(kind domain.BoardReminderKind, leadMinutes int, from, to time.Time, limit int) ([]domain.Board, error) {
+	var boards []domain.Board
+	err := r.db.Model(&domain.Board{}).
+		Joins("JOIN projects ON projects.id = boards.project_id AND projects.is_deleted = ?", false).
+		Where("boards.is_deleted = ? AND boards.due_date > ? AND boards.due_date <= ?", false, from, to).
+		Where("NOT EXISTS (SELECT 1 FROM board_reminders WHERE board_reminders.board_id = boards.id AND board_reminders.kind = ? AND board_reminders.lead_minutes = ? AND board_reminders.due_date = boards.due_date)",
+			kind, leadMinutes).
+		Select("boards.*").
+		Order("boards.due_date ASC, boards.id ASC").
+		Limit(limit).
+		Find(&boards).Error
+	return boards, err
+}
+
+func (r *reminderRepository) ClaimReminder(reminder *domain.BoardReminder) (bool, error) {
+	tx := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "board_id"}, {Name: "kind"}, {Name: "lead_minutes"}, {Name: "due_date"}},
+		DoNothing: true,
+	}).Create(reminder)
+	return tx.RowsAffected == 1, tx.Error
+}
+
+// ==================== Digests ====================
+
+func (r *reminderRepository) FindDigestRecipients(from, to, digestDate time.Time, limit int) ([]uuid.UUID, error) {
+	var userIDs []uuid.UUID
+	err := r.digestNotifications(from, to).
+		Where("NOT EXISTS (SELECT 1 FROM reminder_digests WHERE reminder_digests.user_id = notifications.user_id AND reminder_digests.digest_date = ?)", digestDate).
+		Distinct("notifications.user_id").
+		Order("notifications.user_id ASC").
+		Limit(limit).
+		Pluck("notifications.user_id", &userIDs).Error
+	return userIDs, err
+}
+
+// FindDigestEntries returns one entry per board; a board reminded several times keeps its latest reminder type
+func (r *reminderRepository) FindDigestEntries(userID uuid.UUID, from, to time.Time) ([]ReminderDigestEntry, error) {
+	var rows []struct {
+		ReminderDigestEntry
+		CreatedAt time.Time
+	}
+	err := r.digestNotifications(from, to).
+		Where("notifications.user_id = ?", userID).
+		Select("boards.id AS board_id, boards.project_id, boards.key, boards.title, boards.due_date, notifications.type, notifications.created_at").
+		Order("notifications.created_at ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]ReminderDigestEntry, 0, len(rows))
+	index := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		if i, ok := index[row.BoardID]; ok {
+			entries[i].Type = row.Type
+			continue
+		}
+		index[row.BoardID] = len(entries)
+		entries = append(entries, row.ReminderDigestEntry)
+	}
+	sortDigestEntries(entries)
+	return entries, nil
+}
+
+func (r *reminderRepository) ClaimDigest(digest *domain.ReminderDigest) (bool, error) {
+	tx := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "digest_date"}},
+		DoNothing: true,
+	}).Create(digest)
+	return tx.RowsAffected == 1, tx.Error
+}
+
+// digestNotifications selects the due date notifications in [from, to) whose board still exists
+func (r *reminderRepository) digestNotifications(from, to time.Time) *gorm.DB {
+	return r.db.Model(&domain.Notification{}).
+		Joins("JOIN boards ON boards.id = notifications.board_id AND boards.is_deleted = ?", false).
+		Where("notifications.type IN ? AND notifications.is_deleted = ?", dueDateNotificationTypes, false).
+		Where("notifications.created_at >= ? AND notifications.created_at < ?", from, to)
+}
+
+// sortDigestEntries orders entries by due date (boards without one last), then by key
+func sortDigestEntries(entries []ReminderDigestEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i].DueDate, entries[j].DueDate
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		if !a.Equal(*b) {
+			return a.Before(*b)
+		}
+		return entries[i].Key < entries[j].Key
+	})
+}
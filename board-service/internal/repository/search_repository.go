@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Kinds of search hits
+const (
+	SearchTypeBoard   = "board"
+	SearchTypeComment = "comment"
+)
+
+// Highlight markers around matched words in the titles and snippets of search hits
+// They are private-use characters, so the service can escape the text before turning them into tags
+const (
+	HighlightStart = "\ue000"
+	HighlightStop  = "\ue001"
+)
+
+// maxSearchTerms bounds the words of a query that are matched
+const maxSearchTerms = 10
+
+// SearchFilters narrow a search; zero values do not filter
+type SearchFilters struct {
+	ProjectID     uuid.UUID
+	AssigneeID    uuid.UUID
+	StageFieldID  uuid.UUID // Stage field of StageOptionID
+	StageOptionID uuid.UUID
+	Type          string // SearchTypeBoard or SearchTypeComment, empty for both
+}
+
+// SearchHit is a board or a comment matching a search
+type SearchHit struct {
+	Type      string
+	BoardID   uuid.UUID
+	CommentID *uuid.UUID // Only for comments
+	ProjectID uuid.UUID
+	BoardKey  string
+	Title     string // Board title with highlight markers
+	Snippet   string // Description and text fields of a board, or the comment, with highlight markers
+	Score     float64
+}
+
+// SearchRepository는 보드(제목, 설명, 텍스트 커스텀 필드)와 댓글의 전문 검색을 담당합니다
+// 검색 인덱스(boards.search_vector/search_text, comments.search_vector)는 DB 트리거가 쓰기와 같은 트랜잭션에서 갱신합니다
+type SearchRepository interface {
+	// Search returns the hits in the live projects the user is a member of, best match first
+	Search(userID uuid.UUID, query string, filters SearchFilters, page, limit int) ([]SearchHit, int64, error)
+}
+
+type searchRepository struct {
+	db *gorm.DB
+}
+
+// NewSearchRepository는 새로운 SearchRepository를 생성합니다
+func NewSearchRepository(db *gorm.DB) SearchRepository {
+	return &searchRepository{db: db}
+}
+
+// SearchTerms returns the words of a query: runs of letters and digits, at most maxSearchTerms
+func SearchTerms(query string) []string {
+	terms := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(terms) > maxSearchTerms {
+		terms = terms[:maxSearchTerms]
+	}
+	return terms
+}
+
+// searchTSQuery matches every term of the query, as a word or as the start of one
+// Terms hold only letters and digits, so they need no escaping inside the quotes
+func searchTSQuery(terms []string) string {
+	lexemes := make([]string, 0, len(terms))
+	for _, term := range terms {
+		lexemes = append(lexemes, "'"+term+"':*")
+	}
+	return strings.Join(lexemes, " & ")
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+// searchHitsSQL selects the matching boards and comments with their scores
+// A row matches by words (tsvector), by a part of a word (ILIKE) or by similar words (pg_trgm <%)
+// scope is used by both branches, so it must be inlined explicitly; a materialized scope
+// would hide the search indexes of boards from the match conditions
+const searchHitsSQL = `
+WITH q AS (SELECT to_tsquery('simple', @tsquery) AS query),
+scope AS NOT MATERIALIZED (
+	SELECT b.* FROM boards b
+	WHERE b.is_deleted = false
+	  AND b.project_id IN (
+		SELECT pm.project_id FROM project_members pm
+		JOIN projects p ON p.id = pm.project_id
+		WHERE pm.user_id = @user AND pm.is_deleted = false AND p.is_deleted = false
+	  )
+	  AND (CAST(@project AS uuid) IS NULL OR b.project_id = CAST(@project AS uuid))
+	  AND (CAST(@assignee AS uuid) IS NULL OR b.assignee_id = CAST(@assignee AS uuid))
+	  AND (CAST(@stage AS uuid) IS NULL OR EXISTS (
+		SELECT 1 FROM board_field_values v
+		WHERE v.board_id = b.id AND v.field_id = CAST(@stage_field AS uuid) AND v.value_option_id = CAST(@stage AS uuid) AND v.is_deleted = false
+	  ))
+),
+hits AS (
+	SELECT 'board' AS type, b.id AS board_id, NULL::uuid AS comment_id,
+		ts_rank(b.search_vector, q.query) + word_similarity(@text, b.search_text) AS score
+	FROM scope b, q
+	WHERE @type IN ('', 'board')
+	  AND (b.search_vector @@ q.query OR b.search_text ILIKE @pattern OR @text <% b.search_text)
+	UNION ALL
+	SELECT 'comment', c.board_id, c.id,
+		ts_rank(c.search_vector, q.query) + word_similarity(@text, c.content)
+	FROM comments c JOIN scope b ON b.id = c.board_id, q
+	WHERE @type IN ('', 'comment') AND c.is_deleted = false
+	  AND (c.search_vector @@ q.query OR c.content ILIKE @pattern OR @text <% c.content)
+)`
+
+// searchPageSQL highlights the hits of one page only, since ts_headline reads the whole text
+// The window count runs before LIMIT, so every row carries the total number of hits
+const searchPageSQL = searchHitsSQL + `,
+page AS (
+	SELECT *, count(*) OVER () AS total FROM hits ORDER BY score DESC, board_id, comment_id NULLS FIRST LIMIT @limit OFFSET @offset
+)
+SELECT page.type, page.board_id, page.comment_id, page.score, page.total, b.project_id, b.key AS board_key,
+	ts_headline('simple', b.title, q.query, @title_options) AS title,
+	CASE WHEN page.type = 'board'
+		THEN ts_headline('simple', concat_ws(' ', b.description, board_search_fields(b.id)), q.query, @snippet_options)
+		ELSE ts_headline('simple', c.content, q.query, @snippet_options)
+	END AS snippet
+FROM page
+JOIN boards b ON b.id = page.board_id
+LEFT JOIN comments c ON c.id = page.comment_id
+CROSS JOIN q
+ORDER BY page.score DESC, page.board_id, page.comment_id NULLS FIRST`
+
+func (r *searchRepository) Search(userID uuid.UUID, query string, filters SearchFilters, page, limit int) ([]SearchHit, int64, error) {
+	text := strings.TrimSpace(query)
+	highlight := "StartSel=" + HighlightStart + ", StopSel=" + HighlightStop
+	params := map[string]interface{}{
+		"tsquery":         searchTSQuery(SearchTerms(text)),
+		"text":            text,
+		"pattern":         "%" + escapeLike(text) + "%",
+		"user":            userID,
+		"project":         nullableUUID(filters.ProjectID),
+		"assignee":        nullableUUID(filters.AssigneeID),
+		"stage":           nullableUUID(filters.StageOptionID),
+		"stage_field":     nullableUUID(filters.StageFieldID),
+		"type":            filters.Type,
+		"limit":           limit,
+		"offset":          (page - 1) * limit,
+		"title_options":   highlight + ", HighlightAll=true",
+		"snippet_options": highlight + ", MaxWords=35, MinWords=15, ShortWord=1, MaxFragments=2",
+	}
+
+	var rows []searchRow
+	if err := r.db.Raw(searchPageSQL, params).Scan(&rows).Error; err != nil {
+		return nil, 0, err
+	}
+
+	hits := make([]SearchHit, len(rows))
+	for i, row := range rows {
+		hits[i] = row.SearchHit
+	}
+	if len(rows) > 0 {
+		return hits, rows[0].Total, nil
+	}
+	if page == 1 {
+		return hits, 0, nil
+	}
+
+	// A page past the last hit has no row to carry the total
+	var total int64
+	if err := r.db.Raw(searchHitsSQL+" SELECT COUNT(*) FROM hits", params).Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	return hits, total, nil
+}
+
+// searchRow is a hit of searchPageSQL with the total number of hits
+type searchRow struct {
+	SearchHit
+	Total int64
+}
+
+// nullableUUID returns nil for uuid.Nil, so that an unset filter is NULL in SQL
+func nullableUUID(id uuid.UUID) interface{} {
+	if id == uuid.Nil {
+		return nil
+	}
+	return id
+}
@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SprintRepository는 프로젝트의 스프린트와 스프린트 범위(보드 배정 이력)를 관리합니다
+// 보드의 현재 스프린트는 boards.sprint_id이며, sprint_boards는 리포트를 위한 추가/제외 이력입니다
+type SprintRepository interface {
+	Create(sprint *domain.Sprint) error
+	FindByID(id uuid.UUID) (*domain.Sprint, error)
+	FindByProject(projectID uuid.UUID, state *domain.SprintState) ([]domain.Sprint, error)
+	FindActive(projectID uuid.UUID) (*domain.Sprint, error)
+	Update(sprint *domain.Sprint) error
+	Delete(id uuid.UUID) error
+
+	// Boards of a sprint
+	FindBoardIDs(sprintID uuid.UUID) ([]uuid.UUID, error)
+	CountBoards(sprintIDs []uuid.UUID) (map[uuid.UUID]int, error)
+	AssignBoards(boardIDs []uuid.UUID, sprintID *uuid.UUID) error
+	ClearBoards(sprintID uuid.UUID) error
+	FindBoardIDsWithOption(boardIDs []uuid.UUID, fieldID uuid.UUID, optionIDs []uuid.UUID) (map[uuid.UUID]bool, error)
+	FindBoards(boardIDs []uuid.UUID) ([]domain.Board, error)
+
+	// Scope history
+	FindScope(sprintID uuid.UUID) ([]domain.SprintBoard, error)
+	FindScopeEntry(sprintID, boardID uuid.UUID) (*domain.SprintBoard, error)
+	SaveScopeEntry(entry *domain.SprintBoard) error
+	CommitScope(sprintID uuid.UUID) error
+	LeaveScope(sprintID, boardID uuid.UUID, now time.Time) error
+}
+
+type sprintRepository struct {
+	db *gorm.DB
+}
+
+// NewSprintRepository는 새로운 SprintRepository를 생성합니다
+func NewSprintRepository(db *gorm.DB) SprintRepository {
+	return &sprintRepository{db: db}
+}
+
+func (r *sprintRepository) Create(sprint *domain.Sprint) error {
+	return r.db.Create(sprint).Error
+}
+
+func (r *sprintRepository) FindByID(id uuid.UUID) (*domain.Sprint, error) {
+	var sprint domain.Sprint
+	if err := r.db.Where("id = ? AND is_deleted = ?", id, false).First(&sprint).Error; err != nil {
+		return nil, err
+	}
+	return &sprint, nil
+}
+
+// FindByProject returns the sprints of the project: the active sprint first, then planned and closed sprints by start date
+func (r *sprintRepository) FindByProject(projectID uuid.UUID, state *domain.SprintState) ([]domain.Sprint, error) {
+	query := r.db.Where("project_id = ? AND is_deleted = ?", projectID, false)
+	if state != nil {
+		query = query.Where("state = ?", *state)
+	}
+
+	var sprints []domain.Sprint
+	err := query.
+		Order("CASE state WHEN 'active' THEN 0 WHEN 'planned' THEN 1 ELSE 2 END").
+		Order("start_date IS NULL, start_date ASC, created_at ASC").
+		Find(&sprints).Error
+	return sprints, err
+}
+
+// FindActive returns the running sprint of the project (gorm.ErrRecordNotFound if none)
+func (r *sprintRepository) FindActive(projectID uuid.UUID) (*domain.Sprint, error) {
+	var sprint domain.Sprint
+	err := r.db.Where("project_id = ? AND state = ? AND is_deleted = ?", projectID, domain.SprintStateActive, false).
+		First(&sprint).Error
+	if err != nil {
+		return nil, err
+	}
+	return &sprint, nil
+}
+
+func (r *sprintRepository) Update(sprint *domain.Sprint) error {
+	return r.db.Save(sprint).Error
+}
+
+func (r *sprintRepository) Delete(id uuid.UUID) error {
+	// Soft delete
+	return r.db.Model(&domain.Sprint{}).Where("id = ?", id).Update("is_deleted", true).Error
+}
+
+// ActiveSprintIDs selects the running sprint of the project
+// The "current sprint" view filter uses it so that views follow the sprint as it changes
+func ActiveSprintIDs(db *gorm.DB, projectID uuid.UUID) *gorm.DB {
+	return db.Model(&domain.Sprint{}).Select("id").
+		Where("project_id = ? AND state = ? AND is_deleted = ?", projectID, domain.SprintStateActive, false)
+}
+
+// ==================== Boards of a sprint ====================
+
+// FindBoardIDs returns the boards that are not deleted and currently planned in the sprint
+func (r *sprintRepository) FindBoardIDs(sprintID uuid.UUID) ([]uuid.UUID, error) {
+	var boardIDs []uuid.UUID
+	err := r.db.Model(&domain.Board{}).
+		Where("sprint_id = ? AND is_deleted = ?", sprintID, false).
+		Order("created_at ASC").
+		Pluck("id", &boardIDs).Error
+	return boardIDs, err
+}
+
+// CountBoards returns the number of boards that are not deleted in each sprint
+// Sprints without boards are not in the map
+func (r *sprintRepository) CountBoards(sprintIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	result := make(map[uuid.UUID]int)
+	if len(sprintIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		SprintID uuid.UUID
+		Total    int
+	}
+	err := r.db.Model(&domain.Board{}).
+		Select("sprint_id, COUNT(*) AS total").
+		Where("sprint_id IN ? AND is_deleted = ?", sprintIDs, false).
+		Group("sprint_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		result[row.SprintID] = row.Total
+	}
+	return result, nil
+}
+
+// AssignBoards plans the boards in the sprint, or moves them back to the backlog (nil)
+func (r *sprintRepository) AssignBoards(boardIDs []uuid.UUID, sprintID *uuid.UUID) error {
+	if len(boardIDs) == 0 {
+		return nil
+	}
+	return r.db.Model(&domain.Board{}).
+		Where("id IN ?", boardIDs).
+		Updates(map[string]interface{}{"sprint_id": sprintID, "updated_at": time.Now()}).Error
+}
+
+// ClearBoards moves every board of the sprint, deleted ones included, back to the backlog
+func (r *sprintRepository) ClearBoards(sprintID uuid.UUID) error {
+	return r.db.Model(&domain.Board{}).
+		Where("sprint_id = ?", sprintID).
+		Updates(map[string]interface{}{"sprint_id": nil, "updated_at": time.Now()}).Error
+}
+
+// FindBoardIDsWithOption returns the boards whose value of the single select field is one of the options
+func (r *sprintRepository) FindBoardIDsWithOption(boardIDs []uuid.UUID, fieldID uuid.UUID, optionIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	result := make(map[uuid.UUID]bool)
+	if len(boardIDs) == 0 || len(optionIDs) == 0 {
+		return result, nil
+	}
+
+	var matched []uuid.UUID
+	err := r.db.Model(&domain.BoardFieldValue{}).
+		Where("board_id IN ? AND field_id = ? AND value_option_id IN ? AND is_deleted = ?", boardIDs, fieldID, optionIDs, false).
+		Distinct().
+		Pluck("board_id", &matched).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, boardID := range matched {
+		result[boardID] = true
+	}
+	return result, nil
+}
+
+// FindBoards returns the boards that are not deleted among the given IDs
+func (r *sprintRepository) FindBoards(boardIDs []uuid.UUID) ([]domain.Board, error) {
+	var boards []domain.Board
+	if len(boardIDs) == 0 {
+		return boards, nil
+	}
+	err := r.db.Where("id IN ? AND is_deleted = ?", boardIDs, false).Find(&boards).Error
+	return boards, err
+}
+
+// ==================== Scope history ====================
+
+// FindScope returns every board that was ever planned in the sprint, in the order they joined
+func (r *sprintRepository) FindScope(sprintID uuid.UUID) ([]domain.SprintBoard, error) {
+	var entries []domain.SprintBoard
+	err := r.db.Where("sprint_id = ?", sprintID).
+		Order("added_at ASC").
+		Find(&entries).Error
+	return entries, err
+}
+
+// FindScopeEntry returns the history of the board in the sprint (gorm.ErrRecordNotFound if it never joined)
+func (r *sprintRepository) FindScopeEntry(sprintID, boardID uuid.UUID) (*domain.SprintBoard, error) {
+	var entry domain.SprintBoard
+	if err := r.db.Where("sprint_id = ? AND board_id = ?", sprintID, boardID).First(&entry).Error; err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// SaveScopeEntry creates or updates the history of a board in a sprint
+func (r *sprintRepository) SaveScopeEntry(entry *domain.SprintBoard) error {
+	return r.db.Save(entry).Error
+}
+
+// CommitScope marks the boards in the sprint as committed when it starts
+func (r *sprintRepository) CommitScope(sprintID uuid.UUID) error {
+	return r.db.Model(&domain.SprintBoard{}).
+		Where("sprint_id = ? AND removed_at IS NULL", sprintID).
+		Updates(map[string]interface{}{"committed": true, "updated_at": time.Now()}).Error
+}
+
+// LeaveScope records that the board left the sprint; nothing happens if it is not in the sprint
+// The scope of a closed sprint is final, so boards leaving a closed sprint are not recorded
+func (r *sprintRepository) LeaveScope(sprintID, boardID uuid.UUID, now time.Time) error {
+	closed := r.db.Model(&domain.Sprint{}).Select("id").Where("state = ?", domain.SprintStateClosed)
+	return r.db.Model(&domain.SprintBoard{}).
+		Where("sprint_id = ? AND board_id = ? AND removed_at IS NULL", sprintID, boardID).
+		Where("sprint_id NOT IN (?)", closed).
+		Updates(map[string]interface{}{"removed_at": now, "updated_at": now}).Error
+}
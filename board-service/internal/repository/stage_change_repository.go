@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// BoardCreation은 분석에서 보드가 집계에 포함되기 시작하는 시각입니다
+type BoardCreation struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+}
+
+// StageChangeRepository는 보드의 Stage 필드 변경 이력을 관리합니다
+// 이력은 보드 영구 삭제와 프로젝트 영구 삭제 시에만 지워지며, 삭제된 보드의 이력은 집계에서 제외됩니다
+type StageChangeRepository interface {
+	Create(change *domain.BoardStageChange) error
+
+	// Project analytics
+	FindByProject(projectID, fieldID uuid.UUID, until time.Time) ([]domain.BoardStageChange, error)
+	FindBoardCreations(projectID uuid.UUID, until time.Time) ([]BoardCreation, error)
+}
+
+type stageChangeRepository struct {
+	db *gorm.DB
+}
+
+// NewStageChangeRepository는 새로운 StageChangeRepository를 생성합니다
+func NewStageChangeRepository(db *gorm.DB) StageChangeRepository {
+	return &stageChangeRepository{db: db}
+}
+
+func (r *stageChangeRepository) Create(change *domain.BoardStageChange) error {
+	return r.db.Create(change).Error
+}
+
+// FindByProject returns the changes of the field made before until on boards of the project that are not deleted, oldest first
+// Boards moved in from another project only have history from the move on, since their old changes belong to another Stage field
+func (r *stageChangeRepository) FindByProject(projectID, fieldID uuid.UUID, until time.Time) ([]domain.BoardStageChange, error) {
+	boards := r.db.Model(&domain.Board{}).Select("id").Where("project_id = ? AND is_deleted = ?", projectID, false)
+
+	var changes []domain.BoardStageChange
+	err := r.db.Where("board_id IN (?) AND field_id = ? AND changed_at < ?", boards, fieldID, until).
+		Order("changed_at ASC, created_at ASC").
+		Find(&changes).Error
+	return changes, err
+}
+
+// FindBoardCreations returns the boards of the project that are not deleted and were created before until, oldest first
+func (r *stageChangeRepository) FindBoardCreations(projectID uuid.UUID, until time.Time) ([]BoardCreation, error) {
+	var creations []BoardCreation
+	err := r.db.Model(&domain.Board{}).
+		Select("id, created_at").
+		Where("project_id = ? AND is_deleted = ? AND created_at < ?", projectID, false, until).
+		Order("created_at ASC").
+		Scan(&creations).Error
+	return creations, err
+}
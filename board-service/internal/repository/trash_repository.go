@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// TrashRepository는 휴지통 항목과 삭제된 보드/댓글의 복원, 영구 삭제를 관리합니다
+// 프로젝트의 복원/영구 삭제는 ProjectCascadeRepository가 담당합니다
+type TrashRepository interface {
+	// Trash Item
+	Create(item *domain.TrashItem) error
+	FindByID(id uuid.UUID) (*domain.TrashItem, error)
+	FindByItem(itemType domain.TrashItemType, itemID uuid.UUID) (*domain.TrashItem, error)
+	FindByProject(projectID uuid.UUID, itemType domain.TrashItemType, page, limit int) ([]domain.TrashItem, int64, error)
+	FindProjectsByOwner(ownerID uuid.UUID, page, limit int) ([]domain.TrashItem, int64, error)
+	FindDeletedBefore(before time.Time, limit int) ([]domain.TrashItem, error)
+	Delete(id uuid.UUID) error
+
+	// Deleted entities (FindByID of the entity repositories excludes them)
+	FindDeletedBoard(boardID uuid.UUID) (*domain.Board, error)
+	FindDeletedComment(commentID uuid.UUID) (*domain.Comment, error)
+	FindDeletedProject(projectID uuid.UUID) (*domain.Project, error)
+
+	// Restore는 항목과 함께 삭제된 row(updated_at >= deletedAt)만 되살립니다
+	// 보드보다 먼저 개별 삭제된 댓글이나 지워진 필드 값은 그대로 남습니다
+	RestoreBoard(boardID uuid.UUID, deletedAt time.Time) error
+	RestoreComment(commentID uuid.UUID, deletedAt time.Time) error
+
+	// Purge는 항목과 하위 데이터를 영구 삭제합니다
+	PurgeBoard(boardID uuid.UUID) error
+	PurgeComment(commentID uuid.UUID) error
+}
+
+type trashRepository struct {
+	db *gorm.DB
+}
+
+func NewTrashRepository(db *gorm.DB) TrashRepository {
+	return &trashRepository{db: db}
+}
+
+// ==================== Trash Item ====================
+
+func (r *trashRepository) Create(item *domain.TrashItem) error {
+	return r.db.Create(item).Error
+}
+
+func (r *trashRepository) FindByID(id uuid.UUID) (*domain.TrashItem, error) {
+	var item domain.TrashItem
+	if err := r.db.Where("id = ?", id).First(&item).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (r *trashRepository) FindByItem(itemType domain.TrashItemType, itemID uuid.UUID) (*domain.TrashItem, error) {
+	var item domain.TrashItem
+	if err := r.db.Where("item_type = ? AND item_id = ?", itemType, itemID).First(&item).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// FindByProject returns a page of the project's trashed boards and comments, newest first
+// An empty itemType returns both
+func (r *trashRepository) FindByProject(projectID uuid.UUID, itemType domain.TrashItemType, page, limit int) ([]domain.TrashItem, int64, error) {
+	var items []domain.TrashItem
+	var total int64
+
+	query := r.db.Model(&domain.TrashItem{}).Where("project_id = ? AND item_type <> ?", projectID, domain.TrashItemProject)
+	if itemType != "" {
+		query = query.Where("item_type = ?", itemType)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Order("deleted_at DESC, id DESC").Offset(offset).Limit(limit).Find(&items).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return items, total, nil
+}
+
+// FindProjectsByOwner returns a page of the trashed projects owned by the user, newest first
+func (r *trashRepository) FindProjectsByOwner(ownerID uuid.UUID, page, limit int) ([]domain.TrashItem, int64, error) {
+	var items []domain.TrashItem
+	var total int64
+
+	query := r.db.Model(&domain.TrashItem{}).
+		Joins("JOIN projects ON projects.id = trash_items.item_id").
+		Where("trash_items.item_type = ? AND projects.owner_id = ?", domain.TrashItemProject, ownerID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Order("trash_items.deleted_at DESC, trash_items.id DESC").Offset(offset).Limit(limit).Find(&items).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return items, total, nil
+}
+
+func (r *trashRepository) FindDeletedBefore(before time.Time, limit int) ([]domain.TrashItem, error) {
+	var items []domain.TrashItem
+	if err := r.db.Where("deleted_at < ?", before).
+		Order("deleted_at ASC").
+		Limit(limit).
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *trashRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&domain.TrashItem{}, "id = ?", id).Error
+}
+
+// ==================== Deleted Entities ====================
+
+func (r *trashRepository) FindDeletedBoard(boardID uuid.UUID) (*domain.Board, error) {
+	var board domain.Board
+	if err := r.db.Where("id = ? AND is_deleted = ?", boardID, true).First(&board).Error; err != nil {
+		return nil, err
+	}
+	return &board, nil
+}
+
+func (r *trashRepository) FindDeletedComment(commentID uuid.UUID) (*domain.Comment, error) {
+	var comment domain.Comment
+	if err := r.db.Where("id = ? AND is_deleted = ?", commentID, true).First(&comment).Error; err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+func (r *trashRepository) FindDeletedProject(projectID uuid.UUID) (*domain.Project, error) {
+	var project domain.Project
+	if err := r.db.Where("id = ? AND is_deleted = ?", projectID, true).First(&project).Error; err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// ==================== Restore ====================
+
+func (r *trashRepository) RestoreBoard(boardID uuid.UUID, deletedAt time.Time) error {
+	if err := r.db.Model(&domain.Comment{}).
+		Where("board_id = ? AND is_deleted = ? AND updated_at >= ?", boardID, true, deletedAt).
+		Update("is_deleted", false).Error; err != nil {
+		return err
+	}
+	if err := r.db.Model(&domain.BoardFieldValue{}).
+		Where("board_id = ? AND is_deleted = ? AND updated_at >= ?", boardID, true, deletedAt).
+		Update("is_deleted", false).Error; err != nil {
+		return err
+	}
+	return r.db.Model(&domain.Board{}).Where("id = ?", boardID).Update("is_deleted", false).Error
+}
+
+// RestoreComment restores the comment and the replies deleted together with it
+func (r *trashRepository) RestoreComment(commentID uuid.UUID, deletedAt time.Time) error {
+	ids, err := findCommentThreadIDs(r.db, commentID)
+	if err != nil {
+		return err
+	}
+	if err := r.db.Model(&domain.Comment{}).
+		Where("id IN ? AND id <> ? AND is_deleted = ? AND updated_at >= ?", ids, commentID, true, deletedAt).
+		Update("is_deleted", false).Error; err != nil {
+		return err
+	}
+	return r.db.Model(&domain.Comment{}).Where("id = ?", commentID).Update("is_deleted", false).Error
+}
+
+// ==================== Purge ====================
+
+func (r *trashRepository) PurgeBoard(boardID uuid.UUID) error {
+	comments := r.db.Model(&domain.Comment{}).Select("id").Where("board_id = ?", boardID)
+
+	// Trash items of comments deleted before the board are purged with it
+	if err := r.db.Where("item_type = ? AND item_id IN (?)", domain.TrashItemComment, comments).
+		Delete(&domain.TrashItem{}).Error; err != nil {
+		return err
+	}
+	if err := r.db.Where("board_id = ?", boardID).Delete(&domain.BoardActivity{}).Error; err != nil {
+		return err
+	}
+	if err := r.db.Where("board_id = ?", boardID).Delete(&domain.Notification{}).Error; err != nil {
+		return err
+	}
+	if err := r.db.Where("board_id = ?", boardID).Delete(&domain.BoardWatcher{}).Error; err != nil {
+		return err
+	}
+	if err := r.db.Where("board_id = ?", boardID).Delete(&domain.BoardKeyAlias{}).Error; err != nil {
+		return err
+	}
+	if err := r.db.Where("source_board_id = ? OR target_board_id = ?", boardID, boardID).Delete(&domain.BoardRelation{}).Error; err != nil {
+		return err
+	}
+	if err := r.db.Where("board_id = ?", boardID).Delete(&domain.ChecklistItem{}).Error; err != nil {
+		return err
+	}
+	if err := r.db.Where("board_id = ?", boardID).Delete(&domain.Attachment{}).Error; err != nil {
+		return err
+	}
+	if err := r.db.Where("board_id = ?", boardID).Delete(&domain.SprintBoard{}).Error; err != nil {
+		return err
+	}
+	if err := r.db.Where("board_id = ?", boardID).Delete(&domain.WorkLog{}).Error; err != nil {
+		return err
+	}
+	if err := r.db.Where("board_id = ?", boardID).Delete(&domain.BoardStageChange{}).Error; err != nil {
+		return err
+	}
+	// Sub-tasks outlive their parent and become top-level boards
+	if err := r.db.Model(&domain.Board{}).Where("parent_board_id = ?", boardID).
+		Update("parent_board_id", nil).Error; err != nil {
+		return err
+	}
+	if err := r.db.Where("comment_id IN (?)", comments).Delete(&domain.CommentReaction{}).Error; err != nil {
+		return err
+	}
+	if err := r.db.Where("board_id = ?", boardID).Delete(&domain.Comment{}).Error; err != nil {
+		return err
+	}
+	if err := r.db.Where("board_id = ?", boardID).Delete(&domain.BoardFieldValue{}).Error; err != nil {
+		return err
+	}
+	if err := r.db.Where("board_id = ?", boardID).Delete(&domain.UserBoardOrder{}).Error; err != nil {
+		return err
+	}
+	return r.db.Where("id = ?", boardID).Delete(&domain.Board{}).Error
+}
+
+// PurgeComment deletes the comment with all of its replies, their reactions, notifications and attachments
+func (r *trashRepository) PurgeComment(commentID uuid.UUID) error {
+	ids, err := findCommentThreadIDs(r.db, commentID)
+	if err != nil {
+		return err
+	}
+
+	// Replies deleted on their own before the comment have trash items of their own
+	if err := r.db.Where("item_type = ? AND item_id IN ?", domain.TrashItemComment, ids).
+		Delete(&domain.TrashItem{}).Error; err != nil {
+		return err
+	}
+	if err := r.db.Where("comment_id IN ?", ids).Delete(&domain.CommentReaction{}).Error; err != nil {
+		return err
+	}
+	if err := r.db.Where("comment_id IN ?", ids).Delete(&domain.Notification{}).Error; err != nil {
+		return err
+	}
+	if err := r.db.Where("comment_id IN ?", ids).Delete(&domain.Attachment{}).Error; err != nil {
+		return err
+	}
+	return r.db.Where("id IN ?", ids).Delete(&domain.Comment{}).Error
+}
@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// WebhookRepository는 Webhook 구독, 전송(delivery) 및 전송 시도 기록을 관리합니다
+type WebhookRepository interface {
+	// Webhook subscriptions
+	CreateWebhook(webhook *domain.Webhook) error
+	FindWebhookByID(webhookID uuid.UUID) (*domain.Webhook, error)
+	FindWebhooksByProject(projectID uuid.UUID) ([]domain.Webhook, error)
+	FindActiveWebhooksByProject(projectID uuid.UUID) ([]domain.Webhook, error)
+	UpdateWebhook(webhook *domain.Webhook) error
+	DeleteWebhook(webhookID uuid.UUID) error
+
+	// Deliveries
+	CreateDelivery(delivery *domain.WebhookDelivery) error
+	CreateDeliveries(deliveries []domain.WebhookDelivery) error
+	FindDeliveryByID(deliveryID uuid.UUID) (*domain.WebhookDelivery, error)
+	FindDeliveriesByWebhook(webhookID uuid.UUID, page, limit int) ([]domain.WebhookDelivery, int64, error)
+	ClaimDueDeliveries(now time.Time, lease time.Duration, limit int) ([]domain.WebhookDelivery, error)
+	ReleaseDeliveries(ids []uuid.UUID, at time.Time) error
+	UpdateDelivery(delivery *domain.WebhookDelivery) error
+
+	// Delivery attempts
+	CreateAttempt(attempt *domain.WebhookDeliveryAttempt) error
+	FindAttemptsByDelivery(deliveryID uuid.UUID) ([]domain.WebhookDeliveryAttempt, error)
+}
+
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepository는 새로운 WebhookRepository를 생성합니다
+func NewWebhookRepository(db *gorm.DB) WebhookRepository {
+	return &webhookRepository{db: db}
+}
+
+// ==================== Webhook Subscriptions ====================
+
+func (r *webhookRepository) CreateWebhook(webhook *domain.Webhook) error {
+	return r.db.Create(webhook).Error
+}
+
+func (r *webhookRepository) FindWebhookByID(webhookID uuid.UUID) (*domain.Webhook, error) {
+	var webhook domain.Webhook
+	err := r.db.Where("id = ? AND is_deleted = ?", webhookID, false).First(&webhook).Error
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+func (r *webhookRepository) FindWebhooksByProject(projectID uuid.UUID) ([]domain.Webhook, error) {
+	var webhooks []domain.Webhook
+	err := r.db.Where("project_id = ? AND is_deleted = ?", projectID, false).
+		Order("created_at ASC").
+		Find(&webhooks).Error
+	return webhooks, err
+}
+
+func (r *webhookRepository) FindActiveWebhooksByProject(projectID uuid.UUID) ([]domain.Webhook, error) {
+	var webhooks []domain.Webhook
+	err := r.db.Where("project_id = ? AND is_active = ? AND is_deleted = ?", projectID, true, false).
+		Find(&webhooks).Error
+	return webhooks, err
+}
+
+func (r *webhookRepository) UpdateWebhook(webhook *domain.Webhook) error {
+	return r.db.Save(webhook).Error
+}
+
+func (r *webhookRepository) DeleteWebhook(webhookID uuid.UUID) error {
+	return r.db.Model(&domain.Webhook{}).
+		Where("id = ?", webhookID).
+		Updates(map[string]interface{}{"is_deleted": true, "is_active": false}).Error
+}
+
+// ==================== Deliveries ====================
+
+func (r *webhookRepository) CreateDelivery(delivery *domain.WebhookDelivery) error {
+	return r.db.Create(delivery).Error
+}
+
+// CreateDeliveries enqueues deliveries, skipping events already enqueued for the webhook
+// The relay publishes at least once, so the same event can arrive here more than once
+func (r *webhookRepository) CreateDeliveries(deliveries []domain.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{
+		Columns:     []clause.Column{{Name: "webhook_id"}, {Name: "event_id"}},
+		TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "redelivered_from IS NULL"}}},
+		DoNothing:   true,
+	}).Create(&deliveries).Error
+}
+
+func (r *webhookRepository) FindDeliveryByID(deliveryID uuid.UUID) (*domain.WebhookDelivery, error) {
+	var delivery domain.WebhookDelivery
+	err := r.db.Where("id = ? AND is_deleted = ?", deliveryID, false).First(&delivery).Error
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// FindDeliveriesByWebhook returns the webhook's deliveries, newest first
+func (r *webhookRepository) FindDeliveriesByWebhook(webhookID uuid.UUID, page, limit int) ([]domain.WebhookDelivery, int64, error) {
+	var deliveries []domain.WebhookDelivery
+	var total int64
+
+	query := r.db.Model(&domain.WebhookDelivery{}).Where("webhook_id = ? AND is_deleted = ?", webhookID, false)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&deliveries).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return deliveries, total, nil
+}
+
+// ClaimDueDeliveries locks pending deliveries whose next attempt is due and pushes
+// their next_attempt_at forward by lease, so that other replicas skip them while
+// this worker sends them. If the worker dies mid-send the lease expires and the
+// delivery is picked up again.
+func (r *webhookRepository) ClaimDueDeliveries(now time.Time, lease time.Duration, limit int) ([]domain.WebhookDelivery, error) {
+	var deliveries []domain.WebhookDelivery
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ? AND is_deleted = ?", domain.WebhookDeliveryPending, now, false).
+			Order("next_attempt_at ASC").
+			Limit(limit).
+			Find(&deliveries).Error; err != nil {
+			return err
+		}
+
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(deliveries))
+		for i, d := range deliveries {
+			ids[i] = d.ID
+		}
+
+		return tx.Model(&domain.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(lease)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// ReleaseDeliveries gives up the lease on claimed deliveries the worker did not attempt,
+// making them due again at `at`
+func (r *webhookRepository) ReleaseDeliveries(ids []uuid.UUID, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.Model(&domain.WebhookDelivery{}).
+		Where("id IN ? AND status = ?", ids, domain.WebhookDeliveryPending).
+		Update("next_attempt_at", at).Error
+}
+
+func (r *webhookRepository) UpdateDelivery(delivery *domain.WebhookDelivery) error {
+	return r.db.Save(delivery).Error
+}
+
+// ==================== Delivery Attempts ====================
+
+func (r *webhookRepository) CreateAttempt(attempt *domain.WebhookDeliveryAttempt) error {
+	return r.db.Create(attempt).Error
+}
+
+func (r *webhookRepository) FindAttemptsByDelivery(deliveryID uuid.UUID) ([]domain.WebhookDeliveryAttempt, error) {
+	var attempts []domain.WebhookDeliveryAttempt
+	err := r.db.Where("delivery_id = ? AND is_deleted = ?", deliveryID, false).
+		Order("attempt_number ASC").
+		Find(&attempts).Error
+	return attempts, err
+}
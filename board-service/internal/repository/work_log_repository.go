@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"board-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// WorkLogGroup은 작업 시간 합계를 나누는 기준입니다
+type WorkLogGroup string
+
+const (
+	WorkLogGroupUser     WorkLogGroup = "user"     // 작업 시간을 기록한 사용자별
+	WorkLogGroupAssignee WorkLogGroup = "assignee" // 보드 담당자별 (담당자 없는 보드는 nil)
+	WorkLogGroupDate     WorkLogGroup = "date"     // 작업일별
+)
+
+// WorkLogFilter는 프로젝트의 작업 시간 조회/집계 조건입니다 (nil이면 제한하지 않음)
+type WorkLogFilter struct {
+	From       *time.Time // 작업일 시작 (포함)
+	To         *time.Time // 작업일 끝 (포함)
+	UserID     *uuid.UUID // 작업 시간을 기록한 사용자
+	AssigneeID *uuid.UUID // 보드 담당자
+}
+
+// WorkLogSum은 한 그룹의 작업 시간 합계입니다 (그룹 기준 컬럼만 채워짐)
+type WorkLogSum struct {
+	UserID     *uuid.UUID
+	AssigneeID *uuid.UUID
+	WorkDate   *time.Time
+	Minutes    int
+}
+
+// EstimateSum은 담당자별 보드 예상 시간 합계입니다
+type EstimateSum struct {
+	AssigneeID       *uuid.UUID
+	BoardCount       int
+	OriginalMinutes  int
+	RemainingMinutes int
+}
+
+// WorkLogRepository는 보드의 작업 시간 기록과 시간 추적 집계를 관리합니다
+// 기록은 보드와 함께 휴지통으로 이동하며 (삭제된 보드의 기록은 집계에서 제외), 영구 삭제 시에만 지워집니다
+type WorkLogRepository interface {
+	Create(workLog *domain.WorkLog) error
+	FindByID(id uuid.UUID) (*domain.WorkLog, error)
+	FindByBoard(boardID uuid.UUID) ([]domain.WorkLog, error)
+	Update(workLog *domain.WorkLog) error
+	Delete(id uuid.UUID) error
+
+	// Board responses
+	SumByBoards(boardIDs []uuid.UUID) (map[uuid.UUID]int, error)
+
+	// Project reports
+	FindByProject(projectID uuid.UUID, filter WorkLogFilter, page, limit int) ([]domain.WorkLog, int64, error)
+	SumByProject(projectID uuid.UUID, filter WorkLogFilter, group WorkLogGroup) ([]WorkLogSum, error)
+	SumEstimatesByAssignee(projectID uuid.UUID, assigneeID *uuid.UUID) ([]EstimateSum, error)
+}
+
+type workLogRepository struct {
+	db *gorm.DB
+}
+
+// NewWorkLogRepository는 새로운 WorkLogRepository를 생성합니다
+func NewWorkLogRepository(db *gorm.DB) WorkLogRepository {
+	return &workLogRepository{db: db}
+}
+
+func (r *workLogRepository) Create(workLog *domain.WorkLog) error {
+	return r.db.Create(workLog).Error
+}
+
+func (r *workLogRepository) FindByID(id uuid.UUID) (*domain.WorkLog, error) {
+	var workLog domain.WorkLog
+	if err := r.db.Where("id = ?", id).First(&workLog).Error; err != nil {
+		return nil, err
+	}
+	return &workLog, nil
+}
+
+// FindByBoard returns the work logs of the board, latest work date first
+func (r *workLogRepository) FindByBoard(boardID uuid.UUID) ([]domain.WorkLog, error) {
+	var workLogs []domain.WorkLog
+	err := r.db.Where("board_id = ?", boardID).
+		Order("work_date DESC, created_at DESC").
+		Find(&workLogs).Error
+	return workLogs, err
+}
+
+func (r *workLogRepository) Update(workLog *domain.WorkLog) error {
+	return r.db.Save(workLog).Error
+}
+
+// Delete removes the work log; work logs are not soft deleted
+func (r *workLogRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&domain.WorkLog{}).Error
+}
+
+// SumByBoards returns the logged minutes of each board
+// Boards without work logs are not in the map
+func (r *workLogRepository) SumByBoards(boardIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	result := make(map[uuid.UUID]int)
+	if len(boardIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		BoardID uuid.UUID
+		Minutes int
+	}
+	err := r.db.Model(&domain.WorkLog{}).
+		Select("board_id, SUM(duration_minutes) AS minutes").
+		Where("board_id IN ?", boardIDs).
+		Group("board_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		result[row.BoardID] = row.Minutes
+	}
+	return result, nil
+}
+
+// FindByProject returns the work logs of the project's boards that are not deleted, latest work date first
+func (r *workLogRepository) FindByProject(projectID uuid.UUID, filter WorkLogFilter, page, limit int) ([]domain.WorkLog, int64, error) {
+	query := r.projectQuery(projectID, filter)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var workLogs []domain.WorkLog
+	offset := (page - 1) * limit
+	err := query.Select("work_logs.*").
+		Order("work_logs.work_date DESC, work_logs.created_at DESC").
+		Offset(offset).Limit(limit).
+		Find(&workLogs).Error
+	return workLogs, total, err
+}
+
+// SumByProject returns the logged minutes of the project per user, board assignee or work date
+// Groups are ordered by their key (dates oldest first)
+func (r *workLogRepository) SumByProject(projectID uuid.UUID, filter WorkLogFilter, group WorkLogGroup) ([]WorkLogSum, error) {
+	var column, alias string
+	switch group {
+	case WorkLogGroupUser:
+		column, alias = "work_logs.user_id", "user_id"
+	case WorkLogGroupAssignee:
+		column, alias = "boards.assignee_id", "assignee_id"
+	default:
+		column, alias = "work_logs.work_date", "work_date"
+	}
+
+	var sums []WorkLogSum
+	err := r.projectQuery(projectID, filter).
+		Select(column + " AS " + alias + ", SUM(work_logs.duration_minutes) AS minutes").
+		Group(column).
+		Order(column).
+		Scan(&sums).Error
+	return sums, err
+}
+
+// SumEstimatesByAssignee returns the estimates of the project's boards that are not deleted per assignee
+// Boards without any estimate are not counted
+func (r *workLogRepository) SumEstimatesByAssignee(projectID uuid.UUID, assigneeID *uuid.UUID) ([]EstimateSum, error) {
+	query := r.db.Model(&domain.Board{}).
+		Select("assignee_id, COUNT(*) AS board_count, "+
+			"COALESCE(SUM(original_estimate_minutes), 0) AS original_minutes, "+
+			"COALESCE(SUM(remaining_estimate_minutes), 0) AS remaining_minutes").
+		Where("project_id = ? AND is_deleted = ?", projectID, false).
+		Where("original_estimate_minutes IS NOT NULL OR remaining_estimate_minutes IS NOT NULL")
+	if assigneeID != nil {
+		query = query.Where("assignee_id = ?", *assigneeID)
+	}
+
+	var sums []EstimateSum
+	err := query.Group("assignee_id").Order("assignee_id").Scan(&sums).Error
+	return sums, err
+}
+
+// projectQuery selects the work logs of the project's boards that are not deleted
+func (r *workLogRepository) projectQuery(projectID uuid.UUID, filter WorkLogFilter) *gorm.DB {
+	query := r.db.Model(&domain.WorkLog{}).
+		Joins("JOIN boards ON boards.id = work_logs.board_id").
+		Where("boards.project_id = ? AND boards.is_deleted = ?", projectID, false)
+
+	if filter.From != nil {
+		query = query.Where("work_logs.work_date >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("work_logs.work_date <= ?", *filter.To)
+	}
+	if filter.UserID != nil {
+		query = query.Where("work_logs.user_id = ?", *filter.UserID)
+	}
+	if filter.AssigneeID != nil {
+		query = query.Where("boards.assignee_id = ?", *filter.AssigneeID)
+	}
+	return query
+}
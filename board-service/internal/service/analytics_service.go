@@ -0,0 +1,398 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/cache"
+	"board-service/internal/common/auth"
+	"board-service/internal/common/parser"
+	"board-service/internal/common/validator"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/repository"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// analyticsCacheTTL bounds how stale a report can get through changes that do not invalidate it (board deletion, moves to another project)
+	analyticsCacheTTL = 10 * time.Minute
+
+	// analyticsDefaultDays is the range of a report without from and to
+	analyticsDefaultDays = 30
+
+	// analyticsMaxDays is the longest range of a report
+	analyticsMaxDays = 366
+)
+
+// AnalyticsService는 Stage 변경 이력으로 프로젝트의 누적 흐름, 번다운, 리드/사이클 타임을 계산합니다
+// 프로젝트 멤버라면 누구나 조회할 수 있으며, 결과는 Stage가 변경될 때까지 Redis에 캐시됩니다
+// 완료 옵션은 "완료" 라벨의 Stage 옵션이며, 없으면 마지막 Stage 옵션입니다
+type AnalyticsService interface {
+	GetCumulativeFlow(userID, projectID string, req *dto.AnalyticsRangeRequest) (*dto.CumulativeFlowResponse, error)
+	GetBurndown(userID, projectID string, req *dto.AnalyticsRangeRequest) (*dto.BurndownResponse, error)
+	GetCycleTime(userID, projectID string, req *dto.AnalyticsRangeRequest) (*dto.CycleTimeResponse, error)
+}
+
+type analyticsService struct {
+	repo       repository.StageChangeRepository
+	fieldRepo  repository.FieldRepository
+	authorizer auth.ProjectAuthorizer
+	cache      cache.FieldCache
+	logger     *zap.Logger
+}
+
+func NewAnalyticsService(
+	repo repository.StageChangeRepository,
+	fieldRepo repository.FieldRepository,
+	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
+	fieldCache cache.FieldCache,
+	logger *zap.Logger,
+) AnalyticsService {
+	return &analyticsService{
+		repo:       repo,
+		fieldRepo:  fieldRepo,
+		authorizer: auth.NewProjectAuthorizer(projectRepo, roleRepo),
+		cache:      fieldCache,
+		logger:     logger,
+	}
+}
+
+// analyticsRange is the requested day range; until is the end of the last day
+type analyticsRange struct {
+	from  time.Time
+	to    time.Time
+	until time.Time
+}
+
+// dayEnds returns the end (midnight of the next day) of every day of the range
+func (r analyticsRange) dayEnds() []time.Time {
+	var ends []time.Time
+	for day := r.from; !day.After(r.to); day = day.AddDate(0, 0, 1) {
+		ends = append(ends, day.AddDate(0, 0, 1))
+	}
+	return ends
+}
+
+// stageHistory is the Stage field of a project with the boards and the stage changes up to the end of the range
+type stageHistory struct {
+	field     *domain.ProjectField
+	options   []domain.FieldOption
+	creations []repository.BoardCreation
+	changes   []domain.BoardStageChange
+}
+
+// ==================== Reports ====================
+
+// GetCumulativeFlow counts the boards in each Stage option at the end of every day of the range
+func (s *analyticsService) GetCumulativeFlow(userID, projectID string, req *dto.AnalyticsRangeRequest) (*dto.CumulativeFlowResponse, error) {
+	projectUUID, span, err := s.prepare(userID, projectID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.CumulativeFlowResponse{}
+	if s.getCached(projectUUID, "cumulative-flow", span, response) {
+		return response, nil
+	}
+
+	history, err := s.loadHistory(projectUUID, span)
+	if err != nil {
+		return nil, err
+	}
+
+	response = &dto.CumulativeFlowResponse{
+		From:   span.from.Format(workDateLayout),
+		To:     span.to.Format(workDateLayout),
+		Stages: make([]dto.AnalyticsStage, 0, len(history.options)),
+	}
+	for _, option := range history.options {
+		response.Stages = append(response.Stages, dto.AnalyticsStage{OptionID: option.ID.String(), Label: option.Label, Color: option.Color})
+	}
+	history.replay(span, func(day time.Time, total int, counts map[uuid.UUID]int) {
+		flow := dto.CumulativeFlowDay{
+			Date:    day.Format(workDateLayout),
+			Counts:  make(map[string]int, len(history.options)),
+			NoStage: total,
+		}
+		for _, option := range history.options {
+			flow.Counts[option.ID.String()] = counts[option.ID]
+			flow.NoStage -= counts[option.ID]
+		}
+		response.Days = append(response.Days, flow)
+	})
+
+	s.setCached(projectUUID, "cumulative-flow", span, response)
+	return response, nil
+}
+
+// GetBurndown counts the open boards at the end of every day of the range
+func (s *analyticsService) GetBurndown(userID, projectID string, req *dto.AnalyticsRangeRequest) (*dto.BurndownResponse, error) {
+	projectUUID, span, err := s.prepare(userID, projectID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.BurndownResponse{}
+	if s.getCached(projectUUID, "burndown", span, response) {
+		return response, nil
+	}
+
+	history, err := s.loadHistory(projectUUID, span)
+	if err != nil {
+		return nil, err
+	}
+	_, completed, err := resolveStageOptions(history.options)
+	if err != nil {
+		return nil, err
+	}
+
+	response = &dto.BurndownResponse{
+		From:              span.from.Format(workDateLayout),
+		To:                span.to.Format(workDateLayout),
+		CompletedOptionID: completed.String(),
+	}
+	history.replay(span, func(day time.Time, total int, counts map[uuid.UUID]int) {
+		response.Days = append(response.Days, dto.BurndownDay{
+			Date:      day.Format(workDateLayout),
+			Total:     total,
+			Completed: counts[completed],
+			Open:      total - counts[completed],
+		})
+	})
+
+	s.setCached(projectUUID, "burndown", span, response)
+	return response, nil
+}
+
+// GetCycleTime summarizes the lead and cycle times of the boards completed in the range
+// A board counts as completed when it is still in the completed Stage option at the end of the range,
+// at the time of its last move there
+func (s *analyticsService) GetCycleTime(userID, projectID string, req *dto.AnalyticsRangeRequest) (*dto.CycleTimeResponse, error) {
+	projectUUID, span, err := s.prepare(userID, projectID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.CycleTimeResponse{}
+	if s.getCached(projectUUID, "cycle-time", span, response) {
+		return response, nil
+	}
+
+	history, err := s.loadHistory(projectUUID, span)
+	if err != nil {
+		return nil, err
+	}
+	inProgress, completed, err := resolveStageOptions(history.options)
+	if err != nil {
+		return nil, err
+	}
+
+	started := make(map[uuid.UUID]time.Time)
+	finished := make(map[uuid.UUID]time.Time)
+	for _, change := range history.changes {
+		if inProgress != nil && change.ToOptionID != nil && *change.ToOptionID == *inProgress {
+			if _, ok := started[change.BoardID]; !ok {
+				started[change.BoardID] = change.ChangedAt
+			}
+		}
+		if change.ToOptionID != nil && *change.ToOptionID == completed {
+			finished[change.BoardID] = change.ChangedAt
+		} else {
+			// Reopened
+			delete(finished, change.BoardID)
+		}
+	}
+
+	var leadTimes, cycleTimes []time.Duration
+	for _, creation := range history.creations {
+		doneAt, ok := finished[creation.ID]
+		if !ok || doneAt.Before(span.from) {
+			continue
+		}
+		leadTimes = append(leadTimes, doneAt.Sub(creation.CreatedAt))
+		if startedAt, ok := started[creation.ID]; ok {
+			cycleTimes = append(cycleTimes, doneAt.Sub(startedAt))
+		}
+	}
+
+	response = &dto.CycleTimeResponse{
+		From:              span.from.Format(workDateLayout),
+		To:                span.to.Format(workDateLayout),
+		CompletedOptionID: completed.String(),
+		LeadTime:          summarizeDurations(leadTimes),
+		CycleTime:         summarizeDurations(cycleTimes),
+	}
+	if inProgress != nil {
+		id := inProgress.String()
+		response.InProgressOptionID = &id
+	}
+
+	s.setCached(projectUUID, "cycle-time", span, response)
+	return response, nil
+}
+
+// ==================== Helper Methods ====================
+
+// prepare checks that the user is a member of the project and parses the day range of the report
+func (s *analyticsService) prepare(userID, projectID string, req *dto.AnalyticsRangeRequest) (uuid.UUID, analyticsRange, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return uuid.Nil, analyticsRange{}, err
+	}
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return uuid.Nil, analyticsRange{}, err
+	}
+	if _, err := s.authorizer.RequireMember(userUUID, projectUUID); err != nil {
+		return uuid.Nil, analyticsRange{}, err
+	}
+
+	span, err := parseAnalyticsRange(req.From, req.To, time.Now())
+	if err != nil {
+		return uuid.Nil, analyticsRange{}, err
+	}
+	return projectUUID, span, nil
+}
+
+// parseAnalyticsRange parses the day range (YYYY-MM-DD, inclusive)
+// A missing end is today and a missing start is 30 days before the end
+func parseAnalyticsRange(from, to string, now time.Time) (analyticsRange, error) {
+	span := analyticsRange{to: domain.TruncateToDay(now.UTC())}
+	if to != "" {
+		day, err := validator.ValidateDayFormat(to, "종료일")
+		if err != nil {
+			return span, err
+		}
+		span.to = *day
+	}
+	span.from = span.to.AddDate(0, 0, -(analyticsDefaultDays - 1))
+	if from != "" {
+		day, err := validator.ValidateDayFormat(from, "시작일")
+		if err != nil {
+			return span, err
+		}
+		span.from = *day
+	}
+
+	if span.from.After(span.to) {
+		return span, apperrors.New(apperrors.ErrCodeBadRequest, "시작일은 종료일보다 늦을 수 없습니다", 400)
+	}
+	if span.to.Sub(span.from) >= analyticsMaxDays*24*time.Hour {
+		return span, apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("조회 기간은 %d일을 넘을 수 없습니다", analyticsMaxDays), 400)
+	}
+	span.until = span.to.AddDate(0, 0, 1)
+	return span, nil
+}
+
+// loadHistory loads the Stage field of the project with its boards and stage changes up to the end of the range
+func (s *analyticsService) loadHistory(projectID uuid.UUID, span analyticsRange) (*stageHistory, error) {
+	field, err := findStageField(s.fieldRepo, projectID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	if field == nil {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "프로젝트에 Stage 필드가 없습니다", 400)
+	}
+
+	history := &stageHistory{field: field}
+	if history.options, err = s.fieldRepo.FindOptionsByField(field.ID); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 옵션 조회 실패", 500)
+	}
+	if history.creations, err = s.repo.FindBoardCreations(projectID, span.until); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	if history.changes, err = s.repo.FindByProject(projectID, field.ID, span.until); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Stage 변경 이력 조회 실패", 500)
+	}
+	return history, nil
+}
+
+// getCached reads a cached report into response; a miss or a broken entry is computed again
+func (s *analyticsService) getCached(projectID uuid.UUID, report string, span analyticsRange, response interface{}) bool {
+	cached, err := s.cache.GetProjectAnalytics(context.Background(), projectID.String(), analyticsCacheKey(report, span))
+	if err != nil || cached == nil {
+		return false
+	}
+	if err := json.Unmarshal(cached, response); err != nil {
+		s.logger.Warn("Failed to unmarshal cached project analytics", zap.Error(err), zap.String("report", report))
+		return false
+	}
+	return true
+}
+
+func (s *analyticsService) setCached(projectID uuid.UUID, report string, span analyticsRange, response interface{}) {
+	reportJSON, err := json.Marshal(response)
+	if err != nil {
+		s.logger.Warn("Failed to marshal project analytics", zap.Error(err), zap.String("report", report))
+		return
+	}
+	if err := s.cache.SetProjectAnalytics(context.Background(), projectID.String(), analyticsCacheKey(report, span), reportJSON, analyticsCacheTTL); err != nil {
+		s.logger.Warn("Failed to cache project analytics", zap.Error(err), zap.String("report", report))
+	}
+}
+
+// analyticsCacheKey identifies a report of a project by its kind and day range
+func analyticsCacheKey(report string, span analyticsRange) string {
+	return fmt.Sprintf("%s:%s:%s", report, span.from.Format(workDateLayout), span.to.Format(workDateLayout))
+}
+
+// replay walks through the board creations and stage changes and reports the number of boards
+// and the number of boards in each option at the end of every day of the range
+func (h *stageHistory) replay(span analyticsRange, report func(day time.Time, total int, counts map[uuid.UUID]int)) {
+	stages := make(map[uuid.UUID]uuid.UUID)
+	counts := make(map[uuid.UUID]int)
+	total, nextCreation, nextChange := 0, 0, 0
+
+	for _, end := range span.dayEnds() {
+		for nextCreation < len(h.creations) && h.creations[nextCreation].CreatedAt.Before(end) {
+			total++
+			nextCreation++
+		}
+		for nextChange < len(h.changes) && h.changes[nextChange].ChangedAt.Before(end) {
+			change := h.changes[nextChange]
+			if previous, ok := stages[change.BoardID]; ok {
+				counts[previous]--
+				delete(stages, change.BoardID)
+			}
+			if change.ToOptionID != nil {
+				stages[change.BoardID] = *change.ToOptionID
+				counts[*change.ToOptionID]++
+			}
+			nextChange++
+		}
+		report(end.AddDate(0, 0, -1), total, counts)
+	}
+}
+
+// summarizeDurations returns the nearest rank percentiles of the durations in hours
+func summarizeDurations(durations []time.Duration) dto.TimePercentiles {
+	summary := dto.TimePercentiles{Count: len(durations)}
+	if len(durations) == 0 {
+		return summary
+	}
+
+	sorted := make([]time.Duration, len(durations))
+	copy(sorted, durations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	percentile := func(p float64) *float64 {
+		rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+		if rank < 1 {
+			rank = 1
+		}
+		hours := math.Round(sorted[rank-1].Hours()*10) / 10
+		return &hours
+	}
+	summary.P50Hours = percentile(50)
+	summary.P85Hours = percentile(85)
+	summary.P95Hours = percentile(95)
+	return summary
+}
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/repository"
+	"board-service/internal/testutil"
+	"board-service/internal/uow"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ==================== Test Suite Setup ====================
+
+type AnalyticsServiceTestSuite struct {
+	db           *gorm.DB
+	fieldCache   *MockFieldCache
+	service      *analyticsService
+	projectID    uuid.UUID
+	memberID     uuid.UUID
+	stageFieldID uuid.UUID
+	waiting      uuid.UUID // 대기
+	inProgress   uuid.UUID // 진행중
+	done         uuid.UUID // 완료
+}
+
+func setupAnalyticsServiceTest(t *testing.T) *AnalyticsServiceTestSuite {
+	db := NewMockDB()
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+
+	projectRepo := new(testutil.MockProjectRepository)
+	roleRepo := new(testutil.MockRoleRepository)
+	fieldCache := new(MockFieldCache)
+	memberRole := testutil.NewMemberRole()
+	roleRepo.On("FindByID", memberRole.ID).Return(memberRole, nil).Maybe()
+
+	service := NewAnalyticsService(repository.NewStageChangeRepository(db), repository.NewFieldRepository(db),
+		projectRepo, roleRepo, fieldCache, zap.NewNop())
+
+	suite := &AnalyticsServiceTestSuite{
+		db:           db,
+		fieldCache:   fieldCache,
+		service:      service.(*analyticsService),
+		projectID:    uuid.New(),
+		memberID:     uuid.New(),
+		stageFieldID: uuid.New(),
+	}
+	projectRepo.On("FindMemberByUserAndProject", suite.memberID, suite.projectID).
+		Return(&domain.ProjectMember{ProjectID: suite.projectID, UserID: suite.memberID, RoleID: memberRole.ID}, nil).Maybe()
+	projectRepo.On("FindMemberByUserAndProject", mock.Anything, suite.projectID).Return(nil, gorm.ErrRecordNotFound).Maybe()
+
+	require.NoError(t, db.Exec("INSERT INTO project_fields (id, project_id, name, field_type, is_system_default) VALUES (?, ?, 'Stage', 'single_select', true)",
+		suite.stageFieldID, suite.projectID).Error)
+	options := []*uuid.UUID{&suite.waiting, &suite.inProgress, &suite.done}
+	for order, label := range []string{"대기", "진행중", "완료"} {
+		*options[order] = uuid.New()
+		require.NoError(t, db.Exec("INSERT INTO field_options (id, field_id, label, display_order) VALUES (?, ?, ?, ?)",
+			*options[order], suite.stageFieldID, label, order).Error)
+	}
+	return suite
+}
+
+func (s *AnalyticsServiceTestSuite) board(t *testing.T, createdAt string) uuid.UUID {
+	boardID := uuid.New()
+	require.NoError(t, s.db.Exec("INSERT INTO boards (id, project_id, created_at) VALUES (?, ?, ?)", boardID, s.projectID, at(t, createdAt)).Error)
+	return boardID
+}
+
+// move logs a stage change of the board; a nil option clears the stage
+func (s *AnalyticsServiceTestSuite) move(t *testing.T, boardID uuid.UUID, optionID *uuid.UUID, changedAt string) {
+	require.NoError(t, s.db.Create(&domain.BoardStageChange{
+		ProjectID: s.projectID, BoardID: boardID, FieldID: s.stageFieldID, ToOptionID: optionID, ChangedBy: s.memberID, ChangedAt: at(t, changedAt),
+	}).Error)
+}
+
+// expectCacheMiss computes the report and caches it
+func (s *AnalyticsServiceTestSuite) expectCacheMiss(reportKey string) {
+	s.fieldCache.On("GetProjectAnalytics", mock.Anything, s.projectID.String(), reportKey).Return(nil, nil).Once()
+	s.fieldCache.On("SetProjectAnalytics", mock.Anything, s.projectID.String(), reportKey, mock.Anything, analyticsCacheTTL).Return(nil).Once()
+}
+
+func at(t *testing.T, value string) time.Time {
+	parsed, err := time.Parse("2006-01-02 15:04", value)
+	require.NoError(t, err)
+	return parsed
+}
+
+// ==================== Stage History Tests ====================
+
+func TestAnalyticsService_StageChangesAreLogged(t *testing.T) {
+	suite := setupAnalyticsServiceTest(t)
+	boardID := suite.board(t, "2026-03-01 09:00")
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: suite.projectID}
+	stage := &domain.ProjectField{BaseModel: domain.BaseModel{ID: suite.stageFieldID}, ProjectID: suite.projectID,
+		Name: stageFieldName, FieldType: domain.FieldTypeSingleSelect, IsSystemDefault: true}
+
+	values := &fieldValueService{cache: suite.fieldCache, logger: zap.NewNop(), uow: uow.NewUnitOfWork(suite.db)}
+	setStage := func(optionID uuid.UUID) {
+		_, err := values.saveFieldValue(board, stage, suite.memberID, func(repo repository.FieldRepository) error {
+			return values.setSingleSelectValue(repo, boardID, suite.stageFieldID, optionID.String())
+		})
+		require.NoError(t, err)
+	}
+	suite.fieldCache.On("InvalidateProjectAnalytics", mock.Anything, suite.projectID.String()).Return(nil).Twice()
+
+	// When
+	setStage(suite.waiting)
+	setStage(suite.inProgress)
+	setStage(suite.inProgress)
+
+	// Then
+	var changes []domain.BoardStageChange
+	require.NoError(t, suite.db.Order("changed_at ASC").Find(&changes).Error)
+	require.Len(t, changes, 2, "setting the same option again is not a change")
+	assert.Nil(t, changes[0].FromOptionID)
+	assert.Equal(t, suite.waiting, *changes[0].ToOptionID)
+	assert.Equal(t, suite.waiting, *changes[1].FromOptionID)
+	assert.Equal(t, suite.inProgress, *changes[1].ToOptionID)
+	assert.Equal(t, suite.memberID, changes[1].ChangedBy)
+	suite.fieldCache.AssertExpectations(t)
+}
+
+// ==================== Report Tests ====================
+
+func TestAnalyticsService_CumulativeFlowAndBurndown(t *testing.T) {
+	suite := setupAnalyticsServiceTest(t)
+	first := suite.board(t, "2026-02-27 10:00")
+	suite.move(t, first, &suite.waiting, "2026-02-27 10:00")
+	suite.move(t, first, &suite.inProgress, "2026-03-01 09:00")
+	suite.move(t, first, &suite.done, "2026-03-02 15:00")
+	second := suite.board(t, "2026-03-01 12:00")
+	suite.move(t, second, &suite.waiting, "2026-03-01 12:00")
+	suite.move(t, second, &suite.inProgress, "2026-03-03 09:00")
+	suite.board(t, "2026-03-02 08:00") // No stage
+	suite.board(t, "2026-03-04 08:00") // After the range
+	deleted := suite.board(t, "2026-02-20 08:00")
+	require.NoError(t, suite.db.Exec("UPDATE boards SET is_deleted = true WHERE id = ?", deleted).Error)
+
+	req := &dto.AnalyticsRangeRequest{From: "2026-03-01", To: "2026-03-03"}
+	suite.expectCacheMiss("cumulative-flow:2026-03-01:2026-03-03")
+	suite.expectCacheMiss("burndown:2026-03-01:2026-03-03")
+
+	// When
+	flow, err := suite.service.GetCumulativeFlow(suite.memberID.String(), suite.projectID.String(), req)
+	require.NoError(t, err)
+	burndown, err := suite.service.GetBurndown(suite.memberID.String(), suite.projectID.String(), req)
+	require.NoError(t, err)
+
+	// Then
+	require.Len(t, flow.Stages, 3)
+	assert.Equal(t, "대기", flow.Stages[0].Label)
+	require.Len(t, flow.Days, 3)
+	counts := func(day dto.CumulativeFlowDay) []int {
+		return []int{day.Counts[suite.waiting.String()], day.Counts[suite.inProgress.String()], day.Counts[suite.done.String()], day.NoStage}
+	}
+	assert.Equal(t, "2026-03-01", flow.Days[0].Date)
+	assert.Equal(t, []int{1, 1, 0, 0}, counts(flow.Days[0]))
+	assert.Equal(t, []int{1, 0, 1, 1}, counts(flow.Days[1]))
+	assert.Equal(t, []int{0, 1, 1, 1}, counts(flow.Days[2]))
+
+	assert.Equal(t, suite.done.String(), burndown.CompletedOptionID)
+	assert.Equal(t, []dto.BurndownDay{
+		{Date: "2026-03-01", Total: 2, Completed: 0, Open: 2},
+		{Date: "2026-03-02", Total: 3, Completed: 1, Open: 2},
+		{Date: "2026-03-03", Total: 3, Completed: 1, Open: 2},
+	}, burndown.Days)
+	suite.fieldCache.AssertExpectations(t)
+}
+
+func TestAnalyticsService_CycleTimePercentiles(t *testing.T) {
+	suite := setupAnalyticsServiceTest(t)
+
+	quick := suite.board(t, "2026-03-01 00:00")
+	suite.move(t, quick, &suite.inProgress, "2026-03-02 00:00")
+	suite.move(t, quick, &suite.done, "2026-03-03 00:00")
+
+	reopened := suite.board(t, "2026-03-01 00:00")
+	suite.move(t, reopened, &suite.inProgress, "2026-03-01 12:00")
+	suite.move(t, reopened, &suite.done, "2026-03-02 00:00")
+	suite.move(t, reopened, &suite.inProgress, "2026-03-05 00:00")
+	suite.move(t, reopened, &suite.done, "2026-03-06 00:00")
+
+	skipped := suite.board(t, "2026-03-01 00:00")
+	suite.move(t, skipped, &suite.done, "2026-03-04 00:00")
+
+	stillOpen := suite.board(t, "2026-03-01 00:00")
+	suite.move(t, stillOpen, &suite.inProgress, "2026-03-02 00:00")
+	suite.move(t, stillOpen, &suite.done, "2026-03-10 00:00")
+	suite.move(t, stillOpen, &suite.inProgress, "2026-03-11 00:00")
+
+	earlier := suite.board(t, "2026-02-01 00:00")
+	suite.move(t, earlier, &suite.done, "2026-02-20 00:00")
+
+	suite.expectCacheMiss("cycle-time:2026-03-01:2026-03-31")
+
+	// When
+	report, err := suite.service.GetCycleTime(suite.memberID.String(), suite.projectID.String(),
+		&dto.AnalyticsRangeRequest{From: "2026-03-01", To: "2026-03-31"})
+
+	// Then
+	require.NoError(t, err)
+	require.NotNil(t, report.InProgressOptionID)
+	assert.Equal(t, suite.inProgress.String(), *report.InProgressOptionID)
+	hours := func(summary dto.TimePercentiles) []float64 {
+		return []float64{*summary.P50Hours, *summary.P85Hours, *summary.P95Hours}
+	}
+	assert.Equal(t, 3, report.LeadTime.Count, "boards completed before the range or reopened are left out")
+	assert.Equal(t, []float64{72, 120, 120}, hours(report.LeadTime))
+	assert.Equal(t, 2, report.CycleTime.Count, "boards that skipped 진행중 have no cycle time")
+	assert.Equal(t, []float64{24, 108, 108}, hours(report.CycleTime), "cycle time runs from the first move to 진행중")
+	suite.fieldCache.AssertExpectations(t)
+}
+
+func TestAnalyticsService_CachedReport(t *testing.T) {
+	suite := setupAnalyticsServiceTest(t)
+	cached := dto.BurndownResponse{From: "2026-03-01", To: "2026-03-01", CompletedOptionID: suite.done.String(),
+		Days: []dto.BurndownDay{{Date: "2026-03-01", Total: 7, Completed: 2, Open: 5}}}
+	cachedJSON, err := json.Marshal(cached)
+	require.NoError(t, err)
+	suite.fieldCache.On("GetProjectAnalytics", mock.Anything, suite.projectID.String(), "burndown:2026-03-01:2026-03-01").Return(cachedJSON, nil).Once()
+
+	// When
+	burndown, err := suite.service.GetBurndown(suite.memberID.String(), suite.projectID.String(),
+		&dto.AnalyticsRangeRequest{From: "2026-03-01", To: "2026-03-01"})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, cached, *burndown)
+	suite.fieldCache.AssertNotCalled(t, "SetProjectAnalytics", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAnalyticsService_RequiresMember(t *testing.T) {
+	suite := setupAnalyticsServiceTest(t)
+
+	// When
+	_, err := suite.service.GetCumulativeFlow(uuid.New().String(), suite.projectID.String(), &dto.AnalyticsRangeRequest{})
+
+	// Then
+	assert.Equal(t, 403, appErrorStatus(t, err))
+	suite.fieldCache.AssertNotCalled(t, "GetProjectAnalytics", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestParseAnalyticsRange(t *testing.T) {
+	now := time.Date(2026, 3, 31, 15, 0, 0, 0, time.UTC)
+
+	span, err := parseAnalyticsRange("", "", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), span.from, "the last 30 days by default")
+	assert.Equal(t, time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC), span.to)
+	assert.Equal(t, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), span.until)
+
+	_, err = parseAnalyticsRange("2025-03-31", "2026-03-31", now)
+	assert.NoError(t, err, "366 days")
+	_, err = parseAnalyticsRange("2025-03-30", "2026-03-31", now)
+	assert.Equal(t, 400, appErrorStatus(t, err), "more than 366 days")
+	_, err = parseAnalyticsRange("2026-03-05", "2026-03-01", now)
+	assert.Equal(t, 400, appErrorStatus(t, err), "from after to")
+	_, err = parseAnalyticsRange("03/01/2026", "", now)
+	assert.Equal(t, 400, appErrorStatus(t, err))
+}
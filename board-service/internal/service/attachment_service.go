@@ -0,0 +1,504 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/auth"
+	"board-service/internal/common/parser"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"board-service/internal/storage"
+	"board-service/internal/uow"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// AttachmentPolicy limits what can be uploaded and how long download links stay valid
+type AttachmentPolicy struct {
+	MaxSize      int64    // Bytes
+	AllowedTypes []string // MIME types detected from the content; "image/*" allows a whole family
+	URLExpiry    time.Duration
+	Signer       *storage.URLSigner
+}
+
+// Allows reports whether a detected content type may be uploaded
+func (p AttachmentPolicy) Allows(contentType string) bool {
+	for _, allowed := range p.AllowedTypes {
+		if allowed == contentType {
+			return true
+		}
+		if family, ok := strings.CutSuffix(allowed, "/*"); ok && strings.HasPrefix(contentType, family+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// AttachmentService는 보드와 댓글의 첨부 파일을 관리합니다
+// 파일 내용은 SHA-256 체크섬 단위로 저장소에 한 번만 저장되며 (중복 제거),
+// 다운로드는 만료 시간이 있는 서명된 링크로만 가능합니다
+type AttachmentService interface {
+	UploadAttachment(userID, boardID string, req *dto.UploadAttachmentRequest, fileName string, content io.Reader) (*dto.AttachmentResponse, error)
+	GetAttachments(userID, boardID string, req *dto.GetAttachmentsRequest) ([]dto.AttachmentResponse, error)
+	GetDownloadURL(userID, attachmentID string) (*dto.AttachmentDownloadResponse, error)
+	// OpenDownload checks a signed link and returns the file; the caller closes the reader
+	OpenDownload(attachmentID string, req *dto.DownloadAttachmentRequest) (*dto.AttachmentResponse, io.ReadCloser, error)
+	DeleteAttachment(userID, attachmentID string) error
+}
+
+type attachmentService struct {
+	repo        repository.AttachmentRepository
+	boardRepo   repository.BoardRepository
+	commentRepo repository.CommentRepository
+	store       storage.Storage
+	blobs       *attachmentBlobs
+	policy      AttachmentPolicy
+	activities  *boardActivityRecorder
+	authorizer  auth.ProjectAuthorizer
+	logger      *zap.Logger
+	uow         uow.UnitOfWork
+	now         func() time.Time
+}
+
+func NewAttachmentService(
+	repo repository.AttachmentRepository,
+	boardRepo repository.BoardRepository,
+	commentRepo repository.CommentRepository,
+	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
+	activityRepo repository.BoardActivityRepository,
+	store storage.Storage,
+	policy AttachmentPolicy,
+	logger *zap.Logger,
+	db *gorm.DB,
+) AttachmentService {
+	return &attachmentService{
+		repo:        repo,
+		boardRepo:   boardRepo,
+		commentRepo: commentRepo,
+		store:       store,
+		blobs:       newAttachmentBlobs(repo, store, logger),
+		policy:      policy,
+		activities:  newBoardActivityRecorder(activityRepo, logger),
+		authorizer:  auth.NewProjectAuthorizer(projectRepo, roleRepo),
+		logger:      logger,
+		uow:         uow.NewUnitOfWork(db),
+		now:         time.Now,
+	}
+}
+
+// ==================== Upload ====================
+
+// UploadAttachment stores the file and attaches it to the board or to one of its comments
+// The upload is spooled to a temporary file while it is hashed, so the size limit holds
+// whatever size the client announced, and content that is already stored is not uploaded again
+func (s *attachmentService) UploadAttachment(userID, boardID string, req *dto.UploadAttachmentRequest, fileName string, content io.Reader) (*dto.AttachmentResponse, error) {
+	userUUID, board, err := s.findBoardForMember(userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+	commentUUID, err := s.findCommentOfBoard(board, req.CommentID)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := os.CreateTemp("", "attachment-*")
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부 파일 저장 실패", 500)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hash), io.LimitReader(content, s.policy.MaxSize+1))
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "첨부 파일을 읽을 수 없습니다", 400)
+	}
+	if size > s.policy.MaxSize {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest,
+			fmt.Sprintf("첨부 파일은 %dMB를 초과할 수 없습니다", s.policy.MaxSize/(1024*1024)), http.StatusRequestEntityTooLarge)
+	}
+
+	contentType, err := detectContentType(tmp)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부 파일 저장 실패", 500)
+	}
+	if !s.policy.Allows(contentType) {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest,
+			fmt.Sprintf("허용되지 않는 파일 형식입니다 (%s)", contentType), http.StatusUnsupportedMediaType)
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	attachment, err := domain.NewAttachment(board.ID, commentUUID, fileName, contentType, size, checksum, storage.ObjectKey(checksum), userUUID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	ctx := context.Background()
+	stored := false // Whether this upload wrote the object
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		// Held until commit, so deleting the last other attachment of this content cannot remove
+		// the object between the check below and the creation of this attachment
+		if err := repos.Attachment.LockStorageKey(attachment.StorageKey); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부 파일 저장 실패", 500)
+		}
+
+		// Content-addressed keys make the upload idempotent: an existing object already holds these bytes
+		exists, err := s.store.Exists(ctx, attachment.StorageKey)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부 파일 저장 실패", 500)
+		}
+		if !exists {
+			if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부 파일 저장 실패", 500)
+			}
+			if err := s.store.Put(ctx, attachment.StorageKey, tmp, size, contentType); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부 파일 저장 실패", 500)
+			}
+			stored = true
+		}
+
+		if err := repos.Attachment.Create(attachment); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부 파일 생성 실패", 500)
+		}
+		return writeAttachmentEvent(repos.Outbox, event.BoardAttachmentAdded, board, userUUID, attachment)
+	})
+	if err != nil {
+		// Only the object written by this upload is removed, and only if nothing else uses it
+		if stored {
+			s.blobs.release([]string{attachment.StorageKey})
+		}
+		return nil, err
+	}
+
+	s.activities.record(attachmentActivity(board, userUUID, nil, attachment))
+
+	response := toAttachmentResponse(attachment)
+	return &response, nil
+}
+
+// ==================== List ====================
+
+func (s *attachmentService) GetAttachments(userID, boardID string, req *dto.GetAttachmentsRequest) ([]dto.AttachmentResponse, error) {
+	_, board, err := s.findBoardForMember(userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+	commentUUID, err := parser.ParseOptionalUUID(&req.CommentID, "댓글")
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.repo.FindByBoard(board.ID, commentUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부 파일 조회 실패", 500)
+	}
+
+	responses := make([]dto.AttachmentResponse, 0, len(attachments))
+	for i := range attachments {
+		responses = append(responses, toAttachmentResponse(&attachments[i]))
+	}
+	return responses, nil
+}
+
+// ==================== Download ====================
+
+// GetDownloadURL returns a link to the file that can be opened without a token until it expires
+func (s *attachmentService) GetDownloadURL(userID, attachmentID string) (*dto.AttachmentDownloadResponse, error) {
+	_, _, attachment, err := s.findAttachmentForMember(userID, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := s.now().Add(s.policy.URLExpiry).Truncate(time.Second)
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expiresAt.Unix(), 10))
+	query.Set("signature", s.policy.Signer.Sign(attachment.ID.String(), expiresAt))
+
+	return &dto.AttachmentDownloadResponse{
+		URL:       "/api/attachments/" + attachment.ID.String() + "/download?" + query.Encode(),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (s *attachmentService) OpenDownload(attachmentID string, req *dto.DownloadAttachmentRequest) (*dto.AttachmentResponse, io.ReadCloser, error) {
+	attachmentUUID, err := parser.ParseUUID(attachmentID, "첨부 파일")
+	if err != nil {
+		return nil, nil, err
+	}
+	// The signature is checked before anything is looked up, so links cannot be used to probe IDs
+	if !s.policy.Signer.Verify(attachmentUUID.String(), req.Expires, req.Signature) {
+		return nil, nil, apperrors.New(apperrors.ErrCodeForbidden, "다운로드 링크가 유효하지 않거나 만료되었습니다", 403)
+	}
+
+	attachment, err := s.findVisibleAttachment(attachmentUUID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reader, err := s.store.Open(context.Background(), attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			s.logger.Error("Attachment content is missing",
+				zap.String("attachment_id", attachment.ID.String()),
+				zap.String("storage_key", attachment.StorageKey),
+			)
+			return nil, nil, apperrors.New(apperrors.ErrCodeNotFound, "첨부 파일 내용을 찾을 수 없습니다", 404)
+		}
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부 파일 조회 실패", 500)
+	}
+
+	response := toAttachmentResponse(attachment)
+	return &response, reader, nil
+}
+
+// ==================== Delete ====================
+
+// DeleteAttachment removes an attachment (uploader or ADMIN+)
+// The stored content is removed once no other attachment uses it
+func (s *attachmentService) DeleteAttachment(userID, attachmentID string) error {
+	userUUID, board, attachment, err := s.findAttachmentForMember(userID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	canDelete, err := s.authorizer.CanDelete(userUUID, board.ProjectID, attachment.UploadedBy)
+	if err != nil {
+		return err
+	}
+	if !canDelete {
+		return apperrors.New(apperrors.ErrCodeForbidden, "첨부 파일을 삭제할 권한이 없습니다", 403)
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Attachment.Delete(attachment.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부 파일 삭제 실패", 500)
+		}
+		return writeAttachmentEvent(repos.Outbox, event.BoardAttachmentRemoved, board, userUUID, attachment)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.blobs.release([]string{attachment.StorageKey})
+	s.activities.record(attachmentActivity(board, userUUID, attachment, nil))
+	return nil
+}
+
+// ==================== Helper Methods ====================
+
+// findBoardForMember finds the board and checks that the user is a member of its project
+func (s *attachmentService) findBoardForMember(userID, boardID string) (uuid.UUID, *domain.Board, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	board, err := s.findBoard(boardUUID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+	if _, err := s.authorizer.RequireMember(userUUID, board.ProjectID); err != nil {
+		return uuid.Nil, nil, err
+	}
+	return userUUID, board, nil
+}
+
+// findAttachmentForMember finds a visible attachment and checks that the user is a member of its board's project
+func (s *attachmentService) findAttachmentForMember(userID, attachmentID string) (uuid.UUID, *domain.Board, *domain.Attachment, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return uuid.Nil, nil, nil, err
+	}
+	attachmentUUID, err := parser.ParseUUID(attachmentID, "첨부 파일")
+	if err != nil {
+		return uuid.Nil, nil, nil, err
+	}
+
+	attachment, err := s.findVisibleAttachment(attachmentUUID)
+	if err != nil {
+		return uuid.Nil, nil, nil, err
+	}
+	board, err := s.findBoard(attachment.BoardID)
+	if err != nil {
+		return uuid.Nil, nil, nil, err
+	}
+	if _, err := s.authorizer.RequireMember(userUUID, board.ProjectID); err != nil {
+		return uuid.Nil, nil, nil, err
+	}
+	return userUUID, board, attachment, nil
+}
+
+// findVisibleAttachment finds an attachment whose board and comment are not deleted
+// Files of a deleted board or comment come back with it when it is restored from the trash
+func (s *attachmentService) findVisibleAttachment(attachmentID uuid.UUID) (*domain.Attachment, error) {
+	notFound := apperrors.New(apperrors.ErrCodeNotFound, "첨부 파일을 찾을 수 없습니다", 404)
+
+	attachment, err := s.repo.FindByID(attachmentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, notFound
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부 파일 조회 실패", 500)
+	}
+	if _, err := s.boardRepo.FindByID(attachment.BoardID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, notFound
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	if attachment.IsCommentAttachment() {
+		if _, err := s.commentRepo.FindByID(*attachment.CommentID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, notFound
+			}
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "댓글 조회 실패", 500)
+		}
+	}
+	return attachment, nil
+}
+
+func (s *attachmentService) findBoard(boardID uuid.UUID) (*domain.Board, error) {
+	board, err := s.boardRepo.FindByID(boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	return board, nil
+}
+
+// findCommentOfBoard checks that the comment the file is attached to belongs to the board
+func (s *attachmentService) findCommentOfBoard(board *domain.Board, commentID string) (*uuid.UUID, error) {
+	if commentID == "" {
+		return nil, nil
+	}
+	commentUUID, err := parser.ParseUUID(commentID, "댓글")
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.commentRepo.FindByID(commentUUID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "댓글 조회 실패", 500)
+	}
+	if err != nil || comment.BoardID != board.ID {
+		return nil, apperrors.New(apperrors.ErrCodeNotFound, "댓글을 찾을 수 없습니다", 404)
+	}
+	return &commentUUID, nil
+}
+
+// detectContentType sniffs the MIME type from the first 512 bytes of the spooled upload
+// The type announced by the client is ignored, since it cannot be trusted
+func detectContentType(file *os.File) (string, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(head[:n]))
+	if err != nil {
+		return "application/octet-stream", nil
+	}
+	return mediaType, nil
+}
+
+// writeAttachmentEvent records an added or removed attachment of the board in the outbox
+func writeAttachmentEvent(outbox repository.OutboxWriter, eventType event.Type, board *domain.Board, actorID uuid.UUID, attachment *domain.Attachment) error {
+	if err := outbox.Write(event.NewBoardEvent(eventType, board.ProjectID, board.ID, actorID, toAttachmentResponse(attachment))); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "첨부 파일 이벤트 기록 실패", 500)
+	}
+	return nil
+}
+
+// attachmentActivity records an added (old nil) or removed (new nil) attachment
+func attachmentActivity(board *domain.Board, actorID uuid.UUID, oldAttachment, newAttachment *domain.Attachment) domain.BoardActivity {
+	snapshot := func(attachment *domain.Attachment) *string {
+		if attachment == nil {
+			return nil
+		}
+		return encodeActivityValue(map[string]interface{}{
+			"attachmentId": attachment.ID.String(),
+			"fileName":     attachment.FileName,
+		})
+	}
+
+	activity := domain.NewBoardActivity(board, actorID, domain.BoardActivityUpdated)
+	activity.SetChange(domain.BoardActivityFieldAttachment, snapshot(oldAttachment), snapshot(newAttachment))
+	return activity
+}
+
+func toAttachmentResponse(attachment *domain.Attachment) dto.AttachmentResponse {
+	response := dto.AttachmentResponse{
+		AttachmentID: attachment.ID.String(),
+		BoardID:      attachment.BoardID.String(),
+		FileName:     attachment.FileName,
+		ContentType:  attachment.ContentType,
+		Size:         attachment.Size,
+		Checksum:     attachment.Checksum,
+		UploadedBy:   attachment.UploadedBy.String(),
+		CreatedAt:    attachment.CreatedAt,
+	}
+	if attachment.CommentID != nil {
+		commentID := attachment.CommentID.String()
+		response.CommentID = &commentID
+	}
+	return response
+}
+
+// ==================== Stored Content ====================
+
+// attachmentBlobs는 더 이상 참조되지 않는 첨부 파일 내용을 저장소에서 삭제합니다
+// 메타데이터 삭제가 커밋된 뒤 호출되며, 실패해도 요청을 실패시키지 않습니다 (남은 내용은 다음 업로드에서 재사용됨)
+type attachmentBlobs struct {
+	repo   repository.AttachmentRepository
+	store  storage.Storage
+	logger *zap.Logger
+}
+
+func newAttachmentBlobs(repo repository.AttachmentRepository, store storage.Storage, logger *zap.Logger) *attachmentBlobs {
+	return &attachmentBlobs{repo: repo, store: store, logger: logger}
+}
+
+// release deletes the stored content of the keys that no attachment references anymore
+// The count and the deletion hold the lock of the key, so an upload reusing the content
+// either commits first and is counted, or waits and stores the content again
+func (b *attachmentBlobs) release(keys []string) {
+	for _, key := range keys {
+		err := b.repo.WithStorageKeyLock(key, func(repo repository.AttachmentRepository) error {
+			count, err := repo.CountByStorageKey(key)
+			if err != nil || count > 0 {
+				return err
+			}
+			return b.store.Delete(context.Background(), key)
+		})
+		if err != nil {
+			b.logger.Warn("Failed to delete attachment content", zap.String("storage_key", key), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,306 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"board-service/internal/storage"
+	"board-service/internal/testutil"
+	"context"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ==================== Test Suite Setup ====================
+
+type AttachmentServiceTestSuite struct {
+	projectRepo *testutil.MockProjectRepository
+	store       storage.Storage
+	db          *gorm.DB
+	service     *attachmentService
+	projectID   uuid.UUID
+	memberID    uuid.UUID
+}
+
+func setupAttachmentServiceTest(t *testing.T) *AttachmentServiceTestSuite {
+	db := NewMockDB()
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	store, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	projectRepo := new(testutil.MockProjectRepository)
+	roleRepo := new(testutil.MockRoleRepository)
+	activityRepo := new(testutil.MockBoardActivityRepository)
+	roleRepo.On("FindByID", mock.Anything).Return(testutil.NewMemberRole(), nil).Maybe()
+	activityRepo.On("BatchCreate", mock.Anything).Return(nil).Maybe()
+
+	policy := AttachmentPolicy{
+		MaxSize:      1024,
+		AllowedTypes: []string{"text/plain", "image/*"},
+		URLExpiry:    5 * time.Minute,
+		Signer:       storage.NewURLSigner("test-secret"),
+	}
+	service := NewAttachmentService(repository.NewAttachmentRepository(db), repository.NewBoardRepository(db), repository.NewCommentRepository(db),
+		projectRepo, roleRepo, activityRepo, store, policy, zap.NewNop(), db)
+
+	suite := &AttachmentServiceTestSuite{
+		projectRepo: projectRepo,
+		store:       store,
+		db:          db,
+		service:     service.(*attachmentService),
+		projectID:   uuid.New(),
+		memberID:    uuid.New(),
+	}
+	projectRepo.On("FindMemberByUserAndProject", suite.memberID, suite.projectID).
+		Return(&domain.ProjectMember{ProjectID: suite.projectID, UserID: suite.memberID, RoleID: uuid.New()}, nil).Maybe()
+	return suite
+}
+
+// board inserts a board of the suite's project
+func (s *AttachmentServiceTestSuite) board(t *testing.T) uuid.UUID {
+	boardID := uuid.New()
+	require.NoError(t, s.db.Exec("INSERT INTO boards (id, project_id) VALUES (?, ?)", boardID, s.projectID).Error)
+	return boardID
+}
+
+func (s *AttachmentServiceTestSuite) upload(boardID uuid.UUID, commentID, content string) (*dto.AttachmentResponse, error) {
+	return s.service.UploadAttachment(s.memberID.String(), boardID.String(), &dto.UploadAttachmentRequest{CommentID: commentID},
+		"notes.txt", strings.NewReader(content))
+}
+
+func (s *AttachmentServiceTestSuite) objectExists(t *testing.T, checksum string) bool {
+	exists, err := s.store.Exists(context.Background(), storage.ObjectKey(checksum))
+	require.NoError(t, err)
+	return exists
+}
+
+// downloadQuery reads the expiry and signature of a download link
+func downloadQuery(t *testing.T, link string) *dto.DownloadAttachmentRequest {
+	parsed, err := url.Parse(link)
+	require.NoError(t, err)
+	expires, err := strconv.ParseInt(parsed.Query().Get("expires"), 10, 64)
+	require.NoError(t, err)
+	return &dto.DownloadAttachmentRequest{Expires: expires, Signature: parsed.Query().Get("signature")}
+}
+
+func appErrorStatus(t *testing.T, err error) int {
+	require.Error(t, err)
+	appErr, ok := err.(*apperrors.AppError)
+	require.True(t, ok, "expected an AppError, got %v", err)
+	return appErr.HTTPStatus
+}
+
+// ==================== Upload Tests ====================
+
+func TestAttachmentService_Upload_DedupesContent(t *testing.T) {
+	suite := setupAttachmentServiceTest(t)
+	first, second := suite.board(t), suite.board(t)
+
+	// When: The same content is attached to two boards
+	a, err := suite.upload(first, "", "hello world")
+	require.NoError(t, err)
+	b, err := suite.upload(second, "", "hello world")
+	require.NoError(t, err)
+
+	// Then: Both attachments share one stored object
+	assert.Equal(t, "text/plain", a.ContentType)
+	assert.Equal(t, int64(11), a.Size)
+	assert.Equal(t, "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9", a.Checksum)
+	assert.Equal(t, a.Checksum, b.Checksum)
+	assert.Equal(t, int64(2), countRows(t, suite.db, "attachments", "storage_key = ?", storage.ObjectKey(a.Checksum)))
+	assert.Equal(t, int64(2), countRows(t, suite.db, "outbox_events", "event_type = ?", string(event.BoardAttachmentAdded)))
+	assert.True(t, suite.objectExists(t, a.Checksum))
+
+	// When: One attachment is deleted, the content stays for the other
+	require.NoError(t, suite.service.DeleteAttachment(suite.memberID.String(), a.AttachmentID))
+	assert.True(t, suite.objectExists(t, a.Checksum))
+
+	// When: The last one is deleted, the content is removed
+	require.NoError(t, suite.service.DeleteAttachment(suite.memberID.String(), b.AttachmentID))
+	assert.False(t, suite.objectExists(t, a.Checksum))
+	assert.Equal(t, int64(2), countRows(t, suite.db, "outbox_events", "event_type = ?", string(event.BoardAttachmentRemoved)))
+}
+
+func TestAttachmentService_Upload_StoresRemovedContentAgain(t *testing.T) {
+	suite := setupAttachmentServiceTest(t)
+	boardID := suite.board(t)
+
+	// Given: The content was removed with its last attachment
+	a, err := suite.upload(boardID, "", "hello world")
+	require.NoError(t, err)
+	require.NoError(t, suite.service.DeleteAttachment(suite.memberID.String(), a.AttachmentID))
+	require.False(t, suite.objectExists(t, a.Checksum))
+
+	// When: The same content is uploaded again
+	b, err := suite.upload(boardID, "", "hello world")
+
+	// Then: The object is stored again under the kept lock row
+	require.NoError(t, err)
+	assert.True(t, suite.objectExists(t, b.Checksum))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "attachment_blobs", "storage_key = ?", storage.ObjectKey(b.Checksum)))
+}
+
+func TestAttachmentService_Upload_TooLarge(t *testing.T) {
+	suite := setupAttachmentServiceTest(t)
+
+	_, err := suite.upload(suite.board(t), "", strings.Repeat("a", 1025))
+
+	assert.Equal(t, 413, appErrorStatus(t, err))
+	assert.Zero(t, countRows(t, suite.db, "attachments", "1 = 1"))
+}
+
+func TestAttachmentService_Upload_DetectedTypeNotAllowed(t *testing.T) {
+	suite := setupAttachmentServiceTest(t)
+
+	// The file name and the client's content type do not matter, only the content
+	_, err := suite.upload(suite.board(t), "", "%PDF-1.4 document")
+
+	assert.Equal(t, 415, appErrorStatus(t, err))
+}
+
+func TestAttachmentService_Upload_NotMember(t *testing.T) {
+	suite := setupAttachmentServiceTest(t)
+
+	outsider := uuid.New()
+	suite.projectRepo.On("FindMemberByUserAndProject", outsider, suite.projectID).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := suite.service.UploadAttachment(outsider.String(), suite.board(t).String(), &dto.UploadAttachmentRequest{},
+		"notes.txt", strings.NewReader("hello"))
+
+	assert.Equal(t, 403, appErrorStatus(t, err))
+}
+
+func TestAttachmentService_Upload_CommentOfAnotherBoard(t *testing.T) {
+	suite := setupAttachmentServiceTest(t)
+	boardID, otherBoard := suite.board(t), suite.board(t)
+	commentID := uuid.New()
+	require.NoError(t, suite.db.Exec("INSERT INTO comments (id, board_id, content) VALUES (?, ?, 'c')", commentID, otherBoard).Error)
+
+	_, err := suite.upload(boardID, commentID.String(), "hello")
+
+	assert.Equal(t, 404, appErrorStatus(t, err))
+}
+
+// ==================== List Tests ====================
+
+func TestAttachmentService_GetAttachments_HidesFilesOfDeletedComments(t *testing.T) {
+	suite := setupAttachmentServiceTest(t)
+	boardID := suite.board(t)
+	kept, deleted := uuid.New(), uuid.New()
+	require.NoError(t, suite.db.Exec("INSERT INTO comments (id, board_id, content) VALUES (?, ?, 'kept'), (?, ?, 'deleted')",
+		kept, boardID, deleted, boardID).Error)
+
+	boardFile, err := suite.upload(boardID, "", "board file")
+	require.NoError(t, err)
+	commentFile, err := suite.upload(boardID, kept.String(), "comment file")
+	require.NoError(t, err)
+	_, err = suite.upload(boardID, deleted.String(), "deleted comment file")
+	require.NoError(t, err)
+	require.NoError(t, suite.db.Exec("UPDATE comments SET is_deleted = true WHERE id = ?", deleted).Error)
+
+	// When
+	all, err := suite.service.GetAttachments(suite.memberID.String(), boardID.String(), &dto.GetAttachmentsRequest{})
+	require.NoError(t, err)
+	ofComment, err := suite.service.GetAttachments(suite.memberID.String(), boardID.String(), &dto.GetAttachmentsRequest{CommentID: kept.String()})
+	require.NoError(t, err)
+
+	// Then
+	ids := []string{}
+	for _, attachment := range all {
+		ids = append(ids, attachment.AttachmentID)
+	}
+	assert.ElementsMatch(t, []string{boardFile.AttachmentID, commentFile.AttachmentID}, ids)
+	require.Len(t, ofComment, 1)
+	assert.Equal(t, commentFile.AttachmentID, ofComment[0].AttachmentID)
+}
+
+// ==================== Download Tests ====================
+
+func TestAttachmentService_Download_SignedLink(t *testing.T) {
+	suite := setupAttachmentServiceTest(t)
+	uploaded, err := suite.upload(suite.board(t), "", "hello world")
+	require.NoError(t, err)
+
+	// When
+	link, err := suite.service.GetDownloadURL(suite.memberID.String(), uploaded.AttachmentID)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(link.URL, "/api/attachments/"+uploaded.AttachmentID+"/download?"))
+
+	attachment, content, err := suite.service.OpenDownload(uploaded.AttachmentID, downloadQuery(t, link.URL))
+	require.NoError(t, err)
+	body, err := io.ReadAll(content)
+	content.Close()
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(body))
+	assert.Equal(t, "notes.txt", attachment.FileName)
+}
+
+func TestAttachmentService_Download_RejectsTamperedAndExpiredLinks(t *testing.T) {
+	suite := setupAttachmentServiceTest(t)
+	uploaded, err := suite.upload(suite.board(t), "", "hello world")
+	require.NoError(t, err)
+	other, err := suite.upload(suite.board(t), "", "other file")
+	require.NoError(t, err)
+
+	link, err := suite.service.GetDownloadURL(suite.memberID.String(), uploaded.AttachmentID)
+	require.NoError(t, err)
+	query := downloadQuery(t, link.URL)
+
+	// A link cannot be reused for another file or with a later expiry
+	_, _, err = suite.service.OpenDownload(other.AttachmentID, query)
+	assert.Equal(t, 403, appErrorStatus(t, err))
+	_, _, err = suite.service.OpenDownload(uploaded.AttachmentID, &dto.DownloadAttachmentRequest{Expires: query.Expires + 3600, Signature: query.Signature})
+	assert.Equal(t, 403, appErrorStatus(t, err))
+
+	// A link created ten minutes ago has expired
+	suite.service.now = func() time.Time { return time.Now().Add(-10 * time.Minute) }
+	expired, err := suite.service.GetDownloadURL(suite.memberID.String(), uploaded.AttachmentID)
+	require.NoError(t, err)
+	_, _, err = suite.service.OpenDownload(uploaded.AttachmentID, downloadQuery(t, expired.URL))
+	assert.Equal(t, 403, appErrorStatus(t, err))
+}
+
+func TestAttachmentService_GetDownloadURL_DeletedBoard(t *testing.T) {
+	suite := setupAttachmentServiceTest(t)
+	boardID := suite.board(t)
+	uploaded, err := suite.upload(boardID, "", "hello world")
+	require.NoError(t, err)
+	require.NoError(t, suite.db.Exec("UPDATE boards SET is_deleted = true WHERE id = ?", boardID).Error)
+
+	_, err = suite.service.GetDownloadURL(suite.memberID.String(), uploaded.AttachmentID)
+
+	assert.Equal(t, 404, appErrorStatus(t, err))
+}
+
+// ==================== Delete Tests ====================
+
+func TestAttachmentService_Delete_OtherMemberForbidden(t *testing.T) {
+	suite := setupAttachmentServiceTest(t)
+	uploaded, err := suite.upload(suite.board(t), "", "hello world")
+	require.NoError(t, err)
+	otherMember := uuid.New()
+	suite.projectRepo.On("FindMemberByUserAndProject", otherMember, suite.projectID).
+		Return(&domain.ProjectMember{ProjectID: suite.projectID, UserID: otherMember, RoleID: uuid.New()}, nil)
+
+	err = suite.service.DeleteAttachment(otherMember.String(), uploaded.AttachmentID)
+
+	assert.Equal(t, 403, appErrorStatus(t, err))
+	assert.True(t, suite.objectExists(t, uploaded.Checksum))
+}
@@ -0,0 +1,376 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/cache"
+	"board-service/internal/client"
+	"board-service/internal/common/pagination"
+	"board-service/internal/common/parser"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/repository"
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// BoardActivityService는 보드 변경 이력(audit trail) 조회를 담당합니다
+// 이력 기록은 각 서비스가 boardActivityRecorder를 통해 직접 수행합니다
+type BoardActivityService interface {
+	GetBoardActivities(userID, boardID string, req *dto.GetBoardActivitiesRequest) (*dto.PaginatedBoardActivitiesResponse, error)
+}
+
+type boardActivityService struct {
+	repo          repository.BoardActivityRepository
+	boardRepo     repository.BoardRepository
+	projectRepo   repository.ProjectRepository
+	userClient    client.UserClient
+	userInfoCache cache.UserInfoCache
+	logger        *zap.Logger
+}
+
+func NewBoardActivityService(
+	repo repository.BoardActivityRepository,
+	boardRepo repository.BoardRepository,
+	projectRepo repository.ProjectRepository,
+	userClient client.UserClient,
+	userInfoCache cache.UserInfoCache,
+	logger *zap.Logger,
+) BoardActivityService {
+	return &boardActivityService{
+		repo:          repo,
+		boardRepo:     boardRepo,
+		projectRepo:   projectRepo,
+		userClient:    userClient,
+		userInfoCache: userInfoCache,
+		logger:        logger,
+	}
+}
+
+// ==================== Get Board Activities ====================
+
+func (s *boardActivityService) GetBoardActivities(userID, boardID string, req *dto.GetBoardActivitiesRequest) (*dto.PaginatedBoardActivitiesResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Find board
+	board, err := s.boardRepo.FindByID(boardUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+
+	// 2. Check project membership
+	_, err = s.projectRepo.FindMemberByUserAndProject(userUUID, board.ProjectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeForbidden, "프로젝트 멤버가 아닙니다", 403)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
+	}
+
+	// 3. Fetch activities (newest first)
+	page, limit := pagination.ValidatePaginationParams(req.Page, req.Limit)
+	activities, total, err := s.repo.FindByBoard(boardUUID, page, limit)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 활동 이력 조회 실패", 500)
+	}
+
+	// 4. Batch fetch actors
+	actorIDs := make([]string, 0, len(activities))
+	seen := make(map[string]bool)
+	for _, activity := range activities {
+		actorID := activity.ActorID.String()
+		if !seen[actorID] {
+			seen[actorID] = true
+			actorIDs = append(actorIDs, actorID)
+		}
+	}
+	userMap := s.getUserInfoBatch(context.Background(), actorIDs)
+
+	// 5. Build responses
+	responses := make([]dto.BoardActivityResponse, 0, len(activities))
+	for _, activity := range activities {
+		responses = append(responses, s.toResponse(&activity, userMap))
+	}
+
+	return &dto.PaginatedBoardActivitiesResponse{
+		Activities: responses,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+	}, nil
+}
+
+// ==================== Helper Methods ====================
+
+func (s *boardActivityService) toResponse(activity *domain.BoardActivity, userMap map[string]client.UserInfo) dto.BoardActivityResponse {
+	response := dto.BoardActivityResponse{
+		ID:        activity.ID.String(),
+		BoardID:   activity.BoardID.String(),
+		Action:    string(activity.Action),
+		Field:     activity.Field,
+		FieldName: activity.FieldName,
+		OldValue:  decodeActivityValue(activity.OldValue),
+		NewValue:  decodeActivityValue(activity.NewValue),
+		CreatedAt: activity.CreatedAt,
+	}
+
+	if activity.FieldID != nil {
+		fieldID := activity.FieldID.String()
+		response.FieldID = &fieldID
+	}
+	if activity.IsCommentActivity() && activity.CommentID != nil {
+		commentID := activity.CommentID.String()
+		response.CommentID = &commentID
+	}
+
+	if actor, ok := userMap[activity.ActorID.String()]; ok {
+		response.Actor = dto.UserInfo{
+			UserID:   actor.UserID,
+			Name:     actor.Name,
+			Email:    actor.Email,
+			IsActive: actor.IsActive,
+		}
+	} else {
+		response.Actor = dto.UserInfo{
+			UserID: activity.ActorID.String(),
+			Name:   "Unknown User",
+		}
+	}
+
+	return response
+}
+
+// getUserInfoBatch fetches user info for multiple users with caching
+func (s *boardActivityService) getUserInfoBatch(ctx context.Context, userIDs []string) map[string]client.UserInfo {
+	userMap := make(map[string]client.UserInfo)
+	if len(userIDs) == 0 {
+		return userMap
+	}
+
+	cachedUsers, err := s.userInfoCache.GetSimpleUsersBatch(ctx, userIDs)
+	if err != nil {
+		s.logger.Warn("Failed to get users from cache", zap.Error(err))
+		cachedUsers = make(map[string]*cache.SimpleUser)
+	}
+
+	missingUserIDs := []string{}
+	for _, userID := range userIDs {
+		if cachedUser, exists := cachedUsers[userID]; exists {
+			userMap[userID] = client.UserInfo{
+				UserID:   cachedUser.ID,
+				Name:     cachedUser.Name,
+				IsActive: true,
+			}
+		} else {
+			missingUserIDs = append(missingUserIDs, userID)
+		}
+	}
+
+	if len(missingUserIDs) > 0 {
+		users, err := s.userClient.GetUsersBatch(ctx, missingUserIDs)
+		if err != nil {
+			s.logger.Warn("Failed to fetch users from User Service", zap.Error(err))
+			return userMap
+		}
+
+		simpleUsers := make([]cache.SimpleUser, 0, len(users))
+		for _, user := range users {
+			userMap[user.UserID] = user
+			simpleUsers = append(simpleUsers, cache.SimpleUser{ID: user.UserID, Name: user.Name})
+		}
+		if cacheErr := s.userInfoCache.SetSimpleUsersBatch(ctx, simpleUsers); cacheErr != nil {
+			s.logger.Warn("Failed to cache users", zap.Error(cacheErr))
+		}
+	}
+
+	return userMap
+}
+
+// ==================== Activity Recorder ====================
+
+// boardActivityRecorder는 보드 변경 이력을 저장합니다
+// 이력 저장 실패가 원래 작업을 실패시키지 않도록 에러는 경고 로그로만 남깁니다
+type boardActivityRecorder struct {
+	repo   repository.BoardActivityRepository
+	logger *zap.Logger
+}
+
+func newBoardActivityRecorder(repo repository.BoardActivityRepository, logger *zap.Logger) *boardActivityRecorder {
+	return &boardActivityRecorder{repo: repo, logger: logger}
+}
+
+// record stores only the activities whose value actually changed
+func (r *boardActivityRecorder) record(activities ...domain.BoardActivity) {
+	changed := make([]domain.BoardActivity, 0, len(activities))
+	for _, activity := range activities {
+		if activity.HasChanged() {
+			changed = append(changed, activity)
+		}
+	}
+	if len(changed) == 0 {
+		return
+	}
+
+	if err := r.repo.BatchCreate(changed); err != nil {
+		r.logger.Warn("Failed to record board activities",
+			zap.Error(err),
+			zap.String("board_id", changed[0].BoardID.String()),
+			zap.Int("count", len(changed)),
+		)
+	}
+}
+
+// buildBoardUpdateActivities compares the board before and after an update
+// and returns one BOARD_UPDATED activity per changed attribute
+func buildBoardUpdateActivities(before, after *domain.Board, actorID uuid.UUID) []domain.BoardActivity {
+	activities := make([]domain.BoardActivity, 0, 5)
+
+	add := func(field string, oldValue, newValue interface{}) {
+		activity := domain.NewBoardActivity(after, actorID, domain.BoardActivityUpdated)
+		activity.SetChange(field, encodeActivityValue(oldValue), encodeActivityValue(newValue))
+		if activity.HasChanged() {
+			activities = append(activities, activity)
+		}
+	}
+
+	add(domain.BoardActivityFieldTitle, before.Title, after.Title)
+	add(domain.BoardActivityFieldDescription, before.Description, after.Description)
+	add(domain.BoardActivityFieldAssignee, uuidPtrValue(before.AssigneeID), uuidPtrValue(after.AssigneeID))
+	add(domain.BoardActivityFieldParticipants, sortedUUIDStrings(before.ParticipantIDs), sortedUUIDStrings(after.ParticipantIDs))
+	add(domain.BoardActivityFieldDueDate, timePtrValue(before.DueDate), timePtrValue(after.DueDate))
+
+	return activities
+}
+
+// snapshotFieldValue returns the current value of a custom field on a board in a JSON friendly form
+// Select options are resolved to {optionId, label} so the history stays readable after option changes
+func snapshotFieldValue(repo repository.FieldRepository, boardID uuid.UUID, field *domain.ProjectField) (interface{}, error) {
+	values, err := repo.FindFieldValuesByBoardAndField(boardID, field.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].DisplayOrder < values[j].DisplayOrder
+	})
+
+	optionIDs := make([]uuid.UUID, 0)
+	for _, v := range values {
+		if v.ValueOptionID != nil {
+			optionIDs = append(optionIDs, *v.ValueOptionID)
+		}
+	}
+	optionsMap := make(map[uuid.UUID]domain.FieldOption)
+	if len(optionIDs) > 0 {
+		options, err := repo.FindOptionsByIDs(optionIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, opt := range options {
+			optionsMap[opt.ID] = opt
+		}
+	}
+
+	snapshot := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		snapshot = append(snapshot, fieldValueSnapshot(v, optionsMap))
+	}
+
+	if field.FieldType == domain.FieldTypeMultiSelect || field.FieldType == domain.FieldTypeMultiUser {
+		return snapshot, nil
+	}
+	return snapshot[0], nil
+}
+
+func fieldValueSnapshot(v domain.BoardFieldValue, optionsMap map[uuid.UUID]domain.FieldOption) interface{} {
+	switch {
+	case v.ValueText != nil:
+		return *v.ValueText
+	case v.ValueNumber != nil:
+		return *v.ValueNumber
+	case v.ValueDate != nil:
+		return v.ValueDate.Format(time.RFC3339)
+	case v.ValueBoolean != nil:
+		return *v.ValueBoolean
+	case v.ValueOptionID != nil:
+		option := map[string]interface{}{"optionId": v.ValueOptionID.String()}
+		if opt, ok := optionsMap[*v.ValueOptionID]; ok {
+			option["label"] = opt.Label
+		}
+		return option
+	case v.ValueUserID != nil:
+		return v.ValueUserID.String()
+	}
+	return nil
+}
+
+// encodeActivityValue serializes a value for BoardActivity.OldValue/NewValue (nil stays nil)
+func encodeActivityValue(value interface{}) *string {
+	if value == nil {
+		return nil
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	str := string(encoded)
+	return &str
+}
+
+func decodeActivityValue(value *string) interface{} {
+	if value == nil {
+		return nil
+	}
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(*value), &decoded); err != nil {
+		return *value
+	}
+	return decoded
+}
+
+func uuidPtrValue(id *uuid.UUID) interface{} {
+	if id == nil {
+		return nil
+	}
+	return id.String()
+}
+
+func timePtrValue(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// sortedUUIDStrings makes participant comparison independent of order
+func sortedUUIDStrings(ids []uuid.UUID) interface{} {
+	if len(ids) == 0 {
+		return nil
+	}
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = id.String()
+	}
+	sort.Strings(strs)
+	return strs
+}
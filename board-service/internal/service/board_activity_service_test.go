@@ -0,0 +1,192 @@
+package service
+
+import (
+	"board-service/internal/cache"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/testutil"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ==================== Test Suite Setup ====================
+
+type BoardActivityServiceTestSuite struct {
+	activityRepo  *testutil.MockBoardActivityRepository
+	boardRepo     *testutil.MockBoardRepository
+	projectRepo   *testutil.MockProjectRepository
+	userClient    *MockUserClient
+	userInfoCache *MockUserInfoCache
+	service       BoardActivityService
+}
+
+func setupBoardActivityServiceTest(t *testing.T) *BoardActivityServiceTestSuite {
+	suite := &BoardActivityServiceTestSuite{
+		activityRepo:  new(testutil.MockBoardActivityRepository),
+		boardRepo:     new(testutil.MockBoardRepository),
+		projectRepo:   new(testutil.MockProjectRepository),
+		userClient:    new(MockUserClient),
+		userInfoCache: new(MockUserInfoCache),
+	}
+
+	suite.service = NewBoardActivityService(
+		suite.activityRepo,
+		suite.boardRepo,
+		suite.projectRepo,
+		suite.userClient,
+		suite.userInfoCache,
+		zap.NewNop(),
+	)
+
+	return suite
+}
+
+// ==================== GetBoardActivities Tests ====================
+
+func TestBoardActivityService_GetBoardActivities_Success(t *testing.T) {
+	suite := setupBoardActivityServiceTest(t)
+
+	// Given: A board with a stage change recorded
+	userID := uuid.New()
+	projectID := uuid.New()
+	board := testutil.NewTestBoard(projectID, userID)
+	field := testutil.NewTestSingleSelectField(projectID, "Stage")
+
+	activity := domain.NewBoardActivity(board, userID, domain.BoardActivityMoved)
+	activity.SetCustomFieldChange(field,
+		encodeActivityValue(map[string]interface{}{"optionId": uuid.New().String(), "label": "진행중"}),
+		encodeActivityValue(map[string]interface{}{"optionId": uuid.New().String(), "label": "완료"}),
+	)
+	activity.ID = uuid.New()
+	activity.CreatedAt = time.Now()
+
+	suite.boardRepo.On("FindByID", board.ID).Return(board, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{}, nil)
+	suite.activityRepo.On("FindByBoard", board.ID, 1, 20).Return([]domain.BoardActivity{activity}, int64(1), nil)
+	suite.userInfoCache.On("GetSimpleUsersBatch", mock.Anything, []string{userID.String()}).
+		Return(map[string]*cache.SimpleUser{userID.String(): {ID: userID.String(), Name: "Alice"}}, nil)
+
+	// When
+	result, err := suite.service.GetBoardActivities(userID.String(), board.ID.String(), &dto.GetBoardActivitiesRequest{})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), result.Total)
+	assert.Equal(t, 1, result.Page)
+	assert.Equal(t, 20, result.Limit)
+	assert.Len(t, result.Activities, 1)
+
+	got := result.Activities[0]
+	assert.Equal(t, "BOARD_MOVED", got.Action)
+	assert.Equal(t, "Stage", got.FieldName)
+	assert.Equal(t, "Alice", got.Actor.Name)
+	assert.Equal(t, "진행중", got.OldValue.(map[string]interface{})["label"])
+	assert.Equal(t, "완료", got.NewValue.(map[string]interface{})["label"])
+
+	suite.activityRepo.AssertExpectations(t)
+}
+
+func TestBoardActivityService_GetBoardActivities_NotMember(t *testing.T) {
+	suite := setupBoardActivityServiceTest(t)
+
+	userID := uuid.New()
+	projectID := uuid.New()
+	board := testutil.NewTestBoard(projectID, uuid.New())
+
+	suite.boardRepo.On("FindByID", board.ID).Return(board, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := suite.service.GetBoardActivities(userID.String(), board.ID.String(), &dto.GetBoardActivitiesRequest{})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "프로젝트 멤버가 아닙니다")
+	suite.activityRepo.AssertNotCalled(t, "FindByBoard", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBoardActivityService_GetBoardActivities_BoardNotFound(t *testing.T) {
+	suite := setupBoardActivityServiceTest(t)
+
+	boardID := uuid.New()
+	suite.boardRepo.On("FindByID", boardID).Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := suite.service.GetBoardActivities(uuid.New().String(), boardID.String(), &dto.GetBoardActivitiesRequest{})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "보드를 찾을 수 없습니다")
+}
+
+// ==================== Activity Builder Tests ====================
+
+func TestBuildBoardUpdateActivities_OnlyChangedAttributes(t *testing.T) {
+	actorID := uuid.New()
+	before := testutil.NewTestBoard(uuid.New(), uuid.New())
+	after := *before
+
+	p1, p2 := uuid.New(), uuid.New()
+	before.ParticipantIDs = []uuid.UUID{p1, p2}
+	after.ParticipantIDs = []uuid.UUID{p2, p1} // Same participants, different order
+	after.Title = "New Title"
+	assignee := uuid.New()
+	after.Assign(assignee)
+
+	activities := buildBoardUpdateActivities(before, &after, actorID)
+
+	assert.Len(t, activities, 2)
+	fields := map[string]domain.BoardActivity{}
+	for _, a := range activities {
+		assert.Equal(t, domain.BoardActivityUpdated, a.Action)
+		assert.Equal(t, actorID, a.ActorID)
+		fields[a.Field] = a
+	}
+
+	assert.Equal(t, `"`+before.Title+`"`, *fields[domain.BoardActivityFieldTitle].OldValue)
+	assert.Equal(t, `"New Title"`, *fields[domain.BoardActivityFieldTitle].NewValue)
+	assert.Nil(t, fields[domain.BoardActivityFieldAssignee].OldValue)
+	assert.Equal(t, `"`+assignee.String()+`"`, *fields[domain.BoardActivityFieldAssignee].NewValue)
+}
+
+func TestSnapshotFieldValue_ResolvesOptionLabels(t *testing.T) {
+	fieldRepo := new(testutil.MockFieldRepository)
+	boardID := uuid.New()
+	field := testutil.NewTestSingleSelectField(uuid.New(), "Stage")
+	option := testutil.NewTestFieldOption(field.ID, "완료", "#00FF00", 3)
+	value := testutil.NewTestFieldValue(boardID, field.ID, option.ID)
+
+	fieldRepo.On("FindFieldValuesByBoardAndField", boardID, field.ID).Return([]domain.BoardFieldValue{*value}, nil)
+	fieldRepo.On("FindOptionsByIDs", []uuid.UUID{option.ID}).Return([]domain.FieldOption{*option}, nil)
+
+	snapshot, err := snapshotFieldValue(fieldRepo, boardID, field)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"optionId": option.ID.String(), "label": "완료"}, snapshot)
+}
+
+func TestBoardActivityRecorder_SkipsUnchangedAndSwallowsErrors(t *testing.T) {
+	activityRepo := new(testutil.MockBoardActivityRepository)
+	recorder := newBoardActivityRecorder(activityRepo, zap.NewNop())
+	board := testutil.NewTestBoard(uuid.New(), uuid.New())
+
+	unchanged := domain.NewBoardActivity(board, uuid.New(), domain.BoardActivityUpdated)
+	unchanged.SetChange(domain.BoardActivityFieldTitle, encodeActivityValue("same"), encodeActivityValue("same"))
+
+	// Nothing changed → repository is not called
+	recorder.record(unchanged)
+	activityRepo.AssertNotCalled(t, "BatchCreate", mock.Anything)
+
+	// Repository failure must not panic or propagate
+	changed := domain.NewBoardActivity(board, uuid.New(), domain.BoardActivityUpdated)
+	changed.SetChange(domain.BoardActivityFieldTitle, encodeActivityValue("old"), encodeActivityValue("new"))
+	activityRepo.On("BatchCreate", mock.Anything).Return(errors.New("db down"))
+
+	recorder.record(unchanged, changed)
+	activityRepo.AssertCalled(t, "BatchCreate", []domain.BoardActivity{changed})
+}
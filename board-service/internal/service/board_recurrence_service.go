@@ -0,0 +1,413 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/parser"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/event"
+	"board-service/internal/metrics"
+	"board-service/internal/repository"
+	"board-service/internal/uow"
+	"context"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ==================== Recurrence Rules ====================
+
+// SetRecurrence creates or replaces the recurrence rule of a template (template creator or ADMIN+)
+// The user who sets the rule becomes the author of the boards it creates
+func (s *boardTemplateService) SetRecurrence(userID, templateID string, req *dto.SetBoardRecurrenceRequest) (*dto.BoardRecurrenceResponse, error) {
+	userUUID, template, err := s.findTemplate(userID, templateID, true)
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.findProject(template.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	rule, err := recurrenceRuleFromRequest(req, project.Location(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	recurrence, err := s.recurrenceRepo.FindByTemplate(template.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "반복 규칙 조회 실패", 500)
+	}
+	if recurrence == nil {
+		if recurrence, err = domain.NewBoardRecurrence(template, *rule, userUUID); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	} else {
+		if err := recurrence.SetRule(*rule); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+		recurrence.CreatedBy = userUUID
+	}
+	recurrence.Schedule(now, project.Location())
+	if recurrence.NextRunAt == nil {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "반복 규칙에 남은 생성 일정이 없습니다", 400)
+	}
+
+	var response *dto.BoardRecurrenceResponse
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		save := repos.Recurrence.Update
+		if recurrence.ID == uuid.Nil {
+			save = repos.Recurrence.Create
+		}
+		if err := save(recurrence); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "반복 규칙 저장 실패", 500)
+		}
+		response = toBoardRecurrenceResponse(recurrence, template, project)
+		return writeBoardRecurrenceEvent(repos.Outbox, event.BoardRecurrenceUpdated, response, template.ProjectID, userUUID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *boardTemplateService) GetRecurrence(userID, templateID string) (*dto.BoardRecurrenceResponse, error) {
+	_, template, err := s.findTemplate(userID, templateID, false)
+	if err != nil {
+		return nil, err
+	}
+	recurrence, err := s.findRecurrence(template.ID)
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.findProject(template.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	return toBoardRecurrenceResponse(recurrence, template, project), nil
+}
+
+// DeleteRecurrence stops a template from creating boards (template creator or ADMIN+)
+func (s *boardTemplateService) DeleteRecurrence(userID, templateID string) error {
+	userUUID, template, err := s.findTemplate(userID, templateID, true)
+	if err != nil {
+		return err
+	}
+	recurrence, err := s.findRecurrence(template.ID)
+	if err != nil {
+		return err
+	}
+	project, err := s.findProject(template.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	return s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Recurrence.DeleteByTemplate(template.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "반복 규칙 삭제 실패", 500)
+		}
+		return writeBoardRecurrenceEvent(repos.Outbox, event.BoardRecurrenceDeleted,
+			toBoardRecurrenceResponse(recurrence, template, project), template.ProjectID, userUUID)
+	})
+}
+
+// GetProjectRecurrences returns the recurrence rules of the project, the next to run first
+func (s *boardTemplateService) GetProjectRecurrences(userID, projectID string) ([]dto.BoardRecurrenceResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizer.RequireMember(userUUID, projectUUID); err != nil {
+		return nil, err
+	}
+	project, err := s.findProject(projectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	recurrences, err := s.recurrenceRepo.FindByProject(projectUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "반복 규칙 조회 실패", 500)
+	}
+	templates, err := s.repo.FindByProject(projectUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 템플릿 조회 실패", 500)
+	}
+	templateByID := make(map[uuid.UUID]*domain.BoardTemplate, len(templates))
+	for i := range templates {
+		templateByID[templates[i].ID] = &templates[i]
+	}
+
+	responses := make([]dto.BoardRecurrenceResponse, 0, len(recurrences))
+	for i := range recurrences {
+		template, ok := templateByID[recurrences[i].TemplateID]
+		if !ok {
+			continue
+		}
+		responses = append(responses, *toBoardRecurrenceResponse(&recurrences[i], template, project))
+	}
+	return responses, nil
+}
+
+func (s *boardTemplateService) findRecurrence(templateID uuid.UUID) (*domain.BoardRecurrence, error) {
+	recurrence, err := s.recurrenceRepo.FindByTemplate(templateID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "반복 규칙을 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "반복 규칙 조회 실패", 500)
+	}
+	return recurrence, nil
+}
+
+func (s *boardTemplateService) findProject(projectID uuid.UUID) (*domain.Project, error) {
+	project, err := s.projectRepo.FindByID(projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 조회 실패", 500)
+	}
+	return project, nil
+}
+
+// recurrenceRuleFromRequest parses the request; the start day defaults to today in the project time zone
+func recurrenceRuleFromRequest(req *dto.SetBoardRecurrenceRequest, loc *time.Location, now time.Time) (*domain.RecurrenceRule, error) {
+	weekdays, err := domain.ParseRecurrenceWeekdays(req.Weekdays)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+	startsOn, err := parseOptionalDay(req.StartsOn, "시작일")
+	if err != nil {
+		return nil, err
+	}
+	if startsOn == nil {
+		local := now.In(loc)
+		today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+		startsOn = &today
+	}
+	endsOn, err := parseOptionalDay(req.EndsOn, "종료일")
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.RecurrenceRule{
+		Frequency:      domain.RecurrenceFrequency(req.Frequency),
+		Interval:       req.Interval,
+		Weekdays:       weekdays,
+		MonthDay:       req.MonthDay,
+		TimeOfDay:      req.TimeOfDay,
+		StartsOn:       *startsOn,
+		EndsOn:         endsOn,
+		MaxOccurrences: req.MaxOccurrences,
+	}, nil
+}
+
+// rescheduleRecurrences moves the next runs of the project's rules to its (new) time zone
+func rescheduleRecurrences(repo repository.BoardRecurrenceRepository, project *domain.Project, now time.Time) error {
+	recurrences, err := repo.FindByProject(project.ID)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "반복 규칙 조회 실패", 500)
+	}
+	for i := range recurrences {
+		// Ended rules stay ended
+		if recurrences[i].NextRunAt == nil {
+			continue
+		}
+		recurrences[i].Schedule(now, project.Location())
+		if err := repo.Update(&recurrences[i]); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "반복 규칙 일정 변경 실패", 500)
+		}
+	}
+	return nil
+}
+
+func writeBoardRecurrenceEvent(outbox repository.OutboxWriter, eventType event.Type, recurrence *dto.BoardRecurrenceResponse, projectID, actorID uuid.UUID) error {
+	data := map[string]interface{}{
+		"recurrence": recurrence,
+	}
+	if err := outbox.Write(event.New(eventType, projectID, actorID, data)); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "반복 규칙 이벤트 기록 실패", 500)
+	}
+	return nil
+}
+
+func toBoardRecurrenceResponse(recurrence *domain.BoardRecurrence, template *domain.BoardTemplate, project *domain.Project) *dto.BoardRecurrenceResponse {
+	response := &dto.BoardRecurrenceResponse{
+		RecurrenceID:    recurrence.ID.String(),
+		TemplateID:      recurrence.TemplateID.String(),
+		TemplateName:    template.Name,
+		ProjectID:       recurrence.ProjectID.String(),
+		Frequency:       string(recurrence.Frequency),
+		Interval:        recurrence.Interval,
+		Weekdays:        recurrence.WeekdayCodes(),
+		MonthDay:        recurrence.MonthDay,
+		TimeOfDay:       recurrence.TimeOfDay,
+		TimeZone:        project.Location().String(),
+		StartsOn:        recurrence.StartsOn.Format("2006-01-02"),
+		EndsOn:          formatOptionalDay(recurrence.EndsOn),
+		MaxOccurrences:  recurrence.MaxOccurrences,
+		OccurrenceCount: recurrence.OccurrenceCount,
+		NextRunAt:       recurrence.NextRunAt,
+		LastRunAt:       recurrence.LastRunAt,
+		CreatedBy:       recurrence.CreatedBy.String(),
+		CreatedAt:       recurrence.CreatedAt,
+		UpdatedAt:       recurrence.UpdatedAt,
+	}
+	if recurrence.LastBoardID != nil {
+		lastBoardID := recurrence.LastBoardID.String()
+		response.LastBoardID = &lastBoardID
+	}
+	return response
+}
+
+// ==================== Scheduled Boards ====================
+
+// CreateDueRecurringBoards creates the board of every recurrence rule that is due at now
+// and returns how many boards were created
+// Each rule is locked, its board created and its next run advanced in one transaction, so
+// schedulers running on several replicas never create the same occurrence twice
+func (s *boardService) CreateDueRecurringBoards(now time.Time, limit int) (int, error) {
+	due, err := s.recurrences.FindDue(now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	for i := range due {
+		ok, err := s.createRecurringBoard(&due[i], now)
+		if err != nil {
+			// One broken rule must not stop the others; it is retried on the next run
+			s.logger.Error("Failed to create recurring board", zap.Error(err),
+				zap.String("recurrence_id", due[i].ID.String()), zap.String("template_id", due[i].TemplateID.String()))
+			continue
+		}
+		if ok {
+			created++
+		}
+	}
+	return created, nil
+}
+
+// createRecurringBoard creates the board of one due occurrence
+// It returns false when another scheduler took the occurrence or the rule had to be stopped
+func (s *boardService) createRecurringBoard(recurrence *domain.BoardRecurrence, now time.Time) (bool, error) {
+	project, err := s.projectRepo.FindByID(recurrence.ProjectID)
+	if err != nil {
+		return false, err
+	}
+	loc := project.Location()
+
+	// The author must still be a member and the template must still exist
+	template, err := s.loadBoardTemplate(recurrence.TemplateID.String(), recurrence.ProjectID)
+	if err != nil {
+		var appErr *apperrors.AppError
+		if errors.As(err, &appErr) && appErr.HTTPStatus == 404 {
+			return false, s.stopRecurrence(recurrence, now, "template deleted")
+		}
+		return false, err
+	}
+	if !s.isProjectMember(recurrence.CreatedBy, recurrence.ProjectID) {
+		return false, s.stopRecurrence(recurrence, now, "author is no longer a project member")
+	}
+
+	// The title uses the day of the occurrence, not of a delayed run
+	title := template.template.RenderTitle("", recurrence.NextRunAt.In(loc))
+	if title == "" || utf8.RuneCountInString(title) > maxBoardTitleLength {
+		title = template.template.Name
+	}
+	board := &domain.Board{
+		ProjectID:   recurrence.ProjectID,
+		Title:       title,
+		Description: template.template.Description,
+		CreatedBy:   recurrence.CreatedBy,
+	}
+	if assignee := template.template.DefaultAssignee(recurrence.CreatedBy); assignee != nil && s.isProjectMember(*assignee, recurrence.ProjectID) {
+		board.AssigneeID = assignee
+	}
+	userMap := s.getUserInfoBatch(context.Background(), boardUserIDs(board))
+
+	created := false
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		locked, err := repos.Recurrence.LockDue(recurrence.ID, now)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil // Taken by another scheduler
+			}
+			return err
+		}
+
+		project, err := repos.Project.IncrementBoardSequence(recurrence.ProjectID)
+		if err != nil {
+			return err
+		}
+		board.AssignNumber(project, project.BoardSequence)
+		board.CustomFieldsCache = template.cache
+		if err := repos.Board.Create(board); err != nil {
+			return err
+		}
+		if err := template.apply(repos, board, recurrence.CreatedBy); err != nil {
+			return err
+		}
+
+		response := s.buildBoardResponseWithUsers(repos.Field, board, userMap)
+		if err := repos.Outbox.Write(event.NewBoardEvent(event.BoardCreated, board.ProjectID, board.ID, recurrence.CreatedBy, response)); err != nil {
+			return err
+		}
+
+		locked.RecordRun(board.ID, now, loc)
+		if err := repos.Recurrence.Update(locked); err != nil {
+			return err
+		}
+		created = true
+		return nil
+	})
+	if err != nil || !created {
+		return false, err
+	}
+
+	metrics.BoardCreatedTotal.WithLabelValues(board.ProjectID.String()).Inc()
+	createdActivity := domain.NewBoardActivity(board, recurrence.CreatedBy, domain.BoardActivityCreated)
+	createdActivity.SetChange(domain.BoardActivityFieldTitle, nil, encodeActivityValue(board.Title))
+	s.activities.record(createdActivity)
+	s.notifier.boardChanged(nil, board, recurrence.CreatedBy)
+	if template.presets.stageOption != nil {
+		invalidateProjectAnalytics(s.fieldCache, s.logger, board.ProjectID)
+	}
+
+	s.logger.Info("Created recurring board",
+		zap.String("recurrence_id", recurrence.ID.String()),
+		zap.String("board_id", board.ID.String()),
+		zap.String("key", board.Key))
+	return true, nil
+}
+
+// stopRecurrence ends a rule that can no longer create boards
+func (s *boardService) stopRecurrence(recurrence *domain.BoardRecurrence, now time.Time, reason string) error {
+	err := s.uow.Do(func(repos *uow.Repositories) error {
+		locked, err := repos.Recurrence.LockDue(recurrence.ID, now)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+		locked.Stop()
+		return repos.Recurrence.Update(locked)
+	})
+	if err == nil {
+		s.logger.Warn("Stopped board recurrence",
+			zap.String("recurrence_id", recurrence.ID.String()),
+			zap.String("reason", reason))
+	}
+	return err
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/event"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// weeklySync creates a template with a dated title and a Monday/Thursday rule at 09:00 in Asia/Seoul
+func (s *BoardTemplateTestSuite) weeklySync(t *testing.T, maxOccurrences *int) (*dto.BoardTemplateResponse, *dto.BoardRecurrenceResponse) {
+	template, err := s.templates.CreateTemplate(s.memberID.String(), s.projectID.String(), &dto.CreateBoardTemplateRequest{
+		Name:         "Weekly sync",
+		TitlePattern: "Weekly sync {date}",
+		FieldValues:  map[string]interface{}{s.stageFieldID.String(): s.options["대기"].String()},
+	})
+	require.NoError(t, err)
+
+	startsOn := "2030-01-07" // Monday
+	recurrence, err := s.templates.SetRecurrence(s.memberID.String(), template.TemplateID, &dto.SetBoardRecurrenceRequest{
+		Frequency:      string(domain.RecurrenceWeekly),
+		Weekdays:       []string{"TH", "MO"},
+		TimeOfDay:      "09:00",
+		StartsOn:       &startsOn,
+		MaxOccurrences: maxOccurrences,
+	})
+	require.NoError(t, err)
+	return template, recurrence
+}
+
+func TestBoardTemplateService_SetRecurrence(t *testing.T) {
+	suite := setupBoardTemplateTest(t)
+	template, recurrence := suite.weeklySync(t, nil)
+
+	assert.Equal(t, []string{"MO", "TH"}, recurrence.Weekdays)
+	assert.Equal(t, 1, recurrence.Interval)
+	assert.Equal(t, "Asia/Seoul", recurrence.TimeZone)
+	require.NotNil(t, recurrence.NextRunAt)
+	assert.True(t, time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC).Equal(*recurrence.NextRunAt), "09:00 in Seoul")
+	assert.Equal(t, int64(1), countRows(t, suite.db, "outbox_events", "event_type = ?", string(event.BoardRecurrenceUpdated)))
+
+	// Replacing the rule keeps a single rule per template
+	_, err := suite.templates.SetRecurrence(suite.adminID.String(), template.TemplateID, &dto.SetBoardRecurrenceRequest{Frequency: string(domain.RecurrenceDaily)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "board_recurrences", "template_id = ? AND is_deleted = ?", template.TemplateID, false))
+	recurrences, err := suite.templates.GetProjectRecurrences(suite.otherID.String(), suite.projectID.String())
+	require.NoError(t, err)
+	require.Len(t, recurrences, 1)
+	assert.Equal(t, "DAILY", recurrences[0].Frequency)
+	assert.Equal(t, suite.adminID.String(), recurrences[0].CreatedBy, "the user who sets the rule authors the boards")
+
+	require.NoError(t, suite.templates.DeleteRecurrence(suite.memberID.String(), template.TemplateID))
+	_, err = suite.templates.GetRecurrence(suite.memberID.String(), template.TemplateID)
+	require.Error(t, err)
+	assert.Equal(t, 404, err.(*apperrors.AppError).HTTPStatus)
+}
+
+func TestBoardTemplateService_SetRecurrence_Rejects(t *testing.T) {
+	suite := setupBoardTemplateTest(t)
+	template, _ := suite.weeklySync(t, nil)
+
+	_, err := suite.templates.SetRecurrence(suite.otherID.String(), template.TemplateID, &dto.SetBoardRecurrenceRequest{Frequency: string(domain.RecurrenceDaily)})
+	require.Error(t, err)
+	assert.Equal(t, 403, err.(*apperrors.AppError).HTTPStatus)
+
+	startsOn, endsOn := "2030-01-07", "2030-01-06"
+	_, err = suite.templates.SetRecurrence(suite.memberID.String(), template.TemplateID, &dto.SetBoardRecurrenceRequest{
+		Frequency: string(domain.RecurrenceDaily), StartsOn: &startsOn, EndsOn: &endsOn,
+	})
+	require.Error(t, err)
+	assert.Equal(t, 400, err.(*apperrors.AppError).HTTPStatus)
+
+	// An end day in the past leaves nothing to create
+	startsOn, endsOn = "2020-01-01", "2020-01-31"
+	_, err = suite.templates.SetRecurrence(suite.memberID.String(), template.TemplateID, &dto.SetBoardRecurrenceRequest{
+		Frequency: string(domain.RecurrenceDaily), StartsOn: &startsOn, EndsOn: &endsOn,
+	})
+	require.Error(t, err)
+	assert.Equal(t, 400, err.(*apperrors.AppError).HTTPStatus)
+}
+
+func TestBoardService_CreateDueRecurringBoards(t *testing.T) {
+	suite := setupBoardTemplateTest(t)
+	template, recurrence := suite.weeklySync(t, nil)
+	first := *recurrence.NextRunAt
+
+	created, err := suite.boards.CreateDueRecurringBoards(first.Add(-time.Minute), 10)
+	require.NoError(t, err)
+	assert.Zero(t, created, "not due yet")
+
+	created, err = suite.boards.CreateDueRecurringBoards(first.Add(time.Minute), 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, created)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "boards", "title = ? AND created_by = ?", "Weekly sync 2030-01-07", suite.memberID))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "board_field_values", "field_id = ? AND value_option_id = ?", suite.stageFieldID, suite.options["대기"]))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "outbox_events", "event_type = ?", string(event.BoardCreated)))
+
+	// The occurrence was advanced with the board, so a second scheduler finds nothing
+	created, err = suite.boards.CreateDueRecurringBoards(first.Add(time.Minute), 10)
+	require.NoError(t, err)
+	assert.Zero(t, created)
+
+	next, err := suite.templates.GetRecurrence(suite.memberID.String(), template.TemplateID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, next.OccurrenceCount)
+	require.NotNil(t, next.LastBoardID)
+	require.NotNil(t, next.NextRunAt)
+	assert.True(t, time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC).Equal(*next.NextRunAt), "Thursday 09:00 in Seoul")
+
+	// After a week without a scheduler only one board is created and the missed occurrences are skipped
+	late := time.Date(2030, 1, 17, 3, 0, 0, 0, time.UTC)
+	created, err = suite.boards.CreateDueRecurringBoards(late, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, created)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "boards", "title = ?", "Weekly sync 2030-01-10"), "titled with the occurrence day")
+	next, err = suite.templates.GetRecurrence(suite.memberID.String(), template.TemplateID)
+	require.NoError(t, err)
+	assert.True(t, time.Date(2030, 1, 21, 0, 0, 0, 0, time.UTC).Equal(*next.NextRunAt), "Monday 2030-01-21 09:00 in Seoul")
+}
+
+func TestBoardService_CreateDueRecurringBoards_EndsAfterMaxOccurrences(t *testing.T) {
+	suite := setupBoardTemplateTest(t)
+	one := 1
+	template, recurrence := suite.weeklySync(t, &one)
+
+	created, err := suite.boards.CreateDueRecurringBoards(recurrence.NextRunAt.Add(time.Minute), 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, created)
+
+	ended, err := suite.templates.GetRecurrence(suite.memberID.String(), template.TemplateID)
+	require.NoError(t, err)
+	assert.Nil(t, ended.NextRunAt)
+	created, err = suite.boards.CreateDueRecurringBoards(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC), 10)
+	require.NoError(t, err)
+	assert.Zero(t, created)
+}
+
+func TestBoardService_CreateDueRecurringBoards_StopsWhenAuthorLeft(t *testing.T) {
+	suite := setupBoardTemplateTest(t)
+	template, recurrence := suite.weeklySync(t, nil)
+	require.NoError(t, suite.db.Exec("UPDATE board_recurrences SET created_by = ? WHERE template_id = ?", suite.outsiderID, template.TemplateID).Error)
+
+	created, err := suite.boards.CreateDueRecurringBoards(recurrence.NextRunAt.Add(time.Minute), 10)
+	require.NoError(t, err)
+	assert.Zero(t, created)
+	assert.Equal(t, int64(0), countRows(t, suite.db, "boards", "project_id = ?", suite.projectID))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "board_recurrences", "template_id = ? AND next_run_at IS NULL", template.TemplateID))
+}
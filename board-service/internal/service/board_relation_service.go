@@ -0,0 +1,441 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/auth"
+	"board-service/internal/common/parser"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"board-service/internal/uow"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// BoardRelationService는 보드 간 관계(BLOCKS, RELATES, DUPLICATES)를 관리합니다
+// 같은 워크스페이스의 다른 프로젝트 보드와도 연결할 수 있으며, 양쪽 프로젝트의 멤버만 관계를 추가/삭제할 수 있습니다
+type BoardRelationService interface {
+	GetRelations(userID, boardID string) (*dto.BoardRelationsResponse, error)
+	CreateRelation(userID, boardID string, req *dto.CreateBoardRelationRequest) (*dto.BoardRelationResponse, error)
+	DeleteRelation(userID, boardID, relationID string) error
+}
+
+type boardRelationService struct {
+	repo        repository.BoardRelationRepository
+	boardRepo   repository.BoardRepository
+	projectRepo repository.ProjectRepository
+	relations   *boardRelationReader
+	activities  *boardActivityRecorder
+	authorizer  auth.ProjectAuthorizer
+	logger      *zap.Logger
+	uow         uow.UnitOfWork
+}
+
+func NewBoardRelationService(
+	repo repository.BoardRelationRepository,
+	boardRepo repository.BoardRepository,
+	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
+	activityRepo repository.BoardActivityRepository,
+	logger *zap.Logger,
+	db *gorm.DB,
+) BoardRelationService {
+	return &boardRelationService{
+		repo:        repo,
+		boardRepo:   boardRepo,
+		projectRepo: projectRepo,
+		relations:   newBoardRelationReader(repo, projectRepo, logger),
+		activities:  newBoardActivityRecorder(activityRepo, logger),
+		authorizer:  auth.NewProjectAuthorizer(projectRepo, roleRepo),
+		logger:      logger,
+		uow:         uow.NewUnitOfWork(db),
+	}
+}
+
+// ==================== Get Relations ====================
+
+func (s *boardRelationService) GetRelations(userID, boardID string) (*dto.BoardRelationsResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := s.findBoard(boardID, "보드를 찾을 수 없습니다")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizer.RequireMember(userUUID, board.ProjectID); err != nil {
+		return nil, err
+	}
+
+	relations, err := s.relations.forBoard(board.ID, userUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 관계 조회 실패", 500)
+	}
+	return relations, nil
+}
+
+// ==================== Create Relation ====================
+
+// CreateRelation links the board to another board
+// BLOCKED_BY and DUPLICATED_BY are stored as BLOCKS and DUPLICATES from the other board,
+// and a BLOCKS relation that would close a loop (A blocks B blocks ... blocks A) is rejected
+func (s *boardRelationService) CreateRelation(userID, boardID string, req *dto.CreateBoardRelationRequest) (*dto.BoardRelationResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	relationType, swapped, err := domain.ParseBoardRelationType(req.Type)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	// 1. Find both boards
+	board, err := s.findBoard(boardID, "보드를 찾을 수 없습니다")
+	if err != nil {
+		return nil, err
+	}
+	other, err := s.findBoard(req.TargetBoardID, "연결할 보드를 찾을 수 없습니다")
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Check membership in both projects and that they share a workspace
+	if err := s.requireBothEnds(userUUID, board, other); err != nil {
+		return nil, err
+	}
+
+	source, target := board, other
+	if swapped {
+		source, target = other, board
+	}
+	relation, err := domain.NewBoardRelation(source.ID, target.ID, relationType, userUUID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	// 3. Check duplicates and cycles, then create the relation in one transaction
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		existing, err := repos.Relation.FindBetween(source.ID, target.ID, relationType)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 관계 조회 실패", 500)
+		}
+		for _, r := range existing {
+			// A reversed BLOCKS relation is reported as a cycle below
+			if r.SourceBoardID == source.ID || relationType != domain.BoardRelationBlocks {
+				return apperrors.New(apperrors.ErrCodeConflict, "이미 연결된 보드입니다", 409)
+			}
+		}
+
+		if relationType == domain.BoardRelationBlocks {
+			cycle, err := createsBlockingCycle(repos.Relation, source.ID, target.ID)
+			if err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 관계 조회 실패", 500)
+			}
+			if cycle {
+				return apperrors.New(apperrors.ErrCodeBadRequest, "순환하는 선행 관계는 만들 수 없습니다", 400)
+			}
+		}
+
+		if err := repos.Relation.Create(relation); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 관계 생성 실패", 500)
+		}
+		return writeRelationEvents(repos.Outbox, event.BoardRelationAdded, relation, source, target, userUUID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Record the new relation on both boards
+	relation.SourceBoard, relation.TargetBoard = source, target
+	s.activities.record(relationActivities(relation, userUUID, false)...)
+
+	response := toBoardRelationResponse(relation, board.ID, true)
+	return &response, nil
+}
+
+// ==================== Delete Relation ====================
+
+func (s *boardRelationService) DeleteRelation(userID, boardID, relationID string) error {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	relationUUID, err := parser.ParseUUID(relationID, "relation ID")
+	if err != nil {
+		return err
+	}
+
+	// 1. Find board and relation (the relation must belong to the board in the path)
+	board, err := s.findBoard(boardID, "보드를 찾을 수 없습니다")
+	if err != nil {
+		return err
+	}
+	relation, err := s.repo.FindByID(relationUUID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 관계 조회 실패", 500)
+	}
+	if relation == nil || !relation.Involves(board.ID) {
+		return apperrors.New(apperrors.ErrCodeNotFound, "보드 관계를 찾을 수 없습니다", 404)
+	}
+
+	// 2. Check membership in both projects
+	// The other board may be in the trash; only the board in the path is checked then
+	otherID := relation.TargetBoardID
+	if relation.TargetBoardID == board.ID {
+		otherID = relation.SourceBoardID
+	}
+	other, err := s.boardRepo.FindByID(otherID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	if other == nil {
+		if _, err := s.authorizer.RequireMember(userUUID, board.ProjectID); err != nil {
+			return err
+		}
+	} else if err := s.requireBothEnds(userUUID, board, other); err != nil {
+		return err
+	}
+
+	source, target := board, other
+	if relation.SourceBoardID != board.ID {
+		source, target = other, board
+	}
+
+	// 3. Delete relation and record the events in the same transaction
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Relation.Delete(relation.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 관계 삭제 실패", 500)
+		}
+		return writeRelationEvents(repos.Outbox, event.BoardRelationRemoved, relation, source, target, userUUID)
+	})
+	if err != nil {
+		return err
+	}
+
+	relation.SourceBoard, relation.TargetBoard = source, target
+	s.activities.record(relationActivities(relation, userUUID, true)...)
+	return nil
+}
+
+// ==================== Helper Methods ====================
+
+func (s *boardRelationService) findBoard(boardID, notFoundMessage string) (*domain.Board, error) {
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := s.boardRepo.FindByID(boardUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, notFoundMessage, 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	return board, nil
+}
+
+// requireBothEnds checks that the user is a member of the projects of both boards
+// and that boards of different projects belong to the same workspace
+func (s *boardRelationService) requireBothEnds(userID uuid.UUID, board, other *domain.Board) error {
+	if _, err := s.authorizer.RequireMember(userID, board.ProjectID); err != nil {
+		return err
+	}
+	if other.ProjectID == board.ProjectID {
+		return nil
+	}
+	if _, err := s.authorizer.RequireMember(userID, other.ProjectID); err != nil {
+		return err
+	}
+
+	project, err := s.projectRepo.FindByID(board.ProjectID)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 조회 실패", 500)
+	}
+	otherProject, err := s.projectRepo.FindByID(other.ProjectID)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 조회 실패", 500)
+	}
+	if !otherProject.BelongsToWorkspace(project.WorkspaceID) {
+		return apperrors.New(apperrors.ErrCodeBadRequest, "같은 워크스페이스의 보드만 연결할 수 있습니다", 400)
+	}
+	return nil
+}
+
+// createsBlockingCycle returns true if the source is reachable from the target through BLOCKS relations,
+// i.e. if "source BLOCKS target" would close a loop
+// The check runs in the creating transaction; two concurrent requests closing the same loop from both
+// ends can still both succeed, which only makes both boards show up as blocked
+func createsBlockingCycle(repo repository.BoardRelationRepository, sourceID, targetID uuid.UUID) (bool, error) {
+	visited := map[uuid.UUID]bool{targetID: true}
+	frontier := []uuid.UUID{targetID}
+
+	for len(frontier) > 0 {
+		blockedIDs, err := repo.FindBlockedByAny(frontier)
+		if err != nil {
+			return false, err
+		}
+
+		frontier = nil
+		for _, id := range blockedIDs {
+			if id == sourceID {
+				return true, nil
+			}
+			if !visited[id] {
+				visited[id] = true
+				frontier = append(frontier, id)
+			}
+		}
+	}
+	return false, nil
+}
+
+// writeRelationEvents records the relation event once in the project of each board
+// A board in the trash (nil) gets no event
+func writeRelationEvents(outbox repository.OutboxWriter, eventType event.Type, relation *domain.BoardRelation, source, target *domain.Board, actorID uuid.UUID) error {
+	data := map[string]interface{}{
+		"relationId":    relation.ID.String(),
+		"type":          string(relation.Type),
+		"sourceBoardId": relation.SourceBoardID.String(),
+		"targetBoardId": relation.TargetBoardID.String(),
+	}
+
+	projects := make(map[uuid.UUID]bool)
+	for _, board := range []*domain.Board{source, target} {
+		if board == nil || projects[board.ProjectID] {
+			continue
+		}
+		projects[board.ProjectID] = true
+		if err := outbox.Write(event.NewBoardEvent(eventType, board.ProjectID, board.ID, actorID, data)); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 관계 이벤트 기록 실패", 500)
+		}
+	}
+	return nil
+}
+
+// relationActivities records the relation on both boards, each from its own side (e.g. BLOCKS / BLOCKED_BY)
+// The history of one board may be read by members of another project, so only the key of the other board is kept
+// Boards that are not loaded (deleted) are skipped
+func relationActivities(relation *domain.BoardRelation, actorID uuid.UUID, removed bool) []domain.BoardActivity {
+	activities := make([]domain.BoardActivity, 0, 2)
+	for _, board := range []*domain.Board{relation.SourceBoard, relation.TargetBoard} {
+		if board == nil {
+			continue
+		}
+		value := encodeActivityValue(toBoardRelationResponse(relation, board.ID, false))
+		activity := domain.NewBoardActivity(board, actorID, domain.BoardActivityUpdated)
+		if removed {
+			activity.SetChange(domain.BoardActivityFieldRelation, value, nil)
+		} else {
+			activity.SetChange(domain.BoardActivityFieldRelation, nil, value)
+		}
+		activities = append(activities, activity)
+	}
+	return activities
+}
+
+// toBoardRelationResponse describes the relation from the given board's side
+// The title of the other board is hidden when its project is not visible to the user
+func toBoardRelationResponse(relation *domain.BoardRelation, boardID uuid.UUID, showTitle bool) dto.BoardRelationResponse {
+	response := dto.BoardRelationResponse{
+		RelationID: relation.ID.String(),
+		Type:       relation.LabelFor(boardID),
+		CreatedBy:  relation.CreatedBy.String(),
+		CreatedAt:  relation.CreatedAt,
+	}
+	if other := relation.OtherBoard(boardID); other != nil {
+		response.Board = dto.RelatedBoardResponse{
+			BoardID:   other.ID.String(),
+			ProjectID: other.ProjectID.String(),
+			Key:       other.Key,
+		}
+		if showTitle {
+			response.Board.Title = other.Title
+		}
+	}
+	return response
+}
+
+// ==================== Relation Reader ====================
+
+// boardRelationReader는 보드 응답에 포함되는 관계 목록과 blocked 여부를 조회합니다
+// 보드 서비스와 뷰 서비스의 응답에도 사용되며, 그쪽에서는 실패해도 보드 조회를 실패시키지 않습니다
+type boardRelationReader struct {
+	repo        repository.BoardRelationRepository
+	projectRepo repository.ProjectRepository
+	logger      *zap.Logger
+}
+
+func newBoardRelationReader(repo repository.BoardRelationRepository, projectRepo repository.ProjectRepository, logger *zap.Logger) *boardRelationReader {
+	return &boardRelationReader{repo: repo, projectRepo: projectRepo, logger: logger}
+}
+
+// forBoard returns the relations of the board grouped by direction
+// Related boards of projects the user is not a member of are listed without their title
+func (r *boardRelationReader) forBoard(boardID, userID uuid.UUID) (*dto.BoardRelationsResponse, error) {
+	relations, err := r.repo.FindByBoard(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.BoardRelationsResponse{
+		Outgoing: make([]dto.BoardRelationResponse, 0),
+		Incoming: make([]dto.BoardRelationResponse, 0),
+	}
+	visible := make(map[uuid.UUID]bool)
+	for i := range relations {
+		relation := &relations[i]
+		other := relation.OtherBoard(boardID)
+		if other == nil {
+			continue
+		}
+
+		if _, checked := visible[other.ProjectID]; !checked {
+			_, err := r.projectRepo.FindMemberByUserAndProject(userID, other.ProjectID)
+			visible[other.ProjectID] = err == nil
+		}
+
+		item := toBoardRelationResponse(relation, boardID, visible[other.ProjectID])
+		if relation.SourceBoardID == boardID {
+			response.Outgoing = append(response.Outgoing, item)
+			continue
+		}
+		response.Incoming = append(response.Incoming, item)
+		if relation.Type == domain.BoardRelationBlocks {
+			response.IsBlocked = true
+		}
+	}
+	return response, nil
+}
+
+// attach adds the relations and the blocked flag to a single board response (best-effort)
+func (r *boardRelationReader) attach(response *dto.BoardResponse, boardID, userID uuid.UUID) {
+	relations, err := r.forBoard(boardID, userID)
+	if err != nil {
+		r.logger.Warn("Failed to fetch board relations", zap.Error(err), zap.String("board_id", boardID.String()))
+		return
+	}
+	response.Relations = relations
+	response.IsBlocked = relations.IsBlocked
+}
+
+// blocked returns the given boards that are blocked (best-effort, empty on failure)
+func (r *boardRelationReader) blocked(boardIDs []uuid.UUID) map[uuid.UUID]bool {
+	blocked := make(map[uuid.UUID]bool)
+	blockedIDs, err := r.repo.FilterBlocked(boardIDs)
+	if err != nil {
+		r.logger.Warn("Failed to fetch blocked boards", zap.Error(err))
+		return blocked
+	}
+	for _, id := range blockedIDs {
+		blocked[id] = true
+	}
+	return blocked
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/testutil"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// ==================== Test Suite Setup ====================
+
+type BoardRelationServiceTestSuite struct {
+	relationRepo *testutil.MockBoardRelationRepository
+	boardRepo    *testutil.MockBoardRepository
+	projectRepo  *testutil.MockProjectRepository
+	roleRepo     *testutil.MockRoleRepository
+	service      BoardRelationService
+}
+
+func setupBoardRelationServiceTest(t *testing.T) *BoardRelationServiceTestSuite {
+	suite := &BoardRelationServiceTestSuite{
+		relationRepo: new(testutil.MockBoardRelationRepository),
+		boardRepo:    new(testutil.MockBoardRepository),
+		projectRepo:  new(testutil.MockProjectRepository),
+		roleRepo:     new(testutil.MockRoleRepository),
+	}
+
+	suite.service = NewBoardRelationService(suite.relationRepo, suite.boardRepo, suite.projectRepo, suite.roleRepo,
+		new(testutil.MockBoardActivityRepository), zap.NewNop(), nil)
+	suite.roleRepo.On("FindByID", mock.Anything).Return(testutil.NewMemberRole(), nil).Maybe()
+
+	return suite
+}
+
+// board registers a board of a project in the given workspace, with userID as a member
+func (s *BoardRelationServiceTestSuite) board(userID, workspaceID uuid.UUID) *domain.Board {
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New()}
+	s.boardRepo.On("FindByID", board.ID).Return(board, nil)
+	s.projectRepo.On("FindByID", board.ProjectID).Return(&domain.Project{
+		BaseModel:   domain.BaseModel{ID: board.ProjectID},
+		WorkspaceID: workspaceID,
+	}, nil).Maybe()
+	s.projectRepo.On("FindMemberByUserAndProject", userID, board.ProjectID).
+		Return(&domain.ProjectMember{ProjectID: board.ProjectID, UserID: userID, RoleID: uuid.New()}, nil).Maybe()
+	return board
+}
+
+// ==================== CreateRelation Tests ====================
+
+func TestBoardRelationService_CreateRelation_InvalidType(t *testing.T) {
+	suite := setupBoardRelationServiceTest(t)
+
+	_, err := suite.service.CreateRelation(uuid.New().String(), uuid.New().String(), &dto.CreateBoardRelationRequest{
+		TargetBoardID: uuid.New().String(),
+		Type:          "FOLLOWS",
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, 400, err.(*apperrors.AppError).HTTPStatus)
+}
+
+func TestBoardRelationService_CreateRelation_SelfLink(t *testing.T) {
+	suite := setupBoardRelationServiceTest(t)
+	userID := uuid.New()
+	board := suite.board(userID, uuid.New())
+
+	_, err := suite.service.CreateRelation(userID.String(), board.ID.String(), &dto.CreateBoardRelationRequest{
+		TargetBoardID: board.ID.String(),
+		Type:          "BLOCKS",
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, 400, err.(*apperrors.AppError).HTTPStatus)
+	suite.relationRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestBoardRelationService_CreateRelation_OtherWorkspace(t *testing.T) {
+	suite := setupBoardRelationServiceTest(t)
+	userID := uuid.New()
+	board := suite.board(userID, uuid.New())
+	other := suite.board(userID, uuid.New())
+
+	_, err := suite.service.CreateRelation(userID.String(), board.ID.String(), &dto.CreateBoardRelationRequest{
+		TargetBoardID: other.ID.String(),
+		Type:          "RELATES",
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, "같은 워크스페이스의 보드만 연결할 수 있습니다", err.(*apperrors.AppError).Message)
+	suite.relationRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+// ==================== DeleteRelation Tests ====================
+
+func TestBoardRelationService_DeleteRelation_NotOnBoard(t *testing.T) {
+	suite := setupBoardRelationServiceTest(t)
+	userID := uuid.New()
+	board := suite.board(userID, uuid.New())
+	relation := &domain.BoardRelation{BaseModel: domain.BaseModel{ID: uuid.New()}, SourceBoardID: uuid.New(), TargetBoardID: uuid.New()}
+	suite.relationRepo.On("FindByID", relation.ID).Return(relation, nil)
+
+	err := suite.service.DeleteRelation(userID.String(), board.ID.String(), relation.ID.String())
+
+	require.Error(t, err)
+	assert.Equal(t, 404, err.(*apperrors.AppError).HTTPStatus)
+	suite.relationRepo.AssertNotCalled(t, "Delete", mock.Anything)
+}
@@ -10,6 +10,7 @@ import (
 	"board-service/internal/common/validator"
 	"board-service/internal/domain"
 	"board-service/internal/dto"
+	"board-service/internal/event"
 	"board-service/internal/metrics"
 	"board-service/internal/repository"
 	"board-service/internal/uow"
@@ -26,10 +27,15 @@ import (
 type BoardService interface {
 	CreateBoard(userID string, req *dto.CreateBoardRequest) (*dto.BoardResponse, error)
 	GetBoard(boardID, userID string) (*dto.BoardResponse, error)
+	GetBoardByKey(key, userID string) (*dto.BoardResponse, error)
 	GetBoards(userID string, req *dto.GetBoardsRequest) (*dto.PaginatedBoardsResponse, error)
 	UpdateBoard(boardID, userID string, req *dto.UpdateBoardRequest) (*dto.BoardResponse, error)
 	DeleteBoard(boardID, userID string) error
 	MoveBoard(userID, boardID string, req *dto.MoveBoardRequest) (*dto.MoveBoardResponse, error)
+	MoveBoardToProject(boardID, targetProjectID, userID string) (*dto.BoardResponse, error)
+	SetParentBoard(boardID, userID string, req *dto.SetParentBoardRequest) (*dto.BoardResponse, error)
+	DuplicateBoard(boardID, userID string, req *dto.DuplicateBoardRequest) (*dto.BoardResponse, error)
+	CreateDueRecurringBoards(now time.Time, limit int) (int, error)
 }
 
 type boardService struct {
@@ -38,9 +44,17 @@ type boardService struct {
 	roleRepo      repository.RoleRepository
 	fieldRepo     repository.FieldRepository       // For custom fields system
 	commentRepo   repository.CommentRepository     // For UnitOfWork operations
+	activities    *boardActivityRecorder           // Board activity history (audit trail)
+	notifier      *boardNotifier                   // Mention and assignment notifications
+	relations     *boardRelationReader             // Board relations and blocked flag in responses
+	progress      *boardProgressReader             // Checklist progress (including sub-tasks) in responses
+	timeTracking  *boardTimeReader                 // Estimates and logged time in responses
+	templateRepo  repository.BoardTemplateRepository // Board templates for new boards
+	recurrences   repository.BoardRecurrenceRepository // Board template schedules for recurring boards
 	authorizer    auth.ProjectAuthorizer           // Centralized authorization
 	userClient    client.UserClient
 	userInfoCache cache.UserInfoCache
+	fieldCache    cache.FieldCache                 // Project analytics invalidation on stage changes
 	logger        *zap.Logger
 	db            *gorm.DB
 	uow           uow.UnitOfWork                   // Unit of Work for transaction management
@@ -53,8 +67,16 @@ func NewBoardService(
 	roleRepo repository.RoleRepository,
 	fieldRepo repository.FieldRepository,
 	commentRepo repository.CommentRepository,
+	activityRepo repository.BoardActivityRepository,
+	notificationRepo repository.NotificationRepository,
+	relationRepo repository.BoardRelationRepository,
+	checklistRepo repository.ChecklistRepository,
+	workLogRepo repository.WorkLogRepository,
+	boardTemplateRepo repository.BoardTemplateRepository,
+	boardRecurrenceRepo repository.BoardRecurrenceRepository,
 	userClient client.UserClient,
 	userInfoCache cache.UserInfoCache,
+	fieldCache cache.FieldCache,
 	logger *zap.Logger,
 	db *gorm.DB,
 ) BoardService {
@@ -73,9 +95,17 @@ func NewBoardService(
 		roleRepo:      roleRepo,
 		fieldRepo:     fieldRepo,
 		commentRepo:   commentRepo,
+		activities:    newBoardActivityRecorder(activityRepo, logger),
+		notifier:      newBoardNotifier(notificationRepo, projectRepo, userClient, userInfoCache, logger),
+		relations:     newBoardRelationReader(relationRepo, projectRepo, logger),
+		progress:      newBoardProgressReader(checklistRepo, repo, logger),
+		timeTracking:  newBoardTimeReader(workLogRepo, logger),
+		templateRepo:  boardTemplateRepo,
+		recurrences:   boardRecurrenceRepo,
 		authorizer:    authorizer,
 		userClient:    userClient,
 		userInfoCache: userInfoCache,
+		fieldCache:    fieldCache,
 		logger:        logger,
 		db:            db,
 		uow:           unitOfWork,
@@ -109,6 +139,24 @@ func (s *boardService) CreateBoard(userID string, req *dto.CreateBoardRequest) (
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
 	}
 
+	// 1-1. Board template (optional): title pattern, description, assignee rule, field values and checklist
+	title := req.Title
+	content := req.Content
+	var template *boardFromTemplate
+	if req.TemplateID != nil {
+		template, err = s.loadBoardTemplate(*req.TemplateID, projectUUID)
+		if err != nil {
+			return nil, err
+		}
+		title = template.title(req.Title)
+		if content == "" {
+			content = template.template.Description
+		}
+	}
+	if err := validateBoardTitle(title); err != nil {
+		return nil, err
+	}
+
 	// 2. Validate Assignee (optional) using common parser
 	assigneeUUID, err := parser.ParseOptionalUUID(req.AssigneeID, "담당자")
 	if err != nil {
@@ -123,6 +171,11 @@ func (s *boardService) CreateBoard(userID string, req *dto.CreateBoardRequest) (
 			}
 			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "담당자 확인 실패", 500)
 		}
+	} else if template != nil {
+		// The template rule applies only while its assignee is still a member
+		if defaultAssignee := template.template.DefaultAssignee(userUUID); defaultAssignee != nil && s.isProjectMember(*defaultAssignee, projectUUID) {
+			assigneeUUID = defaultAssignee
+		}
 	}
 
 	// 2-1. Validate Participants (optional) using common parser
@@ -158,8 +211,8 @@ func (s *boardService) CreateBoard(userID string, req *dto.CreateBoardRequest) (
 	// 4. Create Board
 	board := &domain.Board{
 		ProjectID:         projectUUID,
-		Title:             req.Title,
-		Description:       req.Content,
+		Title:             title,
+		Description:       content,
 		AssigneeID:        assigneeUUID,
 		ParticipantIDs:    participantUUIDs,
 		CreatedBy:         userUUID,
@@ -167,10 +220,55 @@ func (s *boardService) CreateBoard(userID string, req *dto.CreateBoardRequest) (
 		CustomFieldsCache: "{}",  // Initialize empty, use FieldValueService to set values
 	}
 
-	err = s.repo.Create(board)
+	// 4-1. Parent board (optional): the new board becomes a sub-task of a board of the same project
+	if req.ParentBoardID != nil {
+		parent, err := s.findParentBoard(*req.ParentBoardID)
+		if err != nil {
+			return nil, err
+		}
+		if err := board.SetParent(parent); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+
+	// 5. Allocate the board number, save board and record the created event in the same transaction
+	// 사용자 정보는 외부 호출이므로 트랜잭션 밖에서 미리 조회
+	userMap := s.getUserInfoBatch(context.Background(), boardUserIDs(board))
+
+	var response *dto.BoardResponse
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		// 프로젝트 행 잠금으로 동시 생성이 직렬화되고, 롤백되면 번호도 함께 반환됨 (번호 공백 없음)
+		project, err := repos.Project.IncrementBoardSequence(projectUUID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return apperrors.New(apperrors.ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", 404)
+			}
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 번호 할당 실패", 500)
+		}
+		board.AssignNumber(project, project.BoardSequence)
+
+		if template != nil {
+			board.CustomFieldsCache = template.cache
+		}
+		if err := repos.Board.Create(board); err != nil {
+			s.logger.Error("Failed to create board", zap.Error(err))
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "칸반 생성 실패", 500)
+		}
+		if template != nil {
+			if err := template.apply(repos, board, userUUID); err != nil {
+				return err
+			}
+		}
+
+		response = s.buildBoardResponseWithUsers(repos.Field, board, userMap)
+
+		if err := repos.Outbox.Write(event.NewBoardEvent(event.BoardCreated, board.ProjectID, board.ID, userUUID, response)); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 생성 이벤트 기록 실패", 500)
+		}
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Failed to create board", zap.Error(err))
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "칸반 생성 실패", 500)
+		return nil, err
 	}
 
 	// Metrics: Record success
@@ -178,11 +276,18 @@ func (s *boardService) CreateBoard(userID string, req *dto.CreateBoardRequest) (
 	metrics.BoardCreatedTotal.WithLabelValues(projectIDStr).Inc()
 	metrics.RecordDuration(start, metrics.BoardOperationDuration, "create", projectIDStr)
 
+	createdActivity := domain.NewBoardActivity(board, userUUID, domain.BoardActivityCreated)
+	createdActivity.SetChange(domain.BoardActivityFieldTitle, nil, encodeActivityValue(board.Title))
+	s.activities.record(createdActivity)
+	s.notifier.boardChanged(nil, board, userUUID)
+	if template != nil && template.presets.stageOption != nil {
+		invalidateProjectAnalytics(s.fieldCache, s.logger, projectUUID)
+	}
+
 	// Note: Custom field values (stage, role, importance) should be set via FieldValueService
 	// after board creation using /field-values API
 
-	// 5. Build response
-	return s.buildBoardResponse(board)
+	return response, nil
 }
 
 // ==================== Get Single Board ====================
@@ -217,7 +322,50 @@ func (s *boardService) GetBoard(boardID, userID string) (*dto.BoardResponse, err
 	// 3. Build response
 	// Note: Custom field values are now in custom_fields_cache (JSONB)
 	// Frontend should fetch field definitions and parse custom_fields_cache
-	return s.buildBoardResponse(board)
+	response, err := s.buildBoardResponse(board)
+	if err != nil {
+		return nil, err
+	}
+	s.relations.attach(response, board.ID, userUUID)
+	s.progress.attach(response, board.ID)
+	s.timeTracking.attach(response, board)
+
+	return response, nil
+}
+
+// ==================== Get Board By Key ====================
+
+// GetBoardByKey finds a board by its key (e.g. "WEB-123"), case-insensitively
+// Keys a board had before moving to another project resolve to the board as well
+func (s *boardService) GetBoardByKey(key, userID string) (*dto.BoardResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Find board by current key or alias
+	board, err := s.repo.FindByKey(domain.NormalizeBoardKey(key))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+
+	// 2. Check if user is project member
+	if _, err := s.authorizer.RequireMember(userUUID, board.ProjectID); err != nil {
+		return nil, err
+	}
+
+	response, err := s.buildBoardResponse(board)
+	if err != nil {
+		return nil, err
+	}
+	s.relations.attach(response, board.ID, userUUID)
+	s.progress.attach(response, board.ID)
+	s.timeTracking.attach(response, board)
+
+	return response, nil
 }
 
 // ==================== Get Boards (List with Filters) ====================
@@ -259,23 +407,23 @@ func (s *boardService) GetBoards(userID string, req *dto.GetBoardsRequest) (*dto
 			filters.AuthorID = authorUUID
 		}
 	}
+	if req.ParentBoardID != "" {
+		parentUUID, err := parser.ParseUUID(req.ParentBoardID, "상위 보드")
+		if err == nil {
+			filters.ParentID = parentUUID
+		}
+	}
+	filters.TopLevelOnly = req.HideSubtasks
 
-	// 3. Validate pagination using common pagination utility
-	page, limit := pagination.ValidatePaginationParams(req.Page, req.Limit)
-
-	// 4. Fetch boards
-	boards, total, err := s.repo.FindByProject(projectUUID, filters, page, limit)
+	// 3-4. Fetch the page of boards, by cursor or by page number
+	boards, result, err := s.findBoardsPage(projectUUID, filters, req)
 	if err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+		return nil, err
 	}
 
 	if len(boards) == 0 {
-		return &dto.PaginatedBoardsResponse{
-			Boards: []dto.BoardResponse{},
-			Total:  total,
-			Page:   page,
-			Limit:  limit,
-		}, nil
+		result.Boards = []dto.BoardResponse{}
+		return result, nil
 	}
 
 	// 5. Collect user IDs for batch queries
@@ -359,21 +507,70 @@ func (s *boardService) GetBoards(userID string, req *dto.GetBoardsRequest) (*dto
 		}
 	}
 
-	// 11. Build responses
+	// 11. Batch fetch blocked flags, progress and time tracking
+	blocked := s.relations.blocked(boardIDs)
+	progress := s.progress.list(boardIDs)
+	timeTracking := s.timeTracking.list(boards)
+
+	// 12. Build responses
 	responses := make([]dto.BoardResponse, 0, len(boards))
 	for _, board := range boards {
 		response, err := s.buildBoardResponseOptimized(&board, userMap, fieldValuesMap, fieldsMap, optionsMap)
 		if err == nil && response != nil {
+			response.IsBlocked = blocked[board.ID]
+			response.Progress = progress[board.ID]
+			response.TimeTracking = timeTracking[board.ID]
 			responses = append(responses, *response)
 		}
 	}
 
-	return &dto.PaginatedBoardsResponse{
-		Boards: responses,
-		Total:  total,
-		Page:   page,
-		Limit:  limit,
-	}, nil
+	result.Boards = responses
+	return result, nil
+}
+
+// findBoardsPage reads the boards after (or before) the cursor of the request, or the page with its number
+// Pages read by number are always counted and carry the cursors of their first and last boards
+func (s *boardService) findBoardsPage(projectID uuid.UUID, filters repository.BoardFilters, req *dto.GetBoardsRequest) ([]domain.Board, *dto.PaginatedBoardsResponse, error) {
+	if req.Cursor == "" {
+		page, limit := pagination.ValidatePaginationParams(req.Page, req.Limit)
+		boards, total, err := s.repo.FindByProject(projectID, filters, page, limit)
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+		}
+		result := &dto.PaginatedBoardsResponse{Total: &total, Page: page, Limit: limit}
+		if len(boards) > 0 && int64(pagination.CalculateOffset(page, limit)+len(boards)) < total {
+			result.NextCursor = pagination.EncodeCursor(repository.BoardCursor(&boards[len(boards)-1], false))
+		}
+		if len(boards) > 0 && page > 1 {
+			result.PrevCursor = pagination.EncodeCursor(repository.BoardCursor(&boards[0], true))
+		}
+		return boards, result, nil
+	}
+
+	cursorReq, err := pagination.NewCursorRequest(req.Cursor, req.Limit, req.IncludeTotal)
+	if err != nil {
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 커서", 400)
+	}
+	page, err := s.repo.FindPageByProject(projectID, filters, cursorReq.Cursor, cursorReq.Limit)
+	if errors.Is(err, pagination.ErrInvalidCursor) {
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 커서", 400)
+	}
+	if err != nil {
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	result := &dto.PaginatedBoardsResponse{
+		Limit:      cursorReq.Limit,
+		NextCursor: pagination.EncodeCursor(page.Next),
+		PrevCursor: pagination.EncodeCursor(page.Prev),
+	}
+	if cursorReq.IncludeTotal {
+		total, err := s.repo.CountByProject(projectID, filters)
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 카운트 실패", 500)
+		}
+		result.Total = &total
+	}
+	return page.Items, result, nil
 }
 
 // ==================== Update Board ====================
@@ -411,6 +608,9 @@ func (s *boardService) UpdateBoard(boardID, userID string, req *dto.UpdateBoardR
 		return nil, apperrors.New(apperrors.ErrCodeForbidden, "수정 권한이 없습니다", 403)
 	}
 
+	// Keep a copy of the current state for the activity history
+	before := *board
+
 	// 3. Update fields using Domain methods (Rich Domain Model)
 	if req.Title != "" {
 		// Domain 메서드 사용: 검증 로직이 Domain에 포함됨
@@ -479,18 +679,40 @@ func (s *boardService) UpdateBoard(boardID, userID string, req *dto.UpdateBoardR
 		board.SetDueDate(*dueDate)
 	}
 
-	// 4. Save board
-	if err := s.repo.Update(board); err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 수정 실패", 500)
+	// 4. Save board and record the updated event in the same transaction
+	// 사용자 정보는 외부 호출이므로 트랜잭션 밖에서 미리 조회
+	userMap := s.getUserInfoBatch(context.Background(), boardUserIDs(board))
+
+	var response *dto.BoardResponse
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Board.Update(board); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 수정 실패", 500)
+		}
+
+		response = s.buildBoardResponseWithUsers(repos.Field, board, userMap)
+
+		if err := repos.Outbox.Write(event.NewBoardEvent(event.BoardUpdated, board.ProjectID, board.ID, userUUID, response)); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 수정 이벤트 기록 실패", 500)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	// 5. Record changed attributes
+	s.activities.record(buildBoardUpdateActivities(&before, board, userUUID)...)
+	s.notifier.boardChanged(&before, board, userUUID)
+	s.relations.attach(response, board.ID, userUUID)
+	s.progress.attach(response, board.ID)
+	s.timeTracking.attach(response, board)
+
 	// Metrics: Record success
 	projectIDStr := board.ProjectID.String()
 	metrics.BoardUpdatedTotal.WithLabelValues(projectIDStr).Inc()
 	metrics.RecordDuration(start, metrics.BoardOperationDuration, "update", projectIDStr)
 
-	// 5. Return updated board
-	return s.GetBoard(board.ID.String(), userID)
+	return response, nil
 }
 
 // ==================== Delete Board (Soft) ====================
@@ -531,8 +753,10 @@ func (s *boardService) DeleteBoard(boardID, userID string) error {
 
 	projectIDStr := board.ProjectID.String()
 
-	// 3. UnitOfWork로 보드와 댓글을 트랜잭션으로 삭제
+	// 3. UnitOfWork로 보드와 댓글, 필드 값을 트랜잭션으로 삭제 (휴지통에서 복원 가능)
 	err = s.uow.Do(func(repos *uow.Repositories) error {
+		trashItem := domain.NewTrashItem(domain.TrashItemBoard, board.ID, board.ProjectID, userUUID, board.Title)
+
 		// 3-1. 보드 삭제 (Domain 메서드 사용)
 		board.MarkAsDeleted()
 		if err := repos.Board.Update(board); err != nil {
@@ -555,6 +779,21 @@ func (s *boardService) DeleteBoard(boardID, userID string) error {
 			}
 		}
 
+		// 3-3. 필드 값 삭제
+		if err := repos.Field.DeleteFieldValuesByBoard(boardUUID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 삭제 실패", 500)
+		}
+
+		// 3-4. 휴지통에 기록
+		if err := repos.Trash.Create(trashItem); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "휴지통 기록 실패", 500)
+		}
+
+		// 3-5. 삭제 이벤트를 같은 트랜잭션으로 outbox에 기록
+		if err := repos.Outbox.Write(event.NewBoardEvent(event.BoardDeleted, board.ProjectID, board.ID, userUUID, nil)); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 삭제 이벤트 기록 실패", 500)
+		}
+
 		s.logger.Info("보드와 댓글 삭제 완료",
 			zap.String("board_id", boardUUID.String()),
 			zap.Int("comments_deleted", len(comments)),
@@ -568,33 +807,357 @@ func (s *boardService) DeleteBoard(boardID, userID string) error {
 	if err == nil {
 		metrics.BoardDeletedTotal.WithLabelValues(projectIDStr).Inc()
 		metrics.RecordDuration(start, metrics.BoardOperationDuration, "delete", projectIDStr)
+
+		// Kept with the board so the history shows the deletion after a restore
+		deletedActivity := domain.NewBoardActivity(board, userUUID, domain.BoardActivityDeleted)
+		deletedActivity.SetChange(domain.BoardActivityFieldTitle, encodeActivityValue(board.Title), nil)
+		s.activities.record(deletedActivity)
 	}
 
 	return err
 }
 
+// ==================== Move Board To Project ====================
+
+// MoveBoardToProject moves a board to another project of the same workspace
+// 다음 작업들이 하나의 트랜잭션으로 처리됩니다:
+//  1. 대상 프로젝트에서 새 보드 번호와 키 할당 (이전 키는 별칭으로 보존)
+//  2. 이전 프로젝트의 커스텀 필드 값 삭제
+//  3. 대상 프로젝트 멤버가 아닌 담당자, 참여자 해제
+//  4. 상위 보드와 하위 보드 연결 해제 (하위 보드는 이전 프로젝트에 남음)
+//  5. 이전 프로젝트에는 board.deleted, 대상 프로젝트에는 board.created 이벤트 기록
+func (s *boardService) MoveBoardToProject(boardID, targetProjectID, userID string) (*dto.BoardResponse, error) {
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	targetProjectUUID, err := parser.ParseProjectID(targetProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Find board
+	board, err := s.repo.FindByID(boardUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	if board.ProjectID == targetProjectUUID {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "이미 대상 프로젝트에 있는 보드입니다", 400)
+	}
+
+	// 2. Check permission in the current project (author or ADMIN+)
+	canEdit, err := s.authorizer.CanEdit(userUUID, board.ProjectID, board.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+	if !canEdit {
+		return nil, apperrors.New(apperrors.ErrCodeForbidden, "이동 권한이 없습니다", 403)
+	}
+
+	// 3. Check target project (same workspace, user is a member)
+	sourceProject, err := s.projectRepo.FindByID(board.ProjectID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 조회 실패", 500)
+	}
+	targetProject, err := s.projectRepo.FindByID(targetProjectUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "대상 프로젝트를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 조회 실패", 500)
+	}
+	if !targetProject.BelongsToWorkspace(sourceProject.WorkspaceID) {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "같은 워크스페이스의 프로젝트로만 이동할 수 있습니다", 400)
+	}
+	if _, err := s.authorizer.RequireMember(userUUID, targetProjectUUID); err != nil {
+		return nil, err
+	}
+
+	// Keep a copy of the current state for the activity history
+	before := *board
+
+	// 4. Unassign users who are not members of the target project
+	if board.AssigneeID != nil && !s.isProjectMember(*board.AssigneeID, targetProjectUUID) {
+		board.Unassign()
+	}
+	participantIDs := make([]uuid.UUID, 0, len(board.ParticipantIDs))
+	for _, participantID := range board.ParticipantIDs {
+		if s.isProjectMember(participantID, targetProjectUUID) {
+			participantIDs = append(participantIDs, participantID)
+		}
+	}
+	board.ParticipantIDs = participantIDs
+
+	// 5. Move board, keep the previous key as an alias and record the events in the same transaction
+	// 사용자 정보는 외부 호출이므로 트랜잭션 밖에서 미리 조회
+	userMap := s.getUserInfoBatch(context.Background(), boardUserIDs(board))
+
+	var response *dto.BoardResponse
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		project, err := repos.Project.IncrementBoardSequence(targetProjectUUID)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 번호 할당 실패", 500)
+		}
+		board.AssignNumber(project, project.BoardSequence)
+		board.CustomFieldsCache = "{}"
+
+		// Sub-tasks stay in the source project: the board leaves its parent and its sub-tasks become top-level boards
+		board.ClearParent()
+		if err := repos.Board.DetachChildren(board.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "하위 보드 분리 실패", 500)
+		}
+
+		// Sprints belong to the source project: the board goes to the backlog of the target project
+		if board.SprintID != nil {
+			if err := repos.Sprint.LeaveScope(*board.SprintID, board.ID, time.Now()); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 제외 실패", 500)
+			}
+			board.AssignSprint(nil)
+		}
+		// So do milestones
+		board.AssignMilestone(nil)
+
+		if err := repos.Field.DeleteFieldValuesByBoard(board.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 삭제 실패", 500)
+		}
+		if err := repos.Board.Update(board); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 이동 실패", 500)
+		}
+		if before.Key != "" {
+			if err := repos.Board.CreateKeyAlias(&domain.BoardKeyAlias{Key: before.Key, BoardID: board.ID}); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "이전 보드 키 보존 실패", 500)
+			}
+		}
+
+		response = s.buildBoardResponseWithUsers(repos.Field, board, userMap)
+
+		removedEvent := event.NewBoardEvent(event.BoardDeleted, before.ProjectID, board.ID, userUUID, map[string]interface{}{
+			"movedToProjectId": board.ProjectID.String(),
+			"key":              board.Key,
+		})
+		if err := repos.Outbox.Write(removedEvent); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 이동 이벤트 기록 실패", 500)
+		}
+		if err := repos.Outbox.Write(event.NewBoardEvent(event.BoardCreated, board.ProjectID, board.ID, userUUID, response)); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 이동 이벤트 기록 실패", 500)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 6. Record the key change along with any unassigned users
+	keyActivity := domain.NewBoardActivity(board, userUUID, domain.BoardActivityUpdated)
+	keyActivity.SetChange(domain.BoardActivityFieldKey, encodeActivityValue(before.Key), encodeActivityValue(board.Key))
+	s.activities.record(append([]domain.BoardActivity{keyActivity}, buildBoardUpdateActivities(&before, board, userUUID)...)...)
+	s.relations.attach(response, board.ID, userUUID)
+	s.progress.attach(response, board.ID)
+	s.timeTracking.attach(response, board)
+
+	return response, nil
+}
+
+// ==================== Sub-tasks ====================
+
+// SetParentBoard makes the board a sub-task of another board of the same project, or a top-level board again
+// A board cannot become a sub-task of itself or of one of its own sub-tasks (at any depth)
+func (s *boardService) SetParentBoard(boardID, userID string, req *dto.SetParentBoardRequest) (*dto.BoardResponse, error) {
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Find board
+	board, err := s.repo.FindByID(boardUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+
+	// 2. Check permission (author or ADMIN+)
+	canEdit, err := s.authorizer.CanEdit(userUUID, board.ProjectID, board.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+	if !canEdit {
+		return nil, apperrors.New(apperrors.ErrCodeForbidden, "수정 권한이 없습니다", 403)
+	}
+
+	// 3. Set or clear the parent (same project, not the board itself)
+	oldParentID := board.ParentBoardID
+	var parent *domain.Board
+	if req.ParentBoardID != nil && *req.ParentBoardID != "" {
+		parent, err = s.findParentBoard(*req.ParentBoardID)
+		if err != nil {
+			return nil, err
+		}
+		if err := board.SetParent(parent); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	} else {
+		board.ClearParent()
+	}
+
+	// 4. Check for cycles, save board and record the updated event in the same transaction
+	// The check runs in the saving transaction; two concurrent requests linking two boards to each other
+	// can still both succeed, in which case both boards are listed as sub-tasks
+	userMap := s.getUserInfoBatch(context.Background(), boardUserIDs(board))
+
+	var response *dto.BoardResponse
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if parent != nil {
+			cycle, err := createsParentCycle(repos.Board, board.ID, parent.ID)
+			if err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "상위 보드 확인 실패", 500)
+			}
+			if cycle {
+				return apperrors.New(apperrors.ErrCodeBadRequest, "하위 보드를 상위 보드로 지정할 수 없습니다", 400)
+			}
+		}
+
+		if err := repos.Board.Update(board); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 수정 실패", 500)
+		}
+
+		response = s.buildBoardResponseWithUsers(repos.Field, board, userMap)
+
+		if err := repos.Outbox.Write(event.NewBoardEvent(event.BoardUpdated, board.ProjectID, board.ID, userUUID, response)); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 수정 이벤트 기록 실패", 500)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 5. Record the parent change (keys are kept so the history stays readable after the parent is deleted)
+	parentActivity := domain.NewBoardActivity(board, userUUID, domain.BoardActivityUpdated)
+	parentActivity.SetChange(domain.BoardActivityFieldParent, s.parentActivityValue(oldParentID), s.parentActivityValue(board.ParentBoardID))
+	if parentActivity.HasChanged() {
+		s.activities.record(parentActivity)
+	}
+	s.relations.attach(response, board.ID, userUUID)
+	s.progress.attach(response, board.ID)
+	s.timeTracking.attach(response, board)
+
+	return response, nil
+}
+
+// findParentBoard finds the board that is to become a parent
+func (s *boardService) findParentBoard(parentBoardID string) (*domain.Board, error) {
+	parentUUID, err := parser.ParseUUID(parentBoardID, "상위 보드")
+	if err != nil {
+		return nil, err
+	}
+
+	parent, err := s.repo.FindByID(parentUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "상위 보드를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	return parent, nil
+}
+
+// parentActivityValue encodes a parent board as {boardId, key} for the activity history
+// The key is left out when the parent cannot be loaded (e.g. it is in the trash)
+func (s *boardService) parentActivityValue(parentID *uuid.UUID) *string {
+	if parentID == nil {
+		return nil
+	}
+	value := map[string]interface{}{"boardId": parentID.String()}
+	if parent, err := s.repo.FindByID(*parentID); err == nil {
+		value["key"] = parent.Key
+	}
+	return encodeActivityValue(value)
+}
+
+// createsParentCycle returns true if the board is the new parent or one of its ancestors,
+// i.e. if making parentID the parent of boardID would close a loop
+// Deleted boards are walked as well, since a restore brings their place in the hierarchy back
+func createsParentCycle(repo repository.BoardRepository, boardID, parentID uuid.UUID) (bool, error) {
+	visited := make(map[uuid.UUID]bool)
+	current := &parentID
+	for current != nil && !visited[*current] {
+		if *current == boardID {
+			return true, nil
+		}
+		visited[*current] = true
+
+		next, err := repo.FindParentID(*current)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return false, nil
+			}
+			return false, err
+		}
+		current = next
+	}
+	return false, nil
+}
+
+// isProjectMember returns true if the user is a member of the project
+// A failed lookup is treated as "not a member"
+func (s *boardService) isProjectMember(userID, projectID uuid.UUID) bool {
+	_, err := s.projectRepo.FindMemberByUserAndProject(userID, projectID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.logger.Warn("Failed to check project membership", zap.Error(err), zap.String("user_id", userID.String()))
+	}
+	return err == nil
+}
+
 // ==================== Helper: Build Board Response ====================
 
 func (s *boardService) buildBoardResponse(board *domain.Board) (*dto.BoardResponse, error) {
-	// Collect user IDs for batch query
+	// Fetch users with caching
+	ctx := context.Background()
+	userMap := s.getUserInfoBatch(ctx, boardUserIDs(board))
+
+	return s.buildBoardResponseWithUsers(s.fieldRepo, board, userMap), nil
+}
+
+// boardUserIDs collects the users shown in a board response (author, assignee, participants)
+func boardUserIDs(board *domain.Board) []string {
 	userIDs := []string{board.CreatedBy.String()}
 	if board.AssigneeID != nil {
 		userIDs = append(userIDs, board.AssigneeID.String())
 	}
-	// Add participant IDs
 	for _, participantID := range board.ParticipantIDs {
 		userIDs = append(userIDs, participantID.String())
 	}
+	return userIDs
+}
 
-	// Fetch users with caching
-	ctx := context.Background()
-	userMap := s.getUserInfoBatch(ctx, userIDs)
-
+// buildBoardResponseWithUsers builds a board response from pre-fetched users
+// fieldRepo is passed in so that the response can be built inside a transaction
+func (s *boardService) buildBoardResponseWithUsers(
+	fieldRepo repository.FieldRepository,
+	board *domain.Board,
+	userMap map[string]client.UserInfo,
+) *dto.BoardResponse {
 	// Use mapper to build response (eliminates duplication)
 	response := s.mapper.ToResponseWithUserMap(board, userMap)
 
 	// Fetch field values for this board
-	fieldValues, err := s.fieldRepo.FindFieldValuesByBoard(board.ID)
+	fieldValues, err := fieldRepo.FindFieldValuesByBoard(board.ID)
 	if err != nil {
 		s.logger.Warn("Failed to fetch field values", zap.Error(err), zap.String("board_id", board.ID.String()))
 	} else if len(fieldValues) > 0 {
@@ -613,7 +1176,7 @@ func (s *boardService) buildBoardResponse(board *domain.Board) (*dto.BoardRespon
 		}
 
 		// Fetch field metadata
-		fields, err := s.fieldRepo.FindFieldsByIDs(fieldIDs)
+		fields, err := fieldRepo.FindFieldsByIDs(fieldIDs)
 		if err != nil {
 			s.logger.Warn("Failed to fetch fields", zap.Error(err))
 		} else {
@@ -634,7 +1197,7 @@ func (s *boardService) buildBoardResponse(board *domain.Board) (*dto.BoardRespon
 			// Fetch options if any
 			optionsMap := make(map[string]domain.FieldOption)
 			if len(optionIDs) > 0 {
-				options, err := s.fieldRepo.FindOptionsByIDs(optionIDs)
+				options, err := fieldRepo.FindOptionsByIDs(optionIDs)
 				if err != nil {
 					s.logger.Warn("Failed to fetch options", zap.Error(err))
 				} else {
@@ -649,7 +1212,7 @@ func (s *boardService) buildBoardResponse(board *domain.Board) (*dto.BoardRespon
 		}
 	}
 
-	return response, nil
+	return response
 }
 
 // buildBoardResponseOptimized builds a board response using pre-fetched data (batch optimized)
@@ -808,6 +1371,12 @@ func (s *boardService) MoveBoard(userID, boardID string, req *dto.MoveBoardReque
 		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "유효하지 않은 옵션입니다", 400)
 	}
 
+	// Snapshot the current group value for the activity history
+	oldValue, err := snapshotFieldValue(s.fieldRepo, boardUUID, field)
+	if err != nil {
+		s.logger.Warn("Failed to snapshot field value", zap.Error(err), zap.String("board_id", boardID))
+	}
+
 	// 7. Generate new position using fractional indexing
 	var beforePos, afterPos string
 	if req.BeforePosition != nil {
@@ -822,10 +1391,21 @@ func (s *boardService) MoveBoard(userID, boardID string, req *dto.MoveBoardReque
 
 	// 8. Execute in transaction
 	var finalPosition string
+	stageChanged := false
 	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// Repositories bound to the transaction so that the move and its event commit together
+		fieldRepo := repository.NewFieldRepository(tx)
+
+		var oldStage *uuid.UUID
+		if isStageField(field) {
+			if oldStage, err = currentStageOption(fieldRepo, boardUUID, fieldUUID); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 조회 실패", 500)
+			}
+		}
+
 		// 8-1. Update field value (change column)
 		// Delete old value first
-		if err := s.fieldRepo.BatchDeleteFieldValues(boardUUID, fieldUUID); err != nil {
+		if err := fieldRepo.BatchDeleteFieldValues(boardUUID, fieldUUID); err != nil {
 			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "기존 필드 값 삭제 실패", 500)
 		}
 
@@ -836,10 +1416,17 @@ func (s *boardService) MoveBoard(userID, boardID string, req *dto.MoveBoardReque
 			ValueOptionID: &newValueUUID,
 			DisplayOrder:  0,
 		}
-		if err := s.fieldRepo.SetFieldValue(newFieldValue); err != nil {
+		if err := fieldRepo.SetFieldValue(newFieldValue); err != nil {
 			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 설정 실패", 500)
 		}
 
+		// Moving between Stage columns feeds the project analytics
+		if isStageField(field) {
+			if stageChanged, err = logStageChange(repository.NewStageChangeRepository(tx), board, fieldUUID, oldStage, &newValueUUID, userUUID); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Stage 변경 이력 기록 실패", 500)
+			}
+		}
+
 		// 8-2. Update board position (fractional indexing - only 1 row!)
 		boardOrder := domain.UserBoardOrder{
 			ViewID:   viewUUID,
@@ -847,17 +1434,28 @@ func (s *boardService) MoveBoard(userID, boardID string, req *dto.MoveBoardReque
 			BoardID:  boardUUID,
 			Position: newPosition,
 		}
-		if err := s.fieldRepo.SetBoardOrder(&boardOrder); err != nil {
+		if err := fieldRepo.SetBoardOrder(&boardOrder); err != nil {
 			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 순서 업데이트 실패", 500)
 		}
 
 		finalPosition = newPosition
 
 		// 8-3. Update JSONB cache
-		if _, err := s.fieldRepo.UpdateBoardFieldCache(boardUUID); err != nil {
+		if _, err := fieldRepo.UpdateBoardFieldCache(boardUUID); err != nil {
 			s.logger.Warn("Failed to update board cache", zap.Error(err))
 		}
 
+		// 8-4. Record the move event in the outbox
+		movedEvent := event.NewBoardEvent(event.BoardMoved, board.ProjectID, board.ID, userUUID, map[string]interface{}{
+			"viewId":   req.ViewID,
+			"fieldId":  req.GroupByFieldID,
+			"optionId": req.NewFieldValue,
+			"position": finalPosition,
+		})
+		if err := repository.NewOutboxRepository(tx).Write(movedEvent); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 이동 이벤트 기록 실패", 500)
+		}
+
 		return nil
 	})
 
@@ -867,6 +1465,19 @@ func (s *boardService) MoveBoard(userID, boardID string, req *dto.MoveBoardReque
 		}
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 이동 실패", 500)
 	}
+	if stageChanged {
+		invalidateProjectAnalytics(s.fieldCache, s.logger, board.ProjectID)
+	}
+
+	// 9. Record the move (old group → new group)
+	// Reordering inside the same group is not recorded (old == new)
+	var newValue interface{} = map[string]interface{}{"optionId": option.ID.String(), "label": option.Label}
+	if field.FieldType == domain.FieldTypeMultiSelect {
+		newValue = []interface{}{newValue}
+	}
+	movedActivity := domain.NewBoardActivity(board, userUUID, domain.BoardActivityMoved)
+	movedActivity.SetCustomFieldChange(field, encodeActivityValue(oldValue), encodeActivityValue(newValue))
+	s.activities.record(movedActivity)
 
 	return &dto.MoveBoardResponse{
 		BoardID:       boardID,
@@ -141,6 +141,11 @@ type BoardServiceTestSuite struct {
 	roleRepo      *testutil.MockRoleRepository
 	fieldRepo     *testutil.MockFieldRepository
 	commentRepo   *testutil.MockCommentRepository
+	activityRepo  *testutil.MockBoardActivityRepository
+	notifyRepo    *testutil.MockNotificationRepository
+	relationRepo  *testutil.MockBoardRelationRepository
+	checklistRepo *testutil.MockChecklistRepository
+	workLogRepo   *testutil.MockWorkLogRepository
 	userClient    *MockUserClient
 	userInfoCache *MockUserInfoCache
 	logger        *zap.Logger
@@ -154,6 +159,11 @@ func setupBoardServiceTest(t *testing.T) *BoardServiceTestSuite {
 		roleRepo:      new(testutil.MockRoleRepository),
 		fieldRepo:     new(testutil.MockFieldRepository),
 		commentRepo:   new(testutil.MockCommentRepository),
+		activityRepo:  new(testutil.MockBoardActivityRepository),
+		notifyRepo:    new(testutil.MockNotificationRepository),
+		relationRepo:  new(testutil.MockBoardRelationRepository),
+		checklistRepo: new(testutil.MockChecklistRepository),
+		workLogRepo:   new(testutil.MockWorkLogRepository),
 		userClient:    new(MockUserClient),
 		userInfoCache: new(MockUserInfoCache),
 		logger:        zap.NewNop(),
@@ -166,12 +176,30 @@ func setupBoardServiceTest(t *testing.T) *BoardServiceTestSuite {
 		suite.roleRepo,
 		suite.fieldRepo,
 		suite.commentRepo,
+		suite.activityRepo,
+		suite.notifyRepo,
+		suite.relationRepo,
+		suite.checklistRepo,
+		suite.workLogRepo,
+		nil, // boardTemplateRepo - only used with a templateId
+		nil, // boardRecurrenceRepo - only used by the recurrence scheduler
 		suite.userClient,
 		suite.userInfoCache,
+		nil, // fieldCache - only used by MoveBoard
 		suite.logger,
 		nil, // db - will be mocked when needed
 	)
 
+	// Activity history and notifications are best-effort and verified in dedicated tests
+	suite.activityRepo.On("BatchCreate", mock.Anything).Return(nil).Maybe()
+	suite.notifyRepo.On("BatchCreate", mock.Anything).Return(nil).Maybe()
+	suite.notifyRepo.On("AddWatchers", mock.Anything, mock.Anything).Return(nil).Maybe()
+	suite.relationRepo.On("FindByBoard", mock.Anything).Return([]domain.BoardRelation{}, nil).Maybe()
+	suite.relationRepo.On("FilterBlocked", mock.Anything).Return([]uuid.UUID{}, nil).Maybe()
+	suite.boardRepo.On("FindChildIDs", mock.Anything).Return(map[uuid.UUID][]uuid.UUID{}, nil).Maybe()
+	suite.checklistRepo.On("CountByBoards", mock.Anything).Return(map[uuid.UUID]domain.ChecklistCount{}, nil).Maybe()
+	suite.workLogRepo.On("SumByBoards", mock.Anything).Return(map[uuid.UUID]int{}, nil).Maybe()
+
 	return suite
 }
 
@@ -443,6 +471,98 @@ func TestGetBoard_UserNotMember(t *testing.T) {
 	assert.Equal(t, apperrors.ErrCodeForbidden, appErr.Code)
 }
 
+// ==================== GetBoardByKey Tests ====================
+
+func TestGetBoardByKey_Success(t *testing.T) {
+	suite := setupBoardServiceTest(t)
+	defer suite.boardRepo.AssertExpectations(t)
+
+	userID := uuid.New()
+	projectID := uuid.New()
+	board := testutil.NewTestBoard(projectID, userID)
+	board.Number = 12
+	board.Key = "WEB-12"
+	member := testutil.NewTestProjectMember(projectID, userID, uuid.New())
+	member.Role = testutil.NewMemberRole()
+
+	// The key is looked up case-insensitively
+	suite.boardRepo.On("FindByKey", "WEB-12").Return(board, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(member, nil)
+	suite.userInfoCache.On("GetSimpleUsersBatch", mock.Anything, mock.Anything).
+		Return(make(map[string]*cache.SimpleUser), nil)
+	suite.userClient.On("GetUsersBatch", mock.Anything, mock.Anything).
+		Return([]client.UserInfo{{UserID: userID.String(), Name: "Test User"}}, nil)
+	suite.userInfoCache.On("SetSimpleUsersBatch", mock.Anything, mock.Anything).Return(nil)
+	suite.fieldRepo.On("FindFieldValuesByBoard", board.ID).Return([]domain.BoardFieldValue{}, nil)
+
+	result, err := suite.service.GetBoardByKey(" web-12 ", userID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, board.ID.String(), result.ID)
+	assert.Equal(t, "WEB-12", result.Key)
+	assert.Equal(t, 12, result.Number)
+}
+
+func TestGetBoardByKey_NotFound(t *testing.T) {
+	suite := setupBoardServiceTest(t)
+
+	suite.boardRepo.On("FindByKey", "WEB-404").Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := suite.service.GetBoardByKey("WEB-404", uuid.New().String())
+
+	assert.Nil(t, result)
+	var appErr *apperrors.AppError
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, apperrors.ErrCodeNotFound, appErr.Code)
+}
+
+// ==================== MoveBoardToProject Tests ====================
+
+func TestMoveBoardToProject_SameProject(t *testing.T) {
+	suite := setupBoardServiceTest(t)
+
+	userID := uuid.New()
+	projectID := uuid.New()
+	board := testutil.NewTestBoard(projectID, userID)
+	suite.boardRepo.On("FindByID", board.ID).Return(board, nil)
+
+	result, err := suite.service.MoveBoardToProject(board.ID.String(), projectID.String(), userID.String())
+
+	assert.Nil(t, result)
+	var appErr *apperrors.AppError
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, 400, appErr.HTTPStatus)
+}
+
+func TestMoveBoardToProject_OtherWorkspace(t *testing.T) {
+	suite := setupBoardServiceTest(t)
+	defer suite.boardRepo.AssertExpectations(t)
+
+	userID := uuid.New()
+	sourceProjectID := uuid.New()
+	targetProjectID := uuid.New()
+	board := testutil.NewTestBoard(sourceProjectID, userID)
+	member := testutil.NewTestProjectMember(sourceProjectID, userID, uuid.New())
+	member.Role = testutil.NewMemberRole()
+
+	suite.boardRepo.On("FindByID", board.ID).Return(board, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, sourceProjectID).Return(member, nil)
+	suite.roleRepo.On("FindByID", member.RoleID).Return(member.Role, nil).Maybe()
+	suite.projectRepo.On("FindByID", sourceProjectID).
+		Return(&domain.Project{BaseModel: domain.BaseModel{ID: sourceProjectID}, WorkspaceID: uuid.New()}, nil)
+	suite.projectRepo.On("FindByID", targetProjectID).
+		Return(&domain.Project{BaseModel: domain.BaseModel{ID: targetProjectID}, WorkspaceID: uuid.New()}, nil)
+
+	result, err := suite.service.MoveBoardToProject(board.ID.String(), targetProjectID.String(), userID.String())
+
+	// Then: Boards never leave their workspace and nothing is written
+	assert.Nil(t, result)
+	var appErr *apperrors.AppError
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, 400, appErr.HTTPStatus)
+	suite.boardRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
 // ==================== GetBoards Tests ====================
 
 func TestGetBoards_Success(t *testing.T) {
@@ -486,7 +606,7 @@ func TestGetBoards_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 	assert.Equal(t, 2, len(result.Boards))
-	assert.Equal(t, int64(2), result.Total)
+	assert.Equal(t, int64(2), *result.Total)
 }
 
 func TestGetBoards_DefaultPagination(t *testing.T) {
@@ -3,7 +3,6 @@ package service
 import (
 	"board-service/internal/apperrors"
 	"board-service/internal/common/parser"
-	"board-service/internal/dto"
 )
 
 // ==================== Unit of Work 적용 예제 ====================
@@ -86,126 +85,16 @@ func (s *boardService) DeleteBoardWithComments(boardID, userID string) error {
 }
 
 // ==================== 예제 2: 보드 이동 (프로젝트 간 이동) ====================
-
-// MoveBoardToProject는 보드를 다른 프로젝트로 이동합니다
-// 이동 시 다음 작업들이 하나의 트랜잭션으로 처리됩니다:
-//   1. 보드의 ProjectID 변경
-//   2. 이전 프로젝트의 커스텀 필드 값 삭제
-//   3. 담당자가 새 프로젝트 멤버가 아니면 할당 해제
-//   4. 보드 순서 초기화
-//
-// UnitOfWork의 이점:
-//   - 복잡한 다단계 작업의 원자성 보장
-//   - 실패 시 모든 변경사항 자동 롤백
-//   - 코드 가독성 향상 (비즈니스 로직에 집중)
 //
-func (s *boardService) MoveBoardToProject(boardID, targetProjectID, userID string) (*dto.BoardResponse, error) {
-	// Parse UUIDs
-	boardUUID, err := parser.ParseBoardID(boardID)
-	if err != nil {
-		return nil, err
-	}
-
-	targetProjectUUID, err := parser.ParseProjectID(targetProjectID)
-	if err != nil {
-		return nil, err
-	}
-
-	userUUID, err := parser.ParseUserID(userID)
-	if err != nil {
-		return nil, err
-	}
-
-	// return s.uow.Do(func(repos *uow.Repositories) error {
-	// 	// 1. 보드 조회
-	// 	board, err := repos.Board.FindByID(boardUUID)
-	// 	if err != nil {
-	// 		return apperrors.Wrap(err, apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
-	// 	}
-	//
-	// 	// 2. 현재 프로젝트에서 이동 권한 확인
-	// 	canEdit, err := s.authorizer.CanEdit(userUUID, board.ProjectID, board.CreatedBy)
-	// 	if err != nil {
-	// 		return err
-	// 	}
-	// 	if !canEdit {
-	// 		return apperrors.New(apperrors.ErrCodeForbidden, "이동 권한이 없습니다", 403)
-	// 	}
-	//
-	// 	// 3. 대상 프로젝트 존재 확인 및 멤버십 확인
-	// 	targetProject, err := repos.Project.FindByID(targetProjectUUID)
-	// 	if err != nil {
-	// 		return apperrors.Wrap(err, apperrors.ErrCodeNotFound, "대상 프로젝트를 찾을 수 없습니다", 404)
-	// 	}
-	//
-	// 	_, err = repos.Project.FindMemberByUserAndProject(userUUID, targetProjectUUID)
-	// 	if err != nil {
-	// 		return apperrors.New(apperrors.ErrCodeForbidden, "대상 프로젝트의 멤버가 아닙니다", 403)
-	// 	}
-	//
-	// 	// 4. 보드 이동 (프로젝트 변경)
-	// 	board.ProjectID = targetProject.ID
-	//
-	// 	// 5. 담당자가 새 프로젝트 멤버인지 확인
-	// 	if board.AssigneeID != nil {
-	// 		_, err := repos.Project.FindMemberByUserAndProject(*board.AssigneeID, targetProjectUUID)
-	// 		if err != nil {
-	// 			// 새 프로젝트 멤버가 아니면 할당 해제 (Domain 메서드)
-	// 			board.Unassign()
-	// 		}
-	// 	}
-	//
-	// 	// 6. 이전 프로젝트의 커스텀 필드 값 모두 삭제
-	// 	fieldValues, err := repos.Field.FindFieldValuesByBoard(boardUUID)
-	// 	if err != nil {
-	// 		return err
-	// 	}
-	// 	for _, fv := range fieldValues {
-	// 		if err := repos.Field.DeleteFieldValue(boardUUID, fv.FieldID); err != nil {
-	// 			return err
-	// 		}
-	// 	}
-	//
-	// 	// 7. 보드 저장
-	// 	if err := repos.Board.Update(board); err != nil {
-	// 		return err
-	// 	}
-	//
-	// 	return nil
-	// })
-
-	// 현재는 UoW가 Service에 주입되지 않았으므로 주석 처리
-	_, _, _ = boardUUID, targetProjectUUID, userUUID
-	return nil, apperrors.New(apperrors.ErrCodeNotImplemented, "UoW 미구현", 501)
-}
+// boardService.MoveBoardToProject (board_service.go)에 실제로 구현되어 있습니다.
+// 새 보드 번호 할당(프로젝트 행 잠금) → 필드 값 삭제 → 보드 저장 → 이전 키 별칭 기록 → outbox 이벤트 기록이
+// 하나의 트랜잭션으로 처리되고, 활동 기록은 커밋 이후에 수행됩니다.
 
 // ==================== 예제 3: 프로젝트 삭제 시 모든 관련 데이터 삭제 ====================
-
-// DeleteProjectWithAllData는 프로젝트와 모든 관련 데이터를 삭제합니다
-// 다음 작업들이 하나의 트랜잭션으로 처리됩니다:
-//   1. 프로젝트의 모든 보드 조회 및 삭제
-//   2. 각 보드의 모든 댓글 삭제
-//   3. 프로젝트의 모든 커스텀 필드 삭제
-//   4. 프로젝트의 모든 멤버 관계 삭제
-//   5. 프로젝트 자체 삭제
-//
-// 이런 복잡한 cascade 삭제는 UnitOfWork 없이 구현하기 매우 어렵습니다.
-// UoW를 사용하면 중간에 실패해도 모든 변경사항이 자동으로 롤백됩니다.
 //
-func DeleteProjectWithAllData(projectID, userID string) error {
-	// 구현은 위 패턴과 동일
-	// return s.uow.Do(func(repos *uow.Repositories) error {
-	//     // 1. 프로젝트 조회 및 권한 확인
-	//     // 2. 모든 보드 조회 및 삭제
-	//     // 3. 각 보드의 댓글 삭제
-	//     // 4. 커스텀 필드 삭제
-	//     // 5. 멤버 관계 삭제
-	//     // 6. 프로젝트 삭제
-	//     return nil
-	// })
-	_, _ = projectID, userID
-	return apperrors.New(apperrors.ErrCodeNotImplemented, "예제 함수", 501)
-}
+// projectService.DeleteProjectWithAllData (project_service.go)에 실제로 구현되어 있습니다.
+// 하위 데이터 조회 → 일괄 삭제 → outbox 이벤트 기록이 하나의 트랜잭션으로 처리되고,
+// 캐시 무효화는 커밋 이후에 수행됩니다 (외부 시스템 호출은 트랜잭션 밖에서).
 
 // ==================== UnitOfWork 적용 시 주의사항 ====================
 //
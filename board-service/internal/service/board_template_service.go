@@ -0,0 +1,844 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/auth"
+	"board-service/internal/common/parser"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/event"
+	"board-service/internal/metrics"
+	"board-service/internal/repository"
+	"board-service/internal/uow"
+	"board-service/internal/util"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// BoardTemplateService는 프로젝트의 보드 템플릿(제목 패턴, 본문, 필드 값, 체크리스트, 기본 담당자 규칙)을 관리합니다
+// 프로젝트 멤버라면 누구나 템플릿을 만들고 사용할 수 있으며, 수정/삭제는 작성자 또는 ADMIN 이상만 가능합니다
+// 필드 값은 저장할 때 검증하고, 보드를 만들 때는 그 사이 삭제된 필드/옵션이나 탈퇴한 멤버의 값을 건너뜁니다
+// 템플릿마다 하나의 반복 규칙을 둘 수 있으며, 규칙에 따른 보드 생성은 BoardService.CreateDueRecurringBoards가 수행합니다
+type BoardTemplateService interface {
+	CreateTemplate(userID, projectID string, req *dto.CreateBoardTemplateRequest) (*dto.BoardTemplateResponse, error)
+	GetTemplates(userID, projectID string) ([]dto.BoardTemplateResponse, error)
+	GetTemplate(userID, templateID string) (*dto.BoardTemplateResponse, error)
+	UpdateTemplate(userID, templateID string, req *dto.UpdateBoardTemplateRequest) (*dto.BoardTemplateResponse, error)
+	DeleteTemplate(userID, templateID string) error
+
+	SetRecurrence(userID, templateID string, req *dto.SetBoardRecurrenceRequest) (*dto.BoardRecurrenceResponse, error)
+	GetRecurrence(userID, templateID string) (*dto.BoardRecurrenceResponse, error)
+	DeleteRecurrence(userID, templateID string) error
+	GetProjectRecurrences(userID, projectID string) ([]dto.BoardRecurrenceResponse, error)
+}
+
+type boardTemplateService struct {
+	repo           repository.BoardTemplateRepository
+	recurrenceRepo repository.BoardRecurrenceRepository
+	fieldRepo      repository.FieldRepository
+	projectRepo    repository.ProjectRepository
+	authorizer     auth.ProjectAuthorizer
+	logger         *zap.Logger
+	uow            uow.UnitOfWork
+}
+
+func NewBoardTemplateService(
+	repo repository.BoardTemplateRepository,
+	recurrenceRepo repository.BoardRecurrenceRepository,
+	fieldRepo repository.FieldRepository,
+	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
+	logger *zap.Logger,
+	db *gorm.DB,
+) BoardTemplateService {
+	return &boardTemplateService{
+		repo:           repo,
+		recurrenceRepo: recurrenceRepo,
+		fieldRepo:      fieldRepo,
+		projectRepo:    projectRepo,
+		authorizer:     auth.NewProjectAuthorizer(projectRepo, roleRepo),
+		logger:         logger,
+		uow:            uow.NewUnitOfWork(db),
+	}
+}
+
+// ==================== Templates ====================
+
+// CreateTemplate creates a board template of the project
+func (s *boardTemplateService) CreateTemplate(userID, projectID string, req *dto.CreateBoardTemplateRequest) (*dto.BoardTemplateResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizer.RequireMember(userUUID, projectUUID); err != nil {
+		return nil, err
+	}
+
+	template, err := domain.NewBoardTemplate(projectUUID, req.Name, userUUID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+	if err := template.UpdateTitlePattern(req.TitlePattern); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+	if err := template.UpdateDescription(req.Description); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+	if err := template.SetChecklist(req.Checklist); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+	rule := req.AssigneeRule
+	if rule == "" {
+		rule = string(domain.BoardTemplateAssigneeNone)
+	}
+	if err := s.applyAssigneeRule(template, rule, req.AssigneeID); err != nil {
+		return nil, err
+	}
+	if err := s.applyFieldValues(template, req.FieldValues); err != nil {
+		return nil, err
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.BoardTemplate.Create(template); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 템플릿 생성 실패", 500)
+		}
+		return writeBoardTemplateEvent(repos.Outbox, event.BoardTemplateCreated, template, userUUID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toBoardTemplateResponse(template), nil
+}
+
+// GetTemplates lists the board templates of the project ordered by name
+func (s *boardTemplateService) GetTemplates(userID, projectID string) ([]dto.BoardTemplateResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizer.RequireMember(userUUID, projectUUID); err != nil {
+		return nil, err
+	}
+
+	templates, err := s.repo.FindByProject(projectUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 템플릿 조회 실패", 500)
+	}
+
+	responses := make([]dto.BoardTemplateResponse, 0, len(templates))
+	for i := range templates {
+		responses = append(responses, *toBoardTemplateResponse(&templates[i]))
+	}
+	return responses, nil
+}
+
+func (s *boardTemplateService) GetTemplate(userID, templateID string) (*dto.BoardTemplateResponse, error) {
+	_, template, err := s.findTemplate(userID, templateID, false)
+	if err != nil {
+		return nil, err
+	}
+	return toBoardTemplateResponse(template), nil
+}
+
+// UpdateTemplate changes the given attributes of the template
+func (s *boardTemplateService) UpdateTemplate(userID, templateID string, req *dto.UpdateBoardTemplateRequest) (*dto.BoardTemplateResponse, error) {
+	userUUID, template, err := s.findTemplate(userID, templateID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		if err := template.UpdateName(*req.Name); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+	if req.TitlePattern != nil {
+		if err := template.UpdateTitlePattern(*req.TitlePattern); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+	if req.Description != nil {
+		if err := template.UpdateDescription(*req.Description); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+	if req.Checklist != nil {
+		if err := template.SetChecklist(req.Checklist); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+	if req.AssigneeRule != nil || req.AssigneeID != nil {
+		rule := string(template.AssigneeRule)
+		if req.AssigneeRule != nil {
+			rule = *req.AssigneeRule
+		}
+		if err := s.applyAssigneeRule(template, rule, req.AssigneeID); err != nil {
+			return nil, err
+		}
+	}
+	if req.FieldValues != nil {
+		if err := s.applyFieldValues(template, req.FieldValues); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.BoardTemplate.Update(template); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 템플릿 수정 실패", 500)
+		}
+		return writeBoardTemplateEvent(repos.Outbox, event.BoardTemplateUpdated, template, userUUID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toBoardTemplateResponse(template), nil
+}
+
+// DeleteTemplate deletes the template; boards created from it are kept
+func (s *boardTemplateService) DeleteTemplate(userID, templateID string) error {
+	userUUID, template, err := s.findTemplate(userID, templateID, true)
+	if err != nil {
+		return err
+	}
+
+	return s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.BoardTemplate.Delete(template.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 템플릿 삭제 실패", 500)
+		}
+		// The recurrence of the template stops with it
+		if err := repos.Recurrence.DeleteByTemplate(template.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "반복 규칙 삭제 실패", 500)
+		}
+		return writeBoardTemplateEvent(repos.Outbox, event.BoardTemplateDeleted, template, userUUID)
+	})
+}
+
+// ==================== Helper Methods ====================
+
+// findTemplate finds the template and checks that the user is a member of its project
+// With edit set, the user must also be the creator of the template or an ADMIN
+func (s *boardTemplateService) findTemplate(userID, templateID string, edit bool) (uuid.UUID, *domain.BoardTemplate, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+	templateUUID, err := parser.ParseUUID(templateID, "템플릿")
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	template, err := s.repo.FindByID(templateUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, nil, apperrors.New(apperrors.ErrCodeNotFound, "보드 템플릿을 찾을 수 없습니다", 404)
+		}
+		return uuid.Nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 템플릿 조회 실패", 500)
+	}
+	if _, err := s.authorizer.RequireMember(userUUID, template.ProjectID); err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	if edit {
+		canEdit, err := s.authorizer.CanEdit(userUUID, template.ProjectID, template.CreatedBy)
+		if err != nil {
+			return uuid.Nil, nil, err
+		}
+		if !canEdit {
+			return uuid.Nil, nil, apperrors.New(apperrors.ErrCodeForbidden, "보드 템플릿을 수정할 권한이 없습니다", 403)
+		}
+	}
+	return userUUID, template, nil
+}
+
+// applyAssigneeRule sets the default assignee rule; the USER rule needs a member of the project
+func (s *boardTemplateService) applyAssigneeRule(template *domain.BoardTemplate, rule string, assigneeID *string) error {
+	var assigneeUUID *uuid.UUID
+	if domain.BoardTemplateAssigneeRule(rule) == domain.BoardTemplateAssigneeUser {
+		if assigneeID == nil || *assigneeID == "" {
+			assigneeUUID = template.AssigneeID
+		} else {
+			parsed, err := parser.ParseUUID(*assigneeID, "담당자")
+			if err != nil {
+				return err
+			}
+			assigneeUUID = &parsed
+		}
+		if assigneeUUID != nil {
+			if _, err := s.projectRepo.FindMemberByUserAndProject(*assigneeUUID, template.ProjectID); err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return apperrors.New(apperrors.ErrCodeBadRequest, "담당자가 프로젝트 멤버가 아닙니다", 400)
+				}
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "담당자 확인 실패", 500)
+			}
+		}
+	}
+
+	if err := template.SetAssigneeRule(domain.BoardTemplateAssigneeRule(rule), assigneeUUID); err != nil {
+		return apperrors.FromDomainError(err)
+	}
+	return nil
+}
+
+// applyFieldValues validates the preset values against the fields of the project and keeps them in the template
+func (s *boardTemplateService) applyFieldValues(template *domain.BoardTemplate, values map[string]interface{}) error {
+	if _, err := resolvePresetValues(s.fieldRepo, s.projectRepo, template.ProjectID, values, true); err != nil {
+		return err
+	}
+	if err := template.SetFieldValues(values); err != nil {
+		return apperrors.FromDomainError(err)
+	}
+	return nil
+}
+
+func writeBoardTemplateEvent(outbox repository.OutboxWriter, eventType event.Type, template *domain.BoardTemplate, actorID uuid.UUID) error {
+	data := map[string]interface{}{
+		"template": toBoardTemplateResponse(template),
+	}
+	if err := outbox.Write(event.New(eventType, template.ProjectID, actorID, data)); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 템플릿 이벤트 기록 실패", 500)
+	}
+	return nil
+}
+
+func toBoardTemplateResponse(template *domain.BoardTemplate) *dto.BoardTemplateResponse {
+	fieldValues, err := template.DecodeFieldValues()
+	if err != nil {
+		fieldValues = map[string]interface{}{}
+	}
+	checklist, err := template.DecodeChecklist()
+	if err != nil {
+		checklist = []string{}
+	}
+
+	response := &dto.BoardTemplateResponse{
+		TemplateID:   template.ID.String(),
+		ProjectID:    template.ProjectID.String(),
+		Name:         template.Name,
+		TitlePattern: template.TitlePattern,
+		Description:  template.Description,
+		FieldValues:  fieldValues,
+		Checklist:    checklist,
+		AssigneeRule: string(template.AssigneeRule),
+		CreatedBy:    template.CreatedBy.String(),
+		CreatedAt:    template.CreatedAt,
+		UpdatedAt:    template.UpdatedAt,
+	}
+	if template.AssigneeID != nil {
+		assigneeID := template.AssigneeID.String()
+		response.AssigneeID = &assigneeID
+	}
+	return response
+}
+
+// ==================== Preset Field Values ====================
+
+// boardPresets are the field values a template gives to a new board
+type boardPresets struct {
+	values      []domain.BoardFieldValue // Without a board; see forBoard
+	fieldTypes  map[uuid.UUID]domain.FieldType
+	stageField  uuid.UUID
+	stageOption *uuid.UUID // Preset Stage option, recorded in the stage history
+}
+
+// forBoard returns the preset values as rows of the board
+func (p *boardPresets) forBoard(boardID uuid.UUID) []domain.BoardFieldValue {
+	values := make([]domain.BoardFieldValue, len(p.values))
+	for i, value := range p.values {
+		value.BoardID = boardID
+		values[i] = value
+	}
+	return values
+}
+
+// resolvePresetValues converts preset values (field ID to value, as in the field-values API) into field value rows
+// With strict set (saving a template), an unknown field or an invalid value is an error;
+// otherwise (creating a board) it is skipped, since fields, options and members may have changed since
+func resolvePresetValues(fieldRepo repository.FieldRepository, projectRepo repository.ProjectRepository, projectID uuid.UUID, presets map[string]interface{}, strict bool) (*boardPresets, error) {
+	result := &boardPresets{fieldTypes: make(map[uuid.UUID]domain.FieldType, len(presets))}
+	if len(presets) == 0 {
+		return result, nil
+	}
+
+	fields, err := fieldRepo.FindFieldsByProject(projectID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	fieldsByID := make(map[uuid.UUID]*domain.ProjectField, len(fields))
+	for i := range fields {
+		fieldsByID[fields[i].ID] = &fields[i]
+	}
+
+	isMember := func(userID uuid.UUID) bool {
+		_, err := projectRepo.FindMemberByUserAndProject(userID, projectID)
+		return err == nil
+	}
+
+	for key, raw := range presets {
+		fieldID, err := uuid.Parse(key)
+		var field *domain.ProjectField
+		if err == nil {
+			field = fieldsByID[fieldID]
+		}
+		if field == nil {
+			if strict {
+				return nil, apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("프로젝트에 없는 필드입니다: %s", key), 400)
+			}
+			continue
+		}
+
+		var options map[uuid.UUID]bool
+		if field.FieldType == domain.FieldTypeSingleSelect || field.FieldType == domain.FieldTypeMultiSelect {
+			fieldOptions, err := fieldRepo.FindOptionsByField(field.ID)
+			if err != nil {
+				return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 옵션 조회 실패", 500)
+			}
+			options = make(map[uuid.UUID]bool, len(fieldOptions))
+			for _, option := range fieldOptions {
+				options[option.ID] = true
+			}
+		}
+
+		values, err := presetFieldValues(field, raw, options, isMember)
+		if err != nil {
+			if strict {
+				return nil, err
+			}
+			continue
+		}
+		result.values = append(result.values, values...)
+		result.fieldTypes[field.ID] = field.FieldType
+		if isStageField(field) && len(values) > 0 {
+			result.stageField = field.ID
+			result.stageOption = values[0].ValueOptionID
+		}
+	}
+	return result, nil
+}
+
+// presetFieldValues converts one preset value into the rows of its field with the same rules as the field-values API
+// Multi-select and multi-user fields take an array; options must belong to the field and users must be project members
+func presetFieldValues(field *domain.ProjectField, raw interface{}, options map[uuid.UUID]bool, isMember func(uuid.UUID) bool) ([]domain.BoardFieldValue, error) {
+	var config domain.FieldConfig
+	if field.Config != "" {
+		_ = json.Unmarshal([]byte(field.Config), &config)
+	}
+	invalid := func(message string) error {
+		return apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("%s: %s", field.Name, message), 400)
+	}
+	parseID := func(value interface{}, message string) (uuid.UUID, error) {
+		str, ok := value.(string)
+		if !ok {
+			return uuid.Nil, invalid(message)
+		}
+		id, err := uuid.Parse(str)
+		if err != nil {
+			return uuid.Nil, invalid(message)
+		}
+		return id, nil
+	}
+	row := domain.BoardFieldValue{FieldID: field.ID}
+
+	switch field.FieldType {
+	case domain.FieldTypeText:
+		text, ok := raw.(string)
+		if !ok {
+			return nil, invalid("텍스트 값이 필요합니다")
+		}
+		if config.MaxLength != nil && len(text) > *config.MaxLength {
+			return nil, invalid(fmt.Sprintf("텍스트 길이가 최대값(%d)을 초과했습니다", *config.MaxLength))
+		}
+		row.ValueText = &text
+	case domain.FieldTypeURL:
+		text, ok := raw.(string)
+		if !ok {
+			return nil, invalid("URL 문자열이 필요합니다")
+		}
+		if _, err := url.ParseRequestURI(text); err != nil {
+			return nil, invalid("유효하지 않은 URL 형식입니다")
+		}
+		row.ValueText = &text
+	case domain.FieldTypeNumber:
+		number, ok := raw.(float64)
+		if !ok {
+			return nil, invalid("숫자 값이 필요합니다")
+		}
+		if (config.Min != nil && number < *config.Min) || (config.Max != nil && number > *config.Max) {
+			return nil, invalid("값이 허용 범위를 벗어났습니다")
+		}
+		row.ValueNumber = &number
+	case domain.FieldTypeDate, domain.FieldTypeDateTime:
+		text, ok := raw.(string)
+		if !ok {
+			return nil, invalid("날짜 문자열이 필요합니다 (ISO 8601)")
+		}
+		date, err := time.Parse(time.RFC3339, text)
+		if err != nil {
+			return nil, invalid("잘못된 날짜 형식입니다 (ISO 8601)")
+		}
+		row.ValueDate = &date
+	case domain.FieldTypeCheckbox:
+		checked, ok := raw.(bool)
+		if !ok {
+			return nil, invalid("불린 값이 필요합니다")
+		}
+		row.ValueBoolean = &checked
+	case domain.FieldTypeSingleSelect:
+		optionID, err := parseID(raw, "옵션 ID가 필요합니다")
+		if err != nil {
+			return nil, err
+		}
+		if !options[optionID] {
+			return nil, invalid("유효하지 않은 옵션입니다")
+		}
+		row.ValueOptionID = &optionID
+	case domain.FieldTypeSingleUser:
+		userID, err := parseID(raw, "사용자 ID가 필요합니다")
+		if err != nil {
+			return nil, err
+		}
+		if !isMember(userID) {
+			return nil, invalid("프로젝트 멤버가 아닙니다")
+		}
+		row.ValueUserID = &userID
+	case domain.FieldTypeMultiSelect, domain.FieldTypeMultiUser:
+		items, ok := raw.([]interface{})
+		if !ok {
+			return nil, invalid("ID 배열이 필요합니다")
+		}
+		limit := config.MaxSelections
+		if field.FieldType == domain.FieldTypeMultiUser {
+			limit = config.MaxUsers
+		}
+		if limit != nil && len(items) > *limit {
+			return nil, invalid(fmt.Sprintf("선택 개수가 최대값(%d)을 초과했습니다", *limit))
+		}
+
+		rows := make([]domain.BoardFieldValue, 0, len(items))
+		for i, item := range items {
+			id, err := parseID(item, "잘못된 ID 형식")
+			if err != nil {
+				return nil, err
+			}
+			value := domain.BoardFieldValue{FieldID: field.ID, DisplayOrder: i}
+			if field.FieldType == domain.FieldTypeMultiSelect {
+				if !options[id] {
+					return nil, invalid("유효하지 않은 옵션입니다")
+				}
+				value.ValueOptionID = &id
+			} else {
+				if !isMember(id) {
+					return nil, invalid("프로젝트 멤버가 아닙니다")
+				}
+				value.ValueUserID = &id
+			}
+			rows = append(rows, value)
+		}
+		return rows, nil
+	default:
+		return nil, invalid("지원하지 않는 필드 타입입니다")
+	}
+	return []domain.BoardFieldValue{row}, nil
+}
+
+// ==================== Boards from Templates ====================
+
+// maxBoardTitleLength is the maximum length of a board title in characters (see dto.CreateBoardRequest)
+const maxBoardTitleLength = 200
+
+// boardFromTemplate is a board template resolved for a new board of its project
+type boardFromTemplate struct {
+	template  *domain.BoardTemplate
+	presets   *boardPresets
+	checklist []string
+	cache     string // custom_fields_cache of the preset values
+}
+
+// loadBoardTemplate finds a template of the project and resolves its preset values and checklist
+func (s *boardService) loadBoardTemplate(templateID string, projectID uuid.UUID) (*boardFromTemplate, error) {
+	templateUUID, err := parser.ParseUUID(templateID, "템플릿")
+	if err != nil {
+		return nil, err
+	}
+	template, err := s.templateRepo.FindByID(templateUUID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 템플릿 조회 실패", 500)
+	}
+	if template == nil || template.ProjectID != projectID {
+		return nil, apperrors.New(apperrors.ErrCodeNotFound, "보드 템플릿을 찾을 수 없습니다", 404)
+	}
+
+	values, err := template.DecodeFieldValues()
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 템플릿 필드 값 해석 실패", 500)
+	}
+	checklist, err := template.DecodeChecklist()
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 템플릿 체크리스트 해석 실패", 500)
+	}
+	presets, err := resolvePresetValues(s.fieldRepo, s.projectRepo, projectID, values, false)
+	if err != nil {
+		return nil, err
+	}
+	cache, err := buildFieldValuesCache(presets.values, presets.fieldTypes)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 캐시 생성 실패", 500)
+	}
+
+	return &boardFromTemplate{template: template, presets: presets, checklist: checklist, cache: cache}, nil
+}
+
+// title renders the title pattern of the template with the requested title
+func (t *boardFromTemplate) title(requested string) string {
+	return t.template.RenderTitle(requested, time.Now())
+}
+
+// apply saves the preset field values and the checklist of the template on the created board
+// and records the preset Stage in the stage history
+func (t *boardFromTemplate) apply(repos *uow.Repositories, board *domain.Board, actorID uuid.UUID) error {
+	if values := t.presets.forBoard(board.ID); len(values) > 0 {
+		if err := repos.Field.BatchSetFieldValues(values); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 저장 실패", 500)
+		}
+	}
+	if t.presets.stageOption != nil {
+		if _, err := logStageChange(repos.StageChange, board, t.presets.stageField, nil, t.presets.stageOption, actorID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Stage 이력 기록 실패", 500)
+		}
+	}
+
+	var position string
+	for _, content := range t.checklist {
+		position = util.GeneratePositionBetween(position, "")
+		item, err := domain.NewChecklistItem(board.ID, content, position, actorID)
+		if err != nil {
+			return apperrors.FromDomainError(err)
+		}
+		if err := repos.Checklist.Create(item); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 항목 생성 실패", 500)
+		}
+	}
+	return nil
+}
+
+// validateBoardTitle checks the title of a board built from a template or a copy
+func validateBoardTitle(title string) error {
+	if title == "" {
+		return apperrors.New(apperrors.ErrCodeValidation, "제목은 필수입니다", 400)
+	}
+	if utf8.RuneCountInString(title) > maxBoardTitleLength {
+		return apperrors.New(apperrors.ErrCodeValidation, "제목은 200자를 초과할 수 없습니다", 400)
+	}
+	return nil
+}
+
+// ==================== Duplicate Board ====================
+
+// duplicateTitleSuffix is appended to the title of a copy when no title is given
+const duplicateTitleSuffix = " (사본)"
+
+// DuplicateBoard copies a board in its project: content, assignee, participants, due date, parent, milestone,
+// estimates and all field values (custom_fields_cache is rebuilt from the copied values)
+// The copy starts in the backlog without comments, relations, attachments or logged time
+func (s *boardService) DuplicateBoard(boardID, userID string, req *dto.DuplicateBoardRequest) (*dto.BoardResponse, error) {
+	start := time.Now()
+
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := s.repo.FindByID(boardUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	if _, err := s.authorizer.RequireMember(userUUID, source.ProjectID); err != nil {
+		return nil, err
+	}
+
+	title := duplicateTitle(source.Title)
+	if req.Title != nil {
+		title = *req.Title
+	}
+	if err := validateBoardTitle(title); err != nil {
+		return nil, err
+	}
+
+	board := &domain.Board{
+		ProjectID:               source.ProjectID,
+		Title:                   title,
+		Description:             source.Description,
+		CreatedBy:               userUUID,
+		DueDate:                 source.DueDate,
+		ParentBoardID:           source.ParentBoardID,
+		MilestoneID:             source.MilestoneID,
+		OriginalEstimateMinutes: source.OriginalEstimateMinutes,
+		// The copy has no logged time yet, so all of the original estimate remains
+		RemainingEstimateMinutes: source.OriginalEstimateMinutes,
+	}
+	// Users who left the project are not copied
+	if source.AssigneeID != nil && s.isProjectMember(*source.AssigneeID, source.ProjectID) {
+		board.AssigneeID = source.AssigneeID
+	}
+	board.ParticipantIDs = make([]uuid.UUID, 0, len(source.ParticipantIDs))
+	for _, participantID := range source.ParticipantIDs {
+		if s.isProjectMember(participantID, source.ProjectID) {
+			board.ParticipantIDs = append(board.ParticipantIDs, participantID)
+		}
+	}
+
+	fields, err := s.fieldRepo.FindFieldsByProject(source.ProjectID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	fieldTypes := make(map[uuid.UUID]domain.FieldType, len(fields))
+	var stageField *domain.ProjectField
+	for i := range fields {
+		fieldTypes[fields[i].ID] = fields[i].FieldType
+		if isStageField(&fields[i]) {
+			stageField = &fields[i]
+		}
+	}
+
+	userMap := s.getUserInfoBatch(context.Background(), boardUserIDs(board))
+
+	var response *dto.BoardResponse
+	stageCopied := false
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		values, err := repos.Field.FindFieldValuesByBoard(source.ID)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 조회 실패", 500)
+		}
+		copies := make([]domain.BoardFieldValue, 0, len(values))
+		for _, value := range values {
+			if _, ok := fieldTypes[value.FieldID]; !ok {
+				continue
+			}
+			copies = append(copies, domain.BoardFieldValue{
+				FieldID:       value.FieldID,
+				ValueText:     value.ValueText,
+				ValueNumber:   value.ValueNumber,
+				ValueDate:     value.ValueDate,
+				ValueBoolean:  value.ValueBoolean,
+				ValueOptionID: value.ValueOptionID,
+				ValueUserID:   value.ValueUserID,
+				DisplayOrder:  value.DisplayOrder,
+			})
+		}
+		cache, err := buildFieldValuesCache(copies, fieldTypes)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 캐시 생성 실패", 500)
+		}
+		board.CustomFieldsCache = cache
+
+		project, err := repos.Project.IncrementBoardSequence(board.ProjectID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return apperrors.New(apperrors.ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", 404)
+			}
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 번호 할당 실패", 500)
+		}
+		board.AssignNumber(project, project.BoardSequence)
+
+		if err := repos.Board.Create(board); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 복제 실패", 500)
+		}
+
+		for i := range copies {
+			copies[i].BoardID = board.ID
+		}
+		if len(copies) > 0 {
+			if err := repos.Field.BatchSetFieldValues(copies); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 복제 실패", 500)
+			}
+		}
+		if stageField != nil {
+			for _, value := range copies {
+				if value.FieldID != stageField.ID || value.ValueOptionID == nil {
+					continue
+				}
+				if stageCopied, err = logStageChange(repos.StageChange, board, stageField.ID, nil, value.ValueOptionID, userUUID); err != nil {
+					return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Stage 이력 기록 실패", 500)
+				}
+				break
+			}
+		}
+
+		if req.IncludeChecklist {
+			items, err := repos.Checklist.FindByBoard(source.ID)
+			if err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 조회 실패", 500)
+			}
+			for _, sourceItem := range items {
+				item, err := domain.NewChecklistItem(board.ID, sourceItem.Content, sourceItem.Position, userUUID)
+				if err != nil {
+					return apperrors.FromDomainError(err)
+				}
+				item.AssigneeID = sourceItem.AssigneeID
+				item.DueDate = sourceItem.DueDate
+				if err := repos.Checklist.Create(item); err != nil {
+					return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 항목 복제 실패", 500)
+				}
+			}
+		}
+
+		response = s.buildBoardResponseWithUsers(repos.Field, board, userMap)
+
+		if err := repos.Outbox.Write(event.NewBoardEvent(event.BoardCreated, board.ProjectID, board.ID, userUUID, response)); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 생성 이벤트 기록 실패", 500)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	projectIDStr := board.ProjectID.String()
+	metrics.BoardCreatedTotal.WithLabelValues(projectIDStr).Inc()
+	metrics.RecordDuration(start, metrics.BoardOperationDuration, "duplicate", projectIDStr)
+
+	createdActivity := domain.NewBoardActivity(board, userUUID, domain.BoardActivityCreated)
+	createdActivity.SetChange(domain.BoardActivityFieldTitle, nil, encodeActivityValue(board.Title))
+	s.activities.record(createdActivity)
+	s.notifier.boardChanged(nil, board, userUUID)
+	if stageCopied {
+		invalidateProjectAnalytics(s.fieldCache, s.logger, board.ProjectID)
+	}
+
+	return response, nil
+}
+
+// duplicateTitle is the default title of a copy; the source title is shortened so that the suffix fits
+func duplicateTitle(title string) string {
+	runes := []rune(title)
+	limit := maxBoardTitleLength - utf8.RuneCountInString(duplicateTitleSuffix)
+	if len(runes) > limit {
+		runes = runes[:limit]
+	}
+	return string(runes) + duplicateTitleSuffix
+}
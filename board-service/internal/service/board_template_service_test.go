@@ -0,0 +1,268 @@
+package service
+
+import (
+	"board-service/internal/cache"
+	"board-service/internal/client"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"board-service/internal/testutil"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ==================== Test Suite Setup ====================
+
+type BoardTemplateTestSuite struct {
+	db           *gorm.DB
+	templates    BoardTemplateService
+	boards       BoardService
+	projectID    uuid.UUID
+	project      *domain.Project // Returned by the project repository mock (Asia/Seoul)
+	adminID      uuid.UUID
+	memberID     uuid.UUID
+	otherID      uuid.UUID // Another member
+	outsiderID   uuid.UUID // Not a member of the project
+	stageFieldID uuid.UUID
+	labelFieldID uuid.UUID
+	options      map[string]uuid.UUID // Stage options (대기, 완료) and labels (bug, ui) by label
+}
+
+func setupBoardTemplateTest(t *testing.T) *BoardTemplateTestSuite {
+	db := NewMockDB()
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+
+	suite := &BoardTemplateTestSuite{
+		db:           db,
+		projectID:    uuid.New(),
+		adminID:      uuid.New(),
+		memberID:     uuid.New(),
+		otherID:      uuid.New(),
+		outsiderID:   uuid.New(),
+		stageFieldID: uuid.New(),
+		labelFieldID: uuid.New(),
+		options:      map[string]uuid.UUID{},
+	}
+	require.NoError(t, db.Exec("INSERT INTO projects (id, workspace_id, owner_id, name, key, time_zone) VALUES (?, ?, ?, 'Web', 'WEB', 'Asia/Seoul')",
+		suite.projectID, uuid.New(), suite.adminID).Error)
+	suite.project = &domain.Project{BaseModel: domain.BaseModel{ID: suite.projectID}, Name: "Web", Key: "WEB", TimeZone: "Asia/Seoul"}
+
+	projectRepo := new(testutil.MockProjectRepository)
+	roleRepo := new(testutil.MockRoleRepository)
+	activityRepo := new(testutil.MockBoardActivityRepository)
+	adminRole, memberRole := testutil.NewAdminRole(), testutil.NewMemberRole()
+	roleRepo.On("FindByID", adminRole.ID).Return(adminRole, nil).Maybe()
+	roleRepo.On("FindByID", memberRole.ID).Return(memberRole, nil).Maybe()
+	activityRepo.On("BatchCreate", mock.Anything).Return(nil).Maybe()
+	for userID, role := range map[uuid.UUID]*domain.Role{suite.adminID: adminRole, suite.memberID: memberRole, suite.otherID: memberRole} {
+		projectRepo.On("FindMemberByUserAndProject", userID, suite.projectID).
+			Return(&domain.ProjectMember{ProjectID: suite.projectID, UserID: userID, RoleID: role.ID}, nil).Maybe()
+	}
+	projectRepo.On("FindMemberByUserAndProject", mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound).Maybe()
+	projectRepo.On("FindByID", suite.projectID).Return(suite.project, nil).Maybe()
+
+	userClient := new(MockUserClient)
+	userInfoCache := new(MockUserInfoCache)
+	userClient.On("GetUsersBatch", mock.Anything, mock.Anything).Return([]client.UserInfo{}, nil).Maybe()
+	userInfoCache.On("GetSimpleUsersBatch", mock.Anything, mock.Anything).Return(map[string]*cache.SimpleUser{}, nil).Maybe()
+	userInfoCache.On("SetSimpleUsersBatch", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	templateRepo := repository.NewBoardTemplateRepository(db)
+	fieldRepo := repository.NewFieldRepository(db)
+	recurrenceRepo := repository.NewBoardRecurrenceRepository(db)
+	suite.templates = NewBoardTemplateService(templateRepo, recurrenceRepo, fieldRepo, projectRepo, roleRepo, zap.NewNop(), db)
+	suite.boards = NewBoardService(repository.NewBoardRepository(db), projectRepo, roleRepo, fieldRepo, repository.NewCommentRepository(db),
+		activityRepo, repository.NewNotificationRepository(db), repository.NewBoardRelationRepository(db), repository.NewChecklistRepository(db),
+		repository.NewWorkLogRepository(db), templateRepo, recurrenceRepo, userClient, userInfoCache, nil, zap.NewNop(), db)
+
+	require.NoError(t, db.Exec("INSERT INTO project_fields (id, project_id, name, field_type, is_system_default) VALUES (?, ?, 'Stage', 'single_select', true)",
+		suite.stageFieldID, suite.projectID).Error)
+	require.NoError(t, db.Exec("INSERT INTO project_fields (id, project_id, name, field_type) VALUES (?, ?, 'Labels', 'multi_select')",
+		suite.labelFieldID, suite.projectID).Error)
+	for fieldID, labels := range map[uuid.UUID][]string{suite.stageFieldID: {"대기", "완료"}, suite.labelFieldID: {"bug", "ui"}} {
+		for order, label := range labels {
+			optionID := uuid.New()
+			require.NoError(t, db.Exec("INSERT INTO field_options (id, field_id, label, display_order) VALUES (?, ?, ?, ?)",
+				optionID, fieldID, label, order).Error)
+			suite.options[label] = optionID
+		}
+	}
+	return suite
+}
+
+// bugReport creates a template with a title pattern, a Stage and labels, a checklist and the CREATOR rule
+func (s *BoardTemplateTestSuite) bugReport(t *testing.T) *dto.BoardTemplateResponse {
+	template, err := s.templates.CreateTemplate(s.memberID.String(), s.projectID.String(), &dto.CreateBoardTemplateRequest{
+		Name:         "Bug report",
+		TitlePattern: "[BUG] {title}",
+		Description:  "## 재현 절차\n\n## 기대 결과",
+		FieldValues: map[string]interface{}{
+			s.stageFieldID.String(): s.options["대기"].String(),
+			s.labelFieldID.String(): []interface{}{s.options["bug"].String(), s.options["ui"].String()},
+		},
+		Checklist:    []string{"재현 확인", "원인 분석", " "},
+		AssigneeRule: string(domain.BoardTemplateAssigneeCreator),
+	})
+	require.NoError(t, err)
+	return template
+}
+
+func (s *BoardTemplateTestSuite) fieldValueCount(t *testing.T, boardID string) int64 {
+	return countRows(t, s.db, "board_field_values", "board_id = ?", boardID)
+}
+
+func decodeFieldsCache(t *testing.T, cache string) map[string]interface{} {
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(cache), &decoded))
+	return decoded
+}
+
+// ==================== Template Tests ====================
+
+func TestBoardTemplateService_CreateTemplate_ValidatesPresets(t *testing.T) {
+	suite := setupBoardTemplateTest(t)
+
+	for name, values := range map[string]map[string]interface{}{
+		"option of another field": {suite.stageFieldID.String(): suite.options["bug"].String()},
+		"unknown field":           {uuid.NewString(): "value"},
+		"single value for multi":  {suite.labelFieldID.String(): suite.options["bug"].String()},
+	} {
+		_, err := suite.templates.CreateTemplate(suite.memberID.String(), suite.projectID.String(), &dto.CreateBoardTemplateRequest{Name: "Invalid", FieldValues: values})
+		assert.Equal(t, 400, appErrorStatus(t, err), name)
+	}
+
+	outsider := suite.outsiderID.String()
+	_, err := suite.templates.CreateTemplate(suite.memberID.String(), suite.projectID.String(), &dto.CreateBoardTemplateRequest{
+		Name: "Invalid", AssigneeRule: string(domain.BoardTemplateAssigneeUser), AssigneeID: &outsider,
+	})
+	assert.Equal(t, 400, appErrorStatus(t, err), "the fixed assignee must be a member")
+
+	_, err = suite.templates.CreateTemplate(suite.outsiderID.String(), suite.projectID.String(), &dto.CreateBoardTemplateRequest{Name: "Bug report"})
+	assert.Equal(t, 403, appErrorStatus(t, err))
+
+	template := suite.bugReport(t)
+	assert.Equal(t, []string{"재현 확인", "원인 분석"}, template.Checklist, "blank items are dropped")
+	assert.Len(t, template.FieldValues, 2)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "outbox_events", "event_type = ?", string(event.BoardTemplateCreated)))
+}
+
+func TestBoardTemplateService_UpdateTemplate_CreatorOrAdmin(t *testing.T) {
+	suite := setupBoardTemplateTest(t)
+	template := suite.bugReport(t)
+
+	name := "Renamed"
+	_, err := suite.templates.UpdateTemplate(suite.otherID.String(), template.TemplateID, &dto.UpdateBoardTemplateRequest{Name: &name})
+	assert.Equal(t, 403, appErrorStatus(t, err), "other members cannot change the template")
+
+	updated, err := suite.templates.UpdateTemplate(suite.adminID.String(), template.TemplateID, &dto.UpdateBoardTemplateRequest{
+		Name:      &name,
+		Checklist: []string{},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", updated.Name)
+	assert.Empty(t, updated.Checklist)
+	assert.Len(t, updated.FieldValues, 2, "missing field values are kept")
+
+	require.NoError(t, suite.templates.DeleteTemplate(suite.memberID.String(), template.TemplateID))
+	templates, err := suite.templates.GetTemplates(suite.otherID.String(), suite.projectID.String())
+	require.NoError(t, err)
+	assert.Empty(t, templates)
+}
+
+// ==================== Board Tests ====================
+
+func TestBoardService_CreateBoard_FromTemplate(t *testing.T) {
+	suite := setupBoardTemplateTest(t)
+	template := suite.bugReport(t)
+
+	board, err := suite.boards.CreateBoard(suite.memberID.String(), &dto.CreateBoardRequest{
+		ProjectID:  suite.projectID.String(),
+		Title:      "로그인 실패",
+		TemplateID: &template.TemplateID,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "[BUG] 로그인 실패", board.Title)
+	assert.Equal(t, "## 재현 절차\n\n## 기대 결과", board.Content)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "boards", "id = ? AND assignee_id = ?", board.ID, suite.memberID), "the CREATOR rule assigns the creator")
+	assert.Equal(t, int64(3), suite.fieldValueCount(t, board.ID))
+	assert.Equal(t, int64(2), countRows(t, suite.db, "checklist_items", "board_id = ? AND is_checked = ?", board.ID, false))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "board_stage_changes", "board_id = ? AND to_option_id = ?", board.ID, suite.options["대기"]))
+
+	var cached string
+	require.NoError(t, suite.db.Raw("SELECT custom_fields_cache FROM boards WHERE id = ?", board.ID).Scan(&cached).Error)
+	fieldsCache := decodeFieldsCache(t, cached)
+	assert.Equal(t, suite.options["대기"].String(), fieldsCache[suite.stageFieldID.String()])
+	assert.Equal(t, []interface{}{suite.options["bug"].String(), suite.options["ui"].String()}, fieldsCache[suite.labelFieldID.String()])
+}
+
+func TestBoardService_CreateBoard_TemplateSkipsStalePresets(t *testing.T) {
+	suite := setupBoardTemplateTest(t)
+	template := suite.bugReport(t)
+
+	// The Labels field is deleted after the template was saved
+	require.NoError(t, suite.db.Exec("UPDATE project_fields SET is_deleted = true WHERE id = ?", suite.labelFieldID).Error)
+
+	board, err := suite.boards.CreateBoard(suite.memberID.String(), &dto.CreateBoardRequest{
+		ProjectID:  suite.projectID.String(),
+		Title:      "로그인 실패",
+		TemplateID: &template.TemplateID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), suite.fieldValueCount(t, board.ID), "only the Stage value is applied")
+
+	untitled, err := suite.boards.CreateBoard(suite.memberID.String(), &dto.CreateBoardRequest{ProjectID: suite.projectID.String(), TemplateID: &template.TemplateID})
+	require.NoError(t, err)
+	assert.Equal(t, "[BUG]", untitled.Title, "the pattern alone is the title")
+
+	otherTemplate := uuid.NewString()
+	_, err = suite.boards.CreateBoard(suite.memberID.String(), &dto.CreateBoardRequest{ProjectID: suite.projectID.String(), Title: "x", TemplateID: &otherTemplate})
+	assert.Equal(t, 404, appErrorStatus(t, err))
+}
+
+func TestBoardService_DuplicateBoard_CopiesValuesAndRebuildsCache(t *testing.T) {
+	suite := setupBoardTemplateTest(t)
+	template := suite.bugReport(t)
+	source, err := suite.boards.CreateBoard(suite.memberID.String(), &dto.CreateBoardRequest{
+		ProjectID:  suite.projectID.String(),
+		Title:      "로그인 실패",
+		TemplateID: &template.TemplateID,
+	})
+	require.NoError(t, err)
+
+	_, err = suite.boards.DuplicateBoard(source.ID, suite.outsiderID.String(), &dto.DuplicateBoardRequest{})
+	assert.Equal(t, 403, appErrorStatus(t, err))
+
+	copied, err := suite.boards.DuplicateBoard(source.ID, suite.otherID.String(), &dto.DuplicateBoardRequest{IncludeChecklist: true})
+	require.NoError(t, err)
+
+	assert.NotEqual(t, source.ID, copied.ID)
+	assert.Equal(t, "WEB-2", copied.Key)
+	assert.Equal(t, "[BUG] 로그인 실패 (사본)", copied.Title)
+	assert.Equal(t, source.Content, copied.Content)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "boards", "id = ? AND assignee_id = ?", copied.ID, suite.memberID))
+	assert.Equal(t, int64(3), suite.fieldValueCount(t, copied.ID))
+	assert.Equal(t, int64(2), countRows(t, suite.db, "checklist_items", "board_id = ? AND created_by = ?", copied.ID, suite.otherID))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "board_stage_changes", "board_id = ?", copied.ID))
+
+	var caches []string
+	require.NoError(t, suite.db.Raw("SELECT custom_fields_cache FROM boards WHERE id IN (?, ?) ORDER BY number", source.ID, copied.ID).Scan(&caches).Error)
+	require.Len(t, caches, 2)
+	assert.Equal(t, decodeFieldsCache(t, caches[0]), decodeFieldsCache(t, caches[1]))
+
+	title := "다른 제목"
+	renamed, err := suite.boards.DuplicateBoard(source.ID, suite.memberID.String(), &dto.DuplicateBoardRequest{Title: &title})
+	require.NoError(t, err)
+	assert.Equal(t, "다른 제목", renamed.Title)
+	assert.Zero(t, countRows(t, suite.db, "checklist_items", "board_id = ?", renamed.ID), "the checklist is copied only on request")
+}
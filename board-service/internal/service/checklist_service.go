@@ -0,0 +1,490 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/auth"
+	"board-service/internal/common/parser"
+	"board-service/internal/common/validator"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"board-service/internal/uow"
+	"board-service/internal/util"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ChecklistService는 보드의 체크리스트 항목을 관리합니다
+// 프로젝트 멤버라면 누구나 항목을 추가/수정/체크할 수 있습니다 (필드 값과 동일)
+type ChecklistService interface {
+	GetChecklist(userID, boardID string) (*dto.ChecklistResponse, error)
+	CreateItem(userID, boardID string, req *dto.CreateChecklistItemRequest) (*dto.ChecklistItemResponse, error)
+	UpdateItem(userID, boardID, itemID string, req *dto.UpdateChecklistItemRequest) (*dto.ChecklistItemResponse, error)
+	MoveItem(userID, boardID, itemID string, req *dto.MoveChecklistItemRequest) (*dto.ChecklistItemResponse, error)
+	DeleteItem(userID, boardID, itemID string) error
+}
+
+type checklistService struct {
+	repo        repository.ChecklistRepository
+	boardRepo   repository.BoardRepository
+	projectRepo repository.ProjectRepository
+	progress    *boardProgressReader
+	activities  *boardActivityRecorder
+	authorizer  auth.ProjectAuthorizer
+	logger      *zap.Logger
+	uow         uow.UnitOfWork
+}
+
+func NewChecklistService(
+	repo repository.ChecklistRepository,
+	boardRepo repository.BoardRepository,
+	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
+	activityRepo repository.BoardActivityRepository,
+	logger *zap.Logger,
+	db *gorm.DB,
+) ChecklistService {
+	return &checklistService{
+		repo:        repo,
+		boardRepo:   boardRepo,
+		projectRepo: projectRepo,
+		progress:    newBoardProgressReader(repo, boardRepo, logger),
+		activities:  newBoardActivityRecorder(activityRepo, logger),
+		authorizer:  auth.NewProjectAuthorizer(projectRepo, roleRepo),
+		logger:      logger,
+		uow:         uow.NewUnitOfWork(db),
+	}
+}
+
+// ==================== Get Checklist ====================
+
+func (s *checklistService) GetChecklist(userID, boardID string) (*dto.ChecklistResponse, error) {
+	_, board, err := s.findBoardForMember(userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.repo.FindByBoard(board.ID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 조회 실패", 500)
+	}
+	progress, err := s.progress.forBoards([]uuid.UUID{board.ID})
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "진행률 조회 실패", 500)
+	}
+
+	response := &dto.ChecklistResponse{
+		Items:    make([]dto.ChecklistItemResponse, 0, len(items)),
+		Progress: *progress[board.ID],
+	}
+	for i := range items {
+		response.Items = append(response.Items, toChecklistItemResponse(&items[i]))
+	}
+	return response, nil
+}
+
+// ==================== Create Item ====================
+
+// CreateItem appends an item at the end of the checklist
+func (s *checklistService) CreateItem(userID, boardID string, req *dto.CreateChecklistItemRequest) (*dto.ChecklistItemResponse, error) {
+	userUUID, board, err := s.findBoardForMember(userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := domain.NewChecklistItem(board.ID, req.Content, "", userUUID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+	if err := s.applyAssignee(item, board, req.AssigneeID); err != nil {
+		return nil, err
+	}
+	if req.DueDate != nil {
+		if err := applyChecklistDueDate(item, *req.DueDate); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		items, err := repos.Checklist.FindByBoard(board.ID)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 조회 실패", 500)
+		}
+		var last string
+		if len(items) > 0 {
+			last = items[len(items)-1].Position
+		}
+		item.Position = util.GeneratePositionBetween(last, "")
+
+		if err := repos.Checklist.Create(item); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 항목 생성 실패", 500)
+		}
+		return writeChecklistEvent(repos.Outbox, board, userUUID, "created", item)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.activities.record(checklistActivity(board, userUUID, nil, item))
+
+	response := toChecklistItemResponse(item)
+	return &response, nil
+}
+
+// ==================== Update Item ====================
+
+func (s *checklistService) UpdateItem(userID, boardID, itemID string, req *dto.UpdateChecklistItemRequest) (*dto.ChecklistItemResponse, error) {
+	userUUID, board, err := s.findBoardForMember(userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.findItem(board, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep a copy of the current state for the activity history
+	before := *item
+
+	if req.Content != nil {
+		if err := item.UpdateContent(*req.Content); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+	if req.IsChecked != nil {
+		if *req.IsChecked {
+			item.Check(userUUID)
+		} else {
+			item.Uncheck()
+		}
+	}
+	if err := s.applyAssignee(item, board, req.AssigneeID); err != nil {
+		return nil, err
+	}
+	if req.DueDate != nil {
+		if err := applyChecklistDueDate(item, *req.DueDate); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Checklist.Update(item); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 항목 수정 실패", 500)
+		}
+		return writeChecklistEvent(repos.Outbox, board, userUUID, "updated", item)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if activity := checklistActivity(board, userUUID, &before, item); activity.HasChanged() {
+		s.activities.record(activity)
+	}
+
+	response := toChecklistItemResponse(item)
+	return &response, nil
+}
+
+// ==================== Move Item ====================
+
+// MoveItem places the item right after another item of the checklist (or at the top)
+// Only the moved item gets a new position (fractional indexing)
+func (s *checklistService) MoveItem(userID, boardID, itemID string, req *dto.MoveChecklistItemRequest) (*dto.ChecklistItemResponse, error) {
+	userUUID, board, err := s.findBoardForMember(userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.findItem(board, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	afterUUID, err := parser.ParseOptionalUUID(req.AfterItemID, "체크리스트 항목")
+	if err != nil {
+		return nil, err
+	}
+	if afterUUID != nil && *afterUUID == item.ID {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "항목을 자기 자신 뒤로 이동할 수 없습니다", 400)
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		items, err := repos.Checklist.FindByBoard(board.ID)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 조회 실패", 500)
+		}
+
+		before, after, err := checklistNeighbours(items, item.ID, afterUUID)
+		if err != nil {
+			return err
+		}
+		item.MoveTo(util.GeneratePositionBetween(before, after))
+
+		if err := repos.Checklist.Update(item); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 항목 이동 실패", 500)
+		}
+		return writeChecklistEvent(repos.Outbox, board, userUUID, "moved", item)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := toChecklistItemResponse(item)
+	return &response, nil
+}
+
+// ==================== Delete Item ====================
+
+func (s *checklistService) DeleteItem(userID, boardID, itemID string) error {
+	userUUID, board, err := s.findBoardForMember(userID, boardID)
+	if err != nil {
+		return err
+	}
+	item, err := s.findItem(board, itemID)
+	if err != nil {
+		return err
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Checklist.Delete(item.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 항목 삭제 실패", 500)
+		}
+		return writeChecklistEvent(repos.Outbox, board, userUUID, "deleted", item)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.activities.record(checklistActivity(board, userUUID, item, nil))
+	return nil
+}
+
+// ==================== Helper Methods ====================
+
+// findBoardForMember finds the board and checks that the user is a member of its project
+func (s *checklistService) findBoardForMember(userID, boardID string) (uuid.UUID, *domain.Board, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	board, err := s.boardRepo.FindByID(boardUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+		}
+		return uuid.Nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	if _, err := s.authorizer.RequireMember(userUUID, board.ProjectID); err != nil {
+		return uuid.Nil, nil, err
+	}
+	return userUUID, board, nil
+}
+
+// findItem finds an item of the board's checklist
+func (s *checklistService) findItem(board *domain.Board, itemID string) (*domain.ChecklistItem, error) {
+	itemUUID, err := parser.ParseUUID(itemID, "체크리스트 항목")
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.repo.FindByID(itemUUID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 항목 조회 실패", 500)
+	}
+	if item == nil || item.BoardID != board.ID {
+		return nil, apperrors.New(apperrors.ErrCodeNotFound, "체크리스트 항목을 찾을 수 없습니다", 404)
+	}
+	return item, nil
+}
+
+// applyAssignee assigns the item to a project member; an empty ID removes the assignee
+func (s *checklistService) applyAssignee(item *domain.ChecklistItem, board *domain.Board, assigneeID *string) error {
+	if assigneeID == nil {
+		return nil
+	}
+	if *assigneeID == "" {
+		item.Unassign()
+		return nil
+	}
+
+	assigneeUUID, err := parser.ParseUUID(*assigneeID, "담당자")
+	if err != nil {
+		return err
+	}
+	if _, err := s.projectRepo.FindMemberByUserAndProject(assigneeUUID, board.ProjectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.New(apperrors.ErrCodeNotFound, "담당자가 프로젝트 멤버가 아닙니다", 404)
+		}
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "담당자 확인 실패", 500)
+	}
+	item.Assign(assigneeUUID)
+	return nil
+}
+
+// applyChecklistDueDate sets the due date of the item; an empty date clears it
+func applyChecklistDueDate(item *domain.ChecklistItem, dueDate string) error {
+	if dueDate == "" {
+		item.SetDueDate(nil)
+		return nil
+	}
+	parsed, err := validator.ValidateDateFormat(dueDate, "마감일")
+	if err != nil {
+		return err
+	}
+	item.SetDueDate(parsed)
+	return nil
+}
+
+// checklistNeighbours returns the positions between which the item is moved
+// afterID nil moves the item to the top; the moved item itself is skipped
+func checklistNeighbours(items []domain.ChecklistItem, itemID uuid.UUID, afterID *uuid.UUID) (string, string, error) {
+	others := make([]domain.ChecklistItem, 0, len(items))
+	for _, other := range items {
+		if other.ID != itemID {
+			others = append(others, other)
+		}
+	}
+
+	if afterID == nil {
+		if len(others) == 0 {
+			return "", "", nil
+		}
+		return "", others[0].Position, nil
+	}
+	for i, other := range others {
+		if other.ID != *afterID {
+			continue
+		}
+		if i+1 < len(others) {
+			return other.Position, others[i+1].Position, nil
+		}
+		return other.Position, "", nil
+	}
+	return "", "", apperrors.New(apperrors.ErrCodeNotFound, "기준 체크리스트 항목을 찾을 수 없습니다", 404)
+}
+
+// writeChecklistEvent records a checklist change of the board in the outbox
+func writeChecklistEvent(outbox repository.OutboxWriter, board *domain.Board, actorID uuid.UUID, action string, item *domain.ChecklistItem) error {
+	data := map[string]interface{}{
+		"action": action,
+		"item":   toChecklistItemResponse(item),
+	}
+	if err := outbox.Write(event.NewBoardEvent(event.BoardChecklistChanged, board.ProjectID, board.ID, actorID, data)); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "체크리스트 이벤트 기록 실패", 500)
+	}
+	return nil
+}
+
+// checklistActivity records an added (old nil), changed or removed (new nil) checklist item
+// Only the content and the checked state are kept; reordering is not recorded
+func checklistActivity(board *domain.Board, actorID uuid.UUID, oldItem, newItem *domain.ChecklistItem) domain.BoardActivity {
+	snapshot := func(item *domain.ChecklistItem) *string {
+		if item == nil {
+			return nil
+		}
+		return encodeActivityValue(map[string]interface{}{
+			"itemId":    item.ID.String(),
+			"content":   item.Content,
+			"isChecked": item.IsChecked,
+		})
+	}
+
+	activity := domain.NewBoardActivity(board, actorID, domain.BoardActivityUpdated)
+	activity.SetChange(domain.BoardActivityFieldChecklist, snapshot(oldItem), snapshot(newItem))
+	return activity
+}
+
+func toChecklistItemResponse(item *domain.ChecklistItem) dto.ChecklistItemResponse {
+	response := dto.ChecklistItemResponse{
+		ItemID:    item.ID.String(),
+		BoardID:   item.BoardID.String(),
+		Content:   item.Content,
+		Position:  item.Position,
+		IsChecked: item.IsChecked,
+		CheckedAt: item.CheckedAt,
+		DueDate:   item.DueDate,
+		CreatedBy: item.CreatedBy.String(),
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+	}
+	if item.CheckedBy != nil {
+		checkedBy := item.CheckedBy.String()
+		response.CheckedBy = &checkedBy
+	}
+	if item.AssigneeID != nil {
+		assigneeID := item.AssigneeID.String()
+		response.AssigneeID = &assigneeID
+	}
+	return response
+}
+
+// ==================== Progress Reader ====================
+
+// boardProgressReader는 보드 응답에 포함되는 진행률(체크리스트 + 하위 보드의 체크리스트)을 조회합니다
+// 보드 서비스와 뷰 서비스의 목록 응답에 사용되며, 실패해도 보드 조회를 실패시키지 않습니다
+type boardProgressReader struct {
+	repo      repository.ChecklistRepository
+	boardRepo repository.BoardRepository
+	logger    *zap.Logger
+}
+
+func newBoardProgressReader(repo repository.ChecklistRepository, boardRepo repository.BoardRepository, logger *zap.Logger) *boardProgressReader {
+	return &boardProgressReader{repo: repo, boardRepo: boardRepo, logger: logger}
+}
+
+// forBoards returns the progress of each given board, counting the checklists of its direct sub-tasks
+func (r *boardProgressReader) forBoards(boardIDs []uuid.UUID) (map[uuid.UUID]*dto.BoardProgressResponse, error) {
+	children, err := r.boardRepo.FindChildIDs(boardIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	countIDs := append([]uuid.UUID{}, boardIDs...)
+	for _, childIDs := range children {
+		countIDs = append(countIDs, childIDs...)
+	}
+	counts, err := r.repo.CountByBoards(countIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[uuid.UUID]*dto.BoardProgressResponse, len(boardIDs))
+	for _, boardID := range boardIDs {
+		progress := domain.BoardProgress{SubtaskCount: len(children[boardID])}
+		progress.Add(counts[boardID])
+		for _, childID := range children[boardID] {
+			progress.Add(counts[childID])
+		}
+		result[boardID] = &dto.BoardProgressResponse{
+			ChecklistTotal:   progress.ChecklistTotal,
+			ChecklistChecked: progress.ChecklistChecked,
+			SubtaskCount:     progress.SubtaskCount,
+			Percent:          progress.Percent(),
+		}
+	}
+	return result, nil
+}
+
+// attach adds the progress to a single board response (best-effort)
+func (r *boardProgressReader) attach(response *dto.BoardResponse, boardID uuid.UUID) {
+	progress := r.list([]uuid.UUID{boardID})
+	response.Progress = progress[boardID]
+}
+
+// list returns the progress of the given boards (best-effort, empty on failure)
+func (r *boardProgressReader) list(boardIDs []uuid.UUID) map[uuid.UUID]*dto.BoardProgressResponse {
+	progress, err := r.forBoards(boardIDs)
+	if err != nil {
+		r.logger.Warn("Failed to fetch board progress", zap.Error(err))
+		return make(map[uuid.UUID]*dto.BoardProgressResponse)
+	}
+	return progress
+}
@@ -4,9 +4,13 @@ import (
 	"board-service/internal/apperrors"
 	"board-service/internal/cache"
 	"board-service/internal/client"
+	"board-service/internal/common/auth"
+	"board-service/internal/common/pagination"
 	"board-service/internal/domain"
 	"board-service/internal/dto"
+	"board-service/internal/event"
 	"board-service/internal/repository"
+	"board-service/internal/uow"
 	"context"
 	"errors"
 	"fmt"
@@ -22,28 +26,45 @@ type CommentService interface {
 	GetCommentsByBoardID(ctx context.Context, boardID uuid.UUID, userID uuid.UUID) ([]dto.CommentResponse, error)
 	UpdateComment(ctx context.Context, commentID uuid.UUID, req dto.UpdateCommentRequest, userID uuid.UUID) (*dto.CommentResponse, error)
 	DeleteComment(ctx context.Context, commentID uuid.UUID, userID uuid.UUID) error
+
+	// Threads and reactions
+	GetCommentReplies(ctx context.Context, commentID uuid.UUID, req dto.GetCommentRepliesRequest, userID uuid.UUID) (*dto.PaginatedCommentsResponse, error)
+	ToggleReaction(ctx context.Context, commentID uuid.UUID, req dto.ToggleCommentReactionRequest, userID uuid.UUID) (*dto.ToggleCommentReactionResponse, error)
 }
 
+// CommentThreadDepth is how many levels of replies a top-level comment can have.
+type CommentThreadDepth int
+
 type commentService struct {
 	commentRepo   repository.CommentRepository
 	boardRepo     repository.BoardRepository
 	projectRepo   repository.ProjectRepository
+	authorizer    auth.ProjectAuthorizer
+	activities    *boardActivityRecorder
+	notifier      *boardNotifier
 	userClient    client.UserClient
 	userInfoCache cache.UserInfoCache
 	logger        *zap.Logger
 	db            *gorm.DB
+	uow           uow.UnitOfWork
+	maxDepth      int
 }
 
 // NewCommentService creates a new instance of CommentService.
-func NewCommentService(cr repository.CommentRepository, kr repository.BoardRepository, pr repository.ProjectRepository, uc client.UserClient, uic cache.UserInfoCache, l *zap.Logger, db *gorm.DB) CommentService {
+func NewCommentService(cr repository.CommentRepository, kr repository.BoardRepository, pr repository.ProjectRepository, rr repository.RoleRepository, ar repository.BoardActivityRepository, nr repository.NotificationRepository, uc client.UserClient, uic cache.UserInfoCache, maxDepth CommentThreadDepth, l *zap.Logger, db *gorm.DB) CommentService {
 	return &commentService{
 		commentRepo:   cr,
 		boardRepo:     kr,
 		projectRepo:   pr,
+		authorizer:    auth.NewProjectAuthorizer(pr, rr),
+		activities:    newBoardActivityRecorder(ar, l),
+		notifier:      newBoardNotifier(nr, pr, uc, uic, l),
 		userClient:    uc,
 		userInfoCache: uic,
 		logger:        l,
 		db:            db,
+		uow:           uow.NewUnitOfWork(db),
+		maxDepth:      int(maxDepth),
 	}
 }
 
@@ -71,21 +92,53 @@ func (s *commentService) CreateComment(ctx context.Context, req dto.CreateCommen
 		Content:  req.Content,
 	}
 
-	if err := s.commentRepo.Create(comment); err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to create comment", 500)
+	if req.ParentCommentID != nil {
+		parent, err := s.commentRepo.FindByID(*req.ParentCommentID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, apperrors.New(apperrors.ErrCodeNotFound, fmt.Sprintf("parent comment with id %s not found", *req.ParentCommentID), 404)
+			}
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to find parent comment", 500)
+		}
+		if !parent.BelongsToBoard(req.BoardID) {
+			return nil, apperrors.New(apperrors.ErrCodeBadRequest, "parent comment belongs to another board", 400)
+		}
+
+		// Domain 메서드 사용: 답글 단계 제한이 Domain에 포함됨
+		comment, err = parent.NewReply(userID, req.Content, s.maxDepth)
+		if err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
 	}
 
 	user := s.getSimpleUserWithCache(ctx, userID.String())
 
-	return &dto.CommentResponse{
-		ID:         comment.ID,
-		UserID:     comment.UserID,
-		UserName:   user.Name,
-		UserAvatar: user.AvatarURL,
-		Content:    comment.Content,
-		CreatedAt:  comment.CreatedAt,
-		UpdatedAt:  comment.UpdatedAt,
-	}, nil
+	// The comment and its created event are committed together
+	var response *dto.CommentResponse
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Comment.Create(comment); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to create comment", 500)
+		}
+
+		response = newCommentResponse(comment, user, 0, nil)
+
+		if err := repos.Outbox.Write(event.NewBoardEvent(event.CommentCreated, board.ProjectID, board.ID, userID, response)); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to record comment event", 500)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	activity := domain.NewBoardActivity(board, userID, domain.BoardActivityCommentAdded)
+	activity.SetChange(domain.BoardActivityFieldComment, nil, encodeActivityValue(comment.Content))
+	activity.CommentID = &comment.ID
+	s.activities.record(activity)
+
+	s.notifier.commentAdded(board, comment, userID)
+
+	return response, nil
 }
 
 // GetCommentsByBoardID retrieves all comments for a given board.
@@ -106,37 +159,13 @@ func (s *commentService) GetCommentsByBoardID(ctx context.Context, boardID uuid.
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to check project membership", 500)
 	}
 
-	comments, err := s.commentRepo.FindByBoardID(boardID)
+	// Replies are loaded per thread with GetCommentReplies
+	comments, err := s.commentRepo.FindRootsByBoardID(boardID)
 	if err != nil {
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to get comments", 500)
 	}
 
-	// Batch fetch user info with caching
-	userIDs := make([]string, 0, len(comments))
-	for _, c := range comments {
-		userIDs = append(userIDs, c.UserID.String())
-	}
-
-	userMap := s.getSimpleUsersBatch(ctx, userIDs)
-
-	responses := make([]dto.CommentResponse, len(comments))
-	for i, c := range comments {
-		user, ok := userMap[c.UserID.String()]
-		if !ok {
-			user = cache.SimpleUser{Name: "Unknown User", AvatarURL: ""}
-		}
-		responses[i] = dto.CommentResponse{
-			ID:         c.ID,
-			UserID:     c.UserID,
-			UserName:   user.Name,
-			UserAvatar: user.AvatarURL,
-			Content:    c.Content,
-			CreatedAt:  c.CreatedAt,
-			UpdatedAt:  c.UpdatedAt,
-		}
-	}
-
-	return responses, nil
+	return s.toCommentResponses(ctx, comments, userID)
 }
 
 // UpdateComment updates an existing comment.
@@ -153,27 +182,52 @@ func (s *commentService) UpdateComment(ctx context.Context, commentID uuid.UUID,
 		return nil, apperrors.New(apperrors.ErrCodeForbidden, "user does not have permission to update this comment", 403)
 	}
 
+	oldContent := comment.Content
+
 	// Domain 메서드 사용: 검증 로직이 Domain에 포함됨
 	if err := comment.UpdateContent(req.Content); err != nil {
 		// Domain 에러를 Infrastructure 에러로 변환
 		return nil, apperrors.FromDomainError(err)
 	}
 
-	if err := s.commentRepo.Update(comment); err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to update comment", 500)
+	board, err := s.boardRepo.FindByID(comment.BoardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, fmt.Sprintf("board with id %s not found", comment.BoardID), 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to find board", 500)
 	}
 
 	user := s.getSimpleUserWithCache(ctx, userID.String())
 
-	return &dto.CommentResponse{
-		ID:         comment.ID,
-		UserID:     comment.UserID,
-		UserName:   user.Name,
-		UserAvatar: user.AvatarURL,
-		Content:    comment.Content,
-		CreatedAt:  comment.CreatedAt,
-		UpdatedAt:  comment.UpdatedAt,
-	}, nil
+	replyCounts, reactions, err := s.loadThreadInfo([]uuid.UUID{comment.ID}, userID)
+	if err != nil {
+		// Editing does not change the counters; only they are missing from the response
+		s.logger.Warn("Failed to load comment replies and reactions", zap.Error(err), zap.String("comment_id", comment.ID.String()))
+	}
+
+	// The update and its event are committed together
+	var response *dto.CommentResponse
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Comment.Update(comment); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to update comment", 500)
+		}
+
+		response = newCommentResponse(comment, user, replyCounts[comment.ID], reactions[comment.ID])
+
+		if err := repos.Outbox.Write(event.NewBoardEvent(event.CommentUpdated, board.ProjectID, board.ID, userID, response)); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to record comment event", 500)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.recordBoardCommentChange(board, comment, userID, domain.BoardActivityCommentUpdated, &oldContent, &comment.Content)
+	s.notifier.commentEdited(board, comment, oldContent, userID)
+
+	return response, nil
 }
 
 // DeleteComment deletes a comment.
@@ -186,13 +240,321 @@ func (s *commentService) DeleteComment(ctx context.Context, commentID uuid.UUID,
 		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to find comment", 500)
 	}
 
-	if comment.UserID != userID {
-		// Also allow project owner/admin to delete?
-		// For now, only the author can delete.
+	board, err := s.boardRepo.FindByID(comment.BoardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.New(apperrors.ErrCodeNotFound, fmt.Sprintf("board with id %s not found", comment.BoardID), 404)
+		}
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to find board", 500)
+	}
+
+	// The author or an ADMIN+ can delete a comment
+	canDelete, err := s.authorizer.CanDelete(userID, board.ProjectID, comment.UserID)
+	if err != nil {
+		return err
+	}
+	if !canDelete {
 		return apperrors.New(apperrors.ErrCodeForbidden, "user does not have permission to delete this comment", 403)
 	}
 
-	return s.commentRepo.Delete(comment.ID)
+	// Deleting a comment deletes its whole thread; other users' replies go with it only when an ADMIN+ deletes it
+	if err := s.requireThreadDeletable(comment, board, userID); err != nil {
+		return err
+	}
+
+	// The comment is soft-deleted and moved to the project's trash in one transaction
+	trashItem := domain.NewTrashItem(domain.TrashItemComment, comment.ID, board.ProjectID, userID, comment.Content)
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Comment.Delete(comment.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to delete comment", 500)
+		}
+		if err := repos.Trash.Create(trashItem); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to move comment to trash", 500)
+		}
+		deletedEvent := event.NewBoardEvent(event.CommentDeleted, board.ProjectID, board.ID, userID, map[string]interface{}{"commentId": comment.ID.String()})
+		if err := repos.Outbox.Write(deletedEvent); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to record comment event", 500)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.recordBoardCommentChange(board, comment, userID, domain.BoardActivityCommentDeleted, &comment.Content, nil)
+	return nil
+}
+
+// GetCommentReplies retrieves one page of the direct replies to a comment.
+func (s *commentService) GetCommentReplies(ctx context.Context, commentID uuid.UUID, req dto.GetCommentRepliesRequest, userID uuid.UUID) (*dto.PaginatedCommentsResponse, error) {
+	comment, err := s.commentRepo.FindByID(commentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, fmt.Sprintf("comment with id %s not found", commentID), 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to find comment", 500)
+	}
+
+	if _, err := s.requireBoardMember(comment.BoardID, userID); err != nil {
+		return nil, err
+	}
+
+	replies, result, err := s.findRepliesPage(comment.ID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	responses, err := s.toCommentResponses(ctx, replies, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Comments = responses
+	return result, nil
+}
+
+// findRepliesPage reads the replies after (or before) the cursor of the request, or the page with its number.
+// Pages read by number are always counted and carry the cursors of their first and last replies.
+func (s *commentService) findRepliesPage(commentID uuid.UUID, req dto.GetCommentRepliesRequest) ([]domain.Comment, *dto.PaginatedCommentsResponse, error) {
+	if req.Cursor == "" {
+		if req.Page < 1 {
+			req.Page = 1
+		}
+		if req.Limit < 1 {
+			req.Limit = 20
+		}
+
+		replies, total, err := s.commentRepo.FindReplies(commentID, req.Page, req.Limit)
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to get replies", 500)
+		}
+		result := &dto.PaginatedCommentsResponse{Total: &total, Page: req.Page, Limit: req.Limit}
+		if len(replies) > 0 && int64(pagination.CalculateOffset(req.Page, req.Limit)+len(replies)) < total {
+			result.NextCursor = pagination.EncodeCursor(repository.ReplyCursor(&replies[len(replies)-1], false))
+		}
+		if len(replies) > 0 && req.Page > 1 {
+			result.PrevCursor = pagination.EncodeCursor(repository.ReplyCursor(&replies[0], true))
+		}
+		return replies, result, nil
+	}
+
+	cursorReq, err := pagination.NewCursorRequest(req.Cursor, req.Limit, req.IncludeTotal)
+	if err != nil {
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "invalid cursor", 400)
+	}
+	page, err := s.commentRepo.FindRepliesPage(commentID, cursorReq.Cursor, cursorReq.Limit)
+	if errors.Is(err, pagination.ErrInvalidCursor) {
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "invalid cursor", 400)
+	}
+	if err != nil {
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to get replies", 500)
+	}
+	result := &dto.PaginatedCommentsResponse{
+		Limit:      cursorReq.Limit,
+		NextCursor: pagination.EncodeCursor(page.Next),
+		PrevCursor: pagination.EncodeCursor(page.Prev),
+	}
+	if cursorReq.IncludeTotal {
+		counts, err := s.commentRepo.CountReplies([]uuid.UUID{commentID})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to count replies", 500)
+		}
+		total := counts[commentID]
+		result.Total = &total
+	}
+	return page.Items, result, nil
+}
+
+// ToggleReaction adds the user's emoji reaction to a comment, or removes it if it already exists.
+func (s *commentService) ToggleReaction(ctx context.Context, commentID uuid.UUID, req dto.ToggleCommentReactionRequest, userID uuid.UUID) (*dto.ToggleCommentReactionResponse, error) {
+	comment, err := s.commentRepo.FindByID(commentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, fmt.Sprintf("comment with id %s not found", commentID), 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to find comment", 500)
+	}
+
+	board, err := s.requireBoardMember(comment.BoardID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	reaction, err := domain.NewCommentReaction(comment.ID, userID, req.Emoji)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	// The reaction change and its event are committed together
+	var response *dto.ToggleCommentReactionResponse
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		// Remove first, add otherwise; both statements are keyed on the unique
+		// (comment, user, emoji) index, so concurrent toggles never fail on a duplicate
+		removed, err := repos.Comment.RemoveReaction(comment.ID, userID, reaction.Emoji)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to remove reaction", 500)
+		}
+		if !removed {
+			// A concurrent request may have added it first; either way the user has reacted
+			if _, err := repos.Comment.AddReaction(reaction); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to add reaction", 500)
+			}
+		}
+		reacted := !removed
+
+		count, err := repos.Comment.CountReactions(comment.ID, reaction.Emoji)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to count reactions", 500)
+		}
+
+		response = &dto.ToggleCommentReactionResponse{
+			CommentID: comment.ID,
+			Emoji:     reaction.Emoji,
+			Reacted:   reacted,
+			Count:     count,
+		}
+
+		if err := repos.Outbox.Write(event.NewBoardEvent(event.CommentReacted, board.ProjectID, board.ID, userID, response)); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to record reaction event", 500)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// requireThreadDeletable rejects deleting a comment whose thread holds replies by other users,
+// unless the user is a project ADMIN+
+func (s *commentService) requireThreadDeletable(comment *domain.Comment, board *domain.Board, userID uuid.UUID) error {
+	othersReplied, err := s.commentRepo.HasRepliesByOthers(comment.ID, comment.UserID)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to check replies", 500)
+	}
+	if !othersReplied {
+		return nil
+	}
+
+	role, err := s.authorizer.GetRole(userID, board.ProjectID)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to check project role", 500)
+	}
+	if !auth.IsAdmin(role) {
+		return apperrors.New(apperrors.ErrCodeConflict, "comment has replies from other users; only project admins can delete it", 409)
+	}
+	return nil
+}
+
+// requireBoardMember loads the board and checks that the user is a member of its project
+func (s *commentService) requireBoardMember(boardID, userID uuid.UUID) (*domain.Board, error) {
+	board, err := s.boardRepo.FindByID(boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, fmt.Sprintf("board with id %s not found", boardID), 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to find board", 500)
+	}
+
+	if _, err := s.projectRepo.FindMemberByUserAndProject(userID, board.ProjectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeForbidden, "user is not a member of the project", 403)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to check project membership", 500)
+	}
+
+	return board, nil
+}
+
+// toCommentResponses converts comments to responses with author info, reply counts
+// and the reactions as seen by the requesting user
+func (s *commentService) toCommentResponses(ctx context.Context, comments []domain.Comment, userID uuid.UUID) ([]dto.CommentResponse, error) {
+	// Batch fetch user info with caching
+	userIDs := make([]string, 0, len(comments))
+	commentIDs := make([]uuid.UUID, 0, len(comments))
+	for _, c := range comments {
+		userIDs = append(userIDs, c.UserID.String())
+		commentIDs = append(commentIDs, c.ID)
+	}
+
+	userMap := s.getSimpleUsersBatch(ctx, userIDs)
+
+	replyCounts, reactions, err := s.loadThreadInfo(commentIDs, userID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "failed to get comment replies and reactions", 500)
+	}
+
+	responses := make([]dto.CommentResponse, len(comments))
+	for i := range comments {
+		c := &comments[i]
+		user, ok := userMap[c.UserID.String()]
+		if !ok {
+			user = cache.SimpleUser{Name: "Unknown User", AvatarURL: ""}
+		}
+		responses[i] = *newCommentResponse(c, user, replyCounts[c.ID], reactions[c.ID])
+	}
+
+	return responses, nil
+}
+
+// loadThreadInfo returns the reply count and the aggregated reactions of each comment
+func (s *commentService) loadThreadInfo(commentIDs []uuid.UUID, userID uuid.UUID) (map[uuid.UUID]int64, map[uuid.UUID][]dto.CommentReactionResponse, error) {
+	replyCounts, err := s.commentRepo.CountReplies(commentIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	summaries, err := s.commentRepo.FindReactionSummaries(commentIDs, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reactions := make(map[uuid.UUID][]dto.CommentReactionResponse)
+	for _, summary := range summaries {
+		reactions[summary.CommentID] = append(reactions[summary.CommentID], dto.CommentReactionResponse{
+			Emoji:       summary.Emoji,
+			Count:       summary.Count,
+			ReactedByMe: summary.ReactedByMe,
+		})
+	}
+
+	return replyCounts, reactions, nil
+}
+
+func newCommentResponse(c *domain.Comment, user cache.SimpleUser, replyCount int64, reactions []dto.CommentReactionResponse) *dto.CommentResponse {
+	if reactions == nil {
+		reactions = []dto.CommentReactionResponse{}
+	}
+	return &dto.CommentResponse{
+		ID:              c.ID,
+		UserID:          c.UserID,
+		UserName:        user.Name,
+		UserAvatar:      user.AvatarURL,
+		Content:         c.Content,
+		ParentCommentID: c.ParentCommentID,
+		Depth:           c.Depth,
+		ReplyCount:      replyCount,
+		Reactions:       reactions,
+		CreatedAt:       c.CreatedAt,
+		UpdatedAt:       c.UpdatedAt,
+	}
+}
+
+// recordBoardCommentChange records a comment change in the board's activity history
+func (s *commentService) recordBoardCommentChange(board *domain.Board, comment *domain.Comment, userID uuid.UUID, action domain.BoardActivityAction, oldContent, newContent *string) {
+	var oldValue, newValue interface{}
+	if oldContent != nil {
+		oldValue = *oldContent
+	}
+	if newContent != nil {
+		newValue = *newContent
+	}
+
+	activity := domain.NewBoardActivity(board, userID, action)
+	activity.SetChange(domain.BoardActivityFieldComment, encodeActivityValue(oldValue), encodeActivityValue(newValue))
+	activity.CommentID = &comment.ID
+	s.activities.record(activity)
 }
 
 // getSimpleUserWithCache retrieves simple user info with caching
@@ -1,19 +1,23 @@
 package service
 
 import (
+	"board-service/internal/apperrors"
 	"board-service/internal/cache"
 	"board-service/internal/client"
+	"board-service/internal/common/pagination"
 	"board-service/internal/domain"
 	"board-service/internal/dto"
+	"board-service/internal/event"
+	"board-service/internal/repository"
 	"board-service/internal/testutil"
 	"context"
-	"errors"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
@@ -24,9 +28,13 @@ type CommentServiceTestSuite struct {
 	commentRepo   *testutil.MockCommentRepository
 	boardRepo     *testutil.MockBoardRepository
 	projectRepo   *testutil.MockProjectRepository
+	roleRepo      *testutil.MockRoleRepository
+	activityRepo  *testutil.MockBoardActivityRepository
+	notifyRepo    *testutil.MockNotificationRepository
 	userClient    *MockUserClient
 	userInfoCache *MockUserInfoCache
 	logger        *zap.Logger
+	db            *gorm.DB
 	service       CommentService
 }
 
@@ -34,35 +42,60 @@ func setupCommentServiceTest(t *testing.T) *CommentServiceTestSuite {
 	commentRepo := new(testutil.MockCommentRepository)
 	boardRepo := new(testutil.MockBoardRepository)
 	projectRepo := new(testutil.MockProjectRepository)
+	roleRepo := new(testutil.MockRoleRepository)
+	activityRepo := new(testutil.MockBoardActivityRepository)
+	notifyRepo := new(testutil.MockNotificationRepository)
 	userClient := new(MockUserClient)
 	userInfoCache := new(MockUserInfoCache)
 	logger := zap.NewNop()
+	db := NewMockDB() // in-memory DB for transactions
 
 	service := NewCommentService(
 		commentRepo,
 		boardRepo,
 		projectRepo,
+		roleRepo,
+		activityRepo,
+		notifyRepo,
 		userClient,
 		userInfoCache,
+		CommentThreadDepth(2),
 		logger,
-		nil, // db not used in unit tests
+		db,
 	)
 
+	// Notifications are best-effort and verified in dedicated tests
+	notifyRepo.On("FindWatcherIDs", mock.Anything).Return([]uuid.UUID{}, nil).Maybe()
+	notifyRepo.On("BatchCreate", mock.Anything).Return(nil).Maybe()
+	notifyRepo.On("AddWatchers", mock.Anything, mock.Anything).Return(nil).Maybe()
+
 	return &CommentServiceTestSuite{
 		commentRepo:   commentRepo,
 		boardRepo:     boardRepo,
 		projectRepo:   projectRepo,
+		roleRepo:      roleRepo,
+		activityRepo:  activityRepo,
+		notifyRepo:    notifyRepo,
 		userClient:    userClient,
 		userInfoCache: userInfoCache,
 		logger:        logger,
+		db:            db,
 		service:       service,
 	}
 }
 
+// createCommentTables creates the comment, trash and outbox tables written in the service's transactions
+func createCommentTables(t *testing.T, db *gorm.DB) {
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+}
+
 // ==================== CreateComment Tests ====================
 
 func TestCommentService_CreateComment_Success(t *testing.T) {
 	suite := setupCommentServiceTest(t)
+	createCommentTables(t, suite.db)
 
 	// Given: Valid comment request
 	ctx := context.Background()
@@ -95,7 +128,12 @@ func TestCommentService_CreateComment_Success(t *testing.T) {
 	// Mock setup
 	suite.boardRepo.On("FindByID", boardID).Return(board, nil)
 	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(member, nil)
-	suite.commentRepo.On("Create", mock.AnythingOfType("*domain.Comment")).Return(nil)
+	suite.activityRepo.On("BatchCreate", mock.MatchedBy(func(activities []domain.BoardActivity) bool {
+		return len(activities) == 1 &&
+			activities[0].Action == domain.BoardActivityCommentAdded &&
+			activities[0].ProjectID == projectID &&
+			activities[0].OldValue == nil
+	})).Return(nil)
 	suite.userInfoCache.On("GetSimpleUser", ctx, userID.String()).Return(false, (*cache.SimpleUser)(nil), nil)
 	suite.userClient.On("GetSimpleUser", userID.String()).Return(&client.SimpleUser{
 		ID:        userID.String(),
@@ -114,9 +152,13 @@ func TestCommentService_CreateComment_Success(t *testing.T) {
 	assert.Equal(t, userID, result.UserID)
 	assert.Equal(t, "Test User", result.UserName)
 
+	// The comment and its event are committed together
+	assert.Equal(t, int64(1), countRows(t, suite.db, "comments", "id = ? AND board_id = ? AND content = ?", result.ID, boardID, content))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "outbox_events", "event_type = ? AND project_id = ?", string(event.CommentCreated), projectID))
+
 	suite.boardRepo.AssertExpectations(t)
 	suite.projectRepo.AssertExpectations(t)
-	suite.commentRepo.AssertExpectations(t)
+	suite.activityRepo.AssertExpectations(t)
 }
 
 func TestCommentService_CreateComment_BoardNotFound(t *testing.T) {
@@ -214,9 +256,9 @@ func TestCommentService_CreateComment_RepositoryError(t *testing.T) {
 
 	suite.boardRepo.On("FindByID", boardID).Return(board, nil)
 	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(member, nil)
-	suite.commentRepo.On("Create", mock.AnythingOfType("*domain.Comment")).Return(errors.New("database error"))
+	suite.userInfoCache.On("GetSimpleUser", ctx, userID.String()).Return(true, &cache.SimpleUser{ID: userID.String(), Name: "Test User"}, nil)
 
-	// When: Create comment
+	// When: Create comment (the comments table does not exist)
 	result, err := suite.service.CreateComment(ctx, req, userID)
 
 	// Then: Verify error
@@ -226,7 +268,32 @@ func TestCommentService_CreateComment_RepositoryError(t *testing.T) {
 
 	suite.boardRepo.AssertExpectations(t)
 	suite.projectRepo.AssertExpectations(t)
-	suite.commentRepo.AssertExpectations(t)
+	suite.activityRepo.AssertNotCalled(t, "BatchCreate", mock.Anything)
+}
+
+func TestCommentService_CreateComment_EventWriteFailureRollsBack(t *testing.T) {
+	suite := setupCommentServiceTest(t)
+	createCommentTables(t, suite.db)
+	require.NoError(t, suite.db.Exec("DROP TABLE outbox_events").Error)
+
+	// Given: The outbox cannot be written
+	ctx := context.Background()
+	userID := uuid.New()
+	boardID := uuid.New()
+	projectID := uuid.New()
+
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{UserID: userID, ProjectID: projectID}, nil)
+	suite.userInfoCache.On("GetSimpleUser", ctx, userID.String()).Return(true, &cache.SimpleUser{ID: userID.String(), Name: "Test User"}, nil)
+
+	// When: Create comment
+	result, err := suite.service.CreateComment(ctx, dto.CreateCommentRequest{BoardID: boardID, Content: "Lost event"}, userID)
+
+	// Then: The comment is rolled back with its event, so no change goes unpublished
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Zero(t, countRows(t, suite.db, "comments", "1 = 1"))
+	suite.activityRepo.AssertNotCalled(t, "BatchCreate", mock.Anything)
 }
 
 // ==================== GetCommentsByBoardID Tests ====================
@@ -283,7 +350,11 @@ func TestCommentService_GetCommentsByBoardID_Success(t *testing.T) {
 	// Mock setup
 	suite.boardRepo.On("FindByID", boardID).Return(board, nil)
 	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(member, nil)
-	suite.commentRepo.On("FindByBoardID", boardID).Return(comments, nil)
+	suite.commentRepo.On("FindRootsByBoardID", boardID).Return(comments, nil)
+	suite.commentRepo.On("CountReplies", []uuid.UUID{comments[0].ID, comments[1].ID}).Return(map[uuid.UUID]int64{comments[0].ID: 3}, nil)
+	suite.commentRepo.On("FindReactionSummaries", []uuid.UUID{comments[0].ID, comments[1].ID}, userID).Return([]repository.CommentReactionSummary{
+		{CommentID: comments[1].ID, Emoji: "👍", Count: 2, ReactedByMe: true},
+	}, nil)
 	suite.userInfoCache.On("GetSimpleUsersBatch", ctx, []string{commentUserID.String(), commentUserID.String()}).Return(make(map[string]*cache.SimpleUser), nil)
 	suite.userClient.On("GetSimpleUsers", []string{commentUserID.String(), commentUserID.String()}).Return([]client.SimpleUser{
 		{
@@ -302,6 +373,10 @@ func TestCommentService_GetCommentsByBoardID_Success(t *testing.T) {
 	assert.Len(t, result, 2)
 	assert.Equal(t, "First comment", result[0].Content)
 	assert.Equal(t, "Second comment", result[1].Content)
+	assert.Equal(t, int64(3), result[0].ReplyCount)
+	assert.Empty(t, result[0].Reactions)
+	assert.Zero(t, result[1].ReplyCount)
+	assert.Equal(t, []dto.CommentReactionResponse{{Emoji: "👍", Count: 2, ReactedByMe: true}}, result[1].Reactions)
 
 	suite.boardRepo.AssertExpectations(t)
 	suite.projectRepo.AssertExpectations(t)
@@ -335,7 +410,9 @@ func TestCommentService_GetCommentsByBoardID_EmptyList(t *testing.T) {
 
 	suite.boardRepo.On("FindByID", boardID).Return(board, nil)
 	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(member, nil)
-	suite.commentRepo.On("FindByBoardID", boardID).Return([]domain.Comment{}, nil)
+	suite.commentRepo.On("FindRootsByBoardID", boardID).Return([]domain.Comment{}, nil)
+	suite.commentRepo.On("CountReplies", []uuid.UUID{}).Return(map[uuid.UUID]int64{}, nil)
+	suite.commentRepo.On("FindReactionSummaries", []uuid.UUID{}, userID).Return([]repository.CommentReactionSummary{}, nil)
 	suite.userInfoCache.On("GetSimpleUsersBatch", ctx, []string{}).Return(make(map[string]*cache.SimpleUser), nil)
 
 	// When: Get comments
@@ -407,13 +484,17 @@ func TestCommentService_GetCommentsByBoardID_UserNotProjectMember(t *testing.T)
 
 func TestCommentService_UpdateComment_Success(t *testing.T) {
 	suite := setupCommentServiceTest(t)
+	createCommentTables(t, suite.db)
 
 	// Given: Valid comment update
 	ctx := context.Background()
 	userID := uuid.New()
 	commentID := uuid.New()
 	boardID := uuid.New()
+	projectID := uuid.New()
 	newContent := "Updated comment content"
+	require.NoError(t, suite.db.Exec("INSERT INTO comments (id, board_id, user_id, content) VALUES (?, ?, ?, ?)",
+		commentID, boardID, userID, "Old content").Error)
 
 	req := dto.UpdateCommentRequest{
 		Content: newContent,
@@ -438,7 +519,15 @@ func TestCommentService_UpdateComment_Success(t *testing.T) {
 
 	// Mock setup
 	suite.commentRepo.On("FindByID", commentID).Return(comment, nil)
-	suite.commentRepo.On("Update", mock.AnythingOfType("*domain.Comment")).Return(nil)
+	suite.commentRepo.On("CountReplies", []uuid.UUID{commentID}).Return(map[uuid.UUID]int64{commentID: 1}, nil)
+	suite.commentRepo.On("FindReactionSummaries", []uuid.UUID{commentID}, userID).Return([]repository.CommentReactionSummary{}, nil)
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil)
+	suite.activityRepo.On("BatchCreate", mock.MatchedBy(func(activities []domain.BoardActivity) bool {
+		return len(activities) == 1 &&
+			activities[0].Action == domain.BoardActivityCommentUpdated &&
+			*activities[0].OldValue == `"Old content"` &&
+			*activities[0].NewValue == `"Updated comment content"`
+	})).Return(nil)
 	suite.userInfoCache.On("GetSimpleUser", ctx, userID.String()).Return(true, simpleUser, nil)
 
 	// When: Update comment
@@ -449,8 +538,12 @@ func TestCommentService_UpdateComment_Success(t *testing.T) {
 	assert.NotNil(t, result)
 	assert.Equal(t, newContent, result.Content)
 	assert.Equal(t, userID, result.UserID)
+	assert.Equal(t, int64(1), result.ReplyCount)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "comments", "id = ? AND content = ?", commentID, newContent))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "outbox_events", "event_type = ? AND project_id = ?", string(event.CommentUpdated), projectID))
 
 	suite.commentRepo.AssertExpectations(t)
+	suite.activityRepo.AssertExpectations(t)
 }
 
 func TestCommentService_UpdateComment_CommentNotFound(t *testing.T) {
@@ -556,12 +649,16 @@ func TestCommentService_UpdateComment_EmptyContent(t *testing.T) {
 
 func TestCommentService_DeleteComment_Success(t *testing.T) {
 	suite := setupCommentServiceTest(t)
+	createCommentTables(t, suite.db)
 
 	// Given: Valid comment deletion
 	ctx := context.Background()
 	userID := uuid.New()
 	commentID := uuid.New()
 	boardID := uuid.New()
+	projectID := uuid.New()
+	require.NoError(t, suite.db.Exec("INSERT INTO comments (id, board_id, user_id, content) VALUES (?, ?, ?, ?)",
+		commentID, boardID, userID, "Test content").Error)
 
 	comment := &domain.Comment{
 		BaseModel: domain.BaseModel{
@@ -576,15 +673,26 @@ func TestCommentService_DeleteComment_Success(t *testing.T) {
 
 	// Mock setup
 	suite.commentRepo.On("FindByID", commentID).Return(comment, nil)
-	suite.commentRepo.On("Delete", commentID).Return(nil)
+	suite.commentRepo.On("HasRepliesByOthers", commentID, userID).Return(false, nil)
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil)
+	suite.activityRepo.On("BatchCreate", mock.MatchedBy(func(activities []domain.BoardActivity) bool {
+		return len(activities) == 1 &&
+			activities[0].Action == domain.BoardActivityCommentDeleted &&
+			activities[0].NewValue == nil
+	})).Return(nil)
 
 	// When: Delete comment
 	err := suite.service.DeleteComment(ctx, commentID, userID)
 
-	// Then: Verify success
+	// Then: The comment is soft-deleted and moved to the project's trash
 	assert.NoError(t, err)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "comments", "id = ? AND is_deleted = ?", commentID, true))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "trash_items", "item_type = ? AND item_id = ? AND project_id = ? AND title = ?",
+		string(domain.TrashItemComment), commentID, projectID, "Test content"))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "outbox_events", "event_type = ? AND project_id = ?", string(event.CommentDeleted), projectID))
 
 	suite.commentRepo.AssertExpectations(t)
+	suite.activityRepo.AssertExpectations(t)
 }
 
 func TestCommentService_DeleteComment_CommentNotFound(t *testing.T) {
@@ -610,12 +718,14 @@ func TestCommentService_DeleteComment_CommentNotFound(t *testing.T) {
 func TestCommentService_DeleteComment_NotCommentAuthor(t *testing.T) {
 	suite := setupCommentServiceTest(t)
 
-	// Given: User is not the comment author
+	// Given: User is not the comment author and only a MEMBER
 	ctx := context.Background()
 	userID := uuid.New()
 	otherUserID := uuid.New()
 	commentID := uuid.New()
 	boardID := uuid.New()
+	projectID := uuid.New()
+	roleID := uuid.New()
 
 	comment := &domain.Comment{
 		BaseModel: domain.BaseModel{
@@ -629,6 +739,9 @@ func TestCommentService_DeleteComment_NotCommentAuthor(t *testing.T) {
 	}
 
 	suite.commentRepo.On("FindByID", commentID).Return(comment, nil)
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{UserID: userID, ProjectID: projectID, RoleID: roleID}, nil)
+	suite.roleRepo.On("FindByID", roleID).Return(&domain.Role{Name: "MEMBER", Level: 10}, nil)
 
 	// When: Delete comment
 	err := suite.service.DeleteComment(ctx, commentID, userID)
@@ -639,3 +752,414 @@ func TestCommentService_DeleteComment_NotCommentAuthor(t *testing.T) {
 
 	suite.commentRepo.AssertExpectations(t)
 }
+
+// ==================== Thread Tests ====================
+
+func TestCommentService_CreateComment_Reply(t *testing.T) {
+	suite := setupCommentServiceTest(t)
+	createCommentTables(t, suite.db)
+
+	// Given: A reply to a top-level comment
+	ctx := context.Background()
+	userID := uuid.New()
+	boardID := uuid.New()
+	projectID := uuid.New()
+	parentID := uuid.New()
+
+	parent := &domain.Comment{BaseModel: domain.BaseModel{ID: parentID}, BoardID: boardID, UserID: uuid.New(), Content: "Parent"}
+	req := dto.CreateCommentRequest{BoardID: boardID, Content: "Reply", ParentCommentID: &parentID}
+
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{UserID: userID, ProjectID: projectID}, nil)
+	suite.commentRepo.On("FindByID", parentID).Return(parent, nil)
+	suite.activityRepo.On("BatchCreate", mock.Anything).Return(nil)
+	suite.userInfoCache.On("GetSimpleUser", ctx, userID.String()).Return(true, &cache.SimpleUser{ID: userID.String(), Name: "Test User"}, nil)
+
+	// When: Create reply
+	result, err := suite.service.CreateComment(ctx, req, userID)
+
+	// Then: The reply is one level below its parent
+	require.NoError(t, err)
+	require.NotNil(t, result.ParentCommentID)
+	assert.Equal(t, parentID, *result.ParentCommentID)
+	assert.Equal(t, 1, result.Depth)
+	assert.Zero(t, result.ReplyCount)
+	assert.NotNil(t, result.Reactions)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "comments", "id = ? AND parent_comment_id = ? AND depth = ? AND board_id = ?", result.ID, parentID, 1, boardID))
+
+	suite.commentRepo.AssertExpectations(t)
+}
+
+func TestCommentService_CreateComment_ReplyTooDeep(t *testing.T) {
+	suite := setupCommentServiceTest(t)
+
+	// Given: The parent is already at the maximum depth (2)
+	ctx := context.Background()
+	userID := uuid.New()
+	boardID := uuid.New()
+	projectID := uuid.New()
+	parentID := uuid.New()
+	grandparentID := uuid.New()
+
+	parent := &domain.Comment{BaseModel: domain.BaseModel{ID: parentID}, BoardID: boardID, ParentCommentID: &grandparentID, Depth: 2}
+	req := dto.CreateCommentRequest{BoardID: boardID, Content: "Too deep", ParentCommentID: &parentID}
+
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{UserID: userID, ProjectID: projectID}, nil)
+	suite.commentRepo.On("FindByID", parentID).Return(parent, nil)
+
+	// When: Create reply
+	result, err := suite.service.CreateComment(ctx, req, userID)
+
+	// Then: Verify validation error
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "2단계까지만")
+
+	suite.commentRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestCommentService_CreateComment_ReplyParentOnAnotherBoard(t *testing.T) {
+	suite := setupCommentServiceTest(t)
+
+	// Given: The parent comment belongs to a different board
+	ctx := context.Background()
+	userID := uuid.New()
+	boardID := uuid.New()
+	projectID := uuid.New()
+	parentID := uuid.New()
+
+	parent := &domain.Comment{BaseModel: domain.BaseModel{ID: parentID}, BoardID: uuid.New()}
+	req := dto.CreateCommentRequest{BoardID: boardID, Content: "Reply", ParentCommentID: &parentID}
+
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{UserID: userID, ProjectID: projectID}, nil)
+	suite.commentRepo.On("FindByID", parentID).Return(parent, nil)
+
+	// When: Create reply
+	result, err := suite.service.CreateComment(ctx, req, userID)
+
+	// Then: Verify bad request
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "another board")
+
+	suite.commentRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestCommentService_GetCommentReplies_Paginated(t *testing.T) {
+	suite := setupCommentServiceTest(t)
+
+	// Given: A comment with replies, second page requested
+	ctx := context.Background()
+	userID := uuid.New()
+	boardID := uuid.New()
+	projectID := uuid.New()
+	commentID := uuid.New()
+	authorID := uuid.New()
+
+	replies := []domain.Comment{
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, BoardID: boardID, UserID: authorID, Content: "Reply 3", ParentCommentID: &commentID, Depth: 1},
+	}
+
+	suite.commentRepo.On("FindByID", commentID).Return(&domain.Comment{BaseModel: domain.BaseModel{ID: commentID}, BoardID: boardID}, nil)
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{UserID: userID, ProjectID: projectID}, nil)
+	suite.commentRepo.On("FindReplies", commentID, 2, 2).Return(replies, int64(3), nil)
+	suite.commentRepo.On("CountReplies", []uuid.UUID{replies[0].ID}).Return(map[uuid.UUID]int64{}, nil)
+	suite.commentRepo.On("FindReactionSummaries", []uuid.UUID{replies[0].ID}, userID).Return([]repository.CommentReactionSummary{}, nil)
+	suite.userInfoCache.On("GetSimpleUsersBatch", ctx, []string{authorID.String()}).Return(map[string]*cache.SimpleUser{
+		authorID.String(): {ID: authorID.String(), Name: "Reply Author"},
+	}, nil)
+
+	// When: Get the second page
+	result, err := suite.service.GetCommentReplies(ctx, commentID, dto.GetCommentRepliesRequest{Page: 2, Limit: 2}, userID)
+
+	// Then: Verify the page and total
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), *result.Total)
+	assert.Equal(t, 2, result.Page)
+	assert.Equal(t, 2, result.Limit)
+	require.Len(t, result.Comments, 1)
+	assert.Equal(t, "Reply 3", result.Comments[0].Content)
+	assert.Equal(t, "Reply Author", result.Comments[0].UserName)
+
+	suite.commentRepo.AssertExpectations(t)
+}
+
+func TestCommentService_GetCommentReplies_DefaultPagination(t *testing.T) {
+	suite := setupCommentServiceTest(t)
+
+	// Given: No page or limit in the request
+	ctx := context.Background()
+	userID := uuid.New()
+	boardID := uuid.New()
+	projectID := uuid.New()
+	commentID := uuid.New()
+
+	suite.commentRepo.On("FindByID", commentID).Return(&domain.Comment{BaseModel: domain.BaseModel{ID: commentID}, BoardID: boardID}, nil)
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{UserID: userID, ProjectID: projectID}, nil)
+	suite.commentRepo.On("FindReplies", commentID, 1, 20).Return([]domain.Comment{}, int64(0), nil)
+	suite.commentRepo.On("CountReplies", []uuid.UUID{}).Return(map[uuid.UUID]int64{}, nil)
+	suite.commentRepo.On("FindReactionSummaries", []uuid.UUID{}, userID).Return([]repository.CommentReactionSummary{}, nil)
+	suite.userInfoCache.On("GetSimpleUsersBatch", ctx, []string{}).Return(make(map[string]*cache.SimpleUser), nil)
+
+	// When: Get replies
+	result, err := suite.service.GetCommentReplies(ctx, commentID, dto.GetCommentRepliesRequest{}, userID)
+
+	// Then: The first page of 20 is used
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Page)
+	assert.Equal(t, 20, result.Limit)
+	assert.Empty(t, result.Comments)
+
+	suite.commentRepo.AssertExpectations(t)
+}
+
+func TestCommentService_GetCommentReplies_ByCursor(t *testing.T) {
+	suite := setupCommentServiceTest(t)
+
+	// Given: The cursor of the second reply in the thread
+	ctx := context.Background()
+	userID := uuid.New()
+	boardID := uuid.New()
+	projectID := uuid.New()
+	commentID := uuid.New()
+	replyID := uuid.New()
+	createdAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	after := &pagination.Cursor{Values: []interface{}{createdAt}, ID: replyID.String()}
+	next := &pagination.Cursor{Values: []interface{}{createdAt.Add(time.Minute)}, ID: uuid.New().String()}
+
+	suite.commentRepo.On("FindByID", commentID).Return(&domain.Comment{BaseModel: domain.BaseModel{ID: commentID}, BoardID: boardID}, nil)
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{UserID: userID, ProjectID: projectID}, nil)
+	suite.commentRepo.On("FindRepliesPage", commentID, mock.MatchedBy(func(cursor *pagination.Cursor) bool {
+		return cursor.ID == replyID.String() && createdAt.Equal(cursor.Values[0].(time.Time))
+	}), 5).Return(&repository.KeysetPage[domain.Comment]{Items: []domain.Comment{}, Next: next}, nil)
+	suite.commentRepo.On("CountReplies", []uuid.UUID{}).Return(map[uuid.UUID]int64{}, nil)
+	suite.commentRepo.On("FindReactionSummaries", []uuid.UUID{}, userID).Return([]repository.CommentReactionSummary{}, nil)
+	suite.userInfoCache.On("GetSimpleUsersBatch", ctx, []string{}).Return(make(map[string]*cache.SimpleUser), nil)
+
+	// When: Get the replies after the cursor
+	result, err := suite.service.GetCommentReplies(ctx, commentID, dto.GetCommentRepliesRequest{Cursor: *pagination.EncodeCursor(after), Limit: 5}, userID)
+
+	// Then: The page links to the next one and is not counted
+	require.NoError(t, err)
+	assert.Nil(t, result.Total)
+	assert.Equal(t, pagination.EncodeCursor(next), result.NextCursor)
+	assert.Nil(t, result.PrevCursor)
+
+	// A cursor that was not issued is rejected
+	_, err = suite.service.GetCommentReplies(ctx, commentID, dto.GetCommentRepliesRequest{Cursor: "not a cursor"}, userID)
+	require.Error(t, err)
+	assert.Equal(t, 400, err.(*apperrors.AppError).HTTPStatus)
+
+	suite.commentRepo.AssertExpectations(t)
+}
+
+// ==================== ToggleReaction Tests ====================
+
+func TestCommentService_ToggleReaction_Add(t *testing.T) {
+	suite := setupCommentServiceTest(t)
+	createCommentTables(t, suite.db)
+
+	// Given: Another user reacted with the emoji, the user has not yet
+	ctx := context.Background()
+	userID := uuid.New()
+	boardID := uuid.New()
+	projectID := uuid.New()
+	commentID := uuid.New()
+	require.NoError(t, suite.db.Exec("INSERT INTO comment_reactions (id, comment_id, user_id, emoji) VALUES (?, ?, ?, ?)",
+		uuid.New(), commentID, uuid.New(), "🎉").Error)
+
+	suite.commentRepo.On("FindByID", commentID).Return(&domain.Comment{BaseModel: domain.BaseModel{ID: commentID}, BoardID: boardID}, nil)
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{UserID: userID, ProjectID: projectID}, nil)
+
+	// When: Toggle the reaction (surrounding whitespace is trimmed)
+	result, err := suite.service.ToggleReaction(ctx, commentID, dto.ToggleCommentReactionRequest{Emoji: " 🎉 "}, userID)
+
+	// Then: The reaction is added
+	require.NoError(t, err)
+	assert.True(t, result.Reacted)
+	assert.Equal(t, "🎉", result.Emoji)
+	assert.Equal(t, int64(2), result.Count)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "comment_reactions", "comment_id = ? AND user_id = ? AND emoji = ?", commentID, userID, "🎉"))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "outbox_events", "event_type = ? AND project_id = ?", string(event.CommentReacted), projectID))
+
+	suite.commentRepo.AssertExpectations(t)
+}
+
+func TestCommentService_ToggleReaction_Remove(t *testing.T) {
+	suite := setupCommentServiceTest(t)
+	createCommentTables(t, suite.db)
+
+	// Given: The user already reacted with the emoji
+	ctx := context.Background()
+	userID := uuid.New()
+	boardID := uuid.New()
+	projectID := uuid.New()
+	commentID := uuid.New()
+	require.NoError(t, suite.db.Exec("INSERT INTO comment_reactions (id, comment_id, user_id, emoji) VALUES (?, ?, ?, ?)",
+		uuid.New(), commentID, userID, "👍").Error)
+
+	suite.commentRepo.On("FindByID", commentID).Return(&domain.Comment{BaseModel: domain.BaseModel{ID: commentID}, BoardID: boardID}, nil)
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{UserID: userID, ProjectID: projectID}, nil)
+
+	// When: Toggle the reaction
+	result, err := suite.service.ToggleReaction(ctx, commentID, dto.ToggleCommentReactionRequest{Emoji: "👍"}, userID)
+
+	// Then: The reaction is removed
+	require.NoError(t, err)
+	assert.False(t, result.Reacted)
+	assert.Zero(t, result.Count)
+	assert.Zero(t, countRows(t, suite.db, "comment_reactions", "1 = 1"))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "outbox_events", "event_type = ?", string(event.CommentReacted)))
+
+	suite.commentRepo.AssertExpectations(t)
+}
+
+func TestCommentService_ToggleReaction_InvalidEmoji(t *testing.T) {
+	suite := setupCommentServiceTest(t)
+
+	// Given: Two emojis separated by whitespace
+	ctx := context.Background()
+	userID := uuid.New()
+	boardID := uuid.New()
+	projectID := uuid.New()
+	commentID := uuid.New()
+
+	suite.commentRepo.On("FindByID", commentID).Return(&domain.Comment{BaseModel: domain.BaseModel{ID: commentID}, BoardID: boardID}, nil)
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{UserID: userID, ProjectID: projectID}, nil)
+
+	// When: Toggle the reaction
+	result, err := suite.service.ToggleReaction(ctx, commentID, dto.ToggleCommentReactionRequest{Emoji: "👍 👍"}, userID)
+
+	// Then: Verify validation error
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "올바르지 않은 이모지")
+
+	suite.projectRepo.AssertExpectations(t)
+}
+
+func TestCommentService_DeleteComment_RemovesReplies(t *testing.T) {
+	suite := setupCommentServiceTest(t)
+	createCommentTables(t, suite.db)
+
+	// Given: A comment with a reply and a nested reply, and an unrelated comment
+	ctx := context.Background()
+	userID := uuid.New()
+	boardID := uuid.New()
+	commentID := uuid.New()
+	replyID := uuid.New()
+	nestedID := uuid.New()
+	otherID := uuid.New()
+	require.NoError(t, suite.db.Exec(`INSERT INTO comments (id, board_id, user_id, content, parent_comment_id, depth) VALUES
+		(?, ?, ?, 'root', NULL, 0), (?, ?, ?, 'reply', ?, 1), (?, ?, ?, 'nested', ?, 2), (?, ?, ?, 'other', NULL, 0)`,
+		commentID, boardID, userID, replyID, boardID, userID, commentID, nestedID, boardID, userID, replyID, otherID, boardID, userID).Error)
+
+	suite.commentRepo.On("FindByID", commentID).Return(&domain.Comment{BaseModel: domain.BaseModel{ID: commentID}, BoardID: boardID, UserID: userID, Content: "root"}, nil)
+	suite.commentRepo.On("HasRepliesByOthers", commentID, userID).Return(false, nil)
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: uuid.New()}, nil)
+	suite.activityRepo.On("BatchCreate", mock.Anything).Return(nil)
+
+	// When: Delete the top-level comment
+	err := suite.service.DeleteComment(ctx, commentID, userID)
+
+	// Then: The whole thread is deleted, other comments are kept
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), countRows(t, suite.db, "comments", "is_deleted = ?", true))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "comments", "id = ? AND is_deleted = ?", otherID, false))
+}
+
+func TestCommentService_DeleteComment_OthersRepliesRequireAdmin(t *testing.T) {
+	suite := setupCommentServiceTest(t)
+	createCommentTables(t, suite.db)
+
+	// Given: A MEMBER's comment that another user replied to
+	ctx := context.Background()
+	userID := uuid.New()
+	boardID := uuid.New()
+	projectID := uuid.New()
+	commentID := uuid.New()
+	roleID := uuid.New()
+	require.NoError(t, suite.db.Exec("INSERT INTO comments (id, board_id, user_id, content) VALUES (?, ?, ?, 'root')",
+		commentID, boardID, userID).Error)
+
+	suite.commentRepo.On("FindByID", commentID).Return(&domain.Comment{BaseModel: domain.BaseModel{ID: commentID}, BoardID: boardID, UserID: userID, Content: "root"}, nil)
+	suite.commentRepo.On("HasRepliesByOthers", commentID, userID).Return(true, nil)
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{UserID: userID, ProjectID: projectID, RoleID: roleID}, nil)
+	suite.roleRepo.On("FindByID", roleID).Return(&domain.Role{Name: "MEMBER", Level: 10}, nil)
+
+	// When: The author deletes the comment
+	err := suite.service.DeleteComment(ctx, commentID, userID)
+
+	// Then: The other user's reply is not deleted with it
+	var appErr *apperrors.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 409, appErr.HTTPStatus)
+	assert.Zero(t, countRows(t, suite.db, "comments", "is_deleted = ?", true))
+	assert.Zero(t, countRows(t, suite.db, "trash_items", "1 = 1"))
+}
+
+func TestCommentService_DeleteComment_AdminDeletesThreadWithOthersReplies(t *testing.T) {
+	suite := setupCommentServiceTest(t)
+	createCommentTables(t, suite.db)
+
+	// Given: A comment by one user with a reply by another
+	ctx := context.Background()
+	adminID := uuid.New()
+	authorID := uuid.New()
+	boardID := uuid.New()
+	projectID := uuid.New()
+	commentID := uuid.New()
+	roleID := uuid.New()
+	require.NoError(t, suite.db.Exec(`INSERT INTO comments (id, board_id, user_id, content, parent_comment_id, depth) VALUES
+		(?, ?, ?, 'root', NULL, 0), (?, ?, ?, 'reply', ?, 1)`,
+		commentID, boardID, authorID, uuid.New(), boardID, uuid.New(), commentID).Error)
+
+	suite.commentRepo.On("FindByID", commentID).Return(&domain.Comment{BaseModel: domain.BaseModel{ID: commentID}, BoardID: boardID, UserID: authorID, Content: "root"}, nil)
+	suite.commentRepo.On("HasRepliesByOthers", commentID, authorID).Return(true, nil)
+	suite.boardRepo.On("FindByID", boardID).Return(&domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: projectID}, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", adminID, projectID).Return(&domain.ProjectMember{UserID: adminID, ProjectID: projectID, RoleID: roleID}, nil)
+	suite.roleRepo.On("FindByID", roleID).Return(&domain.Role{Name: "ADMIN", Level: 50}, nil)
+	suite.activityRepo.On("BatchCreate", mock.Anything).Return(nil)
+
+	// When: A project admin deletes the comment
+	err := suite.service.DeleteComment(ctx, commentID, adminID)
+
+	// Then: The whole thread moves to the trash, recorded as deleted by the admin
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), countRows(t, suite.db, "comments", "is_deleted = ?", true))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "trash_items", "item_id = ? AND deleted_by = ?", commentID, adminID))
+}
+
+func TestCommentService_ToggleReaction_DuplicateAddIsIgnored(t *testing.T) {
+	suite := setupCommentServiceTest(t)
+	createCommentTables(t, suite.db)
+
+	// Given: Two concurrent toggles that both found no reaction to remove
+	commentID := uuid.New()
+	userID := uuid.New()
+	repo := repository.NewCommentRepository(suite.db)
+	first, err := domain.NewCommentReaction(commentID, userID, "👍")
+	require.NoError(t, err)
+	second, err := domain.NewCommentReaction(commentID, userID, "👍")
+	require.NoError(t, err)
+
+	// When: Both add the reaction
+	added, err := repo.AddReaction(first)
+	require.NoError(t, err)
+	assert.True(t, added)
+	added, err = repo.AddReaction(second)
+
+	// Then: The second insert is a no-op instead of a unique violation
+	require.NoError(t, err)
+	assert.False(t, added)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "comment_reactions", "comment_id = ? AND user_id = ?", commentID, userID))
+}
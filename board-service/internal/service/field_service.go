@@ -5,7 +5,9 @@ import (
 	"board-service/internal/cache"
 	"board-service/internal/domain"
 	"board-service/internal/dto"
+	"board-service/internal/event"
 	"board-service/internal/repository"
+	"board-service/internal/uow"
 	"context"
 	"encoding/json"
 	"errors"
@@ -42,6 +44,7 @@ type fieldService struct {
 	cache       cache.FieldCache
 	logger      *zap.Logger
 	db          *gorm.DB
+	uow         uow.UnitOfWork
 }
 
 func NewFieldService(
@@ -57,6 +60,7 @@ func NewFieldService(
 		cache:       cache,
 		logger:      logger,
 		db:          db,
+		uow:         uow.NewUnitOfWork(db),
 	}
 }
 
@@ -116,8 +120,18 @@ func (s *fieldService) CreateField(userID string, req *dto.CreateFieldRequest) (
 		Config:       configJSON,
 	}
 
-	if err := s.repo.CreateField(field); err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 생성 실패", 500)
+	var response *dto.FieldResponse
+	err = s.saveWithEvent(func(repo repository.FieldRepository) error {
+		if err := repo.CreateField(field); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 생성 실패", 500)
+		}
+		response = s.buildFieldResponse(field)
+		return nil
+	}, func() event.Event {
+		return event.New(event.FieldCreated, field.ProjectID, userUUID, response)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Invalidate cache
@@ -126,7 +140,7 @@ func (s *fieldService) CreateField(userID string, req *dto.CreateFieldRequest) (
 		s.logger.Warn("Failed to invalidate project fields cache", zap.Error(err))
 	}
 
-	return s.buildFieldResponse(field), nil
+	return response, nil
 }
 
 func (s *fieldService) GetFieldsByProject(userID, projectID string) ([]dto.FieldResponse, error) {
@@ -266,8 +280,18 @@ func (s *fieldService) UpdateField(userID, fieldID string, req *dto.UpdateFieldR
 	}
 
 	// Save
-	if err := s.repo.UpdateField(field); err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 수정 실패", 500)
+	var response *dto.FieldResponse
+	err = s.saveWithEvent(func(repo repository.FieldRepository) error {
+		if err := repo.UpdateField(field); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 수정 실패", 500)
+		}
+		response = s.buildFieldResponse(field)
+		return nil
+	}, func() event.Event {
+		return event.New(event.FieldUpdated, field.ProjectID, userUUID, response)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Invalidate cache
@@ -276,7 +300,7 @@ func (s *fieldService) UpdateField(userID, fieldID string, req *dto.UpdateFieldR
 		s.logger.Warn("Failed to invalidate project fields cache", zap.Error(err))
 	}
 
-	return s.buildFieldResponse(field), nil
+	return response, nil
 }
 
 func (s *fieldService) DeleteField(userID, fieldID string) error {
@@ -318,8 +342,16 @@ func (s *fieldService) DeleteField(userID, fieldID string) error {
 	}
 
 	// Soft delete
-	if err := s.repo.DeleteField(fieldUUID); err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 삭제 실패", 500)
+	err = s.saveWithEvent(func(repo repository.FieldRepository) error {
+		if err := repo.DeleteField(fieldUUID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 삭제 실패", 500)
+		}
+		return nil
+	}, func() event.Event {
+		return event.New(event.FieldDeleted, field.ProjectID, userUUID, map[string]interface{}{"fieldId": fieldID})
+	})
+	if err != nil {
+		return err
 	}
 
 	// Invalidate cache
@@ -366,8 +398,16 @@ func (s *fieldService) UpdateFieldOrder(userID, projectID string, req *dto.Updat
 	}
 
 	// Batch update
-	if err := s.repo.BatchUpdateFieldOrders(orders); err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 순서 업데이트 실패", 500)
+	err = s.saveWithEvent(func(repo repository.FieldRepository) error {
+		if err := repo.BatchUpdateFieldOrders(orders); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 순서 업데이트 실패", 500)
+		}
+		return nil
+	}, func() event.Event {
+		return event.New(event.FieldUpdated, projectUUID, userUUID, map[string]interface{}{"fieldOrders": req.FieldOrders})
+	})
+	if err != nil {
+		return err
 	}
 
 	// Invalidate cache
@@ -435,8 +475,18 @@ func (s *fieldService) CreateOption(userID string, req *dto.CreateOptionRequest)
 		DisplayOrder: nextOrder,
 	}
 
-	if err := s.repo.CreateOption(option); err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 생성 실패", 500)
+	var response *dto.OptionResponse
+	err = s.saveWithEvent(func(repo repository.FieldRepository) error {
+		if err := repo.CreateOption(option); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 생성 실패", 500)
+		}
+		response = s.buildOptionResponse(option)
+		return nil
+	}, func() event.Event {
+		return event.New(event.FieldOptionChanged, field.ProjectID, userUUID, response)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Invalidate cache
@@ -445,7 +495,7 @@ func (s *fieldService) CreateOption(userID string, req *dto.CreateOptionRequest)
 		s.logger.Warn("Failed to invalidate field options cache", zap.Error(err))
 	}
 
-	return s.buildOptionResponse(option), nil
+	return response, nil
 }
 
 func (s *fieldService) GetOptionsByField(userID, fieldID string) ([]dto.OptionResponse, error) {
@@ -594,8 +644,18 @@ func (s *fieldService) UpdateOption(userID, optionID string, req *dto.UpdateOpti
 	}
 
 	// Save
-	if err := s.repo.UpdateOption(option); err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 수정 실패", 500)
+	var response *dto.OptionResponse
+	err = s.saveWithEvent(func(repo repository.FieldRepository) error {
+		if err := repo.UpdateOption(option); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 수정 실패", 500)
+		}
+		response = s.buildOptionResponse(option)
+		return nil
+	}, func() event.Event {
+		return event.New(event.FieldOptionChanged, field.ProjectID, userUUID, response)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Invalidate cache
@@ -604,7 +664,7 @@ func (s *fieldService) UpdateOption(userID, optionID string, req *dto.UpdateOpti
 		s.logger.Warn("Failed to invalidate field options cache", zap.Error(err))
 	}
 
-	return s.buildOptionResponse(option), nil
+	return response, nil
 }
 
 func (s *fieldService) DeleteOption(userID, optionID string) error {
@@ -646,8 +706,20 @@ func (s *fieldService) DeleteOption(userID, optionID string) error {
 	}
 
 	// Soft delete
-	if err := s.repo.DeleteOption(optionUUID); err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 삭제 실패", 500)
+	err = s.saveWithEvent(func(repo repository.FieldRepository) error {
+		if err := repo.DeleteOption(optionUUID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 삭제 실패", 500)
+		}
+		return nil
+	}, func() event.Event {
+		return event.New(event.FieldOptionChanged, field.ProjectID, userUUID, map[string]interface{}{
+			"fieldId":  option.FieldID.String(),
+			"optionId": optionID,
+			"deleted":  true,
+		})
+	})
+	if err != nil {
+		return err
 	}
 
 	// Invalidate cache
@@ -703,8 +775,19 @@ func (s *fieldService) UpdateOptionOrder(userID, fieldID string, req *dto.Update
 	}
 
 	// Batch update
-	if err := s.repo.BatchUpdateOptionOrders(orders); err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 순서 업데이트 실패", 500)
+	err = s.saveWithEvent(func(repo repository.FieldRepository) error {
+		if err := repo.BatchUpdateOptionOrders(orders); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 순서 업데이트 실패", 500)
+		}
+		return nil
+	}, func() event.Event {
+		return event.New(event.FieldOptionChanged, field.ProjectID, userUUID, map[string]interface{}{
+			"fieldId":      fieldID,
+			"optionOrders": req.OptionOrders,
+		})
+	})
+	if err != nil {
+		return err
 	}
 
 	// Invalidate cache
@@ -718,6 +801,21 @@ func (s *fieldService) UpdateOptionOrder(userID, fieldID string, req *dto.Update
 
 // ==================== Helper Methods ====================
 
+// saveWithEvent runs a field change and records its project event in one transaction,
+// so that the event is published only if the change is committed
+// newEvent is called after change so that it can use the saved IDs
+func (s *fieldService) saveWithEvent(change func(repo repository.FieldRepository) error, newEvent func() event.Event) error {
+	return s.uow.Do(func(repos *uow.Repositories) error {
+		if err := change(repos.Field); err != nil {
+			return err
+		}
+		if err := repos.Outbox.Write(newEvent()); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 변경 이벤트 기록 실패", 500)
+		}
+		return nil
+	})
+}
+
 func (s *fieldService) buildFieldResponse(field *domain.ProjectField) *dto.FieldResponse {
 	// Parse config JSON
 	var config map[string]interface{}
@@ -3,9 +3,12 @@ package service
 import (
 	"board-service/internal/apperrors"
 	"board-service/internal/cache"
+	"board-service/internal/client"
 	"board-service/internal/domain"
 	"board-service/internal/dto"
+	"board-service/internal/event"
 	"board-service/internal/repository"
+	"board-service/internal/uow"
 	"context"
 	"encoding/json"
 	"errors"
@@ -37,16 +40,23 @@ type fieldValueService struct {
 	repo         repository.FieldRepository
 	boardRepo    repository.BoardRepository
 	projectRepo  repository.ProjectRepository
+	activities   *boardActivityRecorder
+	notifier     *boardNotifier // Field change notifications for watchers
 	cache        cache.FieldCache
 	logger       *zap.Logger
 	db           *gorm.DB
+	uow          uow.UnitOfWork
 }
 
 func NewFieldValueService(
 	repo repository.FieldRepository,
 	boardRepo repository.BoardRepository,
 	projectRepo repository.ProjectRepository,
+	activityRepo repository.BoardActivityRepository,
+	notificationRepo repository.NotificationRepository,
 	cache cache.FieldCache,
+	userClient client.UserClient,
+	userInfoCache cache.UserInfoCache,
 	logger *zap.Logger,
 	db *gorm.DB,
 ) FieldValueService {
@@ -54,9 +64,12 @@ func NewFieldValueService(
 		repo:        repo,
 		boardRepo:   boardRepo,
 		projectRepo: projectRepo,
+		activities:  newBoardActivityRecorder(activityRepo, logger),
+		notifier:    newBoardNotifier(notificationRepo, projectRepo, userClient, userInfoCache, logger),
 		cache:       cache,
 		logger:      logger,
 		db:          db,
+		uow:         uow.NewUnitOfWork(db),
 	}
 }
 
@@ -111,7 +124,10 @@ func (s *fieldValueService) SetFieldValue(userID string, req *dto.SetFieldValueR
 	}
 
 	// 5. Validate and set value based on field type
-	if err := s.setValueByType(boardUUID, fieldUUID, field.FieldType, field.Config, req.Value, req.Values); err != nil {
+	activity, err := s.saveFieldValue(board, field, userUUID, func(repo repository.FieldRepository) error {
+		return s.setValueByType(repo, boardUUID, fieldUUID, field.FieldType, field.Config, req.Value, req.Values)
+	})
+	if err != nil {
 		return err
 	}
 
@@ -120,6 +136,9 @@ func (s *fieldValueService) SetFieldValue(userID string, req *dto.SetFieldValueR
 		s.logger.Warn("Failed to update board cache", zap.Error(err))
 	}
 
+	// 7. Record activity and notify watchers
+	s.recordChange(board, field, userUUID, activity)
+
 	return nil
 }
 
@@ -171,12 +190,7 @@ func (s *fieldValueService) SetMultiSelectValue(userID string, req *dto.SetMulti
 		return apperrors.New(apperrors.ErrCodeBadRequest, "Multi-select 또는 Multi-user 필드만 지원합니다", 400)
 	}
 
-	// 5. Delete existing values
-	if err := s.repo.BatchDeleteFieldValues(boardUUID, fieldUUID); err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "기존 값 삭제 실패", 500)
-	}
-
-	// 6. Set new ordered values
+	// 5. Build new ordered values
 	values := make([]domain.BoardFieldValue, 0, len(req.Values))
 	for _, orderedVal := range req.Values {
 		valueUUID, err := uuid.Parse(orderedVal.ValueID)
@@ -199,8 +213,18 @@ func (s *fieldValueService) SetMultiSelectValue(userID string, req *dto.SetMulti
 		values = append(values, value)
 	}
 
-	if err := s.repo.BatchSetFieldValues(values); err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "값 설정 실패", 500)
+	// 6. Replace existing values
+	activity, err := s.saveFieldValue(board, field, userUUID, func(repo repository.FieldRepository) error {
+		if err := repo.BatchDeleteFieldValues(boardUUID, fieldUUID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "기존 값 삭제 실패", 500)
+		}
+		if err := repo.BatchSetFieldValues(values); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "값 설정 실패", 500)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	// 7. Update board cache
@@ -208,6 +232,9 @@ func (s *fieldValueService) SetMultiSelectValue(userID string, req *dto.SetMulti
 		s.logger.Warn("Failed to update board cache", zap.Error(err))
 	}
 
+	// 8. Record activity and notify watchers
+	s.recordChange(board, field, userUUID, activity)
+
 	return nil
 }
 
@@ -333,16 +360,34 @@ func (s *fieldValueService) DeleteFieldValue(userID, boardID, fieldID string) er
 		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
 	}
 
-	// 3. Delete field value
-	if err := s.repo.DeleteFieldValue(boardUUID, fieldUUID); err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 삭제 실패", 500)
+	// 3. Fetch field (for activity history)
+	field, err := s.repo.FindFieldByID(fieldUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.New(apperrors.ErrCodeNotFound, "필드를 찾을 수 없습니다", 404)
+		}
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
 	}
 
-	// 4. Update board cache
+	// 4. Delete field value
+	activity, err := s.saveFieldValue(board, field, userUUID, func(repo repository.FieldRepository) error {
+		if err := repo.DeleteFieldValue(boardUUID, fieldUUID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 삭제 실패", 500)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 5. Update board cache
 	if err := s.updateBoardCache(boardUUID); err != nil {
 		s.logger.Warn("Failed to update board cache", zap.Error(err))
 	}
 
+	// 6. Record activity and notify watchers
+	s.recordChange(board, field, userUUID, activity)
+
 	return nil
 }
 
@@ -352,7 +397,7 @@ func (s *fieldValueService) UpdateMultiSelectOrder(userID, boardID, fieldID stri
 
 // ==================== Helper Methods ====================
 
-func (s *fieldValueService) setValueByType(boardID, fieldID uuid.UUID, fieldType domain.FieldType, configJSON string, singleValue, multiValue interface{}) error {
+func (s *fieldValueService) setValueByType(repo repository.FieldRepository, boardID, fieldID uuid.UUID, fieldType domain.FieldType, configJSON string, singleValue, multiValue interface{}) error {
 	// Parse config
 	var config domain.FieldConfig
 	if err := json.Unmarshal([]byte(configJSON), &config); err != nil {
@@ -361,29 +406,29 @@ func (s *fieldValueService) setValueByType(boardID, fieldID uuid.UUID, fieldType
 
 	switch fieldType {
 	case domain.FieldTypeText:
-		return s.setTextValue(boardID, fieldID, singleValue, config)
+		return s.setTextValue(repo, boardID, fieldID, singleValue, config)
 	case domain.FieldTypeNumber:
-		return s.setNumberValue(boardID, fieldID, singleValue, config)
+		return s.setNumberValue(repo, boardID, fieldID, singleValue, config)
 	case domain.FieldTypeSingleSelect:
-		return s.setSingleSelectValue(boardID, fieldID, singleValue)
+		return s.setSingleSelectValue(repo, boardID, fieldID, singleValue)
 	case domain.FieldTypeMultiSelect:
-		return s.setMultiSelectValues(boardID, fieldID, multiValue, config)
+		return s.setMultiSelectValues(repo, boardID, fieldID, multiValue, config)
 	case domain.FieldTypeDate, domain.FieldTypeDateTime:
-		return s.setDateValue(boardID, fieldID, singleValue)
+		return s.setDateValue(repo, boardID, fieldID, singleValue)
 	case domain.FieldTypeSingleUser:
-		return s.setSingleUserValue(boardID, fieldID, singleValue)
+		return s.setSingleUserValue(repo, boardID, fieldID, singleValue)
 	case domain.FieldTypeMultiUser:
-		return s.setMultiUserValues(boardID, fieldID, multiValue, config)
+		return s.setMultiUserValues(repo, boardID, fieldID, multiValue, config)
 	case domain.FieldTypeCheckbox:
-		return s.setCheckboxValue(boardID, fieldID, singleValue)
+		return s.setCheckboxValue(repo, boardID, fieldID, singleValue)
 	case domain.FieldTypeURL:
-		return s.setURLValue(boardID, fieldID, singleValue)
+		return s.setURLValue(repo, boardID, fieldID, singleValue)
 	default:
 		return apperrors.New(apperrors.ErrCodeBadRequest, "지원하지 않는 필드 타입입니다", 400)
 	}
 }
 
-func (s *fieldValueService) setTextValue(boardID, fieldID uuid.UUID, value interface{}, config domain.FieldConfig) error {
+func (s *fieldValueService) setTextValue(repo repository.FieldRepository, boardID, fieldID uuid.UUID, value interface{}, config domain.FieldConfig) error {
 	strVal, ok := value.(string)
 	if !ok {
 		return apperrors.New(apperrors.ErrCodeBadRequest, "텍스트 값이 필요합니다", 400)
@@ -400,10 +445,10 @@ func (s *fieldValueService) setTextValue(boardID, fieldID uuid.UUID, value inter
 		ValueText: &strVal,
 	}
 
-	return s.repo.SetFieldValue(val)
+	return repo.SetFieldValue(val)
 }
 
-func (s *fieldValueService) setNumberValue(boardID, fieldID uuid.UUID, value interface{}, config domain.FieldConfig) error {
+func (s *fieldValueService) setNumberValue(repo repository.FieldRepository, boardID, fieldID uuid.UUID, value interface{}, config domain.FieldConfig) error {
 	var numVal float64
 	switch v := value.(type) {
 	case float64:
@@ -428,10 +473,10 @@ func (s *fieldValueService) setNumberValue(boardID, fieldID uuid.UUID, value int
 		ValueNumber: &numVal,
 	}
 
-	return s.repo.SetFieldValue(val)
+	return repo.SetFieldValue(val)
 }
 
-func (s *fieldValueService) setSingleSelectValue(boardID, fieldID uuid.UUID, value interface{}) error {
+func (s *fieldValueService) setSingleSelectValue(repo repository.FieldRepository, boardID, fieldID uuid.UUID, value interface{}) error {
 	optionIDStr, ok := value.(string)
 	if !ok {
 		return apperrors.New(apperrors.ErrCodeBadRequest, "옵션 ID가 필요합니다", 400)
@@ -443,21 +488,26 @@ func (s *fieldValueService) setSingleSelectValue(boardID, fieldID uuid.UUID, val
 	}
 
 	// Validate option exists and belongs to field
-	option, err := s.repo.FindOptionByID(optionID)
+	option, err := repo.FindOptionByID(optionID)
 	if err != nil || option.FieldID != fieldID {
 		return apperrors.New(apperrors.ErrCodeBadRequest, "유효하지 않은 옵션입니다", 400)
 	}
 
+	// Replace the previous option; a single select keeps one value (the stage history reads it back)
+	if err := repo.BatchDeleteFieldValues(boardID, fieldID); err != nil {
+		return err
+	}
+
 	val := &domain.BoardFieldValue{
 		BoardID:       boardID,
 		FieldID:       fieldID,
 		ValueOptionID: &optionID,
 	}
 
-	return s.repo.SetFieldValue(val)
+	return repo.SetFieldValue(val)
 }
 
-func (s *fieldValueService) setMultiSelectValues(boardID, fieldID uuid.UUID, values interface{}, config domain.FieldConfig) error {
+func (s *fieldValueService) setMultiSelectValues(repo repository.FieldRepository, boardID, fieldID uuid.UUID, values interface{}, config domain.FieldConfig) error {
 	optionIDs, ok := values.([]interface{})
 	if !ok {
 		return apperrors.New(apperrors.ErrCodeBadRequest, "옵션 ID 배열이 필요합니다", 400)
@@ -469,7 +519,7 @@ func (s *fieldValueService) setMultiSelectValues(boardID, fieldID uuid.UUID, val
 	}
 
 	// Delete existing values
-	if err := s.repo.BatchDeleteFieldValues(boardID, fieldID); err != nil {
+	if err := repo.BatchDeleteFieldValues(boardID, fieldID); err != nil {
 		return err
 	}
 
@@ -494,10 +544,10 @@ func (s *fieldValueService) setMultiSelectValues(boardID, fieldID uuid.UUID, val
 		})
 	}
 
-	return s.repo.BatchSetFieldValues(fieldValues)
+	return repo.BatchSetFieldValues(fieldValues)
 }
 
-func (s *fieldValueService) setDateValue(boardID, fieldID uuid.UUID, value interface{}) error {
+func (s *fieldValueService) setDateValue(repo repository.FieldRepository, boardID, fieldID uuid.UUID, value interface{}) error {
 	dateStr, ok := value.(string)
 	if !ok {
 		return apperrors.New(apperrors.ErrCodeBadRequest, "날짜 문자열이 필요합니다 (ISO 8601)", 400)
@@ -514,10 +564,10 @@ func (s *fieldValueService) setDateValue(boardID, fieldID uuid.UUID, value inter
 		ValueDate: &dateVal,
 	}
 
-	return s.repo.SetFieldValue(val)
+	return repo.SetFieldValue(val)
 }
 
-func (s *fieldValueService) setSingleUserValue(boardID, fieldID uuid.UUID, value interface{}) error {
+func (s *fieldValueService) setSingleUserValue(repo repository.FieldRepository, boardID, fieldID uuid.UUID, value interface{}) error {
 	userIDStr, ok := value.(string)
 	if !ok {
 		return apperrors.New(apperrors.ErrCodeBadRequest, "사용자 ID가 필요합니다", 400)
@@ -534,10 +584,10 @@ func (s *fieldValueService) setSingleUserValue(boardID, fieldID uuid.UUID, value
 		ValueUserID: &userID,
 	}
 
-	return s.repo.SetFieldValue(val)
+	return repo.SetFieldValue(val)
 }
 
-func (s *fieldValueService) setMultiUserValues(boardID, fieldID uuid.UUID, values interface{}, config domain.FieldConfig) error {
+func (s *fieldValueService) setMultiUserValues(repo repository.FieldRepository, boardID, fieldID uuid.UUID, values interface{}, config domain.FieldConfig) error {
 	userIDs, ok := values.([]interface{})
 	if !ok {
 		return apperrors.New(apperrors.ErrCodeBadRequest, "사용자 ID 배열이 필요합니다", 400)
@@ -549,7 +599,7 @@ func (s *fieldValueService) setMultiUserValues(boardID, fieldID uuid.UUID, value
 	}
 
 	// Delete existing values
-	if err := s.repo.BatchDeleteFieldValues(boardID, fieldID); err != nil {
+	if err := repo.BatchDeleteFieldValues(boardID, fieldID); err != nil {
 		return err
 	}
 
@@ -574,10 +624,10 @@ func (s *fieldValueService) setMultiUserValues(boardID, fieldID uuid.UUID, value
 		})
 	}
 
-	return s.repo.BatchSetFieldValues(fieldValues)
+	return repo.BatchSetFieldValues(fieldValues)
 }
 
-func (s *fieldValueService) setCheckboxValue(boardID, fieldID uuid.UUID, value interface{}) error {
+func (s *fieldValueService) setCheckboxValue(repo repository.FieldRepository, boardID, fieldID uuid.UUID, value interface{}) error {
 	boolVal, ok := value.(bool)
 	if !ok {
 		return apperrors.New(apperrors.ErrCodeBadRequest, "불린 값이 필요합니다", 400)
@@ -589,10 +639,10 @@ func (s *fieldValueService) setCheckboxValue(boardID, fieldID uuid.UUID, value i
 		ValueBoolean: &boolVal,
 	}
 
-	return s.repo.SetFieldValue(val)
+	return repo.SetFieldValue(val)
 }
 
-func (s *fieldValueService) setURLValue(boardID, fieldID uuid.UUID, value interface{}) error {
+func (s *fieldValueService) setURLValue(repo repository.FieldRepository, boardID, fieldID uuid.UUID, value interface{}) error {
 	urlStr, ok := value.(string)
 	if !ok {
 		return apperrors.New(apperrors.ErrCodeBadRequest, "URL 문자열이 필요합니다", 400)
@@ -609,7 +659,82 @@ func (s *fieldValueService) setURLValue(boardID, fieldID uuid.UUID, value interf
 		ValueText: &urlStr,
 	}
 
-	return s.repo.SetFieldValue(val)
+	return repo.SetFieldValue(val)
+}
+
+// saveFieldValue runs a value change in a transaction and, if the value actually changed,
+// records a field.value_changed event in the same transaction
+// The FIELD_VALUE_CHANGED activity is returned so that it can be recorded after the commit
+func (s *fieldValueService) saveFieldValue(board *domain.Board, field *domain.ProjectField, actorID uuid.UUID, change func(repo repository.FieldRepository) error) ([]domain.BoardActivity, error) {
+	var activities []domain.BoardActivity
+	stageChanged := false
+	err := s.uow.Do(func(repos *uow.Repositories) error {
+		oldValue := s.snapshotForActivity(repos.Field, board.ID, field)
+		var oldStage *uuid.UUID
+		if isStageField(field) {
+			var err error
+			if oldStage, err = currentStageOption(repos.Field, board.ID, field.ID); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 조회 실패", 500)
+			}
+		}
+		if err := change(repos.Field); err != nil {
+			return err
+		}
+		newValue := s.snapshotForActivity(repos.Field, board.ID, field)
+
+		// Stage changes feed the project analytics
+		if isStageField(field) {
+			newStage, err := currentStageOption(repos.Field, board.ID, field.ID)
+			if err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 조회 실패", 500)
+			}
+			if stageChanged, err = logStageChange(repos.StageChange, board, field.ID, oldStage, newStage, actorID); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Stage 변경 이력 기록 실패", 500)
+			}
+		}
+
+		activity := domain.NewBoardActivity(board, actorID, domain.BoardActivityFieldValueChanged)
+		activity.SetCustomFieldChange(field, encodeActivityValue(oldValue), encodeActivityValue(newValue))
+		if !activity.HasChanged() {
+			return nil
+		}
+		activities = append(activities, activity)
+
+		changedEvent := event.NewBoardEvent(event.FieldValueChanged, board.ProjectID, board.ID, actorID, map[string]interface{}{
+			"fieldId":   field.ID.String(),
+			"fieldName": field.Name,
+			"value":     newValue,
+		})
+		if err := repos.Outbox.Write(changedEvent); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 변경 이벤트 기록 실패", 500)
+		}
+		return nil
+	})
+	if err == nil && stageChanged {
+		invalidateProjectAnalytics(s.cache, s.logger, board.ProjectID)
+	}
+	return activities, err
+}
+
+// recordChange records the FIELD_VALUE_CHANGED activity and notifies the board's watchers
+// Nothing happens if the value did not actually change
+func (s *fieldValueService) recordChange(board *domain.Board, field *domain.ProjectField, actorID uuid.UUID, activities []domain.BoardActivity) {
+	if len(activities) == 0 {
+		return
+	}
+	s.activities.record(activities...)
+	s.notifier.fieldChanged(board, field, actorID)
+}
+
+// snapshotForActivity returns the field's current value for the activity history
+// A failed lookup only loses the value in the history, so it is logged instead of returned
+func (s *fieldValueService) snapshotForActivity(repo repository.FieldRepository, boardID uuid.UUID, field *domain.ProjectField) interface{} {
+	value, err := snapshotFieldValue(repo, boardID, field)
+	if err != nil {
+		s.logger.Warn("Failed to snapshot field value", zap.Error(err), zap.String("board_id", boardID.String()))
+		return nil
+	}
+	return value
 }
 
 func (s *fieldValueService) updateBoardCache(boardID uuid.UUID) error {
@@ -0,0 +1,531 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/auth"
+	"board-service/internal/common/parser"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"board-service/internal/uow"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// MilestoneService는 프로젝트의 마일스톤(릴리스 버전)과 보드의 마일스톤 배정, 진행률을 관리합니다
+// 마일스톤 생성/수정/삭제/릴리스는 ADMIN 이상만 가능하며, 조회와 보드 배정은 프로젝트 멤버라면 누구나 가능합니다
+// 보드의 완료 여부는 Stage 필드의 완료 옵션으로 판단합니다 (기본값: "Done" 또는 마지막 Stage 옵션)
+type MilestoneService interface {
+	CreateMilestone(userID, projectID string, req *dto.CreateMilestoneRequest) (*dto.MilestoneResponse, error)
+	GetMilestones(userID, projectID string, req *dto.GetMilestonesRequest) ([]dto.MilestoneResponse, error)
+	GetMilestone(userID, milestoneID string) (*dto.MilestoneDetailResponse, error)
+	UpdateMilestone(userID, milestoneID string, req *dto.UpdateMilestoneRequest) (*dto.MilestoneResponse, error)
+	DeleteMilestone(userID, milestoneID string) error
+
+	// Boards
+	SetBoardMilestone(userID, boardID string, req *dto.SetBoardMilestoneRequest) (*dto.BoardMilestoneResponse, error)
+}
+
+type milestoneService struct {
+	repo       repository.MilestoneRepository
+	boardRepo  repository.BoardRepository
+	fieldRepo  repository.FieldRepository
+	activities *boardActivityRecorder
+	authorizer auth.ProjectAuthorizer
+	logger     *zap.Logger
+	uow        uow.UnitOfWork
+}
+
+func NewMilestoneService(
+	repo repository.MilestoneRepository,
+	boardRepo repository.BoardRepository,
+	fieldRepo repository.FieldRepository,
+	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
+	activityRepo repository.BoardActivityRepository,
+	logger *zap.Logger,
+	db *gorm.DB,
+) MilestoneService {
+	return &milestoneService{
+		repo:       repo,
+		boardRepo:  boardRepo,
+		fieldRepo:  fieldRepo,
+		activities: newBoardActivityRecorder(activityRepo, logger),
+		authorizer: auth.NewProjectAuthorizer(projectRepo, roleRepo),
+		logger:     logger,
+		uow:        uow.NewUnitOfWork(db),
+	}
+}
+
+// completedStage is the Stage option that counts as done, with all options of the Stage field
+// A project without a Stage field (or without options) has no completed option
+type completedStage struct {
+	fieldID  uuid.UUID
+	optionID uuid.UUID
+	options  []domain.FieldOption
+}
+
+func (c *completedStage) exists() bool {
+	return c.optionID != uuid.Nil
+}
+
+// ==================== Milestones ====================
+
+// CreateMilestone creates an unreleased milestone of the project
+func (s *milestoneService) CreateMilestone(userID, projectID string, req *dto.CreateMilestoneRequest) (*dto.MilestoneResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizer.RequireAdmin(userUUID, projectUUID); err != nil {
+		return nil, err
+	}
+
+	targetDate, err := parseOptionalDay(req.TargetDate, "목표일")
+	if err != nil {
+		return nil, err
+	}
+
+	milestone, err := domain.NewMilestone(projectUUID, req.Name, req.Description, targetDate, userUUID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Milestone.Create(milestone); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "마일스톤 생성 실패", 500)
+		}
+		return writeMilestoneEvent(repos.Outbox, event.MilestoneCreated, milestone, userUUID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := toMilestoneResponse(milestone, 0, 0, time.Now())
+	return &response, nil
+}
+
+// GetMilestones lists the milestones of the project with their progress: unreleased milestones first, then by target date
+func (s *milestoneService) GetMilestones(userID, projectID string, req *dto.GetMilestonesRequest) ([]dto.MilestoneResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizer.RequireMember(userUUID, projectUUID); err != nil {
+		return nil, err
+	}
+
+	milestones, err := s.repo.FindByProject(projectUUID, req.Released)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "마일스톤 조회 실패", 500)
+	}
+
+	milestoneIDs := make([]uuid.UUID, 0, len(milestones))
+	for _, milestone := range milestones {
+		milestoneIDs = append(milestoneIDs, milestone.ID)
+	}
+	totals, completed, err := s.countBoards(projectUUID, milestoneIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	responses := make([]dto.MilestoneResponse, 0, len(milestones))
+	for i := range milestones {
+		id := milestones[i].ID
+		responses = append(responses, toMilestoneResponse(&milestones[i], totals[id], completed[id], now))
+	}
+	return responses, nil
+}
+
+// GetMilestone returns the milestone with the number of its boards in each Stage option and its overdue boards
+// An open board is overdue when its due date passed, or when it has no due date and the milestone passed its target date
+func (s *milestoneService) GetMilestone(userID, milestoneID string) (*dto.MilestoneDetailResponse, error) {
+	_, milestone, err := s.findMilestone(userID, milestoneID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	boards, err := s.repo.FindBoards(milestone.ID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "마일스톤 보드 조회 실패", 500)
+	}
+	stage, err := s.completedStage(milestone.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Current Stage option of each board
+	boardOptions := make(map[uuid.UUID]uuid.UUID, len(boards))
+	if stage.exists() && len(boards) > 0 {
+		boardIDs := make([]uuid.UUID, 0, len(boards))
+		for _, board := range boards {
+			boardIDs = append(boardIDs, board.ID)
+		}
+		values, err := s.fieldRepo.FindFieldValuesByBoards(boardIDs)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 단계 조회 실패", 500)
+		}
+		for boardID, boardValues := range values {
+			for _, value := range boardValues {
+				if value.FieldID == stage.fieldID && value.ValueOptionID != nil {
+					boardOptions[boardID] = *value.ValueOptionID
+				}
+			}
+		}
+	}
+
+	counts := make(map[uuid.UUID]int, len(stage.options))
+	noStage, completedCount := 0, 0
+	now := time.Now()
+	overdue := make([]dto.MilestoneBoard, 0)
+	for i := range boards {
+		board := &boards[i]
+		optionID, ok := boardOptions[board.ID]
+		if ok {
+			counts[optionID]++
+		} else {
+			noStage++
+		}
+		if ok && optionID == stage.optionID {
+			completedCount++
+			continue
+		}
+		if milestone.Released {
+			continue
+		}
+		if board.IsOverdue() || (board.DueDate == nil && milestone.IsPastTarget(now)) {
+			overdue = append(overdue, toMilestoneBoard(board))
+		}
+	}
+
+	stages := make([]dto.MilestoneStageProgress, 0, len(stage.options))
+	for _, option := range stage.options {
+		stages = append(stages, dto.MilestoneStageProgress{
+			OptionID:   option.ID.String(),
+			Label:      option.Label,
+			Color:      option.Color,
+			BoardCount: counts[option.ID],
+			Completed:  option.ID == stage.optionID,
+		})
+	}
+
+	response := &dto.MilestoneDetailResponse{
+		MilestoneResponse: toMilestoneResponse(milestone, len(boards), completedCount, now),
+		Stages:            stages,
+		NoStageCount:      noStage,
+		OverdueBoards:     overdue,
+	}
+	if len(boards) > 0 {
+		response.PercentComplete = completedCount * 100 / len(boards)
+	}
+	return response, nil
+}
+
+// UpdateMilestone changes the name, description or target date of a milestone, or releases or reopens it
+func (s *milestoneService) UpdateMilestone(userID, milestoneID string, req *dto.UpdateMilestoneRequest) (*dto.MilestoneResponse, error) {
+	userUUID, milestone, err := s.findMilestone(userID, milestoneID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		if err := milestone.UpdateName(*req.Name); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+	if req.Description != nil {
+		if err := milestone.UpdateDescription(*req.Description); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+	if req.TargetDate != nil {
+		targetDate, err := parseOptionalDay(req.TargetDate, "목표일")
+		if err != nil {
+			return nil, err
+		}
+		milestone.SetTargetDate(targetDate)
+	}
+	if req.Released != nil {
+		if *req.Released {
+			milestone.Release(time.Now())
+		} else {
+			milestone.Unrelease()
+		}
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Milestone.Update(milestone); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "마일스톤 수정 실패", 500)
+		}
+		return writeMilestoneEvent(repos.Outbox, event.MilestoneUpdated, milestone, userUUID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	totals, completed, err := s.countBoards(milestone.ProjectID, []uuid.UUID{milestone.ID})
+	if err != nil {
+		return nil, err
+	}
+	response := toMilestoneResponse(milestone, totals[milestone.ID], completed[milestone.ID], time.Now())
+	return &response, nil
+}
+
+// DeleteMilestone deletes the milestone; its boards are kept without a milestone
+func (s *milestoneService) DeleteMilestone(userID, milestoneID string) error {
+	userUUID, milestone, err := s.findMilestone(userID, milestoneID, true)
+	if err != nil {
+		return err
+	}
+
+	return s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Milestone.ClearBoards(milestone.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "마일스톤 보드 해제 실패", 500)
+		}
+		if err := repos.Milestone.Delete(milestone.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "마일스톤 삭제 실패", 500)
+		}
+		return writeMilestoneEvent(repos.Outbox, event.MilestoneDeleted, milestone, userUUID)
+	})
+}
+
+// ==================== Boards ====================
+
+// SetBoardMilestone targets the board at an unreleased milestone of its project, or removes it from its milestone
+func (s *milestoneService) SetBoardMilestone(userID, boardID string, req *dto.SetBoardMilestoneRequest) (*dto.BoardMilestoneResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := s.boardRepo.FindByID(boardUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	if _, err := s.authorizer.RequireMember(userUUID, board.ProjectID); err != nil {
+		return nil, err
+	}
+
+	var target *domain.Milestone
+	if req.MilestoneID != nil && *req.MilestoneID != "" {
+		milestoneUUID, err := parser.ParseUUID(*req.MilestoneID, "마일스톤")
+		if err != nil {
+			return nil, err
+		}
+		target, err = s.repo.FindByID(milestoneUUID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "마일스톤 조회 실패", 500)
+		}
+		if target == nil || target.ProjectID != board.ProjectID {
+			return nil, apperrors.New(apperrors.ErrCodeBadRequest, "같은 프로젝트의 마일스톤만 지정할 수 있습니다", 400)
+		}
+		if target.Released {
+			return nil, apperrors.New(apperrors.ErrCodeConflict, "릴리스된 마일스톤에는 보드를 추가할 수 없습니다", 409)
+		}
+	}
+
+	response := &dto.BoardMilestoneResponse{BoardID: board.ID.String()}
+	if target != nil {
+		milestoneID := target.ID.String()
+		response.MilestoneID = &milestoneID
+		if board.MilestoneID != nil && *board.MilestoneID == target.ID {
+			return response, nil
+		}
+	} else if board.MilestoneID == nil {
+		return response, nil
+	}
+
+	var previous *domain.Milestone
+	if board.MilestoneID != nil {
+		previous, err = s.repo.FindByID(*board.MilestoneID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "마일스톤 조회 실패", 500)
+		}
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		var targetID *uuid.UUID
+		if target != nil {
+			targetID = &target.ID
+		}
+		if err := repos.Milestone.AssignBoards([]uuid.UUID{board.ID}, targetID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "마일스톤 배정 실패", 500)
+		}
+
+		data := map[string]interface{}{
+			"milestoneId":         targetID,
+			"previousMilestoneId": board.MilestoneID,
+		}
+		if err := repos.Outbox.Write(event.NewBoardEvent(event.BoardMilestoneChanged, board.ProjectID, board.ID, userUUID, data)); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "마일스톤 이벤트 기록 실패", 500)
+		}
+		board.AssignMilestone(targetID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.activities.record(milestoneActivity(board, userUUID, previous, target))
+	return response, nil
+}
+
+// ==================== Helper Methods ====================
+
+// findMilestone finds the milestone and checks that the user is a member (or an ADMIN) of its project
+func (s *milestoneService) findMilestone(userID, milestoneID string, requireAdmin bool) (uuid.UUID, *domain.Milestone, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+	milestoneUUID, err := parser.ParseUUID(milestoneID, "마일스톤")
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	milestone, err := s.repo.FindByID(milestoneUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, nil, apperrors.New(apperrors.ErrCodeNotFound, "마일스톤을 찾을 수 없습니다", 404)
+		}
+		return uuid.Nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "마일스톤 조회 실패", 500)
+	}
+
+	if requireAdmin {
+		_, err = s.authorizer.RequireAdmin(userUUID, milestone.ProjectID)
+	} else {
+		_, err = s.authorizer.RequireMember(userUUID, milestone.ProjectID)
+	}
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+	return userUUID, milestone, nil
+}
+
+// completedStage resolves the Stage field of the project and the option that counts as done
+func (s *milestoneService) completedStage(projectID uuid.UUID) (*completedStage, error) {
+	stageField, err := findStageField(s.fieldRepo, projectID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	if stageField == nil {
+		return &completedStage{}, nil
+	}
+
+	options, err := s.fieldRepo.FindOptionsByField(stageField.ID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 옵션 조회 실패", 500)
+	}
+	if len(options) == 0 {
+		return &completedStage{fieldID: stageField.ID}, nil
+	}
+	_, completedOptionID, err := resolveStageOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	return &completedStage{fieldID: stageField.ID, optionID: completedOptionID, options: options}, nil
+}
+
+// countBoards returns the number of boards and of completed boards in each milestone
+func (s *milestoneService) countBoards(projectID uuid.UUID, milestoneIDs []uuid.UUID) (map[uuid.UUID]int, map[uuid.UUID]int, error) {
+	totals, err := s.repo.CountBoards(milestoneIDs)
+	if err != nil {
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "마일스톤 보드 조회 실패", 500)
+	}
+	if len(totals) == 0 {
+		return totals, map[uuid.UUID]int{}, nil
+	}
+
+	stage, err := s.completedStage(projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !stage.exists() {
+		return totals, map[uuid.UUID]int{}, nil
+	}
+	completed, err := s.repo.CountBoardsWithOption(milestoneIDs, stage.fieldID, stage.optionID)
+	if err != nil {
+		return nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 단계 조회 실패", 500)
+	}
+	return totals, completed, nil
+}
+
+// writeMilestoneEvent records a change of the milestone in the outbox
+func writeMilestoneEvent(outbox repository.OutboxWriter, eventType event.Type, milestone *domain.Milestone, actorID uuid.UUID) error {
+	data := map[string]interface{}{
+		"milestone": toMilestoneResponse(milestone, 0, 0, time.Now()),
+	}
+	if err := outbox.Write(event.New(eventType, milestone.ProjectID, actorID, data)); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "마일스톤 이벤트 기록 실패", 500)
+	}
+	return nil
+}
+
+// milestoneActivity records a board moving between milestones (nil is no milestone)
+func milestoneActivity(board *domain.Board, actorID uuid.UUID, from, to *domain.Milestone) domain.BoardActivity {
+	snapshot := func(milestone *domain.Milestone) *string {
+		if milestone == nil {
+			return nil
+		}
+		return encodeActivityValue(map[string]interface{}{
+			"milestoneId": milestone.ID.String(),
+			"name":        milestone.Name,
+		})
+	}
+	activity := domain.NewBoardActivity(board, actorID, domain.BoardActivityUpdated)
+	activity.SetChange(domain.BoardActivityFieldMilestone, snapshot(from), snapshot(to))
+	return activity
+}
+
+func toMilestoneResponse(milestone *domain.Milestone, boardCount, completedCount int, now time.Time) dto.MilestoneResponse {
+	return dto.MilestoneResponse{
+		MilestoneID:    milestone.ID.String(),
+		ProjectID:      milestone.ProjectID.String(),
+		Name:           milestone.Name,
+		Description:    milestone.Description,
+		TargetDate:     formatOptionalDay(milestone.TargetDate),
+		Released:       milestone.Released,
+		ReleasedAt:     milestone.ReleasedAt,
+		IsOverdue:      milestone.IsOverdue(now, boardCount-completedCount),
+		BoardCount:     boardCount,
+		CompletedCount: completedCount,
+		CreatedBy:      milestone.CreatedBy.String(),
+		CreatedAt:      milestone.CreatedAt,
+		UpdatedAt:      milestone.UpdatedAt,
+	}
+}
+
+func toMilestoneBoard(board *domain.Board) dto.MilestoneBoard {
+	item := dto.MilestoneBoard{
+		BoardID: board.ID.String(),
+		Key:     board.Key,
+		Title:   board.Title,
+		DueDate: board.DueDate,
+	}
+	if board.AssigneeID != nil {
+		assigneeID := board.AssigneeID.String()
+		item.AssigneeID = &assigneeID
+	}
+	return item
+}
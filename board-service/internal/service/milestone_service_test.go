@@ -0,0 +1,223 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"board-service/internal/testutil"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ==================== Test Suite Setup ====================
+
+type MilestoneServiceTestSuite struct {
+	projectRepo  *testutil.MockProjectRepository
+	db           *gorm.DB
+	service      *milestoneService
+	projectID    uuid.UUID
+	adminID      uuid.UUID
+	memberID     uuid.UUID
+	stageFieldID uuid.UUID
+	stageOptions []uuid.UUID // 대기, 진행중, 완료
+}
+
+func setupMilestoneServiceTest(t *testing.T) *MilestoneServiceTestSuite {
+	db := NewMockDB()
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+
+	projectRepo := new(testutil.MockProjectRepository)
+	roleRepo := new(testutil.MockRoleRepository)
+	activityRepo := new(testutil.MockBoardActivityRepository)
+	adminRole, memberRole := testutil.NewAdminRole(), testutil.NewMemberRole()
+	roleRepo.On("FindByID", adminRole.ID).Return(adminRole, nil).Maybe()
+	roleRepo.On("FindByID", memberRole.ID).Return(memberRole, nil).Maybe()
+	activityRepo.On("BatchCreate", mock.Anything).Return(nil).Maybe()
+
+	service := NewMilestoneService(repository.NewMilestoneRepository(db), repository.NewBoardRepository(db), repository.NewFieldRepository(db),
+		projectRepo, roleRepo, activityRepo, zap.NewNop(), db)
+
+	suite := &MilestoneServiceTestSuite{
+		projectRepo:  projectRepo,
+		db:           db,
+		service:      service.(*milestoneService),
+		projectID:    uuid.New(),
+		adminID:      uuid.New(),
+		memberID:     uuid.New(),
+		stageFieldID: uuid.New(),
+	}
+	suite.addMember(suite.adminID, adminRole.ID)
+	suite.addMember(suite.memberID, memberRole.ID)
+
+	require.NoError(t, db.Exec("INSERT INTO project_fields (id, project_id, name, field_type, is_system_default) VALUES (?, ?, 'Stage', 'single_select', true)",
+		suite.stageFieldID, suite.projectID).Error)
+	for order, label := range []string{"대기", "진행중", "완료"} {
+		optionID := uuid.New()
+		require.NoError(t, db.Exec("INSERT INTO field_options (id, field_id, label, display_order) VALUES (?, ?, ?, ?)",
+			optionID, suite.stageFieldID, label, order).Error)
+		suite.stageOptions = append(suite.stageOptions, optionID)
+	}
+	return suite
+}
+
+func (s *MilestoneServiceTestSuite) addMember(userID, roleID uuid.UUID) {
+	s.projectRepo.On("FindMemberByUserAndProject", userID, s.projectID).
+		Return(&domain.ProjectMember{ProjectID: s.projectID, UserID: userID, RoleID: roleID}, nil).Maybe()
+}
+
+func (s *MilestoneServiceTestSuite) board(t *testing.T, title string, dueDate *time.Time) uuid.UUID {
+	boardID := uuid.New()
+	require.NoError(t, s.db.Exec("INSERT INTO boards (id, project_id, title, due_date) VALUES (?, ?, ?, ?)", boardID, s.projectID, title, dueDate).Error)
+	return boardID
+}
+
+func (s *MilestoneServiceTestSuite) setStage(t *testing.T, boardID, optionID uuid.UUID) {
+	require.NoError(t, s.db.Exec("INSERT INTO board_field_values (id, board_id, field_id, value_option_id) VALUES (?, ?, ?, ?)",
+		uuid.New(), boardID, s.stageFieldID, optionID).Error)
+}
+
+func (s *MilestoneServiceTestSuite) createMilestone(t *testing.T, name string, targetDate *string) *dto.MilestoneResponse {
+	milestone, err := s.service.CreateMilestone(s.adminID.String(), s.projectID.String(), &dto.CreateMilestoneRequest{Name: name, TargetDate: targetDate})
+	require.NoError(t, err)
+	return milestone
+}
+
+func (s *MilestoneServiceTestSuite) target(t *testing.T, boardID uuid.UUID, milestoneID *string) {
+	_, err := s.service.SetBoardMilestone(s.memberID.String(), boardID.String(), &dto.SetBoardMilestoneRequest{MilestoneID: milestoneID})
+	require.NoError(t, err)
+}
+
+// ==================== Milestone Tests ====================
+
+func TestMilestoneService_CreateMilestone_RequiresAdmin(t *testing.T) {
+	suite := setupMilestoneServiceTest(t)
+
+	_, err := suite.service.CreateMilestone(suite.memberID.String(), suite.projectID.String(), &dto.CreateMilestoneRequest{Name: "v1.0"})
+	assert.Equal(t, 403, appErrorStatus(t, err))
+
+	invalid := "2026/06/30"
+	_, err = suite.service.CreateMilestone(suite.adminID.String(), suite.projectID.String(), &dto.CreateMilestoneRequest{Name: "v1.0", TargetDate: &invalid})
+	assert.Equal(t, 400, appErrorStatus(t, err))
+
+	targetDate := "2026-06-30"
+	milestone := suite.createMilestone(t, "v1.0", &targetDate)
+	assert.Equal(t, "2026-06-30", *milestone.TargetDate)
+	assert.False(t, milestone.Released)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "outbox_events", "event_type = ?", string(event.MilestoneCreated)))
+}
+
+func TestMilestoneService_GetMilestone_ProgressAndOverdueBoards(t *testing.T) {
+	suite := setupMilestoneServiceTest(t)
+	lastWeek := time.Now().AddDate(0, 0, -7).Format(workDateLayout)
+	milestone := suite.createMilestone(t, "v1.0", &lastWeek)
+
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	yesterday := time.Now().AddDate(0, 0, -1)
+	done := suite.board(t, "Done", &yesterday)
+	late := suite.board(t, "Late", &yesterday)
+	noDueDate := suite.board(t, "No due date", nil)
+	onTrack := suite.board(t, "On track", &tomorrow)
+	for _, boardID := range []uuid.UUID{done, late, noDueDate, onTrack} {
+		suite.target(t, boardID, &milestone.MilestoneID)
+	}
+	suite.setStage(t, done, suite.stageOptions[2])
+	suite.setStage(t, late, suite.stageOptions[1])
+	suite.setStage(t, onTrack, suite.stageOptions[0])
+
+	// When
+	detail, err := suite.service.GetMilestone(suite.memberID.String(), milestone.MilestoneID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 4, detail.BoardCount)
+	assert.Equal(t, 1, detail.CompletedCount)
+	assert.Equal(t, 25, detail.PercentComplete)
+	assert.Equal(t, 1, detail.NoStageCount)
+	assert.True(t, detail.IsOverdue, "past the target date with open boards")
+	require.Len(t, detail.Stages, 3)
+	assert.Equal(t, []int{1, 1, 1}, []int{detail.Stages[0].BoardCount, detail.Stages[1].BoardCount, detail.Stages[2].BoardCount})
+	assert.True(t, detail.Stages[2].Completed)
+
+	overdue := make([]string, 0, len(detail.OverdueBoards))
+	for _, board := range detail.OverdueBoards {
+		overdue = append(overdue, board.Title)
+	}
+	assert.ElementsMatch(t, []string{"Late", "No due date"}, overdue,
+		"boards with a due date use it; boards without one use the milestone's target date")
+
+	milestones, err := suite.service.GetMilestones(suite.memberID.String(), suite.projectID.String(), &dto.GetMilestonesRequest{})
+	require.NoError(t, err)
+	require.Len(t, milestones, 1)
+	assert.Equal(t, 1, milestones[0].CompletedCount)
+	assert.True(t, milestones[0].IsOverdue)
+
+	// Releasing the milestone clears the flag
+	released := true
+	updated, err := suite.service.UpdateMilestone(suite.adminID.String(), milestone.MilestoneID, &dto.UpdateMilestoneRequest{Released: &released})
+	require.NoError(t, err)
+	assert.True(t, updated.Released)
+	assert.False(t, updated.IsOverdue)
+	assert.Equal(t, 4, updated.BoardCount)
+}
+
+func TestMilestoneService_SetBoardMilestone_ValidatesMilestone(t *testing.T) {
+	suite := setupMilestoneServiceTest(t)
+	boardID := suite.board(t, "Login", nil)
+
+	otherProjectMilestone := uuid.New()
+	require.NoError(t, suite.db.Exec("INSERT INTO milestones (id, project_id, name, created_by) VALUES (?, ?, 'Other', ?)",
+		otherProjectMilestone, uuid.New(), suite.adminID).Error)
+	otherID := otherProjectMilestone.String()
+	_, err := suite.service.SetBoardMilestone(suite.memberID.String(), boardID.String(), &dto.SetBoardMilestoneRequest{MilestoneID: &otherID})
+	assert.Equal(t, 400, appErrorStatus(t, err))
+
+	milestone := suite.createMilestone(t, "v1.0", nil)
+	suite.target(t, boardID, &milestone.MilestoneID)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "boards", "id = ? AND milestone_id = ?", boardID, milestone.MilestoneID))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "outbox_events", "event_type = ?", string(event.BoardMilestoneChanged)))
+
+	released := true
+	_, err = suite.service.UpdateMilestone(suite.adminID.String(), milestone.MilestoneID, &dto.UpdateMilestoneRequest{Released: &released})
+	require.NoError(t, err)
+	other := suite.board(t, "Signup", nil)
+	_, err = suite.service.SetBoardMilestone(suite.memberID.String(), other.String(), &dto.SetBoardMilestoneRequest{MilestoneID: &milestone.MilestoneID})
+	assert.Equal(t, 409, appErrorStatus(t, err), "released milestones take no new boards")
+
+	// Deleting the milestone keeps its boards
+	require.NoError(t, suite.service.DeleteMilestone(suite.adminID.String(), milestone.MilestoneID))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "boards", "id = ? AND milestone_id IS NULL AND is_deleted = ?", boardID, false))
+	_, err = suite.service.GetMilestone(suite.memberID.String(), milestone.MilestoneID)
+	assert.Equal(t, 404, appErrorStatus(t, err))
+}
+
+// ==================== View Filter Tests ====================
+
+func TestViewService_MilestoneFilter(t *testing.T) {
+	suite := setupMilestoneServiceTest(t)
+	milestone := suite.createMilestone(t, "v1.0", nil)
+	targeted, untargeted := suite.board(t, "Targeted", nil), suite.board(t, "Untargeted", nil)
+	suite.target(t, targeted, &milestone.MilestoneID)
+	compiler := &viewFilterCompiler{db: suite.db, projectID: suite.projectID, loc: time.UTC, strict: true}
+
+	filtered := func(operator string, value interface{}) []uuid.UUID {
+		var boardIDs []uuid.UUID
+		filter, err := compiler.compile(&domain.FilterNode{Field: "milestone", Operator: operator, Value: value})
+		require.NoError(t, err)
+		require.NoError(t, suite.db.Model(&domain.Board{}).Where(filter.sql, filter.args...).Pluck("id", &boardIDs).Error)
+		return boardIDs
+	}
+
+	assert.Equal(t, []uuid.UUID{targeted}, filtered("eq", milestone.MilestoneID))
+	assert.Equal(t, []uuid.UUID{untargeted}, filtered("eq", nil))
+	assert.Equal(t, []uuid.UUID{targeted}, filtered("ne", nil))
+}
@@ -4,6 +4,7 @@ import (
 	"board-service/internal/cache"
 	"board-service/internal/client"
 	"context"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"gorm.io/driver/sqlite"
@@ -148,6 +149,102 @@ func (m *MockWorkspaceCache) InvalidateWorkspace(ctx context.Context, workspaceI
 	return args.Error(0)
 }
 
+// ==================== Mock FieldCache ====================
+
+type MockFieldCache struct {
+	mock.Mock
+}
+
+func (m *MockFieldCache) GetProjectFields(ctx context.Context, projectID string) ([]byte, error) {
+	args := m.Called(ctx, projectID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockFieldCache) SetProjectFields(ctx context.Context, projectID string, fieldsJSON []byte, ttl time.Duration) error {
+	args := m.Called(ctx, projectID, fieldsJSON, ttl)
+	return args.Error(0)
+}
+
+func (m *MockFieldCache) InvalidateProjectFields(ctx context.Context, projectID string) error {
+	args := m.Called(ctx, projectID)
+	return args.Error(0)
+}
+
+func (m *MockFieldCache) GetFieldOptions(ctx context.Context, fieldID string) ([]byte, error) {
+	args := m.Called(ctx, fieldID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockFieldCache) SetFieldOptions(ctx context.Context, fieldID string, optionsJSON []byte, ttl time.Duration) error {
+	args := m.Called(ctx, fieldID, optionsJSON, ttl)
+	return args.Error(0)
+}
+
+func (m *MockFieldCache) InvalidateFieldOptions(ctx context.Context, fieldID string) error {
+	args := m.Called(ctx, fieldID)
+	return args.Error(0)
+}
+
+func (m *MockFieldCache) GetBoardFieldValues(ctx context.Context, boardID string) (map[string]interface{}, error) {
+	args := m.Called(ctx, boardID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+func (m *MockFieldCache) SetBoardFieldValues(ctx context.Context, boardID string, values map[string]interface{}, ttl time.Duration) error {
+	args := m.Called(ctx, boardID, values, ttl)
+	return args.Error(0)
+}
+
+func (m *MockFieldCache) InvalidateBoardFieldValues(ctx context.Context, boardID string) error {
+	args := m.Called(ctx, boardID)
+	return args.Error(0)
+}
+
+func (m *MockFieldCache) GetViewResults(ctx context.Context, viewID, filterHash string) ([]byte, error) {
+	args := m.Called(ctx, viewID, filterHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockFieldCache) SetViewResults(ctx context.Context, viewID, filterHash string, resultsJSON []byte, ttl time.Duration) error {
+	args := m.Called(ctx, viewID, filterHash, resultsJSON, ttl)
+	return args.Error(0)
+}
+
+func (m *MockFieldCache) InvalidateViewResults(ctx context.Context, viewID string) error {
+	args := m.Called(ctx, viewID)
+	return args.Error(0)
+}
+
+func (m *MockFieldCache) GetProjectAnalytics(ctx context.Context, projectID, reportKey string) ([]byte, error) {
+	args := m.Called(ctx, projectID, reportKey)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockFieldCache) SetProjectAnalytics(ctx context.Context, projectID, reportKey string, reportJSON []byte, ttl time.Duration) error {
+	args := m.Called(ctx, projectID, reportKey, reportJSON, ttl)
+	return args.Error(0)
+}
+
+func (m *MockFieldCache) InvalidateProjectAnalytics(ctx context.Context, projectID string) error {
+	args := m.Called(ctx, projectID)
+	return args.Error(0)
+}
+
 // ==================== Mock DB ====================
 
 // NewMockDB creates an in-memory SQLite DB for testing transactions
@@ -158,5 +255,13 @@ func NewMockDB() *gorm.DB {
 	if err != nil {
 		panic("failed to create mock database: " + err.Error())
 	}
+
+	// Every connection would otherwise open its own empty in-memory database
+	sqlDB, err := db.DB()
+	if err != nil {
+		panic("failed to get mock database connection: " + err.Error())
+	}
+	sqlDB.SetMaxOpenConns(1)
+
 	return db
 }
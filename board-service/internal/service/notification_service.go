@@ -0,0 +1,575 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/cache"
+	"board-service/internal/client"
+	"board-service/internal/common/auth"
+	"board-service/internal/common/pagination"
+	"board-service/internal/common/parser"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/repository"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// NotificationService는 사용자 알림함 조회, 읽음 처리와 보드 구독(watch)을 담당합니다
+// 알림 생성은 BoardService, CommentService, FieldValueService가 boardNotifier를 통해 직접 수행합니다
+type NotificationService interface {
+	// Inbox
+	GetNotifications(userID string, req *dto.GetNotificationsRequest) (*dto.PaginatedNotificationsResponse, error)
+	GetUnreadCount(userID string) (*dto.UnreadNotificationCountResponse, error)
+	MarkRead(userID, notificationID string) error
+	MarkAllRead(userID string) (*dto.MarkAllNotificationsReadResponse, error)
+
+	// Board Watch
+	GetBoardWatch(userID, boardID string) (*dto.BoardWatchResponse, error)
+	WatchBoard(userID, boardID string) (*dto.BoardWatchResponse, error)
+	UnwatchBoard(userID, boardID string) (*dto.BoardWatchResponse, error)
+	GetWatchedBoards(userID string, req *dto.GetWatchedBoardsRequest) (*dto.PaginatedWatchedBoardsResponse, error)
+}
+
+type notificationService struct {
+	repo          repository.NotificationRepository
+	boardRepo     repository.BoardRepository
+	authorizer    auth.ProjectAuthorizer
+	userClient    client.UserClient
+	userInfoCache cache.UserInfoCache
+	logger        *zap.Logger
+}
+
+func NewNotificationService(
+	repo repository.NotificationRepository,
+	boardRepo repository.BoardRepository,
+	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
+	userClient client.UserClient,
+	userInfoCache cache.UserInfoCache,
+	logger *zap.Logger,
+) NotificationService {
+	return &notificationService{
+		repo:          repo,
+		boardRepo:     boardRepo,
+		authorizer:    auth.NewProjectAuthorizer(projectRepo, roleRepo),
+		userClient:    userClient,
+		userInfoCache: userInfoCache,
+		logger:        logger,
+	}
+}
+
+// ==================== Inbox ====================
+
+func (s *notificationService) GetNotifications(userID string, req *dto.GetNotificationsRequest) (*dto.PaginatedNotificationsResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Fetch notifications (newest first)
+	page, limit := pagination.ValidatePaginationParams(req.Page, req.Limit)
+	notifications, total, err := s.repo.FindByUser(userUUID, req.UnreadOnly, page, limit)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "알림 조회 실패", 500)
+	}
+
+	unreadCount, err := s.repo.CountUnread(userUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "읽지 않은 알림 수 조회 실패", 500)
+	}
+
+	// 2. Batch fetch actors
+	actorIDs := make([]string, 0, len(notifications))
+	seen := make(map[string]bool)
+	for _, notification := range notifications {
+		actorID := notification.ActorID.String()
+		if !seen[actorID] {
+			seen[actorID] = true
+			actorIDs = append(actorIDs, actorID)
+		}
+	}
+	userMap := fetchSimpleUsers(context.Background(), s.userInfoCache, s.userClient, s.logger, actorIDs)
+
+	// 3. Build responses
+	responses := make([]dto.NotificationResponse, 0, len(notifications))
+	for _, notification := range notifications {
+		responses = append(responses, s.toResponse(&notification, userMap))
+	}
+
+	return &dto.PaginatedNotificationsResponse{
+		Notifications: responses,
+		UnreadCount:   unreadCount,
+		Total:         total,
+		Page:          page,
+		Limit:         limit,
+	}, nil
+}
+
+func (s *notificationService) GetUnreadCount(userID string) (*dto.UnreadNotificationCountResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountUnread(userUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "읽지 않은 알림 수 조회 실패", 500)
+	}
+
+	return &dto.UnreadNotificationCountResponse{UnreadCount: count}, nil
+}
+
+func (s *notificationService) MarkRead(userID, notificationID string) error {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	notificationUUID, err := parser.ParseUUID(notificationID, "알림")
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.MarkRead(userUUID, notificationUUID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.New(apperrors.ErrCodeNotFound, "알림을 찾을 수 없습니다", 404)
+		}
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "알림 읽음 처리 실패", 500)
+	}
+
+	return nil
+}
+
+func (s *notificationService) MarkAllRead(userID string) (*dto.MarkAllNotificationsReadResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.MarkAllRead(userUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "알림 읽음 처리 실패", 500)
+	}
+
+	return &dto.MarkAllNotificationsReadResponse{Updated: updated}, nil
+}
+
+// ==================== Board Watch ====================
+
+func (s *notificationService) GetBoardWatch(userID, boardID string) (*dto.BoardWatchResponse, error) {
+	userUUID, board, err := s.findWatchableBoard(userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	watching, err := s.repo.IsWatching(board.ID, userUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 구독 조회 실패", 500)
+	}
+
+	return &dto.BoardWatchResponse{BoardID: board.ID.String(), IsWatching: watching}, nil
+}
+
+// WatchBoard subscribes the user to the board; watching an already watched board is a no-op
+func (s *notificationService) WatchBoard(userID, boardID string) (*dto.BoardWatchResponse, error) {
+	userUUID, board, err := s.findWatchableBoard(userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.AddWatchers(board.ID, []uuid.UUID{userUUID}); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 구독 실패", 500)
+	}
+
+	return &dto.BoardWatchResponse{BoardID: board.ID.String(), IsWatching: true}, nil
+}
+
+// UnwatchBoard unsubscribes the user from the board
+// Auto-watching does not re-subscribe the user until they are assigned or comment again
+func (s *notificationService) UnwatchBoard(userID, boardID string) (*dto.BoardWatchResponse, error) {
+	userUUID, board, err := s.findWatchableBoard(userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.RemoveWatcher(board.ID, userUUID); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 구독 해제 실패", 500)
+	}
+
+	return &dto.BoardWatchResponse{BoardID: board.ID.String(), IsWatching: false}, nil
+}
+
+func (s *notificationService) GetWatchedBoards(userID string, req *dto.GetWatchedBoardsRequest) (*dto.PaginatedWatchedBoardsResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, limit := pagination.ValidatePaginationParams(req.Page, req.Limit)
+	boards, total, err := s.repo.FindWatchedBoards(userUUID, page, limit)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "구독 중인 보드 조회 실패", 500)
+	}
+
+	responses := make([]dto.WatchedBoardResponse, 0, len(boards))
+	for _, board := range boards {
+		response := dto.WatchedBoardResponse{
+			BoardID:   board.ID.String(),
+			ProjectID: board.ProjectID.String(),
+			Title:     board.Title,
+			DueDate:   board.DueDate,
+			UpdatedAt: board.UpdatedAt,
+		}
+		if board.AssigneeID != nil {
+			assigneeID := board.AssigneeID.String()
+			response.AssigneeID = &assigneeID
+		}
+		responses = append(responses, response)
+	}
+
+	return &dto.PaginatedWatchedBoardsResponse{
+		Boards: responses,
+		Total:  total,
+		Page:   page,
+		Limit:  limit,
+	}, nil
+}
+
+// ==================== Helper Methods ====================
+
+// findWatchableBoard loads the board and checks that the user is a member of its project
+func (s *notificationService) findWatchableBoard(userID, boardID string) (uuid.UUID, *domain.Board, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	board, err := s.boardRepo.FindByID(boardUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+		}
+		return uuid.Nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+
+	if _, err := s.authorizer.RequireMember(userUUID, board.ProjectID); err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	return userUUID, board, nil
+}
+
+func (s *notificationService) toResponse(notification *domain.Notification, userMap map[string]cache.SimpleUser) dto.NotificationResponse {
+	response := dto.NotificationResponse{
+		ID:         notification.ID.String(),
+		Type:       string(notification.Type),
+		ProjectID:  notification.ProjectID.String(),
+		BoardID:    notification.BoardID.String(),
+		BoardTitle: notification.BoardTitle,
+		Preview:    notification.Preview,
+		IsRead:     notification.IsRead(),
+		ReadAt:     notification.ReadAt,
+		CreatedAt:  notification.CreatedAt,
+	}
+
+	if notification.CommentID != nil {
+		commentID := notification.CommentID.String()
+		response.CommentID = &commentID
+	}
+
+	response.Actor = dto.UserInfo{UserID: notification.ActorID.String(), Name: "Unknown User"}
+	if actor, ok := userMap[notification.ActorID.String()]; ok {
+		response.Actor.Name = actor.Name
+		response.Actor.IsActive = true
+	}
+
+	return response
+}
+
+// fetchSimpleUsers resolves display names through UserInfoCache, falling back to User Service on a miss
+func fetchSimpleUsers(ctx context.Context, userInfoCache cache.UserInfoCache, userClient client.UserClient, logger *zap.Logger, userIDs []string) map[string]cache.SimpleUser {
+	userMap := make(map[string]cache.SimpleUser)
+	if len(userIDs) == 0 {
+		return userMap
+	}
+
+	cachedUsers, err := userInfoCache.GetSimpleUsersBatch(ctx, userIDs)
+	if err != nil {
+		logger.Warn("Failed to get users from cache", zap.Error(err))
+		cachedUsers = make(map[string]*cache.SimpleUser)
+	}
+
+	missingUserIDs := []string{}
+	for _, userID := range userIDs {
+		if cachedUser, exists := cachedUsers[userID]; exists {
+			userMap[userID] = *cachedUser
+		} else {
+			missingUserIDs = append(missingUserIDs, userID)
+		}
+	}
+
+	if len(missingUserIDs) > 0 {
+		users, err := userClient.GetSimpleUsers(missingUserIDs)
+		if err != nil {
+			logger.Warn("Failed to fetch users from User Service", zap.Error(err))
+			return userMap
+		}
+
+		simpleUsers := make([]cache.SimpleUser, 0, len(users))
+		for _, user := range users {
+			simpleUser := cache.SimpleUser{ID: user.ID, Name: user.Name, AvatarURL: user.AvatarURL}
+			userMap[user.ID] = simpleUser
+			simpleUsers = append(simpleUsers, simpleUser)
+		}
+		if cacheErr := userInfoCache.SetSimpleUsersBatch(ctx, simpleUsers); cacheErr != nil {
+			logger.Warn("Failed to cache users", zap.Error(cacheErr))
+		}
+	}
+
+	return userMap
+}
+
+// ==================== Notifier ====================
+
+// boardNotifier는 멘션, 담당자 지정, 구독 중인 보드의 변경(댓글, 필드 값, 마감일)을 알림함에 기록합니다
+// 작성자, 담당자, 댓글 작성자는 보드를 자동으로 구독합니다
+// 알림 실패가 원래 작업을 실패시키지 않도록 에러는 경고 로그로만 남깁니다
+type boardNotifier struct {
+	repo          repository.NotificationRepository
+	projectRepo   repository.ProjectRepository
+	userClient    client.UserClient
+	userInfoCache cache.UserInfoCache
+	logger        *zap.Logger
+}
+
+func newBoardNotifier(
+	repo repository.NotificationRepository,
+	projectRepo repository.ProjectRepository,
+	userClient client.UserClient,
+	userInfoCache cache.UserInfoCache,
+	logger *zap.Logger,
+) *boardNotifier {
+	return &boardNotifier{
+		repo:          repo,
+		projectRepo:   projectRepo,
+		userClient:    userClient,
+		userInfoCache: userInfoCache,
+		logger:        logger,
+	}
+}
+
+// boardChanged notifies users newly mentioned in the description, a newly assigned assignee
+// and, if the due date changed, the board's watchers
+// The author of a new board and a newly assigned assignee start watching the board
+// before is nil for a newly created board
+func (n *boardNotifier) boardChanged(before, after *domain.Board, actorID uuid.UUID) {
+	var previous string
+	var previousAssignee *uuid.UUID
+	if before != nil {
+		previous = before.Description
+		previousAssignee = before.AssigneeID
+	}
+
+	newlyAssigned := after.AssigneeID != nil && (previousAssignee == nil || *previousAssignee != *after.AssigneeID)
+
+	autoWatchers := make([]uuid.UUID, 0, 2)
+	if before == nil {
+		autoWatchers = append(autoWatchers, after.CreatedBy)
+	}
+	if newlyAssigned {
+		autoWatchers = append(autoWatchers, *after.AssigneeID)
+	}
+	n.watch(after.ID, autoWatchers...)
+
+	notifications := n.mentionNotifications(after, actorID, domain.NewMentions(previous, after.Description), after.Description, nil)
+
+	if newlyAssigned && *after.AssigneeID != actorID {
+		notifications = append(notifications, domain.NewNotification(*after.AssigneeID, after, actorID, domain.NotificationAssigned))
+	}
+
+	if before != nil && dueDateChanged(before.DueDate, after.DueDate) {
+		var preview string
+		if after.DueDate != nil {
+			preview = after.DueDate.Format("2006-01-02")
+		}
+		notified := notifiedUsers(actorID, notifications)
+		for _, notification := range n.watcherNotifications(after, actorID, domain.NotificationDueDateChanged, notified) {
+			notification.SetPreview(preview)
+			notifications = append(notifications, notification)
+		}
+	}
+
+	n.save(notifications)
+}
+
+// commentAdded notifies the users mentioned in a new comment and the board's watchers
+// A mentioned watcher only receives the mention; the comment author starts watching the board
+func (n *boardNotifier) commentAdded(board *domain.Board, comment *domain.Comment, actorID uuid.UUID) {
+	n.watch(board.ID, comment.UserID)
+
+	notifications := n.mentionNotifications(board, actorID, domain.ParseMentions(comment.Content), comment.Content, comment)
+
+	notified := notifiedUsers(actorID, notifications)
+	for _, notification := range n.watcherNotifications(board, actorID, domain.NotificationComment, notified) {
+		notification.SetComment(comment)
+		notifications = append(notifications, notification)
+	}
+
+	n.save(notifications)
+}
+
+// commentEdited notifies only the users added as mentions by the edit
+func (n *boardNotifier) commentEdited(board *domain.Board, comment *domain.Comment, previous string, actorID uuid.UUID) {
+	n.save(n.mentionNotifications(board, actorID, domain.NewMentions(previous, comment.Content), comment.Content, comment))
+}
+
+// fieldChanged notifies the board's watchers that a custom field value changed
+func (n *boardNotifier) fieldChanged(board *domain.Board, field *domain.ProjectField, actorID uuid.UUID) {
+	notifications := n.watcherNotifications(board, actorID, domain.NotificationFieldChanged, map[uuid.UUID]bool{actorID: true})
+	for i := range notifications {
+		notifications[i].SetPreview(field.Name)
+	}
+	n.save(notifications)
+}
+
+// watcherNotifications builds notifications of the given type for the board's watchers
+// Users already in notified are skipped and the recipients are added to it
+func (n *boardNotifier) watcherNotifications(board *domain.Board, actorID uuid.UUID, notificationType domain.NotificationType, notified map[uuid.UUID]bool) []domain.Notification {
+	watcherIDs, err := n.repo.FindWatcherIDs(board.ID)
+	if err != nil {
+		n.logger.Warn("Failed to find board watchers", zap.Error(err), zap.String("board_id", board.ID.String()))
+		return nil
+	}
+
+	notifications := make([]domain.Notification, 0, len(watcherIDs))
+	for _, watcherID := range watcherIDs {
+		if notified[watcherID] {
+			continue
+		}
+		notified[watcherID] = true
+		notifications = append(notifications, domain.NewNotification(watcherID, board, actorID, notificationType))
+	}
+	return notifications
+}
+
+// watch subscribes the users to the board, ignoring users who already watch it
+func (n *boardNotifier) watch(boardID uuid.UUID, userIDs ...uuid.UUID) {
+	if len(userIDs) == 0 {
+		return
+	}
+
+	if err := n.repo.AddWatchers(boardID, userIDs); err != nil {
+		n.logger.Warn("Failed to add board watchers", zap.Error(err), zap.String("board_id", boardID.String()))
+	}
+}
+
+// mentionNotifications builds MENTION notifications for the mentioned project members
+// The actor and users who are not members of the board's project are skipped
+func (n *boardNotifier) mentionNotifications(board *domain.Board, actorID uuid.UUID, mentions domain.Mentions, text string, comment *domain.Comment) []domain.Notification {
+	if mentions.IsEmpty() {
+		return nil
+	}
+
+	userIDs := append([]uuid.UUID{}, mentions.UserIDs...)
+	if len(mentions.Handles) > 0 {
+		userIDs = append(userIDs, n.resolveHandles(board.ProjectID, mentions.Handles)...)
+	}
+
+	notifications := make([]domain.Notification, 0, len(userIDs))
+	seen := map[uuid.UUID]bool{actorID: true}
+	for _, userID := range userIDs {
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+
+		if _, err := n.projectRepo.FindMemberByUserAndProject(userID, board.ProjectID); err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				n.logger.Warn("Failed to check mentioned user membership", zap.Error(err), zap.String("user_id", userID.String()))
+			}
+			continue
+		}
+
+		notification := domain.NewNotification(userID, board, actorID, domain.NotificationMention)
+		if comment != nil {
+			notification.SetComment(comment)
+		} else {
+			notification.SetPreview(text)
+		}
+		notifications = append(notifications, notification)
+	}
+	return notifications
+}
+
+// resolveHandles matches handles against the display names of the project's members
+// A handle shared by several members is ambiguous and resolves to nobody
+func (n *boardNotifier) resolveHandles(projectID uuid.UUID, handles []string) []uuid.UUID {
+	members, err := n.projectRepo.FindMembersByProject(projectID)
+	if err != nil {
+		n.logger.Warn("Failed to find project members for mentions", zap.Error(err), zap.String("project_id", projectID.String()))
+		return nil
+	}
+
+	memberIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.UserID.String())
+	}
+	users := fetchSimpleUsers(context.Background(), n.userInfoCache, n.userClient, n.logger, memberIDs)
+
+	byHandle := make(map[string][]uuid.UUID)
+	for _, member := range members {
+		if user, ok := users[member.UserID.String()]; ok {
+			handle := domain.NormalizeMentionHandle(user.Name)
+			byHandle[handle] = append(byHandle[handle], member.UserID)
+		}
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(handles))
+	for _, handle := range handles {
+		if matches := byHandle[handle]; len(matches) == 1 {
+			userIDs = append(userIDs, matches[0])
+		}
+	}
+	return userIDs
+}
+
+func (n *boardNotifier) save(notifications []domain.Notification) {
+	if len(notifications) == 0 {
+		return
+	}
+
+	if err := n.repo.BatchCreate(notifications); err != nil {
+		n.logger.Warn("Failed to create notifications",
+			zap.Error(err),
+			zap.String("board_id", notifications[0].BoardID.String()),
+			zap.Int("count", len(notifications)),
+		)
+	}
+}
+
+// notifiedUsers returns the actor and the recipients of the notifications, who must not be notified again
+func notifiedUsers(actorID uuid.UUID, notifications []domain.Notification) map[uuid.UUID]bool {
+	notified := map[uuid.UUID]bool{actorID: true}
+	for _, notification := range notifications {
+		notified[notification.UserID] = true
+	}
+	return notified
+}
+
+// dueDateChanged returns true if a due date was set, cleared or moved
+func dueDateChanged(before, after *time.Time) bool {
+	if before == nil || after == nil {
+		return before != after
+	}
+	return !before.Equal(*after)
+}
@@ -0,0 +1,544 @@
+package service
+
+import (
+	"board-service/internal/cache"
+	"board-service/internal/client"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/repository"
+	"board-service/internal/testutil"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ==================== Test Suite Setup ====================
+
+type NotificationServiceTestSuite struct {
+	notifyRepo    *testutil.MockNotificationRepository
+	boardRepo     *testutil.MockBoardRepository
+	projectRepo   *testutil.MockProjectRepository
+	roleRepo      *testutil.MockRoleRepository
+	userClient    *MockUserClient
+	userInfoCache *MockUserInfoCache
+	service       NotificationService
+	notifier      *boardNotifier
+
+	saved []domain.Notification // Notifications passed to BatchCreate
+}
+
+func setupNotificationServiceTest(t *testing.T) *NotificationServiceTestSuite {
+	suite := &NotificationServiceTestSuite{
+		notifyRepo:    new(testutil.MockNotificationRepository),
+		boardRepo:     new(testutil.MockBoardRepository),
+		projectRepo:   new(testutil.MockProjectRepository),
+		roleRepo:      new(testutil.MockRoleRepository),
+		userClient:    new(MockUserClient),
+		userInfoCache: new(MockUserInfoCache),
+	}
+
+	suite.service = NewNotificationService(suite.notifyRepo, suite.boardRepo, suite.projectRepo, suite.roleRepo, suite.userClient, suite.userInfoCache, zap.NewNop())
+	suite.notifier = newBoardNotifier(suite.notifyRepo, suite.projectRepo, suite.userClient, suite.userInfoCache, zap.NewNop())
+
+	suite.notifyRepo.On("BatchCreate", mock.Anything).Run(func(args mock.Arguments) {
+		suite.saved = append(suite.saved, args.Get(0).([]domain.Notification)...)
+	}).Return(nil).Maybe()
+	suite.notifyRepo.On("AddWatchers", mock.Anything, mock.Anything).Return(nil).Maybe()
+	suite.roleRepo.On("FindByID", mock.Anything).Return(testutil.NewMemberRole(), nil).Maybe()
+
+	return suite
+}
+
+// member registers userID as a member of the project
+func (s *NotificationServiceTestSuite) member(userID, projectID uuid.UUID) {
+	s.projectRepo.On("FindMemberByUserAndProject", userID, projectID).
+		Return(testutil.NewTestProjectMember(projectID, userID, uuid.New()), nil)
+}
+
+// recipients returns the recipients of the saved notifications of the given type
+func (s *NotificationServiceTestSuite) recipients(notificationType domain.NotificationType) []uuid.UUID {
+	userIDs := []uuid.UUID{}
+	for _, notification := range s.saved {
+		if notification.Type == notificationType {
+			userIDs = append(userIDs, notification.UserID)
+		}
+	}
+	return userIDs
+}
+
+func newTestNotificationComment(board *domain.Board, userID uuid.UUID, content string) *domain.Comment {
+	return &domain.Comment{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		BoardID:   board.ID,
+		UserID:    userID,
+		Content:   content,
+	}
+}
+
+// ==================== Notifier Tests ====================
+
+func TestBoardNotifier_CommentAdded_MentionsAndWatchers(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given: A comment by the actor mentioning a watcher and another member
+	projectID := uuid.New()
+	actorID := uuid.New()
+	mentionedWatcher := uuid.New()
+	mentionedMember := uuid.New()
+	otherWatcher := uuid.New()
+	board := testutil.NewTestBoard(projectID, actorID)
+	comment := newTestNotificationComment(board, actorID, "@"+mentionedWatcher.String()+" @"+mentionedMember.String()+" 확인 부탁드려요")
+
+	suite.member(mentionedWatcher, projectID)
+	suite.member(mentionedMember, projectID)
+	suite.notifyRepo.On("FindWatcherIDs", board.ID).Return([]uuid.UUID{actorID, mentionedWatcher, otherWatcher}, nil)
+
+	// When
+	suite.notifier.commentAdded(board, comment, actorID)
+
+	// Then: The mentioned watcher only gets the mention, the actor gets nothing
+	assert.Equal(t, []uuid.UUID{mentionedWatcher, mentionedMember}, suite.recipients(domain.NotificationMention))
+	assert.Equal(t, []uuid.UUID{otherWatcher}, suite.recipients(domain.NotificationComment))
+	suite.notifyRepo.AssertCalled(t, "AddWatchers", board.ID, []uuid.UUID{actorID})
+	for _, notification := range suite.saved {
+		assert.Equal(t, comment.ID, *notification.CommentID)
+		assert.Equal(t, board.Title, notification.BoardTitle)
+		assert.Equal(t, actorID, notification.ActorID)
+	}
+}
+
+func TestBoardNotifier_CommentAdded_SelfMention(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given: The actor mentions themself on a board nobody watches
+	projectID := uuid.New()
+	actorID := uuid.New()
+	board := testutil.NewTestBoard(projectID, actorID)
+	comment := newTestNotificationComment(board, actorID, "메모 @"+actorID.String())
+
+	suite.notifyRepo.On("FindWatcherIDs", board.ID).Return([]uuid.UUID{}, nil)
+
+	// When
+	suite.notifier.commentAdded(board, comment, actorID)
+
+	// Then
+	assert.Empty(t, suite.saved)
+	suite.notifyRepo.AssertNotCalled(t, "BatchCreate", mock.Anything)
+	suite.projectRepo.AssertNotCalled(t, "FindMemberByUserAndProject", mock.Anything, mock.Anything)
+}
+
+func TestBoardNotifier_CommentAdded_SkipsNonMembers(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given: A mention of a user outside the project
+	projectID := uuid.New()
+	actorID := uuid.New()
+	outsider := uuid.New()
+	board := testutil.NewTestBoard(projectID, actorID)
+	comment := newTestNotificationComment(board, actorID, "@"+outsider.String())
+
+	suite.projectRepo.On("FindMemberByUserAndProject", outsider, projectID).Return(nil, gorm.ErrRecordNotFound)
+	suite.notifyRepo.On("FindWatcherIDs", board.ID).Return([]uuid.UUID{}, nil)
+
+	// When
+	suite.notifier.commentAdded(board, comment, actorID)
+
+	// Then
+	assert.Empty(t, suite.saved)
+}
+
+func TestBoardNotifier_CommentAdded_ResolvesHandles(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given: Members "Jane Doe" and two members named "Kim"
+	projectID := uuid.New()
+	actorID := uuid.New()
+	jane := uuid.New()
+	kim1 := uuid.New()
+	kim2 := uuid.New()
+	board := testutil.NewTestBoard(projectID, actorID)
+	comment := newTestNotificationComment(board, actorID, "@janedoe @kim @nobody 리뷰 부탁드려요")
+
+	members := []domain.ProjectMember{
+		*testutil.NewTestProjectMember(projectID, actorID, uuid.New()),
+		*testutil.NewTestProjectMember(projectID, jane, uuid.New()),
+		*testutil.NewTestProjectMember(projectID, kim1, uuid.New()),
+		*testutil.NewTestProjectMember(projectID, kim2, uuid.New()),
+	}
+	suite.projectRepo.On("FindMembersByProject", projectID).Return(members, nil)
+	suite.userInfoCache.On("GetSimpleUsersBatch", mock.Anything, mock.Anything).Return(map[string]*cache.SimpleUser{
+		actorID.String(): {ID: actorID.String(), Name: "Actor"},
+		jane.String():    {ID: jane.String(), Name: "Jane Doe"},
+	}, nil)
+	suite.userClient.On("GetSimpleUsers", []string{kim1.String(), kim2.String()}).Return([]client.SimpleUser{
+		{ID: kim1.String(), Name: "Kim"},
+		{ID: kim2.String(), Name: "Kim"},
+	}, nil)
+	suite.userInfoCache.On("SetSimpleUsersBatch", mock.Anything, mock.Anything).Return(nil)
+	suite.member(jane, projectID)
+	suite.notifyRepo.On("FindWatcherIDs", board.ID).Return([]uuid.UUID{}, nil)
+
+	// When
+	suite.notifier.commentAdded(board, comment, actorID)
+
+	// Then: Only the unambiguous handle is notified
+	assert.Equal(t, []uuid.UUID{jane}, suite.recipients(domain.NotificationMention))
+}
+
+func TestBoardNotifier_CommentEdited_OnlyNewMentions(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given: An edit that keeps one mention and adds another
+	projectID := uuid.New()
+	actorID := uuid.New()
+	kept := uuid.New()
+	added := uuid.New()
+	board := testutil.NewTestBoard(projectID, actorID)
+	previous := "@" + kept.String() + " 확인 부탁드려요"
+	comment := newTestNotificationComment(board, actorID, previous+" @"+added.String())
+
+	suite.member(added, projectID)
+
+	// When
+	suite.notifier.commentEdited(board, comment, previous, actorID)
+
+	// Then
+	assert.Equal(t, []uuid.UUID{added}, suite.recipients(domain.NotificationMention))
+	suite.notifyRepo.AssertNotCalled(t, "FindWatcherIDs", mock.Anything)
+}
+
+func TestBoardNotifier_BoardChanged_Assigned(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given: The assignee changes to another user
+	projectID := uuid.New()
+	actorID := uuid.New()
+	assigneeID := uuid.New()
+	before := testutil.NewTestBoard(projectID, actorID)
+	after := *before
+	after.AssigneeID = &assigneeID
+
+	// When
+	suite.notifier.boardChanged(before, &after, actorID)
+
+	// Then
+	assert.Equal(t, []uuid.UUID{assigneeID}, suite.recipients(domain.NotificationAssigned))
+	assert.Nil(t, suite.saved[0].CommentID)
+}
+
+func TestBoardNotifier_BoardChanged_SelfAssigned(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given: A new board the actor assigns to themself
+	actorID := uuid.New()
+	board := testutil.NewTestBoardWithAssignee(uuid.New(), actorID, actorID)
+
+	// When
+	suite.notifier.boardChanged(nil, board, actorID)
+
+	// Then
+	assert.Empty(t, suite.saved)
+}
+
+func TestBoardNotifier_BoardChanged_DescriptionMention(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given: A new board whose description mentions a member
+	projectID := uuid.New()
+	actorID := uuid.New()
+	mentioned := uuid.New()
+	board := testutil.NewTestBoard(projectID, actorID)
+	board.Description = "담당: @" + mentioned.String()
+
+	suite.member(mentioned, projectID)
+
+	// When
+	suite.notifier.boardChanged(nil, board, actorID)
+
+	// Then
+	assert.Equal(t, []uuid.UUID{mentioned}, suite.recipients(domain.NotificationMention))
+	assert.Equal(t, board.Description, suite.saved[0].Preview)
+}
+
+func TestBoardNotifier_BoardChanged_AutoWatchesAuthorAndAssignee(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given: A new board assigned to another member
+	actorID := uuid.New()
+	assigneeID := uuid.New()
+	board := testutil.NewTestBoardWithAssignee(uuid.New(), actorID, assigneeID)
+
+	// When
+	suite.notifier.boardChanged(nil, board, actorID)
+
+	// Then: Both the author and the assignee watch the board
+	suite.notifyRepo.AssertCalled(t, "AddWatchers", board.ID, []uuid.UUID{actorID, assigneeID})
+}
+
+func TestBoardNotifier_BoardChanged_UnchangedAssigneeNotRewatched(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given: An update that keeps the assignee (who may have unwatched the board)
+	actorID := uuid.New()
+	before := testutil.NewTestBoardWithAssignee(uuid.New(), actorID, uuid.New())
+	after := *before
+	after.Title = "새 제목"
+
+	// When
+	suite.notifier.boardChanged(before, &after, actorID)
+
+	// Then
+	suite.notifyRepo.AssertNotCalled(t, "AddWatchers", mock.Anything, mock.Anything)
+	assert.Empty(t, suite.saved)
+}
+
+func TestBoardNotifier_BoardChanged_DueDateNotifiesWatchers(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given: The due date of a board watched by the actor, the new assignee and another user is moved
+	projectID := uuid.New()
+	actorID := uuid.New()
+	assigneeID := uuid.New()
+	watcherID := uuid.New()
+	before := testutil.NewTestBoard(projectID, actorID)
+	before.DueDate = testutil.NewTestTimePtr()
+	after := *before
+	dueDate := before.DueDate.AddDate(0, 0, 7)
+	after.DueDate = &dueDate
+	after.AssigneeID = &assigneeID
+
+	suite.notifyRepo.On("FindWatcherIDs", before.ID).Return([]uuid.UUID{actorID, assigneeID, watcherID}, nil)
+
+	// When
+	suite.notifier.boardChanged(before, &after, actorID)
+
+	// Then: The new assignee only gets the assignment, the actor gets nothing
+	assert.Equal(t, []uuid.UUID{assigneeID}, suite.recipients(domain.NotificationAssigned))
+	assert.Equal(t, []uuid.UUID{watcherID}, suite.recipients(domain.NotificationDueDateChanged))
+	assert.Equal(t, dueDate.Format("2006-01-02"), suite.saved[1].Preview)
+}
+
+func TestBoardNotifier_FieldChanged_NotifiesWatchers(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given: A board watched by the actor and another user
+	projectID := uuid.New()
+	actorID := uuid.New()
+	watcherID := uuid.New()
+	board := testutil.NewTestBoard(projectID, actorID)
+	field := testutil.NewTestSingleSelectField(projectID, "Stage")
+
+	suite.notifyRepo.On("FindWatcherIDs", board.ID).Return([]uuid.UUID{actorID, watcherID}, nil)
+
+	// When
+	suite.notifier.fieldChanged(board, field, actorID)
+
+	// Then
+	assert.Equal(t, []uuid.UUID{watcherID}, suite.recipients(domain.NotificationFieldChanged))
+	assert.Equal(t, "Stage", suite.saved[0].Preview)
+}
+
+func TestDueDateChanged(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Hour)
+	same := now
+
+	assert.False(t, dueDateChanged(nil, nil))
+	assert.False(t, dueDateChanged(&now, &same))
+	assert.True(t, dueDateChanged(nil, &now))
+	assert.True(t, dueDateChanged(&now, nil))
+	assert.True(t, dueDateChanged(&now, &later))
+}
+
+// ==================== Inbox Tests ====================
+
+func TestNotificationService_GetNotifications_Success(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given
+	userID := uuid.New()
+	actorID := uuid.New()
+	board := testutil.NewTestBoard(uuid.New(), actorID)
+	notification := domain.NewNotification(userID, board, actorID, domain.NotificationAssigned)
+	notification.ID = uuid.New()
+	notification.CreatedAt = time.Now()
+
+	suite.notifyRepo.On("FindByUser", userID, true, 1, 20).Return([]domain.Notification{notification}, int64(1), nil)
+	suite.notifyRepo.On("CountUnread", userID).Return(int64(3), nil)
+	suite.userInfoCache.On("GetSimpleUsersBatch", mock.Anything, []string{actorID.String()}).
+		Return(map[string]*cache.SimpleUser{actorID.String(): {ID: actorID.String(), Name: "Alice"}}, nil)
+
+	// When
+	result, err := suite.service.GetNotifications(userID.String(), &dto.GetNotificationsRequest{UnreadOnly: true})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), result.Total)
+	assert.Equal(t, int64(3), result.UnreadCount)
+	assert.Len(t, result.Notifications, 1)
+	assert.Equal(t, "ASSIGNED", result.Notifications[0].Type)
+	assert.Equal(t, "Alice", result.Notifications[0].Actor.Name)
+	assert.False(t, result.Notifications[0].IsRead)
+}
+
+func TestNotificationService_MarkRead_NotFound(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given: A notification that does not belong to the user
+	userID := uuid.New()
+	notificationID := uuid.New()
+	suite.notifyRepo.On("MarkRead", userID, notificationID).Return(gorm.ErrRecordNotFound)
+
+	// When
+	err := suite.service.MarkRead(userID.String(), notificationID.String())
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "알림을 찾을 수 없습니다")
+}
+
+func TestNotificationService_MarkAllRead_Error(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	userID := uuid.New()
+	suite.notifyRepo.On("MarkAllRead", userID).Return(int64(0), errors.New("db error"))
+
+	result, err := suite.service.MarkAllRead(userID.String())
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
+
+// ==================== Board Watch Tests ====================
+
+func TestNotificationService_WatchBoard_Success(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given
+	userID := uuid.New()
+	board := testutil.NewTestBoard(uuid.New(), uuid.New())
+	suite.boardRepo.On("FindByID", board.ID).Return(board, nil)
+	suite.member(userID, board.ProjectID)
+
+	// When
+	result, err := suite.service.WatchBoard(userID.String(), board.ID.String())
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, result.IsWatching)
+	suite.notifyRepo.AssertCalled(t, "AddWatchers", board.ID, []uuid.UUID{userID})
+}
+
+func TestNotificationService_WatchBoard_NotMember(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given: A user outside the board's project
+	userID := uuid.New()
+	board := testutil.NewTestBoard(uuid.New(), uuid.New())
+	suite.boardRepo.On("FindByID", board.ID).Return(board, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, board.ProjectID).Return(nil, gorm.ErrRecordNotFound)
+
+	// When
+	result, err := suite.service.WatchBoard(userID.String(), board.ID.String())
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "프로젝트 멤버가 아닙니다")
+	suite.notifyRepo.AssertNotCalled(t, "AddWatchers", mock.Anything, mock.Anything)
+}
+
+func TestNotificationService_UnwatchBoard_Success(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given
+	userID := uuid.New()
+	board := testutil.NewTestBoard(uuid.New(), uuid.New())
+	suite.boardRepo.On("FindByID", board.ID).Return(board, nil)
+	suite.member(userID, board.ProjectID)
+	suite.notifyRepo.On("RemoveWatcher", board.ID, userID).Return(nil)
+
+	// When
+	result, err := suite.service.UnwatchBoard(userID.String(), board.ID.String())
+
+	// Then
+	assert.NoError(t, err)
+	assert.False(t, result.IsWatching)
+	suite.notifyRepo.AssertExpectations(t)
+}
+
+func TestNotificationService_GetWatchedBoards_Success(t *testing.T) {
+	suite := setupNotificationServiceTest(t)
+
+	// Given
+	userID := uuid.New()
+	assigneeID := uuid.New()
+	board := testutil.NewTestBoardWithAssignee(uuid.New(), uuid.New(), assigneeID)
+	suite.notifyRepo.On("FindWatchedBoards", userID, 2, 10).Return([]domain.Board{*board}, int64(11), nil)
+
+	// When
+	result, err := suite.service.GetWatchedBoards(userID.String(), &dto.GetWatchedBoardsRequest{Page: 2, Limit: 10})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), result.Total)
+	assert.Len(t, result.Boards, 1)
+	assert.Equal(t, board.ID.String(), result.Boards[0].BoardID)
+	assert.Equal(t, assigneeID.String(), *result.Boards[0].AssigneeID)
+}
+
+// ==================== Watcher Repository Tests ====================
+
+func TestNotificationRepository_Watchers(t *testing.T) {
+	db := NewMockDB()
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	repo := repository.NewNotificationRepository(db)
+
+	// Given: A board watched by a member and by a user who has left the project
+	projectID := uuid.New()
+	boardID := uuid.New()
+	deletedBoardID := uuid.New()
+	memberID := uuid.New()
+	formerMemberID := uuid.New()
+	require.NoError(t, db.Exec("INSERT INTO boards (id, project_id) VALUES (?, ?), (?, ?)", boardID, projectID, deletedBoardID, projectID).Error)
+	require.NoError(t, db.Exec("UPDATE boards SET is_deleted = true WHERE id = ?", deletedBoardID).Error)
+	require.NoError(t, db.Exec("INSERT INTO project_members (id, project_id, user_id, is_deleted) VALUES (?, ?, ?, false), (?, ?, ?, true)",
+		uuid.New(), projectID, memberID, uuid.New(), projectID, formerMemberID).Error)
+
+	require.NoError(t, repo.AddWatchers(boardID, []uuid.UUID{memberID, formerMemberID}))
+	require.NoError(t, repo.AddWatchers(deletedBoardID, []uuid.UUID{memberID}))
+
+	// When: The member is auto-watched again
+	err := repo.AddWatchers(boardID, []uuid.UUID{memberID})
+
+	// Then: The duplicate is ignored and only current members are watchers
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), countRows(t, db, "board_watchers", "board_id = ?", boardID))
+
+	watcherIDs, err := repo.FindWatcherIDs(boardID)
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{memberID}, watcherIDs)
+
+	boards, total, err := repo.FindWatchedBoards(memberID, 1, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Len(t, boards, 1)
+	assert.Equal(t, boardID, boards[0].ID)
+
+	// When: The member unwatches the board
+	require.NoError(t, repo.RemoveWatcher(boardID, memberID))
+
+	// Then
+	watching, err := repo.IsWatching(boardID, memberID)
+	assert.NoError(t, err)
+	assert.False(t, watching)
+}
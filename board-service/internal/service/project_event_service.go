@@ -0,0 +1,80 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/parser"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ProjectEventService는 프로젝트 실시간 이벤트 스트림 구독을 담당합니다
+// 이벤트는 각 서비스가 변경과 같은 트랜잭션으로 outbox에 기록하고, outbox.Relay가 발행합니다
+type ProjectEventService interface {
+	SubscribeProjectEvents(ctx context.Context, userID, projectID string) (<-chan event.Event, error)
+}
+
+type projectEventService struct {
+	subscriber  event.Subscriber
+	projectRepo repository.ProjectRepository
+	logger      *zap.Logger
+}
+
+func NewProjectEventService(
+	subscriber event.Subscriber,
+	projectRepo repository.ProjectRepository,
+	logger *zap.Logger,
+) ProjectEventService {
+	return &projectEventService{
+		subscriber:  subscriber,
+		projectRepo: projectRepo,
+		logger:      logger,
+	}
+}
+
+// ==================== Subscribe Project Events ====================
+
+func (s *projectEventService) SubscribeProjectEvents(ctx context.Context, userID, projectID string) (<-chan event.Event, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Check project exists
+	if _, err := s.projectRepo.FindByID(projectUUID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 조회 실패", 500)
+	}
+
+	// 2. Check if user is project member
+	if _, err := s.projectRepo.FindMemberByUserAndProject(userUUID, projectUUID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeForbidden, "프로젝트 멤버가 아닙니다", 403)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
+	}
+
+	// 3. Subscribe to the project channel
+	events, err := s.subscriber.Subscribe(ctx, projectUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "이벤트 구독 실패", 500)
+	}
+
+	s.logger.Debug("Project event stream opened",
+		zap.String("user_id", userID),
+		zap.String("project_id", projectID),
+	)
+
+	return events, nil
+}
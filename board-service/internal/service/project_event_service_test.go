@@ -0,0 +1,101 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/event"
+	"board-service/internal/testutil"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ==================== Test Suite Setup ====================
+
+type ProjectEventServiceTestSuite struct {
+	subscriber  *testutil.MockEventSubscriber
+	projectRepo *testutil.MockProjectRepository
+	service     ProjectEventService
+}
+
+func setupProjectEventServiceTest(t *testing.T) *ProjectEventServiceTestSuite {
+	suite := &ProjectEventServiceTestSuite{
+		subscriber:  new(testutil.MockEventSubscriber),
+		projectRepo: new(testutil.MockProjectRepository),
+	}
+
+	suite.service = NewProjectEventService(suite.subscriber, suite.projectRepo, zap.NewNop())
+
+	return suite
+}
+
+// ==================== SubscribeProjectEvents Tests ====================
+
+func TestProjectEventService_SubscribeProjectEvents_Success(t *testing.T) {
+	suite := setupProjectEventServiceTest(t)
+
+	// Given: A member of the project
+	ctx := context.Background()
+	userID := uuid.New()
+	project := testutil.NewTestProject()
+	events := make(chan event.Event, 1)
+	events <- event.New(event.BoardCreated, project.ID, userID, nil)
+
+	suite.projectRepo.On("FindByID", project.ID).Return(project, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, project.ID).Return(&domain.ProjectMember{}, nil)
+	suite.subscriber.On("Subscribe", ctx, project.ID).Return((<-chan event.Event)(events), nil)
+
+	// When
+	stream, err := suite.service.SubscribeProjectEvents(ctx, userID.String(), project.ID.String())
+
+	// Then: The subscriber's stream is handed back to the caller
+	assert.NoError(t, err)
+	received := <-stream
+	assert.Equal(t, event.BoardCreated, received.Type)
+	assert.Equal(t, project.ID.String(), received.ProjectID)
+	suite.subscriber.AssertExpectations(t)
+}
+
+func TestProjectEventService_SubscribeProjectEvents_NotMember(t *testing.T) {
+	suite := setupProjectEventServiceTest(t)
+
+	userID := uuid.New()
+	project := testutil.NewTestProject()
+
+	suite.projectRepo.On("FindByID", project.ID).Return(project, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, project.ID).Return(nil, gorm.ErrRecordNotFound)
+
+	stream, err := suite.service.SubscribeProjectEvents(context.Background(), userID.String(), project.ID.String())
+
+	assert.Error(t, err)
+	assert.Nil(t, stream)
+	assert.Contains(t, err.Error(), "프로젝트 멤버가 아닙니다")
+	suite.subscriber.AssertNotCalled(t, "Subscribe", mock.Anything, mock.Anything)
+}
+
+func TestProjectEventService_SubscribeProjectEvents_ProjectNotFound(t *testing.T) {
+	suite := setupProjectEventServiceTest(t)
+
+	projectID := uuid.New()
+	suite.projectRepo.On("FindByID", projectID).Return(nil, gorm.ErrRecordNotFound)
+
+	stream, err := suite.service.SubscribeProjectEvents(context.Background(), uuid.New().String(), projectID.String())
+
+	assert.Error(t, err)
+	assert.Nil(t, stream)
+	assert.Contains(t, err.Error(), "프로젝트를 찾을 수 없습니다")
+}
+
+func TestProjectEventService_SubscribeProjectEvents_InvalidProjectID(t *testing.T) {
+	suite := setupProjectEventServiceTest(t)
+
+	stream, err := suite.service.SubscribeProjectEvents(context.Background(), uuid.New().String(), "not-a-uuid")
+
+	assert.Error(t, err)
+	assert.Nil(t, stream)
+	suite.projectRepo.AssertNotCalled(t, "FindByID", mock.Anything)
+}
@@ -199,9 +199,14 @@ func TestProjectService_GetProjectInitSettings_Success(t *testing.T) {
 		fieldOptionRepo,
 		boardOrderRepo,
 		viewRepo,
+		nil, // templateRepo
+		nil, // attachmentRepo
+		nil, // attachment storage
 		userClient,
 		workspaceCache,
 		userInfoCache,
+		nil,
+		ProjectDeletionSoft,
 		logger,
 		nil,
 	)
@@ -311,7 +316,7 @@ func TestProjectService_GetProjectInitSettings_ProjectNotFound(t *testing.T) {
 
 	service := NewProjectService(
 		projectRepo,
-		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, ProjectDeletionSoft,
 		logger,
 		nil,
 	)
@@ -337,7 +342,7 @@ func TestProjectService_GetProjectInitSettings_NotProjectMember(t *testing.T) {
 
 	service := NewProjectService(
 		projectRepo,
-		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, ProjectDeletionSoft,
 		logger,
 		nil,
 	)
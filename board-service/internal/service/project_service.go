@@ -6,7 +6,10 @@ import (
 	"board-service/internal/client"
 	"board-service/internal/domain"
 	"board-service/internal/dto"
+	"board-service/internal/event"
 	"board-service/internal/repository"
+	"board-service/internal/storage"
+	"board-service/internal/uow"
 	"context"
 	"encoding/json"
 	"errors"
@@ -18,12 +21,23 @@ import (
 	"gorm.io/gorm"
 )
 
+// ProjectDeletionMode decides what happens to a project's data when it is deleted
+type ProjectDeletionMode string
+
+const (
+	// ProjectDeletionSoft marks the project and its data as deleted
+	ProjectDeletionSoft ProjectDeletionMode = "soft"
+	// ProjectDeletionPurge removes the project and its data permanently
+	ProjectDeletionPurge ProjectDeletionMode = "purge"
+)
+
 type ProjectService interface {
 	CreateProject(userID string, token string, req *dto.CreateProjectRequest) (*dto.ProjectResponse, error)
 	GetProject(projectID, userID string) (*dto.ProjectResponse, error)
 	GetProjectsByWorkspaceID(workspaceID, userID string, token string) ([]dto.ProjectResponse, error)
 	UpdateProject(projectID, userID string, req *dto.UpdateProjectRequest) (*dto.ProjectResponse, error)
 	DeleteProject(projectID, userID string) error
+	DeleteProjectWithAllData(projectID, userID string, mode ProjectDeletionMode) error
 	SearchProjects(userID string, token string, req *dto.SearchProjectsRequest) (*dto.PaginatedProjectsResponse, error)
 
 	// Init Settings
@@ -38,6 +52,13 @@ type ProjectService interface {
 	GetProjectMembers(projectID, userID string) ([]dto.ProjectMemberResponse, error)
 	UpdateMemberRole(projectID, memberID, requestUserID string, req *dto.UpdateProjectMemberRoleRequest) (*dto.ProjectMemberResponse, error)
 	RemoveMember(projectID, memberID, requestUserID string) error
+
+	// Templates
+	CreateTemplate(projectID, userID string, req *dto.CreateProjectTemplateRequest) (*dto.ProjectTemplateResponse, error)
+	GetTemplates(workspaceID, userID, token string) ([]dto.ProjectTemplateResponse, error)
+	GetTemplate(templateID, userID, token string) (*dto.ProjectTemplateDetailResponse, error)
+	DeleteTemplate(templateID, userID, token string) error
+	CloneProject(projectID, userID, token string, req *dto.CloneProjectRequest) (*dto.ProjectResponse, error)
 }
 
 type projectService struct {
@@ -49,11 +70,16 @@ type projectService struct {
 	fieldOptionRepo  repository.FieldOptionRepository
 	boardOrderRepo   repository.BoardOrderRepository
 	viewRepo         repository.ViewRepository
+	templateRepo     repository.ProjectTemplateRepository
+	blobs            *attachmentBlobs
 	userClient       client.UserClient
 	workspaceCache   cache.WorkspaceCache
 	userInfoCache    cache.UserInfoCache
+	fieldCache       cache.FieldCache
+	deletionMode     ProjectDeletionMode
 	logger           *zap.Logger
 	db               *gorm.DB
+	uow              uow.UnitOfWork
 }
 
 func NewProjectService(
@@ -65,9 +91,14 @@ func NewProjectService(
 	fieldOptionRepo repository.FieldOptionRepository,
 	boardOrderRepo repository.BoardOrderRepository,
 	viewRepo repository.ViewRepository,
+	templateRepo repository.ProjectTemplateRepository,
+	attachmentRepo repository.AttachmentRepository,
+	store storage.Storage,
 	userClient client.UserClient,
 	workspaceCache cache.WorkspaceCache,
 	userInfoCache cache.UserInfoCache,
+	fieldCache cache.FieldCache,
+	deletionMode ProjectDeletionMode,
 	logger *zap.Logger,
 	db *gorm.DB,
 ) ProjectService {
@@ -80,11 +111,16 @@ func NewProjectService(
 		fieldOptionRepo:  fieldOptionRepo,
 		boardOrderRepo:   boardOrderRepo,
 		viewRepo:         viewRepo,
+		templateRepo:     templateRepo,
+		blobs:            newAttachmentBlobs(attachmentRepo, store, logger),
 		userClient:       userClient,
 		workspaceCache:   workspaceCache,
 		userInfoCache:    userInfoCache,
+		fieldCache:       fieldCache,
+		deletionMode:     deletionMode,
 		logger:           logger,
 		db:               db,
+		uow:              uow.NewUnitOfWork(db),
 	}
 }
 
@@ -106,54 +142,88 @@ func (s *projectService) CreateProject(userID string, token string, req *dto.Cre
 		return nil, err
 	}
 
-	// Get OWNER role
-	ownerRole, err := s.roleRepo.FindByName("OWNER")
+	// Resolve the structure of the new project (the default fields without a template)
+	content, err := s.findTemplateContent(req.TemplateID, workspaceUUID)
 	if err != nil {
-		s.logger.Error("Failed to find OWNER role", zap.Error(err))
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "권한 조회 실패", 500)
+		return nil, err
 	}
 
-	// Create project and member in transaction
-	var project *domain.Project
-	err = s.db.Transaction(func(tx *gorm.DB) error {
-		// Create project
-		project = &domain.Project{
-			WorkspaceID: workspaceUUID,
-			Name:        req.Name,
-			Description: req.Description,
-			OwnerID:     userUUID,
+	// Create project, owner member and the template structure in one transaction
+	project := &domain.Project{
+		WorkspaceID: workspaceUUID,
+		Name:        req.Name,
+		Description: req.Description,
+		OwnerID:     userUUID,
+		TimeZone:    "UTC",
+	}
+	if req.TimeZone != "" {
+		if err := project.SetTimeZone(req.TimeZone); err != nil {
+			return nil, apperrors.FromDomainError(err)
 		}
-		if err := s.repo.Create(project); err != nil {
+	}
+	if err := s.createProjectWithKey(project, req.Key, content); err != nil {
+		return nil, err
+	}
+
+	// Fetch user info
+	return s.toProjectResponse(project)
+}
+
+// maxProjectKeyAttempts bounds how often a derived key is resolved again after another create took it
+const maxProjectKeyAttempts = 3
+
+// createProjectWithKey creates the project under the requested key or, without one, under a free key derived from its name
+// A key can be taken by a concurrent create between the check and the insert: a requested key then fails with 409,
+// and a derived key is resolved again, which skips the key that was just taken
+func (s *projectService) createProjectWithKey(project *domain.Project, requestedKey string, content *domain.ProjectTemplateContent) error {
+	for attempt := 1; ; attempt++ {
+		key, err := s.resolveProjectKey(requestedKey, project.Name)
+		if err != nil {
 			return err
 		}
+		project.Key = key
 
-		// Create owner member
-		member := &domain.ProjectMember{
-			ProjectID: project.ID,
-			UserID:    userUUID,
-			RoleID:    ownerRole.ID,
-			JoinedAt:  time.Now(),
-		}
-		if err := s.repo.CreateMember(member); err != nil {
+		err = s.createProjectWithContent(project, content)
+		if requestedKey != "" || attempt == maxProjectKeyAttempts || !errors.Is(err, gorm.ErrDuplicatedKey) {
 			return err
 		}
+	}
+}
 
-		// Create default custom fields (stage, role, importance)
-		if err := s.initializeDefaultFields(project.ID); err != nil {
-			s.logger.Error("Failed to initialize default fields", zap.Error(err))
-			return err
+// maxProjectKeyCandidates limits how many suffixed keys (KEY2, KEY3, ...) are tried for a derived key
+const maxProjectKeyCandidates = 100
+
+// resolveProjectKey validates a requested key or, if none was given, derives a free one from the project name
+func (s *projectService) resolveProjectKey(requested, name string) (string, error) {
+	if requested != "" {
+		key := domain.NormalizeProjectKey(requested)
+		if err := domain.ValidateProjectKey(key); err != nil {
+			return "", apperrors.FromDomainError(err)
 		}
 
-		return nil
-	})
+		exists, err := s.repo.ExistsByKey(key)
+		if err != nil {
+			return "", apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 키 확인 실패", 500)
+		}
+		if exists {
+			return "", apperrors.New(apperrors.ErrCodeConflict, "이미 사용 중인 프로젝트 키입니다", 409)
+		}
+		return key, nil
+	}
 
-	if err != nil {
-		s.logger.Error("Failed to create project", zap.Error(err))
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 생성 실패", 500)
+	base := domain.DeriveProjectKey(name)
+	for n := 1; n <= maxProjectKeyCandidates; n++ {
+		key := domain.ProjectKeyCandidate(base, n)
+		exists, err := s.repo.ExistsByKey(key)
+		if err != nil {
+			return "", apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 키 확인 실패", 500)
+		}
+		if !exists {
+			return key, nil
+		}
 	}
 
-	// Fetch user info
-	return s.toProjectResponse(project)
+	return "", apperrors.New(apperrors.ErrCodeConflict, "사용 가능한 프로젝트 키가 없습니다. 키를 직접 지정해 주세요", 409)
 }
 
 // GetProject retrieves a project by ID
@@ -275,6 +345,23 @@ func (s *projectService) UpdateProject(projectID, userID string, req *dto.Update
 		// Domain 메서드 사용: 비즈니스 로직이 Domain에 캡슐화됨
 		project.UpdateDescription(req.Description)
 	}
+	if req.TimeZone != "" && req.TimeZone != project.TimeZone {
+		if err := project.SetTimeZone(req.TimeZone); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+
+		// Recurring boards follow the new time zone from their next run on
+		err = s.uow.Do(func(repos *uow.Repositories) error {
+			if err := repos.Project.Update(project); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 수정 실패", 500)
+			}
+			return rescheduleRecurrences(repos.Recurrence, project, time.Now())
+		})
+		if err != nil {
+			return nil, err
+		}
+		return s.toProjectResponse(project)
+	}
 
 	if err := s.repo.Update(project); err != nil {
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 수정 실패", 500)
@@ -283,8 +370,16 @@ func (s *projectService) UpdateProject(projectID, userID string, req *dto.Update
 	return s.toProjectResponse(project)
 }
 
-// DeleteProject soft deletes a project
+// DeleteProject deletes a project and all of its data using the configured deletion mode
 func (s *projectService) DeleteProject(projectID, userID string) error {
+	return s.DeleteProjectWithAllData(projectID, userID, s.deletionMode)
+}
+
+// DeleteProjectWithAllData deletes a project together with its boards, comments, fields,
+// options, field values, views, board orders, members and join requests in one transaction
+// ProjectDeletionSoft moves the project to the trash; ProjectDeletionPurge also removes
+// activity history and webhooks permanently
+func (s *projectService) DeleteProjectWithAllData(projectID, userID string, mode ProjectDeletionMode) error {
 	projUUID, err := uuid.Parse(projectID)
 	if err != nil {
 		return apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 프로젝트 ID", 400)
@@ -295,15 +390,81 @@ func (s *projectService) DeleteProject(projectID, userID string) error {
 		return apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
 	}
 
+	if mode != ProjectDeletionSoft && mode != ProjectDeletionPurge {
+		return apperrors.New(apperrors.ErrCodeBadRequest, "지원하지 않는 삭제 방식입니다", 400)
+	}
+
 	// Check if user is project OWNER
 	if err := s.checkProjectOwnerPermission(userUUID, projUUID); err != nil {
 		return err
 	}
 
-	if err := s.repo.Delete(projUUID); err != nil {
+	project, err := s.repo.FindByID(projUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.New(apperrors.ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", 404)
+		}
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 조회 실패", 500)
+	}
+
+	// UnitOfWork로 프로젝트와 모든 하위 데이터를 하나의 트랜잭션으로 삭제
+	var targets *repository.ProjectCascadeTargets
+	var storageKeys []string
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		// 1. 캐시 무효화 대상 수집 (삭제 전에 조회해야 purge 시에도 남음)
+		var err error
+		targets, err = repos.ProjectCascade.FindCascadeTargets(projUUID)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "삭제 대상 조회 실패", 500)
+		}
+
+		// 2. 하위 데이터 → 프로젝트 순서로 삭제
+		// soft 모드는 복원 기준 시각(DeletedAt)을 삭제 전에 잡아 휴지통에 기록
+		var result repository.ProjectCascadeResult
+		if mode == ProjectDeletionPurge {
+			if storageKeys, err = repos.Attachment.FindStorageKeysByProject(projUUID); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "삭제 대상 조회 실패", 500)
+			}
+			result, err = repos.ProjectCascade.PurgeProjectData(projUUID)
+		} else {
+			trashItem := domain.NewTrashItem(domain.TrashItemProject, projUUID, projUUID, userUUID, project.Name)
+			result, err = repos.ProjectCascade.SoftDeleteProjectData(projUUID)
+			if err == nil {
+				if err := repos.Trash.Create(trashItem); err != nil {
+					return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "휴지통 기록 실패", 500)
+				}
+			}
+		}
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 삭제 실패", 500)
+		}
+
+		// 3. 삭제 이벤트를 같은 트랜잭션으로 outbox에 기록
+		evt := event.New(event.ProjectDeleted, projUUID, userUUID, map[string]interface{}{
+			"mode": mode,
+		})
+		if err := repos.Outbox.Write(evt); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 삭제 이벤트 기록 실패", 500)
+		}
+
+		s.logger.Info("프로젝트와 하위 데이터 삭제 완료",
+			zap.String("project_id", projectID),
+			zap.String("mode", string(mode)),
+			zap.Any("deleted_rows", result),
+		)
+		return nil
+	})
+	if err != nil {
+		if _, ok := err.(*apperrors.AppError); ok {
+			return err
+		}
 		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 삭제 실패", 500)
 	}
 
+	// 4. 커밋 후 캐시 무효화, 더 이상 참조되지 않는 첨부 파일 내용 삭제
+	s.invalidateProjectCaches(project, targets)
+	s.blobs.release(storageKeys)
+
 	return nil
 }
 
@@ -507,26 +668,49 @@ func (s *projectService) UpdateJoinRequest(requestID, userID string, req *dto.Up
 	joinReq.Status = domain.ProjectJoinRequestStatus(req.Status)
 
 	// If approved, create member
+	var member *domain.ProjectMember
 	if req.Status == "APPROVED" {
 		memberRole, err := s.roleRepo.FindByName("MEMBER")
 		if err != nil {
 			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "권한 조회 실패", 500)
 		}
 
-		member := &domain.ProjectMember{
+		member = &domain.ProjectMember{
 			ProjectID: joinReq.ProjectID,
 			UserID:    joinReq.UserID,
 			RoleID:    memberRole.ID,
 			JoinedAt:  time.Now(),
 		}
+	}
+
+	// 멤버 추가, 신청 처리, 참여 이벤트를 하나의 트랜잭션으로 기록
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if member != nil {
+			if err := repos.Project.CreateMember(member); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 생성 실패", 500)
+			}
+		}
 
-		if err := s.repo.CreateMember(member); err != nil {
-			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 생성 실패", 500)
+		if err := repos.Project.UpdateJoinRequest(joinReq); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "참여 신청 처리 실패", 500)
 		}
-	}
 
-	if err := s.repo.UpdateJoinRequest(joinReq); err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "참여 신청 처리 실패", 500)
+		if member == nil {
+			return nil
+		}
+		joinedEvent := event.New(event.MemberJoined, member.ProjectID, userUUID, map[string]interface{}{
+			"memberId": member.ID.String(),
+			"userId":   member.UserID.String(),
+			"roleName": "MEMBER",
+			"joinedAt": member.JoinedAt,
+		})
+		if err := repos.Outbox.Write(joinedEvent); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 참여 이벤트 기록 실패", 500)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return s.toJoinRequestResponse(joinReq)
@@ -695,6 +879,40 @@ func (s *projectService) RemoveMember(projectID, memberID, requestUserID string)
 
 // Helper methods
 
+// invalidateProjectCaches removes cache entries that belonged to a deleted project
+// Only project-scoped caches are touched; workspace membership does not change when a project is deleted
+// A failure only leaves stale entries until their TTL expires, so it is logged and not returned
+func (s *projectService) invalidateProjectCaches(project *domain.Project, targets *repository.ProjectCascadeTargets) {
+	ctx := context.Background()
+	failed := 0
+
+	if err := s.fieldCache.InvalidateProjectFields(ctx, project.ID.String()); err != nil {
+		failed++
+	}
+	for _, fieldID := range targets.FieldIDs {
+		if err := s.fieldCache.InvalidateFieldOptions(ctx, fieldID.String()); err != nil {
+			failed++
+		}
+	}
+	for _, boardID := range targets.BoardIDs {
+		if err := s.fieldCache.InvalidateBoardFieldValues(ctx, boardID.String()); err != nil {
+			failed++
+		}
+	}
+	for _, viewID := range targets.ViewIDs {
+		if err := s.fieldCache.InvalidateViewResults(ctx, viewID.String()); err != nil {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		s.logger.Warn("Failed to invalidate caches of deleted project",
+			zap.String("project_id", project.ID.String()),
+			zap.Int("failed", failed),
+		)
+	}
+}
+
 // validateWorkspaceMembership checks workspace membership with caching
 func (s *projectService) validateWorkspaceMembership(ctx context.Context, workspaceID, userID, token string) error {
 	// TODO: Temporarily skip cache for debugging
@@ -917,8 +1135,10 @@ func (s *projectService) toProjectResponse(project *domain.Project) (*dto.Projec
 		ID:          project.ID.String(),
 		WorkspaceID: project.WorkspaceID.String(),
 		Name:        project.Name,
+		Key:         project.Key,
 		Description: project.Description,
 		OwnerID:     project.OwnerID.String(),
+		TimeZone:    project.TimeZone,
 		CreatedAt:   project.CreatedAt,
 		UpdatedAt:   project.UpdatedAt,
 	}
@@ -988,122 +1208,6 @@ func (s *projectService) toJoinRequestResponse(req *domain.ProjectJoinRequest) (
 	return response, nil
 }
 
-// initializeDefaultFields creates default custom fields for a new project
-func (s *projectService) initializeDefaultFields(projectID uuid.UUID) error {
-	// 1. Create Stage field
-	stageField := &domain.ProjectField{
-		ProjectID:       projectID,
-		Name:            "Stage",
-		FieldType:       domain.FieldTypeSingleSelect,
-		Description:     "작업 진행 단계",
-		DisplayOrder:    0,
-		IsRequired:      true,
-		IsSystemDefault: true,
-		Config:          "{}",
-	}
-	if err := s.fieldRepo.CreateField(stageField); err != nil {
-		return err
-	}
-
-	// Create Stage options
-	stageOptions := []struct {
-		label string
-		color string
-		order int
-	}{
-		{"대기", "#F59E0B", 0},
-		{"진행중", "#3B82F6", 1},
-		{"완료", "#10B981", 2},
-	}
-	for _, opt := range stageOptions {
-		option := &domain.FieldOption{
-			FieldID:      stageField.ID,
-			Label:        opt.label,
-			Color:        opt.color,
-			DisplayOrder: opt.order,
-		}
-		if err := s.fieldRepo.CreateOption(option); err != nil {
-			return err
-		}
-	}
-
-	// 2. Create Role field
-	roleField := &domain.ProjectField{
-		ProjectID:       projectID,
-		Name:            "Role",
-		FieldType:       domain.FieldTypeSingleSelect,
-		Description:     "담당 역할",
-		DisplayOrder:    1,
-		IsRequired:      false,
-		IsSystemDefault: true,
-		Config:          "{}",
-	}
-	if err := s.fieldRepo.CreateField(roleField); err != nil {
-		return err
-	}
-
-	// Create Role options
-	roleOptions := []struct {
-		label string
-		color string
-		order int
-	}{
-		{"프론트엔드", "#EC4899", 0},
-		{"백엔드", "#8B5CF6", 1},
-		{"디자인", "#F97316", 2},
-	}
-	for _, opt := range roleOptions {
-		option := &domain.FieldOption{
-			FieldID:      roleField.ID,
-			Label:        opt.label,
-			Color:        opt.color,
-			DisplayOrder: opt.order,
-		}
-		if err := s.fieldRepo.CreateOption(option); err != nil {
-			return err
-		}
-	}
-
-	// 3. Create Importance field
-	importanceField := &domain.ProjectField{
-		ProjectID:       projectID,
-		Name:            "Importance",
-		FieldType:       domain.FieldTypeSingleSelect,
-		Description:     "작업 중요도",
-		DisplayOrder:    2,
-		IsRequired:      false,
-		IsSystemDefault: true,
-		Config:          "{}",
-	}
-	if err := s.fieldRepo.CreateField(importanceField); err != nil {
-		return err
-	}
-
-	// Create Importance options
-	importanceOptions := []struct {
-		label string
-		color string
-		order int
-	}{
-		{"낮음", "#94A3B8", 0},
-		{"보통", "#FBBF24", 1},
-		{"높음", "#EF4444", 2},
-	}
-	for _, opt := range importanceOptions {
-		option := &domain.FieldOption{
-			FieldID:      importanceField.ID,
-			Label:        opt.label,
-			Color:        opt.color,
-			DisplayOrder: opt.order,
-		}
-		if err := s.fieldRepo.CreateOption(option); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
 // GetProjectInitSettings retrieves static configuration data needed for project initialization
 func (s *projectService) GetProjectInitSettings(projectID, userID string) (*dto.ProjectInitSettingsResponse, error) {
 	projectUUID, err := uuid.Parse(projectID)
@@ -1,16 +1,22 @@
 package service
 
 import (
+	"board-service/internal/apperrors"
+	"board-service/internal/cache"
 	"board-service/internal/client"
 	"board-service/internal/domain"
 	"board-service/internal/dto"
+	"board-service/internal/event"
+	"board-service/internal/repository"
 	"board-service/internal/testutil"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
 	"gorm.io/gorm"
@@ -25,7 +31,9 @@ type ProjectServiceTestSuite struct {
 	userClient     *MockUserClient
 	workspaceCache *MockWorkspaceCache
 	userInfoCache  *MockUserInfoCache
+	fieldCache     *MockFieldCache
 	logger         *zap.Logger
+	db             *gorm.DB
 	service        ProjectService
 }
 
@@ -36,7 +44,9 @@ func setupProjectServiceTest(t *testing.T) *ProjectServiceTestSuite {
 	userClient := new(MockUserClient)
 	workspaceCache := new(MockWorkspaceCache)
 	userInfoCache := new(MockUserInfoCache)
+	fieldCache := new(MockFieldCache)
 	logger := zaptest.NewLogger(t)
+	db := NewMockDB() // in-memory DB for transactions
 
 	service := NewProjectService(
 		projectRepo,
@@ -47,11 +57,16 @@ func setupProjectServiceTest(t *testing.T) *ProjectServiceTestSuite {
 		nil, // fieldOptionRepo
 		nil, // boardOrderRepo
 		nil, // viewRepo
+		repository.NewProjectTemplateRepository(db),
+		repository.NewAttachmentRepository(db),
+		nil, // attachment storage (the fixtures have no attachments)
 		userClient,
 		workspaceCache,
 		userInfoCache,
+		fieldCache,
+		ProjectDeletionSoft,
 		logger,
-		NewMockDB(), // in-memory DB for transactions
+		db,
 	)
 
 	return &ProjectServiceTestSuite{
@@ -61,7 +76,9 @@ func setupProjectServiceTest(t *testing.T) *ProjectServiceTestSuite {
 		userClient:     userClient,
 		workspaceCache: workspaceCache,
 		userInfoCache:  userInfoCache,
+		fieldCache:     fieldCache,
 		logger:         logger,
+		db:             db,
 		service:        service,
 	}
 }
@@ -243,7 +260,7 @@ func TestProjectService_GetProject_Success(t *testing.T) {
 
 	member := &domain.ProjectMember{
 		BaseModel: domain.BaseModel{
-			ID:       uuid.New(),
+			ID:        uuid.New(),
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
@@ -439,62 +456,252 @@ func TestProjectService_UpdateProject_NotOwner(t *testing.T) {
 
 // ==================== DeleteProject Tests ====================
 
-func TestProjectService_DeleteProject_Success(t *testing.T) {
-	suite := setupProjectServiceTest(t)
+// projectDeletionTablesSQL creates the tables touched by a project deletion
+// AutoMigrate cannot be used with SQLite because of the gen_random_uuid() default of BaseModel
+var projectDeletionTablesSQL = []string{
+	`CREATE TABLE projects (id TEXT PRIMARY KEY, workspace_id TEXT, owner_id TEXT, name TEXT, description TEXT, is_public BOOLEAN DEFAULT false, key TEXT UNIQUE, board_sequence INTEGER NOT NULL DEFAULT 0, time_zone TEXT NOT NULL DEFAULT 'UTC',
+		created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE project_members (id TEXT PRIMARY KEY, project_id TEXT, user_id TEXT, role_id TEXT, joined_at DATETIME, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE project_join_requests (id TEXT PRIMARY KEY, project_id TEXT, user_id TEXT, status TEXT, requested_at DATETIME, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE boards (id TEXT PRIMARY KEY, project_id TEXT, number INTEGER, key TEXT UNIQUE, title TEXT, description TEXT, created_by TEXT, participant_ids TEXT, parent_board_id TEXT, sprint_id TEXT, milestone_id TEXT, assignee_id TEXT,
+		due_date DATETIME, original_estimate_minutes INTEGER, remaining_estimate_minutes INTEGER, custom_fields_cache TEXT DEFAULT '{}', created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE board_key_aliases (id TEXT PRIMARY KEY, key TEXT UNIQUE, board_id TEXT, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE board_relations (id TEXT PRIMARY KEY, source_board_id TEXT, target_board_id TEXT, type TEXT, created_by TEXT,
+		created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false, UNIQUE (source_board_id, target_board_id, type))`,
+	`CREATE TABLE attachments (id TEXT PRIMARY KEY, board_id TEXT, comment_id TEXT, file_name TEXT, content_type TEXT, size INTEGER, checksum TEXT, storage_key TEXT,
+		uploaded_by TEXT, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE attachment_blobs (storage_key TEXT PRIMARY KEY, created_at DATETIME)`,
+	`CREATE TABLE checklist_items (id TEXT PRIMARY KEY, board_id TEXT, content TEXT, position TEXT, is_checked BOOLEAN DEFAULT false, checked_by TEXT, checked_at DATETIME,
+		assignee_id TEXT, due_date DATETIME, created_by TEXT, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE work_logs (id TEXT PRIMARY KEY, board_id TEXT, user_id TEXT, duration_minutes INTEGER, work_date DATE, note TEXT,
+		created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE sprints (id TEXT PRIMARY KEY, project_id TEXT, name TEXT, goal TEXT, start_date DATE, end_date DATE, state TEXT DEFAULT 'planned',
+		started_at DATETIME, closed_at DATETIME, created_by TEXT, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE sprint_boards (id TEXT PRIMARY KEY, sprint_id TEXT, board_id TEXT, added_at DATETIME, removed_at DATETIME, committed BOOLEAN DEFAULT false,
+		completed BOOLEAN DEFAULT false, carried_over BOOLEAN DEFAULT false, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false, UNIQUE (sprint_id, board_id))`,
+	`CREATE TABLE milestones (id TEXT PRIMARY KEY, project_id TEXT, name TEXT, description TEXT, target_date DATE, released BOOLEAN DEFAULT false, released_at DATETIME,
+		created_by TEXT, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE board_templates (id TEXT PRIMARY KEY, project_id TEXT, name TEXT, title_pattern TEXT, description TEXT, field_values TEXT DEFAULT '{}', checklist TEXT DEFAULT '[]',
+		assignee_rule TEXT DEFAULT 'NONE', assignee_id TEXT, created_by TEXT, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE board_recurrences (id TEXT PRIMARY KEY, template_id TEXT, project_id TEXT, frequency TEXT, repeat_interval INTEGER DEFAULT 1, weekdays TEXT, month_day INTEGER DEFAULT 0,
+		time_of_day TEXT, starts_on DATE, ends_on DATE, max_occurrences INTEGER, occurrence_count INTEGER DEFAULT 0, next_run_at DATETIME, last_run_at DATETIME, last_board_id TEXT,
+		created_by TEXT, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE board_stage_changes (id TEXT PRIMARY KEY, project_id TEXT, board_id TEXT, field_id TEXT, from_option_id TEXT, to_option_id TEXT,
+		changed_by TEXT, changed_at DATETIME, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE comments (id TEXT PRIMARY KEY, board_id TEXT, user_id TEXT, content TEXT, parent_comment_id TEXT, depth INTEGER DEFAULT 0,
+		created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE comment_reactions (id TEXT PRIMARY KEY, comment_id TEXT, user_id TEXT, emoji TEXT, created_at DATETIME, UNIQUE (comment_id, user_id, emoji))`,
+	`CREATE TABLE project_fields (id TEXT PRIMARY KEY, project_id TEXT, name TEXT, field_type TEXT, description TEXT, display_order INTEGER DEFAULT 0, is_required BOOLEAN DEFAULT false,
+		is_system_default BOOLEAN DEFAULT false, config TEXT DEFAULT '{}', can_edit_roles TEXT, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE field_options (id TEXT PRIMARY KEY, field_id TEXT, label TEXT, color TEXT, description TEXT, display_order INTEGER DEFAULT 0, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE board_field_values (id TEXT PRIMARY KEY, board_id TEXT, field_id TEXT, value_text TEXT, value_number REAL, value_date DATETIME, value_boolean BOOLEAN,
+		value_option_id TEXT, value_user_id TEXT, display_order INTEGER DEFAULT 0, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE saved_views (id TEXT PRIMARY KEY, project_id TEXT, created_by TEXT, name TEXT, description TEXT, is_default BOOLEAN DEFAULT false, is_shared BOOLEAN DEFAULT true,
+		filters TEXT DEFAULT '{}', sort_by TEXT, sort_direction TEXT DEFAULT 'asc', group_by_field_id TEXT, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE user_board_order (id TEXT PRIMARY KEY, view_id TEXT, user_id TEXT, board_id TEXT, position TEXT, updated_at DATETIME)`,
+	`CREATE TABLE board_activities (id TEXT PRIMARY KEY, board_id TEXT, project_id TEXT, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE notifications (id TEXT PRIMARY KEY, user_id TEXT, project_id TEXT, board_id TEXT, comment_id TEXT, actor_id TEXT, type TEXT, board_title TEXT, preview TEXT, read_at DATETIME,
+		created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE board_watchers (id TEXT PRIMARY KEY, board_id TEXT, user_id TEXT, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false, UNIQUE (board_id, user_id))`,
+	`CREATE TABLE board_reminders (id TEXT PRIMARY KEY, board_id TEXT, kind TEXT, lead_minutes INTEGER DEFAULT 0, due_date DATETIME, recipients INTEGER DEFAULT 0,
+		created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false, UNIQUE (board_id, kind, lead_minutes, due_date))`,
+	`CREATE TABLE reminder_digests (id TEXT PRIMARY KEY, user_id TEXT, digest_date DATE, board_count INTEGER DEFAULT 0, delivered BOOLEAN DEFAULT false,
+		created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false, UNIQUE (user_id, digest_date))`,
+	`CREATE TABLE project_webhooks (id TEXT PRIMARY KEY, project_id TEXT, is_active BOOLEAN DEFAULT true, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE webhook_deliveries (id TEXT PRIMARY KEY, webhook_id TEXT, project_id TEXT, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE webhook_delivery_attempts (id TEXT PRIMARY KEY, delivery_id TEXT, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE outbox_events (id TEXT PRIMARY KEY, event_id TEXT NOT NULL UNIQUE, event_type TEXT NOT NULL, project_id TEXT NOT NULL, payload TEXT NOT NULL,
+		published_at DATETIME, attempts INTEGER NOT NULL DEFAULT 0, last_error TEXT, locked_until DATETIME, created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+	`CREATE TABLE trash_items (id TEXT PRIMARY KEY, project_id TEXT, item_type TEXT, item_id TEXT, title TEXT, deleted_by TEXT, deleted_at DATETIME,
+		created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false, UNIQUE (item_type, item_id))`,
+	`CREATE TABLE project_templates (id TEXT PRIMARY KEY, workspace_id TEXT, name TEXT, description TEXT, content TEXT DEFAULT '{}', source_project_id TEXT, created_by TEXT,
+		created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+}
 
-	// Given
-	projectID := uuid.New()
-	userID := uuid.New()
+// projectDeletionFixture is a project with one row in every dependent table
+type projectDeletionFixture struct {
+	projectID   uuid.UUID
+	workspaceID uuid.UUID
+	ownerID     uuid.UUID
+	ownerRoleID uuid.UUID
+	boardID     uuid.UUID
+	fieldID     uuid.UUID
+	viewID      uuid.UUID
+	otherBoard  uuid.UUID // Board of another project that must survive
+}
 
-	project := &domain.Project{
-		BaseModel: domain.BaseModel{ID: projectID},
-		OwnerID:   userID, // User is owner
+func seedProjectDeletionData(t *testing.T, db *gorm.DB) projectDeletionFixture {
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, db.Exec(stmt).Error)
 	}
 
-	// Mocks
-	suite.projectRepo.On("FindByID", projectID).
-		Return(project, nil)
+	f := projectDeletionFixture{
+		projectID:   uuid.New(),
+		workspaceID: uuid.New(),
+		ownerID:     uuid.New(),
+		ownerRoleID: uuid.New(),
+		boardID:     uuid.New(),
+		fieldID:     uuid.New(),
+		viewID:      uuid.New(),
+		otherBoard:  uuid.New(),
+	}
+	deliveryID := uuid.New()
+	sprintID, templateID := uuid.New(), uuid.New()
+
+	inserts := []struct {
+		query string
+		args  []interface{}
+	}{
+		{"INSERT INTO projects (id, workspace_id, owner_id) VALUES (?, ?, ?)", []interface{}{f.projectID, f.workspaceID, f.ownerID}},
+		{"INSERT INTO project_members (id, project_id, user_id, role_id) VALUES (?, ?, ?, ?)", []interface{}{uuid.New(), f.projectID, f.ownerID, f.ownerRoleID}},
+		{"INSERT INTO project_join_requests (id, project_id, user_id) VALUES (?, ?, ?)", []interface{}{uuid.New(), f.projectID, uuid.New()}},
+		{"INSERT INTO boards (id, project_id) VALUES (?, ?), (?, ?)", []interface{}{f.boardID, f.projectID, f.otherBoard, uuid.New()}},
+		{"INSERT INTO comments (id, board_id) VALUES (?, ?)", []interface{}{uuid.New(), f.boardID}},
+		{"INSERT INTO checklist_items (id, board_id, content, position) VALUES (?, ?, 'step', 'a0')", []interface{}{uuid.New(), f.boardID}},
+		{"INSERT INTO board_reminders (id, board_id, kind, lead_minutes, due_date) VALUES (?, ?, 'DUE_SOON', 60, '2026-01-01 09:00:00')", []interface{}{uuid.New(), f.boardID}},
+		{"INSERT INTO work_logs (id, board_id, user_id, duration_minutes, work_date) VALUES (?, ?, ?, 30, '2026-01-01')", []interface{}{uuid.New(), f.boardID, f.ownerID}},
+		{"INSERT INTO sprints (id, project_id, name, created_by) VALUES (?, ?, 'Sprint 1', ?)", []interface{}{sprintID, f.projectID, f.ownerID}},
+		{"INSERT INTO sprint_boards (id, sprint_id, board_id, added_at) VALUES (?, ?, ?, CURRENT_TIMESTAMP)", []interface{}{uuid.New(), sprintID, f.boardID}},
+		{"INSERT INTO milestones (id, project_id, name, created_by) VALUES (?, ?, 'v1.0', ?)", []interface{}{uuid.New(), f.projectID, f.ownerID}},
+		{"INSERT INTO board_templates (id, project_id, name, created_by) VALUES (?, ?, 'Bug report', ?)", []interface{}{templateID, f.projectID, f.ownerID}},
+		{"INSERT INTO board_recurrences (id, template_id, project_id, frequency, time_of_day, starts_on, created_by) VALUES (?, ?, ?, 'WEEKLY', '09:00', '2026-01-05', ?)",
+			[]interface{}{uuid.New(), templateID, f.projectID, f.ownerID}},
+		{"INSERT INTO board_stage_changes (id, project_id, board_id, field_id, changed_by, changed_at) VALUES (?, ?, ?, ?, ?, CURRENT_TIMESTAMP)", []interface{}{uuid.New(), f.projectID, f.boardID, f.fieldID, f.ownerID}},
+		{"INSERT INTO project_fields (id, project_id) VALUES (?, ?)", []interface{}{f.fieldID, f.projectID}},
+		{"INSERT INTO field_options (id, field_id) VALUES (?, ?)", []interface{}{uuid.New(), f.fieldID}},
+		{"INSERT INTO board_field_values (id, board_id, field_id) VALUES (?, ?, ?)", []interface{}{uuid.New(), f.boardID, f.fieldID}},
+		{"INSERT INTO saved_views (id, project_id) VALUES (?, ?)", []interface{}{f.viewID, f.projectID}},
+		{"INSERT INTO user_board_order (id, view_id, user_id, board_id, position) VALUES (?, ?, ?, ?, 'a0')", []interface{}{uuid.New(), f.viewID, f.ownerID, f.boardID}},
+		{"INSERT INTO board_activities (id, board_id, project_id) VALUES (?, ?, ?)", []interface{}{uuid.New(), f.boardID, f.projectID}},
+		{"INSERT INTO project_webhooks (id, project_id) VALUES (?, ?)", []interface{}{uuid.New(), f.projectID}},
+		{"INSERT INTO webhook_deliveries (id, project_id) VALUES (?, ?)", []interface{}{deliveryID, f.projectID}},
+		{"INSERT INTO webhook_delivery_attempts (id, delivery_id) VALUES (?, ?)", []interface{}{uuid.New(), deliveryID}},
+	}
+	for _, insert := range inserts {
+		require.NoError(t, db.Exec(insert.query, insert.args...).Error)
+	}
 
-	suite.projectRepo.On("Update", mock.AnythingOfType("*domain.Project")).
-		Run(func(args mock.Arguments) {
-			p := args.Get(0).(*domain.Project)
-			assert.True(t, p.IsDeleted, "Project should be marked as deleted")
-		}).
-		Return(nil)
+	return f
+}
+
+func (suite *ProjectServiceTestSuite) expectProjectOwner(f projectDeletionFixture) {
+	suite.projectRepo.On("FindMemberByUserAndProject", f.ownerID, f.projectID).
+		Return(&domain.ProjectMember{ProjectID: f.projectID, UserID: f.ownerID, RoleID: f.ownerRoleID}, nil)
+	suite.roleRepo.On("FindByID", f.ownerRoleID).Return(&domain.Role{Name: "OWNER", Level: 100}, nil)
+	suite.projectRepo.On("FindByID", f.projectID).Return(&domain.Project{
+		BaseModel:   domain.BaseModel{ID: f.projectID},
+		WorkspaceID: f.workspaceID,
+		OwnerID:     f.ownerID,
+	}, nil)
+}
+
+func (suite *ProjectServiceTestSuite) expectProjectCacheInvalidation(f projectDeletionFixture) {
+	suite.fieldCache.On("InvalidateProjectFields", mock.Anything, f.projectID.String()).Return(nil)
+	suite.fieldCache.On("InvalidateFieldOptions", mock.Anything, f.fieldID.String()).Return(nil)
+	suite.fieldCache.On("InvalidateBoardFieldValues", mock.Anything, f.boardID.String()).Return(nil)
+	suite.fieldCache.On("InvalidateViewResults", mock.Anything, f.viewID.String()).Return(nil)
+}
+
+func countRows(t *testing.T, db *gorm.DB, table, where string, args ...interface{}) int64 {
+	var count int64
+	require.NoError(t, db.Table(table).Where(where, args...).Count(&count).Error)
+	return count
+}
+
+func TestProjectService_DeleteProject_SoftDeletesAllData(t *testing.T) {
+	suite := setupProjectServiceTest(t)
+	f := seedProjectDeletionData(t, suite.db)
+	suite.expectProjectOwner(f)
+	suite.expectProjectCacheInvalidation(f)
 
 	// When
-	err := suite.service.DeleteProject(projectID.String(), userID.String())
+	err := suite.service.DeleteProject(f.projectID.String(), f.ownerID.String())
 
 	// Then
-	assert.NoError(t, err)
-	suite.projectRepo.AssertExpectations(t)
+	require.NoError(t, err)
+	for _, table := range []string{
+		"projects", "project_members", "project_join_requests", "comments",
+		"project_fields", "field_options", "board_field_values", "saved_views", "project_webhooks", "sprints", "milestones", "board_templates", "board_recurrences",
+	} {
+		assert.Zero(t, countRows(t, suite.db, table, "is_deleted = ?", false), "%s should be soft deleted", table)
+		assert.NotZero(t, countRows(t, suite.db, table, "1 = 1"), "%s rows should be kept", table)
+	}
+	assert.Equal(t, int64(1), countRows(t, suite.db, "boards", "id = ? AND is_deleted = ?", f.boardID, true))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "boards", "id = ? AND is_deleted = ?", f.otherBoard, false), "other projects are untouched")
+	assert.Equal(t, int64(1), countRows(t, suite.db, "user_board_order", "1 = 1"), "board orders are kept for a restore")
+	assert.Equal(t, int64(1), countRows(t, suite.db, "board_activities", "1 = 1"), "activity history is kept")
+	assert.Equal(t, int64(1), countRows(t, suite.db, "board_stage_changes", "1 = 1"), "stage history is kept")
+	assert.Equal(t, int64(1), countRows(t, suite.db, "outbox_events", "event_type = ? AND project_id = ?", string(event.ProjectDeleted), f.projectID))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "trash_items", "item_type = ? AND item_id = ? AND deleted_by = ?",
+		string(domain.TrashItemProject), f.projectID, f.ownerID), "the project is moved to the trash")
+
+	suite.fieldCache.AssertExpectations(t)
+	suite.workspaceCache.AssertNotCalled(t, "InvalidateMembership", mock.Anything, mock.Anything, mock.Anything)
 }
 
-func TestProjectService_DeleteProject_NotOwner(t *testing.T) {
+func TestProjectService_DeleteProjectWithAllData_PurgeRemovesAllData(t *testing.T) {
 	suite := setupProjectServiceTest(t)
+	f := seedProjectDeletionData(t, suite.db)
+	suite.expectProjectOwner(f)
+	suite.expectProjectCacheInvalidation(f)
 
-	// Given
-	projectID := uuid.New()
-	userID := uuid.New()
-	ownerID := uuid.New() // Different
+	// When
+	err := suite.service.DeleteProjectWithAllData(f.projectID.String(), f.ownerID.String(), ProjectDeletionPurge)
 
-	project := &domain.Project{
-		BaseModel: domain.BaseModel{ID: projectID},
-		OwnerID:   ownerID,
+	// Then
+	require.NoError(t, err)
+	for _, table := range []string{
+		"projects", "project_members", "project_join_requests", "comments", "project_fields", "field_options",
+		"board_field_values", "saved_views", "user_board_order", "board_activities",
+		"project_webhooks", "webhook_deliveries", "webhook_delivery_attempts", "trash_items", "checklist_items",
+		"work_logs", "sprints", "sprint_boards", "milestones", "board_stage_changes", "board_templates", "board_recurrences",
+		"board_reminders",
+	} {
+		assert.Zero(t, countRows(t, suite.db, table, "1 = 1"), "%s should be purged", table)
 	}
+	assert.Equal(t, int64(1), countRows(t, suite.db, "boards", "1 = 1"), "only the other project's board remains")
+	assert.Equal(t, int64(1), countRows(t, suite.db, "outbox_events", "event_type = ?", string(event.ProjectDeleted)))
+
+	suite.fieldCache.AssertExpectations(t)
+	suite.workspaceCache.AssertExpectations(t)
+}
+
+func TestProjectService_DeleteProject_NotOwner(t *testing.T) {
+	suite := setupProjectServiceTest(t)
+	f := seedProjectDeletionData(t, suite.db)
+
+	// Given
+	memberID := uuid.New()
+	memberRoleID := uuid.New()
 
 	// Mocks
-	suite.projectRepo.On("FindByID", projectID).
-		Return(project, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", memberID, f.projectID).
+		Return(&domain.ProjectMember{ProjectID: f.projectID, UserID: memberID, RoleID: memberRoleID}, nil)
+	suite.roleRepo.On("FindByID", memberRoleID).Return(&domain.Role{Name: "MEMBER", Level: 10}, nil)
 
 	// When
-	err := suite.service.DeleteProject(projectID.String(), userID.String())
+	err := suite.service.DeleteProject(f.projectID.String(), memberID.String())
 
 	// Then
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "프로젝트 소유자만 삭제할 수 있습니다")
+	assert.Contains(t, err.Error(), "OWNER 권한이 필요합니다")
+	assert.Zero(t, countRows(t, suite.db, "boards", "is_deleted = ?", true))
+	assert.Zero(t, countRows(t, suite.db, "outbox_events", "1 = 1"))
 
 	suite.projectRepo.AssertExpectations(t)
+	suite.fieldCache.AssertNotCalled(t, "InvalidateProjectFields", mock.Anything, mock.Anything)
+}
+
+func TestProjectService_DeleteProjectWithAllData_UnknownMode(t *testing.T) {
+	suite := setupProjectServiceTest(t)
+
+	// When
+	err := suite.service.DeleteProjectWithAllData(uuid.New().String(), uuid.New().String(), ProjectDeletionMode("archive"))
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "지원하지 않는 삭제 방식입니다")
+	suite.projectRepo.AssertNotCalled(t, "FindMemberByUserAndProject", mock.Anything, mock.Anything)
 }
 
 // ==================== GetProjectMembers Tests ====================
@@ -566,6 +773,89 @@ func TestProjectService_GetProjectMembers_Success(t *testing.T) {
 	suite.projectRepo.AssertExpectations(t)
 }
 
+// ==================== UpdateJoinRequest Tests ====================
+
+func TestProjectService_UpdateJoinRequest_ApprovedPublishesMemberJoined(t *testing.T) {
+	suite := setupProjectServiceTest(t)
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, suite.db.Exec(stmt).Error)
+	}
+
+	// Given
+	projectID := uuid.New()
+	adminID := uuid.New()
+	applicantID := uuid.New()
+	adminRoleID := uuid.New()
+	memberRoleID := uuid.New()
+
+	joinReq := &domain.ProjectJoinRequest{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: projectID,
+		UserID:    applicantID,
+		Status:    domain.ProjectJoinRequestPending,
+	}
+
+	// Mocks
+	suite.projectRepo.On("FindJoinRequestByID", joinReq.ID).Return(joinReq, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", adminID, projectID).
+		Return(&domain.ProjectMember{ProjectID: projectID, UserID: adminID, RoleID: adminRoleID}, nil)
+	suite.roleRepo.On("FindByID", adminRoleID).Return(&domain.Role{Name: "ADMIN", Level: 50}, nil)
+	suite.roleRepo.On("FindByName", "MEMBER").
+		Return(&domain.Role{BaseModel: domain.BaseModel{ID: memberRoleID}, Name: "MEMBER", Level: 10}, nil)
+	suite.userInfoCache.On("GetUserInfo", mock.Anything, applicantID.String()).
+		Return(true, &cache.UserInfo{UserID: applicantID.String(), Name: "Applicant"}, nil)
+
+	// When
+	result, err := suite.service.UpdateJoinRequest(joinReq.ID.String(), adminID.String(),
+		&dto.UpdateProjectJoinRequestRequest{Status: "APPROVED"})
+
+	// Then: The member and the member.joined event are committed together
+	assert.NoError(t, err)
+	assert.Equal(t, "APPROVED", result.Status)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "project_members", "project_id = ? AND user_id = ? AND role_id = ?", projectID, applicantID, memberRoleID))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "project_join_requests", "id = ? AND status = ?", joinReq.ID, "APPROVED"))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "outbox_events", "event_type = ? AND project_id = ?", string(event.MemberJoined), projectID))
+	suite.projectRepo.AssertExpectations(t)
+}
+
+func TestProjectService_UpdateJoinRequest_RejectedDoesNotPublish(t *testing.T) {
+	suite := setupProjectServiceTest(t)
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, suite.db.Exec(stmt).Error)
+	}
+
+	// Given
+	projectID := uuid.New()
+	adminID := uuid.New()
+	applicantID := uuid.New()
+	adminRoleID := uuid.New()
+
+	joinReq := &domain.ProjectJoinRequest{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: projectID,
+		UserID:    applicantID,
+		Status:    domain.ProjectJoinRequestPending,
+	}
+
+	// Mocks
+	suite.projectRepo.On("FindJoinRequestByID", joinReq.ID).Return(joinReq, nil)
+	suite.projectRepo.On("FindMemberByUserAndProject", adminID, projectID).
+		Return(&domain.ProjectMember{ProjectID: projectID, UserID: adminID, RoleID: adminRoleID}, nil)
+	suite.roleRepo.On("FindByID", adminRoleID).Return(&domain.Role{Name: "OWNER", Level: 100}, nil)
+	suite.userInfoCache.On("GetUserInfo", mock.Anything, applicantID.String()).
+		Return(true, &cache.UserInfo{UserID: applicantID.String(), Name: "Applicant"}, nil)
+
+	// When
+	_, err := suite.service.UpdateJoinRequest(joinReq.ID.String(), adminID.String(),
+		&dto.UpdateProjectJoinRequestRequest{Status: "REJECTED"})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "project_join_requests", "id = ? AND status = ?", joinReq.ID, "REJECTED"))
+	assert.Zero(t, countRows(t, suite.db, "project_members", "1 = 1"))
+	assert.Zero(t, countRows(t, suite.db, "outbox_events", "1 = 1"))
+}
+
 // ==================== Helper Functions Test ====================
 
 func TestProjectService_InvalidUUID(t *testing.T) {
@@ -579,3 +869,248 @@ func TestProjectService_InvalidUUID(t *testing.T) {
 	_, err = suite.service.GetProject(uuid.New().String(), "invalid-uuid")
 	assert.Error(t, err)
 }
+
+// ==================== Project Key Tests ====================
+
+func TestProjectService_ResolveProjectKey_DerivesFreeKey(t *testing.T) {
+	suite := setupProjectServiceTest(t)
+	service := suite.service.(*projectService)
+
+	// Given: "WF" and "WF2" are taken
+	suite.projectRepo.On("ExistsByKey", "WF").Return(true, nil)
+	suite.projectRepo.On("ExistsByKey", "WF2").Return(true, nil)
+	suite.projectRepo.On("ExistsByKey", "WF3").Return(false, nil)
+
+	// When
+	key, err := service.resolveProjectKey("", "Web Frontend")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "WF3", key)
+}
+
+func TestProjectService_ResolveProjectKey_RequestedKey(t *testing.T) {
+	suite := setupProjectServiceTest(t)
+	service := suite.service.(*projectService)
+
+	suite.projectRepo.On("ExistsByKey", "WEB").Return(false, nil)
+	suite.projectRepo.On("ExistsByKey", "APP").Return(true, nil)
+
+	// A requested key is normalized
+	key, err := service.resolveProjectKey(" web ", "Website")
+	assert.NoError(t, err)
+	assert.Equal(t, "WEB", key)
+
+	// A taken key is not replaced by another one
+	_, err = service.resolveProjectKey("APP", "Mobile App")
+	var appErr *apperrors.AppError
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, 409, appErr.HTTPStatus)
+
+	// Invalid keys are rejected before the lookup
+	_, err = service.resolveProjectKey("1-WEB", "Website")
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, 400, appErr.HTTPStatus)
+}
+
+// staleKeyRepository reports the given keys as free once, like a check that ran just before another create inserted them
+type staleKeyRepository struct {
+	repository.ProjectRepository
+	stale map[string]bool
+}
+
+func (r *staleKeyRepository) ExistsByKey(key string) (bool, error) {
+	if r.stale[key] {
+		delete(r.stale, key)
+		return false, nil
+	}
+	return r.ProjectRepository.ExistsByKey(key)
+}
+
+func TestProjectService_CreateProject_KeyTakenConcurrently(t *testing.T) {
+	suite := setupProjectTemplateTest(t)
+	suite.createProject(t, "Web Frontend", "WF", nil)
+	suite.createProject(t, "Mobile App", "APP", nil)
+
+	// Given: Both keys were still free when the new projects checked them
+	suite.service.repo = &staleKeyRepository{ProjectRepository: suite.service.repo, stale: map[string]bool{"WF": true, "APP": true}}
+
+	// When: A derived key is taken on insert, the next free key is used
+	project, err := suite.service.CreateProject(suite.ownerID.String(), templateTestToken, &dto.CreateProjectRequest{
+		WorkspaceID: suite.workspaceID.String(),
+		Name:        "Web Frontend",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "WF2", project.Key)
+
+	// When: A requested key is taken on insert, the request fails with 409
+	_, err = suite.service.CreateProject(suite.ownerID.String(), templateTestToken, &dto.CreateProjectRequest{
+		WorkspaceID: suite.workspaceID.String(),
+		Name:        "Another App",
+		Key:         "APP",
+	})
+	assert.Equal(t, 409, appErrorStatus(t, err))
+	assert.Equal(t, int64(3), countRows(t, suite.db, "projects", "1 = 1"))
+}
+
+func TestBoardKeys_SequenceAndAliasLookup(t *testing.T) {
+	db := NewMockDB()
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	projectRepo := repository.NewProjectRepository(db)
+	boardRepo := repository.NewBoardRepository(db)
+
+	// Given: A project with two boards
+	projectID := uuid.New()
+	boardID := uuid.New()
+	require.NoError(t, db.Exec("INSERT INTO projects (id, key, board_sequence) VALUES (?, 'WEB', 2)", projectID).Error)
+	require.NoError(t, db.Exec("INSERT INTO boards (id, project_id, number, key) VALUES (?, ?, 2, 'WEB-2')", boardID, projectID).Error)
+
+	// When: The next board number is allocated
+	project, err := projectRepo.IncrementBoardSequence(projectID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 3, project.BoardSequence)
+	assert.Equal(t, "WEB-3", project.BoardKey(project.BoardSequence))
+
+	_, err = projectRepo.IncrementBoardSequence(uuid.New())
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	// When: The board moves away and keeps its previous key as an alias
+	require.NoError(t, db.Exec("UPDATE boards SET project_id = ?, number = 1, key = 'APP-1' WHERE id = ?", uuid.New(), boardID).Error)
+	require.NoError(t, boardRepo.CreateKeyAlias(&domain.BoardKeyAlias{BaseModel: domain.BaseModel{ID: uuid.New()}, Key: "WEB-2", BoardID: boardID}))
+
+	// Then: Both keys resolve to the board
+	for _, key := range []string{"APP-1", "WEB-2"} {
+		board, err := boardRepo.FindByKey(key)
+		require.NoError(t, err, key)
+		assert.Equal(t, boardID, board.ID)
+	}
+	_, err = boardRepo.FindByKey("WEB-1")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestBoardRelations_BlockingGraph(t *testing.T) {
+	db := NewMockDB()
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	relationRepo := repository.NewBoardRelationRepository(db)
+
+	// Given: A blocks B, B blocks C, and A relates to D
+	projectID := uuid.New()
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	for _, id := range []uuid.UUID{a, b, c, d} {
+		require.NoError(t, db.Exec("INSERT INTO boards (id, project_id) VALUES (?, ?)", id, projectID).Error)
+	}
+	link := func(source, target uuid.UUID, relationType domain.BoardRelationType) {
+		relation, err := domain.NewBoardRelation(source, target, relationType, uuid.New())
+		require.NoError(t, err)
+		relation.ID = uuid.New()
+		require.NoError(t, relationRepo.Create(relation))
+	}
+	link(a, b, domain.BoardRelationBlocks)
+	link(b, c, domain.BoardRelationBlocks)
+	link(a, d, domain.BoardRelationRelates)
+
+	// Then: Closing the chain in either length is a cycle, a new branch is not
+	cycle, err := createsBlockingCycle(relationRepo, c, a)
+	require.NoError(t, err)
+	assert.True(t, cycle)
+	cycle, err = createsBlockingCycle(relationRepo, b, a)
+	require.NoError(t, err)
+	assert.True(t, cycle)
+	cycle, err = createsBlockingCycle(relationRepo, d, a)
+	require.NoError(t, err)
+	assert.False(t, cycle, "RELATES does not take part in the blocking graph")
+
+	// Then: B and C are blocked
+	blocked, err := relationRepo.FilterBlocked([]uuid.UUID{a, b, c, d})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{b, c}, blocked)
+
+	relations, err := relationRepo.FindByBoard(a)
+	require.NoError(t, err)
+	require.Len(t, relations, 2)
+	assert.Equal(t, b, relations[0].TargetBoard.ID)
+
+	// When: The blocker of B is deleted
+	require.NoError(t, db.Exec("UPDATE boards SET is_deleted = true WHERE id = ?", a).Error)
+
+	// Then: B is no longer blocked and its relation with A is hidden, but the cycle check still sees it
+	blocked, err = relationRepo.FilterBlocked([]uuid.UUID{a, b, c, d})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{c}, blocked)
+
+	relations, err = relationRepo.FindByBoard(b)
+	require.NoError(t, err)
+	require.Len(t, relations, 1)
+	assert.Equal(t, c, relations[0].TargetBoardID)
+
+	cycle, err = createsBlockingCycle(relationRepo, c, a)
+	require.NoError(t, err)
+	assert.True(t, cycle)
+}
+
+func TestBoardSubtasks_HierarchyAndProgress(t *testing.T) {
+	db := NewMockDB()
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	boardRepo := repository.NewBoardRepository(db)
+	checklistRepo := repository.NewChecklistRepository(db)
+	progress := newBoardProgressReader(checklistRepo, boardRepo, zap.NewNop())
+
+	// Given: A has the sub-tasks B and C, and B has the sub-task D
+	projectID := uuid.New()
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	require.NoError(t, db.Exec("INSERT INTO boards (id, project_id) VALUES (?, ?)", a, projectID).Error)
+	for child, parent := range map[uuid.UUID]uuid.UUID{b: a, c: a, d: b} {
+		require.NoError(t, db.Exec("INSERT INTO boards (id, project_id, parent_board_id) VALUES (?, ?, ?)", child, projectID, parent).Error)
+	}
+	addItem := func(boardID uuid.UUID, checked bool) {
+		item, err := domain.NewChecklistItem(boardID, "step", "a0", uuid.New())
+		require.NoError(t, err)
+		item.ID = uuid.New()
+		if checked {
+			item.Check(uuid.New())
+		}
+		require.NoError(t, checklistRepo.Create(item))
+	}
+	addItem(a, true)
+	addItem(b, true)
+	addItem(b, false)
+	addItem(c, false)
+	addItem(d, true)
+
+	// Then: Any descendant as the new parent is a cycle, a sibling is not
+	cycle, err := createsParentCycle(boardRepo, a, d)
+	require.NoError(t, err)
+	assert.True(t, cycle)
+	cycle, err = createsParentCycle(boardRepo, b, d)
+	require.NoError(t, err)
+	assert.True(t, cycle)
+	cycle, err = createsParentCycle(boardRepo, d, c)
+	require.NoError(t, err)
+	assert.False(t, cycle)
+
+	// Then: The progress of A counts its own checklist and those of its direct sub-tasks only
+	result, err := progress.forBoards([]uuid.UUID{a, b})
+	require.NoError(t, err)
+	assert.Equal(t, &dto.BoardProgressResponse{ChecklistTotal: 4, ChecklistChecked: 2, SubtaskCount: 2, Percent: 50}, result[a])
+	assert.Equal(t, &dto.BoardProgressResponse{ChecklistTotal: 3, ChecklistChecked: 2, SubtaskCount: 1, Percent: 66}, result[b])
+
+	// When: C is deleted and the sub-tasks of A are detached
+	require.NoError(t, db.Exec("UPDATE boards SET is_deleted = true WHERE id = ?", c).Error)
+	require.NoError(t, boardRepo.DetachChildren(a))
+
+	// Then: A only counts its own checklist
+	result, err = progress.forBoards([]uuid.UUID{a})
+	require.NoError(t, err)
+	assert.Equal(t, &dto.BoardProgressResponse{ChecklistTotal: 1, ChecklistChecked: 1, SubtaskCount: 0, Percent: 100}, result[a])
+	parentID, err := boardRepo.FindParentID(c)
+	require.NoError(t, err)
+	assert.Nil(t, parentID, "deleted sub-tasks are detached as well")
+}
@@ -0,0 +1,726 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/uow"
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ==================== Project Templates ====================
+
+// CreateTemplate keeps the structure of a project as a template of its workspace
+func (s *projectService) CreateTemplate(projectID, userID string, req *dto.CreateProjectTemplateRequest) (*dto.ProjectTemplateResponse, error) {
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 프로젝트 ID", 400)
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
+	}
+
+	project, err := s.findProject(projectUUID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkProjectAdminPermission(userUUID, projectUUID); err != nil {
+		return nil, err
+	}
+
+	boardLimit := -1
+	if req.IncludeBoards {
+		boardLimit = domain.MaxProjectTemplateBoards
+	}
+	content, err := s.snapshotProject(projectUUID, boardLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := domain.NewProjectTemplate(project.WorkspaceID, req.Name, req.Description, content, &projectUUID, userUUID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+	if err := s.templateRepo.Create(template); err != nil {
+		s.logger.Error("Failed to create project template", zap.Error(err))
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "템플릿 생성 실패", 500)
+	}
+
+	return toProjectTemplateResponse(template, content), nil
+}
+
+// GetTemplates returns the project templates of a workspace
+func (s *projectService) GetTemplates(workspaceID, userID, token string) ([]dto.ProjectTemplateResponse, error) {
+	workspaceUUID, err := uuid.Parse(workspaceID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 워크스페이스 ID", 400)
+	}
+	if err := s.validateWorkspaceMembership(context.Background(), workspaceID, userID, token); err != nil {
+		return nil, err
+	}
+
+	templates, err := s.templateRepo.FindByWorkspace(workspaceUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "템플릿 조회 실패", 500)
+	}
+
+	responses := make([]dto.ProjectTemplateResponse, 0, len(templates))
+	for i := range templates {
+		content, err := decodeTemplateContent(&templates[i])
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, *toProjectTemplateResponse(&templates[i], content))
+	}
+	return responses, nil
+}
+
+// GetTemplate returns a template with the fields, views and sample boards it creates
+func (s *projectService) GetTemplate(templateID, userID, token string) (*dto.ProjectTemplateDetailResponse, error) {
+	template, err := s.findTemplate(templateID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateWorkspaceMembership(context.Background(), template.WorkspaceID.String(), userID, token); err != nil {
+		return nil, err
+	}
+
+	content, err := decodeTemplateContent(template)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ProjectTemplateDetailResponse{
+		ProjectTemplateResponse: *toProjectTemplateResponse(template, content),
+		Fields:                  make([]dto.ProjectTemplateField, 0, len(content.Fields)),
+		Views:                   make([]string, 0, len(content.Views)),
+		Boards:                  make([]string, 0, len(content.Boards)),
+	}
+	for _, field := range content.Fields {
+		options := make([]string, 0, len(field.Options))
+		for _, option := range field.Options {
+			options = append(options, option.Label)
+		}
+		response.Fields = append(response.Fields, dto.ProjectTemplateField{
+			Name:            field.Name,
+			FieldType:       string(field.FieldType),
+			IsRequired:      field.IsRequired,
+			IsSystemDefault: field.IsSystemDefault,
+			Options:         options,
+		})
+	}
+	for _, view := range content.Views {
+		response.Views = append(response.Views, view.Name)
+	}
+	for _, board := range content.Boards {
+		response.Boards = append(response.Boards, board.Title)
+	}
+	return response, nil
+}
+
+// DeleteTemplate deletes a template; only the user who created it can delete it
+func (s *projectService) DeleteTemplate(templateID, userID, token string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
+	}
+
+	template, err := s.findTemplate(templateID)
+	if err != nil {
+		return err
+	}
+	if err := s.validateWorkspaceMembership(context.Background(), template.WorkspaceID.String(), userID, token); err != nil {
+		return err
+	}
+	if template.CreatedBy != userUUID {
+		return apperrors.New(apperrors.ErrCodeForbidden, "템플릿을 만든 사용자만 삭제할 수 있습니다", 403)
+	}
+
+	if err := s.templateRepo.Delete(template.ID); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "템플릿 삭제 실패", 500)
+	}
+	return nil
+}
+
+// CloneProject creates a project with the fields, options and shared views of another project
+// and, if requested, copies of its boards and their field values
+func (s *projectService) CloneProject(projectID, userID, token string, req *dto.CloneProjectRequest) (*dto.ProjectResponse, error) {
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 프로젝트 ID", 400)
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
+	}
+
+	source, err := s.findProject(projectUUID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkProjectAdminPermission(userUUID, projectUUID); err != nil {
+		return nil, err
+	}
+	if err := s.validateWorkspaceMembership(context.Background(), source.WorkspaceID.String(), userID, token); err != nil {
+		return nil, err
+	}
+
+	// A clone copies every board, so there is no board limit
+	boardLimit := -1
+	if req.IncludeBoards {
+		boardLimit = 0
+	}
+	content, err := s.snapshotProject(projectUUID, boardLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	project := &domain.Project{
+		WorkspaceID: source.WorkspaceID,
+		Name:        req.Name,
+		Description: req.Description,
+		OwnerID:     userUUID,
+		TimeZone:    source.TimeZone,
+	}
+	if err := s.createProjectWithKey(project, req.Key, content); err != nil {
+		return nil, err
+	}
+
+	return s.toProjectResponse(project)
+}
+
+// findTemplateContent returns the structure of the requested template, or the default fields without one
+func (s *projectService) findTemplateContent(templateID *string, workspaceID uuid.UUID) (*domain.ProjectTemplateContent, error) {
+	if templateID == nil || *templateID == "" {
+		return domain.DefaultProjectTemplateContent(), nil
+	}
+
+	template, err := s.findTemplate(*templateID)
+	if err != nil {
+		return nil, err
+	}
+	// Templates are shared within their workspace only
+	if template.WorkspaceID != workspaceID {
+		return nil, apperrors.New(apperrors.ErrCodeNotFound, "템플릿을 찾을 수 없습니다", 404)
+	}
+	return decodeTemplateContent(template)
+}
+
+// createProjectWithContent creates the project, its owner member and the structure of the content in one transaction
+func (s *projectService) createProjectWithContent(project *domain.Project, content *domain.ProjectTemplateContent) error {
+	ownerRole, err := s.roleRepo.FindByName("OWNER")
+	if err != nil {
+		s.logger.Error("Failed to find OWNER role", zap.Error(err))
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "권한 조회 실패", 500)
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Project.Create(project); err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				// Another project took the key after it was checked
+				return apperrors.Wrap(err, apperrors.ErrCodeConflict, "이미 사용 중인 프로젝트 키입니다", 409)
+			}
+			return err
+		}
+
+		member := &domain.ProjectMember{
+			ProjectID: project.ID,
+			UserID:    project.OwnerID,
+			RoleID:    ownerRole.ID,
+			JoinedAt:  time.Now(),
+		}
+		if err := repos.Project.CreateMember(member); err != nil {
+			return err
+		}
+
+		return applyProjectTemplate(repos, project, content, project.OwnerID)
+	})
+	if err != nil {
+		if appErr, ok := err.(*apperrors.AppError); ok {
+			return appErr
+		}
+		s.logger.Error("Failed to create project", zap.Error(err))
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 생성 실패", 500)
+	}
+	return nil
+}
+
+func (s *projectService) findProject(projectID uuid.UUID) (*domain.Project, error) {
+	project, err := s.repo.FindByID(projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 조회 실패", 500)
+	}
+	return project, nil
+}
+
+func (s *projectService) findTemplate(templateID string) (*domain.ProjectTemplate, error) {
+	templateUUID, err := uuid.Parse(templateID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 템플릿 ID", 400)
+	}
+
+	template, err := s.templateRepo.FindByID(templateUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "템플릿을 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "템플릿 조회 실패", 500)
+	}
+	return template, nil
+}
+
+func decodeTemplateContent(template *domain.ProjectTemplate) (*domain.ProjectTemplateContent, error) {
+	content, err := template.DecodeContent()
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "템플릿 내용을 읽을 수 없습니다", 500)
+	}
+	return content, nil
+}
+
+// ==================== Snapshot ====================
+
+// snapshotProject captures the fields, options and shared views of a project
+// A negative boardLimit leaves the boards out, 0 takes every board, and a positive limit rejects projects with more boards
+func (s *projectService) snapshotProject(projectID uuid.UUID, boardLimit int) (*domain.ProjectTemplateContent, error) {
+	fields, err := s.fieldRepo.FindFieldsByProject(projectID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+
+	content := &domain.ProjectTemplateContent{
+		Fields: make([]domain.TemplateField, 0, len(fields)),
+		Views:  []domain.TemplateView{},
+	}
+	fieldTypes := make(map[uuid.UUID]domain.FieldType, len(fields))
+	for _, field := range fields {
+		options, err := s.fieldRepo.FindOptionsByField(field.ID)
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 옵션 조회 실패", 500)
+		}
+
+		templateField := domain.TemplateField{
+			Ref:             field.ID.String(),
+			Name:            field.Name,
+			FieldType:       field.FieldType,
+			Description:     field.Description,
+			DisplayOrder:    field.DisplayOrder,
+			IsRequired:      field.IsRequired,
+			IsSystemDefault: field.IsSystemDefault,
+			Config:          field.Config,
+			CanEditRoles:    field.CanEditRoles,
+		}
+		for _, option := range options {
+			templateField.Options = append(templateField.Options, domain.TemplateOption{
+				Ref:          option.ID.String(),
+				Label:        option.Label,
+				Color:        option.Color,
+				Description:  option.Description,
+				DisplayOrder: option.DisplayOrder,
+			})
+		}
+		content.Fields = append(content.Fields, templateField)
+		fieldTypes[field.ID] = field.FieldType
+	}
+
+	views, err := s.fieldRepo.FindViewsByProject(projectID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "뷰 조회 실패", 500)
+	}
+	for _, view := range views {
+		// Personal views belong to their owners and are not part of the project structure
+		if !view.IsShared {
+			continue
+		}
+		templateView := domain.TemplateView{
+			Name:          view.Name,
+			Description:   view.Description,
+			IsDefault:     view.IsDefault,
+			Filters:       view.Filters,
+			SortBy:        view.SortBy,
+			SortDirection: view.SortDirection,
+		}
+		if view.GroupByFieldID != nil {
+			ref := view.GroupByFieldID.String()
+			templateView.GroupByFieldRef = &ref
+		}
+		content.Views = append(content.Views, templateView)
+	}
+
+	if boardLimit < 0 {
+		return content, nil
+	}
+	boards, err := s.snapshotBoards(projectID, boardLimit, fieldTypes)
+	if err != nil {
+		return nil, err
+	}
+	content.Boards = boards
+	return content, nil
+}
+
+// snapshotBoards captures the boards of a project with their field values
+// User values are left out: the members of the new project are not known
+func (s *projectService) snapshotBoards(projectID uuid.UUID, limit int, fieldTypes map[uuid.UUID]domain.FieldType) ([]domain.TemplateBoard, error) {
+	boards, err := s.templateRepo.FindProjectBoards(projectID, limit)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	if limit > 0 && len(boards) > limit {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "템플릿에는 보드를 200개까지 포함할 수 있습니다", 400)
+	}
+	if len(boards) == 0 {
+		return nil, nil
+	}
+
+	boardIDs := make([]uuid.UUID, 0, len(boards))
+	included := make(map[uuid.UUID]bool, len(boards))
+	for _, board := range boards {
+		boardIDs = append(boardIDs, board.ID)
+		included[board.ID] = true
+	}
+	valuesByBoard, err := s.fieldRepo.FindFieldValuesByBoards(boardIDs)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 값 조회 실패", 500)
+	}
+
+	templateBoards := make([]domain.TemplateBoard, 0, len(boards))
+	for _, board := range boards {
+		templateBoard := domain.TemplateBoard{
+			Ref:         board.ID.String(),
+			Title:       board.Title,
+			Description: board.Description,
+		}
+		if board.ParentBoardID != nil && included[*board.ParentBoardID] {
+			parentRef := board.ParentBoardID.String()
+			templateBoard.ParentRef = &parentRef
+		}
+
+		for _, value := range valuesByBoard[board.ID] {
+			fieldType, ok := fieldTypes[value.FieldID]
+			if !ok || value.ValueUserID != nil || fieldType == domain.FieldTypeSingleUser || fieldType == domain.FieldTypeMultiUser {
+				continue
+			}
+			templateValue := domain.TemplateFieldValue{
+				FieldRef:     value.FieldID.String(),
+				ValueText:    value.ValueText,
+				ValueNumber:  value.ValueNumber,
+				ValueDate:    value.ValueDate,
+				ValueBoolean: value.ValueBoolean,
+				DisplayOrder: value.DisplayOrder,
+			}
+			if value.ValueOptionID != nil {
+				optionRef := value.ValueOptionID.String()
+				templateValue.OptionRef = &optionRef
+			}
+			templateBoard.Values = append(templateBoard.Values, templateValue)
+		}
+		templateBoards = append(templateBoards, templateBoard)
+	}
+	return templateBoards, nil
+}
+
+// ==================== Apply ====================
+
+// applyProjectTemplate creates the fields, options, views and boards of the content in a new project
+// Refs are replaced by the IDs of the new rows; views and values that point at unknown refs lose those parts
+func applyProjectTemplate(repos *uow.Repositories, project *domain.Project, content *domain.ProjectTemplateContent, actorID uuid.UUID) error {
+	fieldIDs := make(map[string]uuid.UUID, len(content.Fields))
+	optionIDs := make(map[string]uuid.UUID)
+	fieldTypes := make(map[uuid.UUID]domain.FieldType, len(content.Fields))
+	var stageField *domain.ProjectField
+
+	for _, templateField := range content.Fields {
+		field := &domain.ProjectField{
+			ProjectID:       project.ID,
+			Name:            templateField.Name,
+			FieldType:       templateField.FieldType,
+			Description:     templateField.Description,
+			DisplayOrder:    templateField.DisplayOrder,
+			IsRequired:      templateField.IsRequired,
+			IsSystemDefault: templateField.IsSystemDefault,
+			Config:          templateField.Config,
+			CanEditRoles:    templateField.CanEditRoles,
+		}
+		if field.Config == "" {
+			field.Config = "{}"
+		}
+		if err := repos.Field.CreateField(field); err != nil {
+			return err
+		}
+		fieldIDs[templateField.Ref] = field.ID
+		fieldTypes[field.ID] = field.FieldType
+		if isStageField(field) {
+			stageField = field
+		}
+
+		for _, templateOption := range templateField.Options {
+			option := &domain.FieldOption{
+				FieldID:      field.ID,
+				Label:        templateOption.Label,
+				Color:        templateOption.Color,
+				Description:  templateOption.Description,
+				DisplayOrder: templateOption.DisplayOrder,
+			}
+			if err := repos.Field.CreateOption(option); err != nil {
+				return err
+			}
+			optionIDs[templateOption.Ref] = option.ID
+		}
+	}
+
+	for _, templateView := range content.Views {
+		view := &domain.SavedView{
+			ProjectID:     project.ID,
+			CreatedBy:     actorID,
+			Name:          templateView.Name,
+			Description:   templateView.Description,
+			IsDefault:     templateView.IsDefault,
+			IsShared:      true,
+			Filters:       remapViewFilters(templateView.Filters, fieldIDs, optionIDs),
+			SortDirection: templateView.SortDirection,
+		}
+		view.SortBy, view.SortDirection = remapViewSort(templateView.SortBy, templateView.SortDirection, fieldIDs)
+		if templateView.GroupByFieldRef != nil {
+			if fieldID, ok := fieldIDs[*templateView.GroupByFieldRef]; ok {
+				view.GroupByFieldID = &fieldID
+			}
+		}
+		if err := repos.Field.CreateView(view); err != nil {
+			return err
+		}
+	}
+
+	if len(content.Boards) == 0 {
+		return nil
+	}
+
+	// IDs are assigned up front so that a sub-task can point at a parent that comes later
+	boardIDs := make(map[string]uuid.UUID, len(content.Boards))
+	for _, templateBoard := range content.Boards {
+		boardIDs[templateBoard.Ref] = uuid.New()
+	}
+
+	for _, templateBoard := range content.Boards {
+		values := make([]domain.BoardFieldValue, 0, len(templateBoard.Values))
+		var stageOption *uuid.UUID
+		for _, templateValue := range templateBoard.Values {
+			fieldID, ok := fieldIDs[templateValue.FieldRef]
+			if !ok {
+				continue
+			}
+			value := domain.BoardFieldValue{
+				BoardID:      boardIDs[templateBoard.Ref],
+				FieldID:      fieldID,
+				ValueText:    templateValue.ValueText,
+				ValueNumber:  templateValue.ValueNumber,
+				ValueDate:    templateValue.ValueDate,
+				ValueBoolean: templateValue.ValueBoolean,
+				DisplayOrder: templateValue.DisplayOrder,
+			}
+			if templateValue.OptionRef != nil {
+				optionID, ok := optionIDs[*templateValue.OptionRef]
+				if !ok {
+					continue
+				}
+				value.ValueOptionID = &optionID
+				if stageField != nil && fieldID == stageField.ID {
+					stageOption = &optionID
+				}
+			}
+			values = append(values, value)
+		}
+
+		cache, err := buildFieldValuesCache(values, fieldTypes)
+		if err != nil {
+			return err
+		}
+
+		sequenced, err := repos.Project.IncrementBoardSequence(project.ID)
+		if err != nil {
+			return err
+		}
+		board := &domain.Board{
+			ProjectID:         project.ID,
+			Title:             templateBoard.Title,
+			Description:       templateBoard.Description,
+			CreatedBy:         actorID,
+			CustomFieldsCache: cache,
+		}
+		board.ID = boardIDs[templateBoard.Ref]
+		board.AssignNumber(sequenced, sequenced.BoardSequence)
+		if templateBoard.ParentRef != nil {
+			if parentID, ok := boardIDs[*templateBoard.ParentRef]; ok {
+				board.ParentBoardID = &parentID
+			}
+		}
+		if err := repos.Board.Create(board); err != nil {
+			return err
+		}
+
+		if len(values) > 0 {
+			if err := repos.Field.BatchSetFieldValues(values); err != nil {
+				return err
+			}
+		}
+		if stageOption != nil {
+			if _, err := logStageChange(repos.StageChange, board, stageField.ID, nil, stageOption, actorID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// remapViewSort replaces field refs in the sort keys and returns the sort and the direction of its first key
+// A sort that cannot be parsed is dropped
+func remapViewSort(sortBy *string, direction string, fieldIDs map[string]uuid.UUID) (*string, string) {
+	keys, err := domain.ParseViewSort(sortBy, direction)
+	if err != nil || len(keys) == 0 {
+		return nil, domain.SortAsc
+	}
+	for i := range keys {
+		if fieldID, ok := fieldIDs[keys[i].Field]; ok {
+			keys[i].Field = fieldID.String()
+		}
+	}
+	return domain.EncodeViewSort(keys), keys[0].Direction
+}
+
+// remapViewFilters replaces field refs in the filter fields (keys of flat filters) and field or option refs in the filter values
+// Filters that cannot be parsed are dropped
+func remapViewFilters(filters string, fieldIDs, optionIDs map[string]uuid.UUID) string {
+	if filters == "" {
+		return "{}"
+	}
+
+	remapValue := func(value interface{}) interface{} {
+		ref, ok := value.(string)
+		if !ok {
+			return value
+		}
+		if optionID, ok := optionIDs[ref]; ok {
+			return optionID.String()
+		}
+		if fieldID, ok := fieldIDs[ref]; ok {
+			return fieldID.String()
+		}
+		return value
+	}
+	remapValues := func(value interface{}) interface{} {
+		if values, ok := value.([]interface{}); ok {
+			for i := range values {
+				values[i] = remapValue(values[i])
+			}
+			return values
+		}
+		return remapValue(value)
+	}
+
+	if domain.IsFilterTree([]byte(filters)) {
+		root, err := domain.ParseViewFilters([]byte(filters))
+		if err != nil || root == nil {
+			return "{}"
+		}
+		root.Walk(func(node *domain.FilterNode) {
+			if fieldID, ok := fieldIDs[node.Field]; ok {
+				node.Field = fieldID.String()
+			}
+			node.Value = remapValues(node.Value)
+		})
+		encoded, err := json.Marshal(root)
+		if err != nil {
+			return "{}"
+		}
+		return string(encoded)
+	}
+
+	var parsed domain.ViewFilters
+	if err := json.Unmarshal([]byte(filters), &parsed); err != nil {
+		return "{}"
+	}
+
+	remapped := make(domain.ViewFilters, len(parsed))
+	for key, condition := range parsed {
+		if fieldID, ok := fieldIDs[key]; ok {
+			key = fieldID.String()
+		}
+		condition.Value = remapValues(condition.Value)
+		remapped[key] = condition
+	}
+
+	encoded, err := json.Marshal(remapped)
+	if err != nil {
+		return "{}"
+	}
+	return string(encoded)
+}
+
+// buildFieldValuesCache builds the custom_fields_cache of a board from its field values
+// Multi-select and multi-user fields hold arrays, the other fields a single value
+func buildFieldValuesCache(values []domain.BoardFieldValue, fieldTypes map[uuid.UUID]domain.FieldType) (string, error) {
+	cache := make(map[string]interface{}, len(values))
+	for _, value := range values {
+		var actual interface{}
+		switch {
+		case value.ValueText != nil:
+			actual = *value.ValueText
+		case value.ValueNumber != nil:
+			actual = *value.ValueNumber
+		case value.ValueDate != nil:
+			actual = value.ValueDate.Format(time.RFC3339)
+		case value.ValueBoolean != nil:
+			actual = *value.ValueBoolean
+		case value.ValueOptionID != nil:
+			actual = value.ValueOptionID.String()
+		case value.ValueUserID != nil:
+			actual = value.ValueUserID.String()
+		default:
+			continue
+		}
+
+		fieldID := value.FieldID.String()
+		switch fieldTypes[value.FieldID] {
+		case domain.FieldTypeMultiSelect, domain.FieldTypeMultiUser:
+			existing, _ := cache[fieldID].([]interface{})
+			cache[fieldID] = append(existing, actual)
+		default:
+			cache[fieldID] = actual
+		}
+	}
+
+	encoded, err := json.Marshal(cache)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+func toProjectTemplateResponse(template *domain.ProjectTemplate, content *domain.ProjectTemplateContent) *dto.ProjectTemplateResponse {
+	response := &dto.ProjectTemplateResponse{
+		TemplateID:  template.ID.String(),
+		WorkspaceID: template.WorkspaceID.String(),
+		Name:        template.Name,
+		Description: template.Description,
+		FieldCount:  len(content.Fields),
+		ViewCount:   len(content.Views),
+		BoardCount:  len(content.Boards),
+		CreatedBy:   template.CreatedBy.String(),
+		CreatedAt:   template.CreatedAt,
+		UpdatedAt:   template.UpdatedAt,
+	}
+	if template.SourceProjectID != nil {
+		sourceProjectID := template.SourceProjectID.String()
+		response.SourceProjectID = &sourceProjectID
+	}
+	return response
+}
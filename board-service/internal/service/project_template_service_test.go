@@ -0,0 +1,257 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/repository"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ==================== Test Suite Setup ====================
+
+type ProjectTemplateTestSuite struct {
+	db             *gorm.DB
+	service        *projectService
+	workspaceID    uuid.UUID
+	otherWorkspace uuid.UUID // Another workspace the users are members of
+	ownerID        uuid.UUID
+	memberID       uuid.UUID
+	memberRole     uuid.UUID
+}
+
+const templateTestToken = "valid-token"
+
+func setupProjectTemplateTest(t *testing.T) *ProjectTemplateTestSuite {
+	db := NewMockDB()
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	require.NoError(t, db.Exec(`CREATE TABLE roles (id TEXT PRIMARY KEY, name TEXT, level INTEGER, description TEXT,
+		created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`).Error)
+
+	suite := &ProjectTemplateTestSuite{
+		db:             db,
+		workspaceID:    uuid.New(),
+		otherWorkspace: uuid.New(),
+		ownerID:        uuid.New(),
+		memberID:       uuid.New(),
+		memberRole:     uuid.New(),
+	}
+	require.NoError(t, db.Exec("INSERT INTO roles (id, name, level) VALUES (?, 'OWNER', 100), (?, 'MEMBER', 10)", uuid.New(), suite.memberRole).Error)
+
+	userClient := new(MockUserClient)
+	workspaceCache := new(MockWorkspaceCache)
+	userInfoCache := new(MockUserInfoCache)
+	userClient.On("CheckWorkspaceExists", mock.Anything, mock.Anything, templateTestToken).Return(true, nil).Maybe()
+	for _, workspaceID := range []uuid.UUID{suite.workspaceID, suite.otherWorkspace} {
+		userClient.On("ValidateWorkspaceMembership", mock.Anything, workspaceID.String(), mock.Anything, templateTestToken).Return(true, nil).Maybe()
+	}
+	userClient.On("GetUser", mock.Anything, mock.Anything).Return(nil, errors.New("user service unavailable")).Maybe()
+	workspaceCache.On("SetMembership", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	userInfoCache.On("GetUserInfo", mock.Anything, mock.Anything).Return(false, nil, nil).Maybe()
+
+	service := NewProjectService(
+		repository.NewProjectRepository(db),
+		repository.NewRoleRepository(db),
+		repository.NewFieldRepository(db),
+		nil, // boardRepo
+		nil, // projectFieldRepo
+		nil, // fieldOptionRepo
+		nil, // boardOrderRepo
+		nil, // viewRepo
+		repository.NewProjectTemplateRepository(db),
+		repository.NewAttachmentRepository(db),
+		nil, // attachment storage
+		userClient,
+		workspaceCache,
+		userInfoCache,
+		nil,
+		ProjectDeletionSoft,
+		zap.NewNop(),
+		db,
+	)
+	suite.service = service.(*projectService)
+	return suite
+}
+
+func (s *ProjectTemplateTestSuite) createProject(t *testing.T, name, key string, templateID *string) uuid.UUID {
+	project, err := s.service.CreateProject(s.ownerID.String(), templateTestToken, &dto.CreateProjectRequest{
+		WorkspaceID: s.workspaceID.String(),
+		Name:        name,
+		Key:         key,
+		TemplateID:  templateID,
+	})
+	require.NoError(t, err)
+	return uuid.MustParse(project.ID)
+}
+
+func (s *ProjectTemplateTestSuite) fields(t *testing.T, projectID uuid.UUID) map[string]domain.ProjectField {
+	fields, err := s.service.fieldRepo.FindFieldsByProject(projectID)
+	require.NoError(t, err)
+	byName := make(map[string]domain.ProjectField, len(fields))
+	for _, field := range fields {
+		byName[field.Name] = field
+	}
+	return byName
+}
+
+func (s *ProjectTemplateTestSuite) option(t *testing.T, fieldID uuid.UUID, label string) uuid.UUID {
+	options, err := s.service.fieldRepo.FindOptionsByField(fieldID)
+	require.NoError(t, err)
+	for _, option := range options {
+		if option.Label == label {
+			return option.ID
+		}
+	}
+	t.Fatalf("option %q not found", label)
+	return uuid.Nil
+}
+
+// seedSourceProject adds a shared view grouped by Stage, a personal view and two boards (a parent and its sub-task)
+func (s *ProjectTemplateTestSuite) seedSourceProject(t *testing.T, projectID uuid.UUID) {
+	stage := s.fields(t, projectID)["Stage"]
+	inProgress := s.option(t, stage.ID, "진행중")
+
+	filters := `{"` + stage.ID.String() + `":{"operator":"in","value":["` + inProgress.String() + `"]}}`
+	require.NoError(t, s.db.Exec("INSERT INTO saved_views (id, project_id, created_by, name, is_default, is_shared, filters, group_by_field_id) VALUES (?, ?, ?, 'Board', true, true, ?, ?)",
+		uuid.New(), projectID, s.ownerID, filters, stage.ID).Error)
+	require.NoError(t, s.db.Exec("INSERT INTO saved_views (id, project_id, created_by, name, is_shared) VALUES (?, ?, ?, 'Mine', false)",
+		uuid.New(), projectID, s.memberID).Error)
+
+	parentID, childID := uuid.New(), uuid.New()
+	require.NoError(t, s.db.Exec("INSERT INTO boards (id, project_id, number, key, title, created_by, assignee_id) VALUES (?, ?, 1, 'SRC-1', 'Login', ?, ?)",
+		parentID, projectID, s.ownerID, s.memberID).Error)
+	require.NoError(t, s.db.Exec("INSERT INTO boards (id, project_id, number, key, title, created_by, parent_board_id) VALUES (?, ?, 2, 'SRC-2', 'Login form', ?, ?)",
+		childID, projectID, s.ownerID, parentID).Error)
+	require.NoError(t, s.db.Exec("INSERT INTO board_field_values (id, board_id, field_id, value_option_id) VALUES (?, ?, ?, ?)",
+		uuid.New(), parentID, stage.ID, inProgress).Error)
+}
+
+// ==================== Create Project Tests ====================
+
+func TestProjectService_CreateProject_DefaultFieldsWithoutTemplate(t *testing.T) {
+	suite := setupProjectTemplateTest(t)
+
+	projectID := suite.createProject(t, "Web", "WEB", nil)
+
+	fields := suite.fields(t, projectID)
+	require.Len(t, fields, 3)
+	assert.True(t, fields["Stage"].IsRequired)
+	assert.True(t, fields["Importance"].IsSystemDefault)
+	assert.Equal(t, int64(9), countRows(t, suite.db, "field_options", "1 = 1"))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "project_members", "project_id = ? AND user_id = ?", projectID, suite.ownerID))
+}
+
+func TestProjectService_CreateProject_FromTemplate(t *testing.T) {
+	suite := setupProjectTemplateTest(t)
+	sourceID := suite.createProject(t, "Source", "SRC", nil)
+	suite.seedSourceProject(t, sourceID)
+
+	template, err := suite.service.CreateTemplate(sourceID.String(), suite.ownerID.String(), &dto.CreateProjectTemplateRequest{Name: "Scrum", IncludeBoards: true})
+	require.NoError(t, err)
+	assert.Equal(t, 3, template.FieldCount)
+	assert.Equal(t, 1, template.ViewCount, "personal views are not part of a template")
+	assert.Equal(t, 2, template.BoardCount)
+
+	// When
+	projectID := suite.createProject(t, "Target", "TGT", &template.TemplateID)
+
+	// Then: the view points at the new Stage field and option
+	stage := suite.fields(t, projectID)["Stage"]
+	var view domain.SavedView
+	require.NoError(t, suite.db.Where("project_id = ?", projectID).First(&view).Error)
+	require.NotNil(t, view.GroupByFieldID)
+	assert.Equal(t, stage.ID, *view.GroupByFieldID)
+	var filters domain.ViewFilters
+	require.NoError(t, json.Unmarshal([]byte(view.Filters), &filters))
+	assert.Equal(t, []interface{}{suite.option(t, stage.ID, "진행중").String()}, filters[stage.ID.String()].Value)
+
+	// Sample boards get new numbers, keep their Stage and lose their assignee
+	var boards []domain.Board
+	require.NoError(t, suite.db.Where("project_id = ?", projectID).Order("number").Find(&boards).Error)
+	require.Len(t, boards, 2)
+	assert.Equal(t, []string{"TGT-1", "TGT-2"}, []string{boards[0].Key, boards[1].Key})
+	assert.Nil(t, boards[0].AssigneeID)
+	require.NotNil(t, boards[1].ParentBoardID)
+	assert.Equal(t, boards[0].ID, *boards[1].ParentBoardID)
+	assert.JSONEq(t, `{"`+stage.ID.String()+`":"`+suite.option(t, stage.ID, "진행중").String()+`"}`, boards[0].CustomFieldsCache)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "board_stage_changes", "board_id = ? AND from_option_id IS NULL", boards[0].ID))
+
+	// Templates are shared within their workspace only
+	_, err = suite.service.CreateProject(suite.ownerID.String(), templateTestToken, &dto.CreateProjectRequest{
+		WorkspaceID: suite.otherWorkspace.String(), Name: "Elsewhere", TemplateID: &template.TemplateID,
+	})
+	assert.Equal(t, 404, appErrorStatus(t, err))
+}
+
+// ==================== Template Tests ====================
+
+func TestProjectService_CreateTemplate_RequiresAdmin(t *testing.T) {
+	suite := setupProjectTemplateTest(t)
+	projectID := suite.createProject(t, "Source", "SRC", nil)
+	require.NoError(t, suite.db.Exec("INSERT INTO project_members (id, project_id, user_id, role_id) VALUES (?, ?, ?, ?)",
+		uuid.New(), projectID, suite.memberID, suite.memberRole).Error)
+
+	_, err := suite.service.CreateTemplate(projectID.String(), suite.memberID.String(), &dto.CreateProjectTemplateRequest{Name: "Scrum"})
+	assert.Equal(t, 403, appErrorStatus(t, err))
+
+	template, err := suite.service.CreateTemplate(projectID.String(), suite.ownerID.String(), &dto.CreateProjectTemplateRequest{Name: "Scrum"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, template.BoardCount)
+
+	templates, err := suite.service.GetTemplates(suite.workspaceID.String(), suite.memberID.String(), templateTestToken)
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+
+	detail, err := suite.service.GetTemplate(template.TemplateID, suite.memberID.String(), templateTestToken)
+	require.NoError(t, err)
+	require.Len(t, detail.Fields, 3)
+	assert.Equal(t, []string{"대기", "진행중", "완료"}, detail.Fields[0].Options)
+
+	// Only the creator deletes a template
+	err = suite.service.DeleteTemplate(template.TemplateID, suite.memberID.String(), templateTestToken)
+	assert.Equal(t, 403, appErrorStatus(t, err))
+	require.NoError(t, suite.service.DeleteTemplate(template.TemplateID, suite.ownerID.String(), templateTestToken))
+	_, err = suite.service.GetTemplate(template.TemplateID, suite.ownerID.String(), templateTestToken)
+	assert.Equal(t, 404, appErrorStatus(t, err))
+}
+
+// ==================== Clone Tests ====================
+
+func TestProjectService_CloneProject_CopiesStructureAndBoards(t *testing.T) {
+	suite := setupProjectTemplateTest(t)
+	sourceID := suite.createProject(t, "Source", "SRC", nil)
+	suite.seedSourceProject(t, sourceID)
+
+	// Structure only
+	clone, err := suite.service.CloneProject(sourceID.String(), suite.ownerID.String(), templateTestToken, &dto.CloneProjectRequest{Name: "Copy"})
+	require.NoError(t, err)
+	cloneID := uuid.MustParse(clone.ID)
+	assert.Equal(t, suite.workspaceID.String(), clone.WorkspaceID)
+	assert.Len(t, suite.fields(t, cloneID), 3)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "saved_views", "project_id = ?", cloneID))
+	assert.Equal(t, int64(0), countRows(t, suite.db, "boards", "project_id = ?", cloneID))
+
+	// With boards
+	withBoards, err := suite.service.CloneProject(sourceID.String(), suite.ownerID.String(), templateTestToken,
+		&dto.CloneProjectRequest{Name: "Copy with boards", Key: "CPY", IncludeBoards: true})
+	require.NoError(t, err)
+	assert.Equal(t, "CPY", withBoards.Key)
+	assert.Equal(t, int64(2), countRows(t, suite.db, "boards", "project_id = ?", withBoards.ID))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "board_field_values v JOIN boards b ON b.id = v.board_id", "b.project_id = ?", withBoards.ID))
+
+	// The source project is unchanged
+	assert.Equal(t, int64(2), countRows(t, suite.db, "boards", "project_id = ?", sourceID))
+
+	_, err = suite.service.CloneProject(sourceID.String(), suite.memberID.String(), templateTestToken, &dto.CloneProjectRequest{Name: "Copy"})
+	assert.Equal(t, 403, appErrorStatus(t, err))
+}
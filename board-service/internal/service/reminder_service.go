@@ -0,0 +1,346 @@
+package service
+
+import (
+	"board-service/internal/client"
+	"board-service/internal/domain"
+	"board-service/internal/reminder"
+	"board-service/internal/repository"
+	"board-service/internal/uow"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ReminderService는 마감일이 다가오거나 지난 보드의 담당자, 참여자, 구독자에게 알림을 보내고
+// 사용자별로 하루 한 번 마감일 알림 요약을 Notifier(SMTP)로 발송합니다
+// 백그라운드 reminder.Scheduler가 호출하며, 발송 표식(marker)으로 재시작이나 여러 레플리카에서도 한 번만 보냅니다
+type ReminderService interface {
+	SendDueReminders(now time.Time) (int, error)
+	SendDigests(now time.Time) (int, error)
+}
+
+type reminderService struct {
+	repo             repository.ReminderRepository
+	notificationRepo repository.NotificationRepository
+	projectRepo      repository.ProjectRepository
+	fieldRepo        repository.FieldRepository
+	userClient       client.UserClient
+	notifier         reminder.Notifier
+	config           reminder.Config
+	logger           *zap.Logger
+	uow              uow.UnitOfWork
+}
+
+func NewReminderService(
+	repo repository.ReminderRepository,
+	notificationRepo repository.NotificationRepository,
+	projectRepo repository.ProjectRepository,
+	fieldRepo repository.FieldRepository,
+	userClient client.UserClient,
+	notifier reminder.Notifier,
+	config reminder.Config,
+	logger *zap.Logger,
+	db *gorm.DB,
+) ReminderService {
+	return &reminderService{
+		repo:             repo,
+		notificationRepo: notificationRepo,
+		projectRepo:      projectRepo,
+		fieldRepo:        fieldRepo,
+		userClient:       userClient,
+		notifier:         notifier,
+		config:           config,
+		logger:           logger,
+		uow:              uow.NewUnitOfWork(db),
+	}
+}
+
+// reminderProject caches what reminders need to know about a project during one run
+type reminderProject struct {
+	project           *domain.Project
+	members           map[uuid.UUID]bool
+	stageFieldID      uuid.UUID // uuid.Nil if the project has no Stage field
+	completedOptionID uuid.UUID // uuid.Nil if the Stage field has no options
+}
+
+// ==================== Due Date Reminders ====================
+
+// SendDueReminders reminds about boards that entered a lead time before their due date
+// and boards that became overdue, and returns how many reminders were sent
+// A board entering several lead times at once (e.g. created an hour before its due date)
+// is only reminded for the shortest one
+func (s *reminderService) SendDueReminders(now time.Time) (int, error) {
+	projects := make(map[uuid.UUID]*reminderProject)
+	sent := 0
+
+	for i, lead := range s.config.LeadTimes {
+		var shorter time.Duration
+		if i+1 < len(s.config.LeadTimes) {
+			shorter = s.config.LeadTimes[i+1]
+		}
+		boards, err := s.repo.FindBoardsToRemind(domain.BoardReminderDueSoon, int(lead/time.Minute), now.Add(shorter), now.Add(lead), s.config.BatchSize)
+		if err != nil {
+			return sent, err
+		}
+		sent += s.remindBoards(boards, domain.BoardReminderDueSoon, lead, projects)
+	}
+
+	boards, err := s.repo.FindBoardsToRemind(domain.BoardReminderOverdue, 0, now.Add(-reminder.OverdueLookback), now, s.config.BatchSize)
+	if err != nil {
+		return sent, err
+	}
+	sent += s.remindBoards(boards, domain.BoardReminderOverdue, 0, projects)
+	return sent, nil
+}
+
+// remindBoards sends one reminder per board and returns how many were sent
+// One broken board must not stop the others; it is retried on the next run
+func (s *reminderService) remindBoards(boards []domain.Board, kind domain.BoardReminderKind, lead time.Duration, projects map[uuid.UUID]*reminderProject) int {
+	sent := 0
+	for i := range boards {
+		ok, err := s.remindBoard(&boards[i], kind, lead, projects)
+		if err != nil {
+			s.logger.Error("Failed to send due date reminder", zap.Error(err),
+				zap.String("board_id", boards[i].ID.String()), zap.String("kind", string(kind)))
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent
+}
+
+// remindBoard notifies the assignee, participants and watchers of the board
+// It returns false if another scheduler already sent the reminder
+// A board that is already done is marked as reminded without notifying anyone
+func (s *reminderService) remindBoard(board *domain.Board, kind domain.BoardReminderKind, lead time.Duration, projects map[uuid.UUID]*reminderProject) (bool, error) {
+	project, err := s.loadReminderProject(board.ProjectID, projects)
+	if err != nil {
+		return false, err
+	}
+
+	marker := domain.NewBoardReminder(board, kind, lead)
+	var notifications []domain.Notification
+	done, err := s.isCompleted(board, project)
+	if err != nil {
+		return false, err
+	}
+	if !done {
+		recipients, err := s.recipients(board, project)
+		if err != nil {
+			return false, err
+		}
+		preview := board.DueDate.In(project.project.Location()).Format("2006-01-02 15:04")
+		for _, userID := range recipients {
+			// Reminders come from the system, not from a user
+			notification := domain.NewNotification(userID, board, uuid.Nil, marker.NotificationType())
+			notification.SetPreview(preview)
+			notifications = append(notifications, notification)
+		}
+		marker.Recipients = len(notifications)
+	}
+
+	claimed := false
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		var err error
+		if claimed, err = repos.Reminder.ClaimReminder(marker); err != nil || !claimed {
+			return err
+		}
+		return repos.Notification.BatchCreate(notifications)
+	})
+	if err != nil {
+		return false, err
+	}
+	return claimed && !done, nil
+}
+
+// recipients returns the assignee, participants and watchers of the board who are project members
+func (s *reminderService) recipients(board *domain.Board, project *reminderProject) ([]uuid.UUID, error) {
+	watcherIDs, err := s.notificationRepo.FindWatcherIDs(board.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients := make([]uuid.UUID, 0, len(watcherIDs)+1)
+	seen := make(map[uuid.UUID]bool)
+	for _, userID := range append(board.Assignees(), watcherIDs...) {
+		if seen[userID] || !project.members[userID] {
+			continue
+		}
+		seen[userID] = true
+		recipients = append(recipients, userID)
+	}
+	return recipients, nil
+}
+
+// isCompleted returns true if the board is in the completed Stage option of its project
+func (s *reminderService) isCompleted(board *domain.Board, project *reminderProject) (bool, error) {
+	if project.completedOptionID == uuid.Nil {
+		return false, nil
+	}
+	optionID, err := currentStageOption(s.fieldRepo, board.ID, project.stageFieldID)
+	if err != nil {
+		return false, err
+	}
+	return optionID != nil && *optionID == project.completedOptionID, nil
+}
+
+// loadReminderProject loads the project, its members and its completed Stage option once per run
+func (s *reminderService) loadReminderProject(projectID uuid.UUID, projects map[uuid.UUID]*reminderProject) (*reminderProject, error) {
+	if cached, ok := projects[projectID]; ok {
+		return cached, nil
+	}
+
+	project, err := s.projectRepo.FindByID(projectID)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.projectRepo.FindMembersByProject(projectID)
+	if err != nil {
+		return nil, err
+	}
+	loaded := &reminderProject{project: project, members: make(map[uuid.UUID]bool, len(members))}
+	for _, member := range members {
+		loaded.members[member.UserID] = true
+	}
+
+	stageField, err := findStageField(s.fieldRepo, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if stageField != nil {
+		options, err := s.fieldRepo.FindOptionsByField(stageField.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(options) > 0 {
+			_, completedOptionID, err := resolveStageOptions(options)
+			if err != nil {
+				return nil, err
+			}
+			loaded.stageFieldID, loaded.completedOptionID = stageField.ID, completedOptionID
+		}
+	}
+
+	projects[projectID] = loaded
+	return loaded, nil
+}
+
+// ==================== Daily Digest ====================
+
+// SendDigests mails every user the boards they were reminded about during the day before
+// today's digest hour, and returns how many digests were sent
+// Digests are sent from the digest hour on; a digest that fails is retried on the next run
+func (s *reminderService) SendDigests(now time.Time) (int, error) {
+	local := now.In(s.config.DigestLocation)
+	if local.Hour() < s.config.DigestHour {
+		return 0, nil
+	}
+	digestAt := time.Date(local.Year(), local.Month(), local.Day(), s.config.DigestHour, 0, 0, 0, s.config.DigestLocation)
+	digestDate := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	from := digestAt.AddDate(0, 0, -1)
+
+	userIDs, err := s.repo.FindDigestRecipients(from, digestAt, digestDate, s.config.BatchSize)
+	if err != nil || len(userIDs) == 0 {
+		return 0, err
+	}
+	emails := s.fetchEmails(userIDs)
+
+	sent := 0
+	for _, userID := range userIDs {
+		email, resolved := emails[userID]
+		if !resolved {
+			continue
+		}
+		ok, err := s.sendDigest(userID, email, from, digestAt, digestDate)
+		if err != nil {
+			s.logger.Error("Failed to send reminder digest", zap.Error(err), zap.String("user_id", userID.String()))
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// sendDigest claims the user's digest and mails it in the same transaction, so that a failed
+// delivery releases the claim and a concurrent scheduler waits for the claim instead of sending twice
+// A user without an email address (or no longer active) only gets the digest marked as sent
+func (s *reminderService) sendDigest(userID uuid.UUID, email string, from, to, digestDate time.Time) (bool, error) {
+	entries, err := s.repo.FindDigestEntries(userID, from, to)
+	if err != nil || len(entries) == 0 {
+		return false, err
+	}
+
+	digest := &domain.ReminderDigest{UserID: userID, DigestDate: digestDate, BoardCount: len(entries), Delivered: email != ""}
+	sent := false
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		claimed, err := repos.Reminder.ClaimDigest(digest)
+		if err != nil || !claimed || email == "" {
+			return err
+		}
+		if err := s.notifier.Send(context.Background(), s.digestMessage(email, digestDate, entries)); err != nil {
+			return err
+		}
+		sent = true
+		return nil
+	})
+	return sent, err
+}
+
+// fetchEmails resolves the email addresses of the users through User Service
+// Users that cannot be resolved are left out and their digests are retried on the next run
+func (s *reminderService) fetchEmails(userIDs []uuid.UUID) map[uuid.UUID]string {
+	ids := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		ids = append(ids, userID.String())
+	}
+
+	emails := make(map[uuid.UUID]string, len(userIDs))
+	users, err := s.userClient.GetUsersBatch(context.Background(), ids)
+	if err != nil {
+		s.logger.Warn("Failed to fetch digest recipients from User Service", zap.Error(err))
+		return emails
+	}
+	for _, user := range users {
+		userID, err := uuid.Parse(user.UserID)
+		if err != nil {
+			continue
+		}
+		if user.IsActive {
+			emails[userID] = user.Email
+		} else {
+			emails[userID] = ""
+		}
+	}
+	return emails
+}
+
+func (s *reminderService) digestMessage(email string, digestDate time.Time, entries []repository.ReminderDigestEntry) reminder.Message {
+	var body strings.Builder
+	fmt.Fprintf(&body, "%s 마감일 알림 요약입니다.\n\n", digestDate.Format("2006-01-02"))
+	for _, entry := range entries {
+		status := "마감 임박"
+		if entry.Type == domain.NotificationOverdue {
+			status = "마감 지남"
+		}
+		due := ""
+		if entry.DueDate != nil {
+			due = entry.DueDate.In(s.config.DigestLocation).Format("2006-01-02 15:04")
+		}
+		fmt.Fprintf(&body, "- [%s] %s %s (마감 %s)\n", status, entry.Key, entry.Title, due)
+	}
+
+	return reminder.Message{
+		To:      []string{email},
+		Subject: fmt.Sprintf("[weAlist] 마감일 알림 요약 %s (%d건)", digestDate.Format("2006-01-02"), len(entries)),
+		Body:    body.String(),
+	}
+}
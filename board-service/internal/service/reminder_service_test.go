@@ -0,0 +1,196 @@
+package service
+
+import (
+	"board-service/internal/client"
+	"board-service/internal/domain"
+	"board-service/internal/reminder"
+	"board-service/internal/repository"
+	"board-service/internal/testutil"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type ReminderTestSuite struct {
+	db          *gorm.DB
+	service     ReminderService
+	notifier    *reminder.MemoryNotifier
+	projectID   uuid.UUID
+	assigneeID  uuid.UUID
+	watcherID   uuid.UUID
+	outsiderID  uuid.UUID
+	stageField  uuid.UUID
+	doneOption  uuid.UUID
+	now         time.Time
+	boardNumber int
+}
+
+func setupReminderTest(t *testing.T, config reminder.Config) *ReminderTestSuite {
+	db := NewMockDB()
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+
+	suite := &ReminderTestSuite{
+		db:         db,
+		notifier:   reminder.NewMemoryNotifier(),
+		projectID:  uuid.New(),
+		assigneeID: uuid.New(),
+		watcherID:  uuid.New(),
+		outsiderID: uuid.New(),
+		stageField: uuid.New(),
+		doneOption: uuid.New(),
+		now:        time.Date(2030, 3, 4, 10, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, db.Exec("INSERT INTO projects (id, workspace_id, owner_id, name, key, time_zone) VALUES (?, ?, ?, 'Web', 'WEB', 'Asia/Seoul')",
+		suite.projectID, uuid.New(), suite.assigneeID).Error)
+	for _, userID := range []uuid.UUID{suite.assigneeID, suite.watcherID} {
+		require.NoError(t, db.Exec("INSERT INTO project_members (id, project_id, user_id, is_deleted) VALUES (?, ?, ?, false)", uuid.New(), suite.projectID, userID).Error)
+	}
+	require.NoError(t, db.Exec("INSERT INTO project_fields (id, project_id, name, field_type, is_system_default) VALUES (?, ?, 'Stage', 'single_select', true)",
+		suite.stageField, suite.projectID).Error)
+	require.NoError(t, db.Exec("INSERT INTO field_options (id, field_id, label, display_order) VALUES (?, ?, '대기', 0), (?, ?, '완료', 1)",
+		uuid.New(), suite.stageField, suite.doneOption, suite.stageField).Error)
+
+	projectRepo := new(testutil.MockProjectRepository)
+	projectRepo.On("FindByID", suite.projectID).
+		Return(&domain.Project{BaseModel: domain.BaseModel{ID: suite.projectID}, Name: "Web", Key: "WEB", TimeZone: "Asia/Seoul"}, nil).Maybe()
+	projectRepo.On("FindMembersByProject", suite.projectID).Return([]domain.ProjectMember{
+		{ProjectID: suite.projectID, UserID: suite.assigneeID},
+		{ProjectID: suite.projectID, UserID: suite.watcherID},
+	}, nil).Maybe()
+
+	// The watcher has no email address, so only their digest is marked
+	userClient := new(MockUserClient)
+	userClient.On("GetUsersBatch", mock.Anything, mock.Anything).Return([]client.UserInfo{
+		{UserID: suite.assigneeID.String(), Email: "assignee@example.com", IsActive: true},
+		{UserID: suite.watcherID.String(), IsActive: true},
+	}, nil).Maybe()
+
+	suite.service = NewReminderService(repository.NewReminderRepository(db), repository.NewNotificationRepository(db), projectRepo,
+		repository.NewFieldRepository(db), userClient, suite.notifier, config, zap.NewNop(), db)
+	return suite
+}
+
+func reminderTestConfig() reminder.Config {
+	config := reminder.DefaultConfig()
+	config.DigestHour = 0
+	config.DigestLocation = time.UTC
+	return config
+}
+
+// board inserts a board assigned to the assignee and watched by the watcher and a non-member
+func (s *ReminderTestSuite) board(t *testing.T, dueDate time.Time) uuid.UUID {
+	s.boardNumber++
+	boardID := uuid.New()
+	require.NoError(t, s.db.Exec("INSERT INTO boards (id, project_id, number, key, title, created_by, assignee_id, due_date, is_deleted) VALUES (?, ?, ?, ?, ?, ?, ?, ?, false)",
+		boardID, s.projectID, s.boardNumber, fmt.Sprintf("WEB-%d", s.boardNumber), "Board", s.assigneeID, s.assigneeID, dueDate).Error)
+	for _, userID := range []uuid.UUID{s.watcherID, s.outsiderID} {
+		require.NoError(t, s.db.Exec("INSERT INTO board_watchers (id, board_id, user_id) VALUES (?, ?, ?)", uuid.New(), boardID, userID).Error)
+	}
+	return boardID
+}
+
+func TestReminderService_SendDueReminders(t *testing.T) {
+	suite := setupReminderTest(t, reminderTestConfig())
+	soon := suite.board(t, suite.now.Add(30*time.Minute))
+	tomorrow := suite.board(t, suite.now.Add(5*time.Hour))
+	overdue := suite.board(t, suite.now.Add(-2*time.Hour))
+	suite.board(t, suite.now.Add(-72*time.Hour)) // overdue for too long
+	suite.board(t, suite.now.Add(10*24*time.Hour))
+	done := suite.board(t, suite.now.Add(2*time.Hour))
+	require.NoError(t, suite.db.Exec("INSERT INTO board_field_values (id, board_id, field_id, value_option_id) VALUES (?, ?, ?, ?)",
+		uuid.New(), done, suite.stageField, suite.doneOption).Error)
+
+	sent, err := suite.service.SendDueReminders(suite.now)
+	require.NoError(t, err)
+	assert.Equal(t, 3, sent)
+
+	assert.Equal(t, int64(1), countRows(t, suite.db, "board_reminders", "board_id = ? AND kind = ? AND lead_minutes = ?", soon, "DUE_SOON", 60),
+		"reminded for the shortest lead time only")
+	assert.Equal(t, int64(1), countRows(t, suite.db, "board_reminders", "board_id = ? AND kind = ? AND lead_minutes = ?", tomorrow, "DUE_SOON", 24*60))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "board_reminders", "board_id = ? AND kind = ?", overdue, "OVERDUE"))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "board_reminders", "board_id = ? AND recipients = 0", done), "completed boards are marked silently")
+	assert.Equal(t, int64(4), countRows(t, suite.db, "board_reminders", "1 = 1"))
+
+	assert.Equal(t, int64(6), countRows(t, suite.db, "notifications", "1 = 1"))
+	assert.Equal(t, int64(2), countRows(t, suite.db, "notifications", "board_id = ? AND type = ?", soon, "DUE_SOON"))
+	assert.Equal(t, int64(2), countRows(t, suite.db, "notifications", "board_id = ? AND type = ?", overdue, "OVERDUE"))
+	assert.Zero(t, countRows(t, suite.db, "notifications", "user_id = ?", suite.outsiderID), "only project members are notified")
+	assert.Equal(t, int64(1), countRows(t, suite.db, "notifications", "board_id = ? AND user_id = ? AND preview = ?", soon, suite.assigneeID, "2030-03-04 19:30"),
+		"the due date is shown in the project time zone")
+
+	// A restarted or second scheduler finds nothing left to send
+	sent, err = suite.service.SendDueReminders(suite.now)
+	require.NoError(t, err)
+	assert.Zero(t, sent)
+
+	// Moving the due date reminds again
+	require.NoError(t, suite.db.Exec("UPDATE boards SET due_date = ? WHERE id = ?", suite.now.Add(50*time.Minute), soon).Error)
+	sent, err = suite.service.SendDueReminders(suite.now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, int64(2), countRows(t, suite.db, "board_reminders", "board_id = ?", soon))
+}
+
+func TestReminderService_SendDigests(t *testing.T) {
+	suite := setupReminderTest(t, reminderTestConfig())
+	suite.board(t, suite.now.Add(30*time.Minute))
+	suite.board(t, suite.now.Add(-2*time.Hour))
+	_, err := suite.service.SendDueReminders(suite.now)
+	require.NoError(t, err)
+	require.NoError(t, suite.db.Exec("UPDATE notifications SET created_at = ?", suite.now).Error)
+
+	nextDay := time.Date(2030, 3, 5, 0, 30, 0, 0, time.UTC)
+	suite.notifier.FailWith(errors.New("smtp: connection refused"))
+	sent, err := suite.service.SendDigests(nextDay)
+	require.NoError(t, err)
+	assert.Zero(t, sent)
+	assert.Zero(t, countRows(t, suite.db, "reminder_digests", "user_id = ?", suite.assigneeID), "a failed delivery releases the claim")
+
+	suite.notifier.FailWith(nil)
+	sent, err = suite.service.SendDigests(nextDay)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+
+	messages := suite.notifier.Messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, []string{"assignee@example.com"}, messages[0].To)
+	assert.Equal(t, "[weAlist] 마감일 알림 요약 2030-03-05 (2건)", messages[0].Subject)
+	assert.Contains(t, messages[0].Body, "- [마감 임박] WEB-1 Board (마감 2030-03-04 10:30)")
+	assert.Contains(t, messages[0].Body, "- [마감 지남] WEB-2 Board (마감 2030-03-04 08:00)")
+	assert.Equal(t, int64(1), countRows(t, suite.db, "reminder_digests", "user_id = ? AND delivered = ?", suite.watcherID, false),
+		"users without an email address are marked without mail")
+
+	// One digest per user and day
+	sent, err = suite.service.SendDigests(nextDay.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, sent)
+	assert.Len(t, suite.notifier.Messages(), 1)
+}
+
+func TestReminderService_SendDigests_WaitsForDigestHour(t *testing.T) {
+	config := reminderTestConfig()
+	config.DigestHour = 9
+	suite := setupReminderTest(t, config)
+	suite.board(t, suite.now.Add(30*time.Minute))
+	_, err := suite.service.SendDueReminders(suite.now)
+	require.NoError(t, err)
+	require.NoError(t, suite.db.Exec("UPDATE notifications SET created_at = ?", suite.now).Error)
+
+	sent, err := suite.service.SendDigests(time.Date(2030, 3, 5, 8, 59, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Zero(t, sent)
+
+	sent, err = suite.service.SendDigests(time.Date(2030, 3, 5, 9, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+}
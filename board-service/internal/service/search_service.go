@@ -0,0 +1,147 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/auth"
+	"board-service/internal/common/pagination"
+	"board-service/internal/common/parser"
+	"board-service/internal/dto"
+	"board-service/internal/repository"
+	"errors"
+	"html"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SearchService는 사용자가 멤버인 프로젝트의 보드와 댓글을 전문 검색합니다
+// 보드는 제목, 설명, 텍스트 커스텀 필드 값으로, 댓글은 내용으로 검색되며 일치한 부분이 강조된 발췌문을 반환합니다
+type SearchService interface {
+	Search(userID string, req *dto.SearchRequest) (*dto.SearchResponse, error)
+}
+
+type searchService struct {
+	repo       repository.SearchRepository
+	fieldRepo  repository.FieldRepository
+	authorizer auth.ProjectAuthorizer
+}
+
+func NewSearchService(
+	repo repository.SearchRepository,
+	fieldRepo repository.FieldRepository,
+	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
+) SearchService {
+	return &searchService{
+		repo:       repo,
+		fieldRepo:  fieldRepo,
+		authorizer: auth.NewProjectAuthorizer(projectRepo, roleRepo),
+	}
+}
+
+// highlighter escapes the text of a hit and turns the highlight markers into <mark> tags
+var highlighter = strings.NewReplacer(repository.HighlightStart, "<mark>", repository.HighlightStop, "</mark>")
+
+func (s *searchService) Search(userID string, req *dto.SearchRequest) (*dto.SearchResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(repository.SearchTerms(req.Query)) == 0 {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "검색어에 문자나 숫자가 필요합니다", 400)
+	}
+
+	filters, err := s.searchFilters(userUUID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	page, limit := pagination.ValidatePaginationParams(req.Page, req.Limit)
+	hits, total, err := s.repo.Search(userUUID, req.Query, filters, page, limit)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "검색 실패", 500)
+	}
+
+	results := make([]dto.SearchResultResponse, 0, len(hits))
+	for _, hit := range hits {
+		result := dto.SearchResultResponse{
+			Type:      hit.Type,
+			ProjectID: hit.ProjectID.String(),
+			BoardID:   hit.BoardID.String(),
+			BoardKey:  hit.BoardKey,
+			Title:     highlight(hit.Title),
+			Snippet:   highlight(hit.Snippet),
+			Score:     hit.Score,
+		}
+		if hit.CommentID != nil {
+			commentID := hit.CommentID.String()
+			result.CommentID = &commentID
+		}
+		results = append(results, result)
+	}
+
+	return &dto.SearchResponse{
+		Results: results,
+		Total:   total,
+		Page:    page,
+		Limit:   limit,
+	}, nil
+}
+
+// searchFilters validates the filters of the request
+// A project filter requires membership; a stage filter must be an option of a Stage field
+func (s *searchService) searchFilters(userID uuid.UUID, req *dto.SearchRequest) (repository.SearchFilters, error) {
+	filters := repository.SearchFilters{Type: req.Type}
+
+	if req.ProjectID != "" {
+		projectID, err := parser.ParseProjectID(req.ProjectID)
+		if err != nil {
+			return filters, err
+		}
+		if _, err := s.authorizer.RequireMember(userID, projectID); err != nil {
+			return filters, err
+		}
+		filters.ProjectID = projectID
+	}
+
+	if req.AssigneeID != "" {
+		assigneeID, err := parser.ParseUUID(req.AssigneeID, "담당자")
+		if err != nil {
+			return filters, err
+		}
+		filters.AssigneeID = assigneeID
+	}
+
+	if req.StageID != "" {
+		optionID, err := parser.ParseUUID(req.StageID, "Stage")
+		if err != nil {
+			return filters, err
+		}
+		option, err := s.fieldRepo.FindOptionByID(optionID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return filters, apperrors.New(apperrors.ErrCodeBadRequest, "Stage를 찾을 수 없습니다", 400)
+			}
+			return filters, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "옵션 조회 실패", 500)
+		}
+		field, err := s.fieldRepo.FindFieldByID(option.FieldID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return filters, apperrors.New(apperrors.ErrCodeBadRequest, "Stage를 찾을 수 없습니다", 400)
+			}
+			return filters, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+		}
+		if !isStageField(field) {
+			return filters, apperrors.New(apperrors.ErrCodeBadRequest, "Stage 필드의 옵션이 아닙니다", 400)
+		}
+		filters.StageFieldID, filters.StageOptionID = field.ID, option.ID
+	}
+
+	return filters, nil
+}
+
+// highlight returns the text of a hit as HTML, with the matched words in <mark>
+func highlight(text string) string {
+	return highlighter.Replace(html.EscapeString(text))
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/repository"
+	"board-service/internal/testutil"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// ==================== Test Suite Setup ====================
+
+type SearchServiceTestSuite struct {
+	repo        *testutil.MockSearchRepository
+	projectRepo *testutil.MockProjectRepository
+	db          *gorm.DB
+	service     SearchService
+	projectID   uuid.UUID
+	userID      uuid.UUID
+	stageField  uuid.UUID
+	stageOption uuid.UUID
+	otherOption uuid.UUID // Option of a single-select field that is not the Stage
+}
+
+func setupSearchServiceTest(t *testing.T) *SearchServiceTestSuite {
+	db := NewMockDB()
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+
+	repo := new(testutil.MockSearchRepository)
+	projectRepo := new(testutil.MockProjectRepository)
+	roleRepo := new(testutil.MockRoleRepository)
+	memberRole := testutil.NewMemberRole()
+	roleRepo.On("FindByID", memberRole.ID).Return(memberRole, nil).Maybe()
+
+	suite := &SearchServiceTestSuite{
+		repo:        repo,
+		projectRepo: projectRepo,
+		db:          db,
+		service:     NewSearchService(repo, repository.NewFieldRepository(db), projectRepo, roleRepo),
+		projectID:   uuid.New(),
+		userID:      uuid.New(),
+		stageField:  uuid.New(),
+		stageOption: uuid.New(),
+		otherOption: uuid.New(),
+	}
+	projectRepo.On("FindMemberByUserAndProject", suite.userID, suite.projectID).
+		Return(&domain.ProjectMember{ProjectID: suite.projectID, UserID: suite.userID, RoleID: memberRole.ID}, nil).Maybe()
+
+	priorityField := uuid.New()
+	require.NoError(t, db.Exec("INSERT INTO project_fields (id, project_id, name, field_type, is_system_default) VALUES (?, ?, 'Stage', 'single_select', true)",
+		suite.stageField, suite.projectID).Error)
+	require.NoError(t, db.Exec("INSERT INTO project_fields (id, project_id, name, field_type) VALUES (?, ?, 'Priority', 'single_select')",
+		priorityField, suite.projectID).Error)
+	require.NoError(t, db.Exec("INSERT INTO field_options (id, field_id, label) VALUES (?, ?, '진행중'), (?, ?, 'high')",
+		suite.stageOption, suite.stageField, suite.otherOption, priorityField).Error)
+	return suite
+}
+
+// ==================== Search Tests ====================
+
+func TestSearchService_Search_HighlightsMatches(t *testing.T) {
+	suite := setupSearchServiceTest(t)
+
+	// Given: A board and a comment matching the query
+	boardID, commentID := uuid.New(), uuid.New()
+	hl := func(word string) string { return repository.HighlightStart + word + repository.HighlightStop }
+	suite.repo.On("Search", suite.userID, "로그인", repository.SearchFilters{}, 1, 20).Return([]repository.SearchHit{
+		{Type: repository.SearchTypeBoard, BoardID: boardID, ProjectID: suite.projectID, BoardKey: "WEB-1",
+			Title: hl("로그인") + " 오류", Snippet: "<script> 이후 " + hl("로그인을") + " 못 함", Score: 0.9},
+		{Type: repository.SearchTypeComment, BoardID: boardID, CommentID: &commentID, ProjectID: suite.projectID, BoardKey: "WEB-1",
+			Title: hl("로그인") + " 오류", Snippet: "재현: " + hl("로그인") + " & 새로고침", Score: 0.4},
+	}, int64(2), nil)
+
+	// When
+	result, err := suite.service.Search(suite.userID.String(), &dto.SearchRequest{Query: "로그인"})
+
+	// Then: Text is escaped and only the matches are marked
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), result.Total)
+	assert.Equal(t, 1, result.Page)
+	assert.Equal(t, 20, result.Limit)
+	require.Len(t, result.Results, 2)
+	assert.Equal(t, "board", result.Results[0].Type)
+	assert.Equal(t, "<mark>로그인</mark> 오류", result.Results[0].Title)
+	assert.Equal(t, "&lt;script&gt; 이후 <mark>로그인을</mark> 못 함", result.Results[0].Snippet)
+	assert.Nil(t, result.Results[0].CommentID)
+	assert.Equal(t, "comment", result.Results[1].Type)
+	assert.Equal(t, commentID.String(), *result.Results[1].CommentID)
+	assert.Equal(t, "재현: <mark>로그인</mark> &amp; 새로고침", result.Results[1].Snippet)
+}
+
+func TestSearchService_Search_Filters(t *testing.T) {
+	suite := setupSearchServiceTest(t)
+
+	// Given: Filters by project, assignee and stage
+	assigneeID := uuid.New()
+	filters := repository.SearchFilters{
+		ProjectID: suite.projectID, AssigneeID: assigneeID,
+		StageFieldID: suite.stageField, StageOptionID: suite.stageOption, Type: "comment",
+	}
+	suite.repo.On("Search", suite.userID, "배포", filters, 2, 10).Return([]repository.SearchHit{}, int64(0), nil)
+
+	// When
+	result, err := suite.service.Search(suite.userID.String(), &dto.SearchRequest{
+		Query: "배포", ProjectID: suite.projectID.String(), AssigneeID: assigneeID.String(),
+		StageID: suite.stageOption.String(), Type: "comment", Page: 2, Limit: 10,
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Empty(t, result.Results)
+	suite.repo.AssertExpectations(t)
+}
+
+func TestSearchService_Search_RejectsInvalidRequests(t *testing.T) {
+	suite := setupSearchServiceTest(t)
+	otherProject := uuid.New()
+	suite.projectRepo.On("FindMemberByUserAndProject", suite.userID, otherProject).Return(nil, gorm.ErrRecordNotFound)
+
+	tests := []struct {
+		name   string
+		req    dto.SearchRequest
+		status int
+	}{
+		{"query without letters or digits", dto.SearchRequest{Query: " *** "}, 400},
+		{"project the user is not a member of", dto.SearchRequest{Query: "bug", ProjectID: otherProject.String()}, 403},
+		{"option that is not a Stage", dto.SearchRequest{Query: "bug", StageID: suite.otherOption.String()}, 400},
+		{"unknown stage", dto.SearchRequest{Query: "bug", StageID: uuid.New().String()}, 400},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := suite.service.Search(suite.userID.String(), &tt.req)
+			assert.Equal(t, tt.status, appErrorStatus(t, err))
+		})
+	}
+	suite.repo.AssertNotCalled(t, "Search", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSearchTerms(t *testing.T) {
+	assert.Equal(t, []string{"로그인", "API", "v2"}, repository.SearchTerms(" 로그인:API & v2! "))
+	assert.Empty(t, repository.SearchTerms("'&|!:*()"))
+	assert.Len(t, repository.SearchTerms("a b c d e f g h i j k l"), 10)
+}
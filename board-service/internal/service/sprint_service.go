@@ -0,0 +1,759 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/auth"
+	"board-service/internal/common/parser"
+	"board-service/internal/common/validator"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"board-service/internal/uow"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// SprintService는 프로젝트의 스프린트와 보드의 스프린트 배정, 스프린트 리포트를 관리합니다
+// 스프린트 생성/수정/삭제/시작/종료는 ADMIN 이상만 가능하며, 조회와 보드 배정은 프로젝트 멤버라면 누구나 가능합니다
+// 보드의 완료 여부는 Stage 필드의 옵션으로 판단합니다 (기본값: 마지막 Stage 옵션)
+type SprintService interface {
+	CreateSprint(userID, projectID string, req *dto.CreateSprintRequest) (*dto.SprintResponse, error)
+	GetSprints(userID, projectID string, req *dto.GetSprintsRequest) ([]dto.SprintResponse, error)
+	GetSprint(userID, sprintID string) (*dto.SprintResponse, error)
+	UpdateSprint(userID, sprintID string, req *dto.UpdateSprintRequest) (*dto.SprintResponse, error)
+	DeleteSprint(userID, sprintID string) error
+
+	// Lifecycle
+	StartSprint(userID, sprintID string) (*dto.SprintResponse, error)
+	CloseSprint(userID, sprintID string, req *dto.CloseSprintRequest) (*dto.CloseSprintResponse, error)
+
+	// Boards
+	SetBoardSprint(userID, boardID string, req *dto.SetBoardSprintRequest) (*dto.BoardSprintResponse, error)
+
+	// Reports
+	GetSprintReport(userID, sprintID string) (*dto.SprintReportResponse, error)
+}
+
+type sprintService struct {
+	repo       repository.SprintRepository
+	boardRepo  repository.BoardRepository
+	fieldRepo  repository.FieldRepository
+	activities *boardActivityRecorder
+	authorizer auth.ProjectAuthorizer
+	logger     *zap.Logger
+	uow        uow.UnitOfWork
+}
+
+func NewSprintService(
+	repo repository.SprintRepository,
+	boardRepo repository.BoardRepository,
+	fieldRepo repository.FieldRepository,
+	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
+	activityRepo repository.BoardActivityRepository,
+	logger *zap.Logger,
+	db *gorm.DB,
+) SprintService {
+	return &sprintService{
+		repo:       repo,
+		boardRepo:  boardRepo,
+		fieldRepo:  fieldRepo,
+		activities: newBoardActivityRecorder(activityRepo, logger),
+		authorizer: auth.NewProjectAuthorizer(projectRepo, roleRepo),
+		logger:     logger,
+		uow:        uow.NewUnitOfWork(db),
+	}
+}
+
+// ==================== Sprints ====================
+
+// CreateSprint creates a planned sprint of the project
+func (s *sprintService) CreateSprint(userID, projectID string, req *dto.CreateSprintRequest) (*dto.SprintResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizer.RequireAdmin(userUUID, projectUUID); err != nil {
+		return nil, err
+	}
+
+	startDate, err := parseOptionalDay(req.StartDate, "시작일")
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseOptionalDay(req.EndDate, "종료일")
+	if err != nil {
+		return nil, err
+	}
+
+	sprint, err := domain.NewSprint(projectUUID, req.Name, req.Goal, startDate, endDate, userUUID)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Sprint.Create(sprint); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 생성 실패", 500)
+		}
+		return writeSprintEvent(repos.Outbox, event.SprintCreated, sprint, userUUID, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := toSprintResponse(sprint, 0)
+	return &response, nil
+}
+
+// GetSprints lists the sprints of the project: the active sprint first, then planned and closed sprints
+func (s *sprintService) GetSprints(userID, projectID string, req *dto.GetSprintsRequest) ([]dto.SprintResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizer.RequireMember(userUUID, projectUUID); err != nil {
+		return nil, err
+	}
+
+	var state *domain.SprintState
+	if req.State != "" {
+		value := domain.SprintState(req.State)
+		state = &value
+	}
+
+	sprints, err := s.repo.FindByProject(projectUUID, state)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 조회 실패", 500)
+	}
+
+	sprintIDs := make([]uuid.UUID, 0, len(sprints))
+	for _, sprint := range sprints {
+		sprintIDs = append(sprintIDs, sprint.ID)
+	}
+	counts, err := s.repo.CountBoards(sprintIDs)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 보드 조회 실패", 500)
+	}
+
+	responses := make([]dto.SprintResponse, 0, len(sprints))
+	for i := range sprints {
+		responses = append(responses, toSprintResponse(&sprints[i], counts[sprints[i].ID]))
+	}
+	return responses, nil
+}
+
+func (s *sprintService) GetSprint(userID, sprintID string) (*dto.SprintResponse, error) {
+	_, sprint, err := s.findSprint(userID, sprintID, false)
+	if err != nil {
+		return nil, err
+	}
+	return s.sprintResponse(sprint)
+}
+
+// UpdateSprint changes the name, goal or dates of a sprint that is not closed
+func (s *sprintService) UpdateSprint(userID, sprintID string, req *dto.UpdateSprintRequest) (*dto.SprintResponse, error) {
+	userUUID, sprint, err := s.findSprint(userID, sprintID, true)
+	if err != nil {
+		return nil, err
+	}
+	if sprint.IsClosed() {
+		return nil, apperrors.New(apperrors.ErrCodeConflict, "종료된 스프린트는 수정할 수 없습니다", 409)
+	}
+
+	if req.Name != nil {
+		if err := sprint.UpdateName(*req.Name); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+	if req.Goal != nil {
+		if err := sprint.UpdateGoal(*req.Goal); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+	if req.StartDate != nil || req.EndDate != nil {
+		startDate, endDate := sprint.StartDate, sprint.EndDate
+		if req.StartDate != nil {
+			if startDate, err = parseOptionalDay(req.StartDate, "시작일"); err != nil {
+				return nil, err
+			}
+		}
+		if req.EndDate != nil {
+			if endDate, err = parseOptionalDay(req.EndDate, "종료일"); err != nil {
+				return nil, err
+			}
+		}
+		if err := sprint.SetDates(startDate, endDate); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Sprint.Update(sprint); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 수정 실패", 500)
+		}
+		return writeSprintEvent(repos.Outbox, event.SprintUpdated, sprint, userUUID, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.sprintResponse(sprint)
+}
+
+// DeleteSprint deletes a sprint that is not running; its boards go back to the backlog
+func (s *sprintService) DeleteSprint(userID, sprintID string) error {
+	userUUID, sprint, err := s.findSprint(userID, sprintID, true)
+	if err != nil {
+		return err
+	}
+	if sprint.IsActive() {
+		return apperrors.New(apperrors.ErrCodeConflict, "진행 중인 스프린트는 삭제할 수 없습니다", 409)
+	}
+
+	return s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Sprint.ClearBoards(sprint.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 보드 해제 실패", 500)
+		}
+		if err := repos.Sprint.Delete(sprint.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 삭제 실패", 500)
+		}
+		return writeSprintEvent(repos.Outbox, event.SprintDeleted, sprint, userUUID, nil)
+	})
+}
+
+// ==================== Lifecycle ====================
+
+// StartSprint makes a planned sprint the active sprint of the project
+// The boards in the sprint at that moment are its committed scope
+func (s *sprintService) StartSprint(userID, sprintID string) (*dto.SprintResponse, error) {
+	userUUID, sprint, err := s.findSprint(userID, sprintID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := s.repo.FindActive(sprint.ProjectID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 조회 실패", 500)
+	}
+	if active != nil && active.ID != sprint.ID {
+		return nil, apperrors.New(apperrors.ErrCodeConflict, "이미 진행 중인 스프린트가 있습니다", 409)
+	}
+
+	if err := sprint.Start(time.Now()); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Sprint.Update(sprint); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 시작 실패", 500)
+		}
+		if err := repos.Sprint.CommitScope(sprint.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 범위 기록 실패", 500)
+		}
+		return writeSprintEvent(repos.Outbox, event.SprintStarted, sprint, userUUID, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.sprintResponse(sprint)
+}
+
+// CloseSprint ends the active sprint
+// Finished boards stay in the closed sprint; unfinished boards move to the next sprint or back to the backlog
+func (s *sprintService) CloseSprint(userID, sprintID string, req *dto.CloseSprintRequest) (*dto.CloseSprintResponse, error) {
+	userUUID, sprint, err := s.findSprint(userID, sprintID, true)
+	if err != nil {
+		return nil, err
+	}
+	if !sprint.IsActive() {
+		return nil, apperrors.New(apperrors.ErrCodeConflict, "진행 중인 스프린트만 종료할 수 있습니다", 409)
+	}
+
+	var next *domain.Sprint
+	if req.NextSprintID != nil && *req.NextSprintID != "" {
+		if next, err = s.findNextSprint(sprint, *req.NextSprintID); err != nil {
+			return nil, err
+		}
+	}
+
+	stageFieldID, completedOptionIDs, err := s.completedStageOptions(sprint.ProjectID, req.CompletedOptionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := sprint.Close(now); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	var completed, moved []uuid.UUID
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		boardIDs, err := repos.Sprint.FindBoardIDs(sprint.ID)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 보드 조회 실패", 500)
+		}
+		done, err := repos.Sprint.FindBoardIDsWithOption(boardIDs, stageFieldID, completedOptionIDs)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 단계 조회 실패", 500)
+		}
+
+		for _, boardID := range boardIDs {
+			entry, err := repos.Sprint.FindScopeEntry(sprint.ID, boardID)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 범위 조회 실패", 500)
+			}
+			if entry == nil {
+				entry = domain.NewSprintBoard(sprint.ID, boardID, now)
+			}
+			if done[boardID] {
+				entry.Completed = true
+				completed = append(completed, boardID)
+			} else {
+				entry.CarriedOver = next != nil
+				moved = append(moved, boardID)
+			}
+			entry.UpdatedAt = now
+			if err := repos.Sprint.SaveScopeEntry(entry); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 범위 기록 실패", 500)
+			}
+		}
+
+		var nextID *uuid.UUID
+		if next != nil {
+			nextID = &next.ID
+			for _, boardID := range moved {
+				if err := joinSprintScope(repos.Sprint, next.ID, boardID, now); err != nil {
+					return err
+				}
+			}
+		}
+		if err := repos.Sprint.AssignBoards(moved, nextID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "미완료 보드 이동 실패", 500)
+		}
+
+		if err := repos.Sprint.Update(sprint); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 종료 실패", 500)
+		}
+		return writeSprintEvent(repos.Outbox, event.SprintClosed, sprint, userUUID, map[string]interface{}{
+			"completedBoardIds": parser.UUIDsToStrings(completed),
+			"movedBoardIds":     parser.UUIDsToStrings(moved),
+			"nextSprintId":      nextID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, boardID := range moved {
+		board := &domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: sprint.ProjectID}
+		s.activities.record(sprintActivity(board, userUUID, sprint, next))
+	}
+
+	response := &dto.CloseSprintResponse{
+		Sprint:            toSprintResponse(sprint, len(completed)),
+		CompletedBoardIDs: parser.UUIDsToStrings(completed),
+		MovedBoardIDs:     parser.UUIDsToStrings(moved),
+	}
+	if next != nil {
+		nextID := next.ID.String()
+		response.NextSprintID = &nextID
+	}
+	return response, nil
+}
+
+// ==================== Boards ====================
+
+// SetBoardSprint plans the board in a sprint of its project, or moves it back to the backlog
+func (s *sprintService) SetBoardSprint(userID, boardID string, req *dto.SetBoardSprintRequest) (*dto.BoardSprintResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := s.boardRepo.FindByID(boardUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	if _, err := s.authorizer.RequireMember(userUUID, board.ProjectID); err != nil {
+		return nil, err
+	}
+
+	var target *domain.Sprint
+	if req.SprintID != nil && *req.SprintID != "" {
+		sprintUUID, err := parser.ParseUUID(*req.SprintID, "스프린트")
+		if err != nil {
+			return nil, err
+		}
+		target, err = s.repo.FindByID(sprintUUID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 조회 실패", 500)
+		}
+		if target == nil || target.ProjectID != board.ProjectID {
+			return nil, apperrors.New(apperrors.ErrCodeBadRequest, "같은 프로젝트의 스프린트만 지정할 수 있습니다", 400)
+		}
+		if target.IsClosed() {
+			return nil, apperrors.New(apperrors.ErrCodeConflict, "종료된 스프린트에는 보드를 추가할 수 없습니다", 409)
+		}
+	}
+
+	response := &dto.BoardSprintResponse{BoardID: board.ID.String()}
+	if target != nil {
+		sprintID := target.ID.String()
+		response.SprintID = &sprintID
+		if board.SprintID != nil && *board.SprintID == target.ID {
+			return response, nil
+		}
+	} else if board.SprintID == nil {
+		return response, nil
+	}
+
+	var previous *domain.Sprint
+	if board.SprintID != nil {
+		previous, err = s.repo.FindByID(*board.SprintID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 조회 실패", 500)
+		}
+	}
+
+	now := time.Now()
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if board.SprintID != nil {
+			if err := repos.Sprint.LeaveScope(*board.SprintID, board.ID, now); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 제외 실패", 500)
+			}
+		}
+
+		var targetID *uuid.UUID
+		if target != nil {
+			targetID = &target.ID
+			if err := joinSprintScope(repos.Sprint, target.ID, board.ID, now); err != nil {
+				return err
+			}
+		}
+		if err := repos.Sprint.AssignBoards([]uuid.UUID{board.ID}, targetID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 배정 실패", 500)
+		}
+
+		data := map[string]interface{}{
+			"sprintId":         targetID,
+			"previousSprintId": board.SprintID,
+		}
+		if err := repos.Outbox.Write(event.NewBoardEvent(event.BoardSprintChanged, board.ProjectID, board.ID, userUUID, data)); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 이벤트 기록 실패", 500)
+		}
+		board.AssignSprint(targetID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.activities.record(sprintActivity(board, userUUID, previous, target))
+	return response, nil
+}
+
+// ==================== Reports ====================
+
+// GetSprintReport compares the committed scope of a started sprint with what was completed
+// Completion is final for a closed sprint and evaluated now for the active sprint
+func (s *sprintService) GetSprintReport(userID, sprintID string) (*dto.SprintReportResponse, error) {
+	_, sprint, err := s.findSprint(userID, sprintID, false)
+	if err != nil {
+		return nil, err
+	}
+	if sprint.StartedAt == nil {
+		return nil, apperrors.New(apperrors.ErrCodeConflict, "시작되지 않은 스프린트는 리포트가 없습니다", 409)
+	}
+
+	scope, err := s.repo.FindScope(sprint.ID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 범위 조회 실패", 500)
+	}
+	boardIDs := make([]uuid.UUID, 0, len(scope))
+	for _, entry := range scope {
+		boardIDs = append(boardIDs, entry.BoardID)
+	}
+	boardList, err := s.repo.FindBoards(boardIDs)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	boards := make(map[uuid.UUID]*domain.Board, len(boardList))
+	for i := range boardList {
+		boards[boardList[i].ID] = &boardList[i]
+	}
+
+	// The active sprint has no completion flags yet, so check the current stage of its boards
+	done := make(map[uuid.UUID]bool)
+	if !sprint.IsClosed() {
+		stageFieldID, completedOptionIDs, err := s.completedStageOptions(sprint.ProjectID, nil)
+		if err != nil {
+			return nil, err
+		}
+		if done, err = s.repo.FindBoardIDsWithOption(boardIDs, stageFieldID, completedOptionIDs); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 단계 조회 실패", 500)
+		}
+	}
+
+	response := &dto.SprintReportResponse{Boards: make([]dto.SprintReportBoard, 0, len(scope))}
+	for _, entry := range scope {
+		board, ok := boards[entry.BoardID]
+		if !ok {
+			continue
+		}
+		added := entry.IsAddedAfter(*sprint.StartedAt)
+		if !entry.Committed && !added {
+			// Planned and removed again before the sprint started
+			continue
+		}
+
+		item := dto.SprintReportBoard{
+			BoardID:     board.ID.String(),
+			Key:         board.Key,
+			Title:       board.Title,
+			AddedAt:     entry.AddedAt,
+			Committed:   entry.Committed,
+			Added:       added,
+			Removed:     entry.IsRemovedAfter(*sprint.StartedAt) && !entry.CarriedOver,
+			CarriedOver: entry.CarriedOver,
+		}
+		if sprint.IsClosed() {
+			item.Completed = entry.Completed
+		} else {
+			item.Completed = entry.IsInSprint() && done[board.ID]
+		}
+
+		if item.Committed {
+			response.CommittedCount++
+			if item.Completed {
+				response.CommittedCompletedCount++
+			}
+		}
+		if item.Added {
+			response.AddedCount++
+			if item.Completed {
+				response.AddedCompletedCount++
+			}
+		}
+		if item.Removed {
+			response.RemovedCount++
+		}
+		if item.CarriedOver {
+			response.CarriedOverCount++
+		}
+		if item.Completed {
+			response.CompletedCount++
+		} else if entry.IsInSprint() {
+			response.IncompleteCount++
+		}
+		response.Boards = append(response.Boards, item)
+	}
+
+	sprintResponse, err := s.sprintResponse(sprint)
+	if err != nil {
+		return nil, err
+	}
+	response.Sprint = *sprintResponse
+	return response, nil
+}
+
+// ==================== Helper Methods ====================
+
+// findSprint finds the sprint and checks that the user is a member (or an ADMIN) of its project
+func (s *sprintService) findSprint(userID, sprintID string, requireAdmin bool) (uuid.UUID, *domain.Sprint, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+	sprintUUID, err := parser.ParseUUID(sprintID, "스프린트")
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	sprint, err := s.repo.FindByID(sprintUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, nil, apperrors.New(apperrors.ErrCodeNotFound, "스프린트를 찾을 수 없습니다", 404)
+		}
+		return uuid.Nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 조회 실패", 500)
+	}
+
+	if requireAdmin {
+		_, err = s.authorizer.RequireAdmin(userUUID, sprint.ProjectID)
+	} else {
+		_, err = s.authorizer.RequireMember(userUUID, sprint.ProjectID)
+	}
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+	return userUUID, sprint, nil
+}
+
+// findNextSprint finds the planned sprint of the same project that receives the unfinished boards
+func (s *sprintService) findNextSprint(sprint *domain.Sprint, nextSprintID string) (*domain.Sprint, error) {
+	nextUUID, err := parser.ParseUUID(nextSprintID, "다음 스프린트")
+	if err != nil {
+		return nil, err
+	}
+	next, err := s.repo.FindByID(nextUUID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 조회 실패", 500)
+	}
+	if next == nil || next.ID == sprint.ID || next.ProjectID != sprint.ProjectID {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "다음 스프린트는 같은 프로젝트의 다른 스프린트여야 합니다", 400)
+	}
+	if next.State != domain.SprintStatePlanned {
+		return nil, apperrors.New(apperrors.ErrCodeBadRequest, "다음 스프린트는 계획된 스프린트여야 합니다", 400)
+	}
+	return next, nil
+}
+
+// completedStageOptions resolves the Stage field of the project and the options that count as finished
+// Without requested options the last Stage option is used; without a Stage field no board counts as finished
+func (s *sprintService) completedStageOptions(projectID uuid.UUID, requested []string) (uuid.UUID, []uuid.UUID, error) {
+	stageField, err := findStageField(s.fieldRepo, projectID)
+	if err != nil {
+		return uuid.Nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	if stageField == nil {
+		if len(requested) > 0 {
+			return uuid.Nil, nil, apperrors.New(apperrors.ErrCodeBadRequest, "프로젝트에 Stage 필드가 없습니다", 400)
+		}
+		return uuid.Nil, nil, nil
+	}
+
+	options, err := s.fieldRepo.FindOptionsByField(stageField.ID)
+	if err != nil {
+		return uuid.Nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 옵션 조회 실패", 500)
+	}
+	if len(requested) == 0 {
+		var last *domain.FieldOption
+		for i := range options {
+			if last == nil || options[i].DisplayOrder > last.DisplayOrder {
+				last = &options[i]
+			}
+		}
+		if last == nil {
+			return stageField.ID, nil, nil
+		}
+		return stageField.ID, []uuid.UUID{last.ID}, nil
+	}
+
+	known := make(map[uuid.UUID]bool, len(options))
+	for _, option := range options {
+		known[option.ID] = true
+	}
+	optionIDs := make([]uuid.UUID, 0, len(requested))
+	for _, value := range requested {
+		optionID, err := parser.ParseUUID(value, "완료 옵션")
+		if err != nil {
+			return uuid.Nil, nil, err
+		}
+		if !known[optionID] {
+			return uuid.Nil, nil, apperrors.New(apperrors.ErrCodeBadRequest, "완료 옵션은 Stage 필드의 옵션이어야 합니다", 400)
+		}
+		optionIDs = append(optionIDs, optionID)
+	}
+	return stageField.ID, optionIDs, nil
+}
+
+// sprintResponse maps the sprint with the number of boards currently in it
+func (s *sprintService) sprintResponse(sprint *domain.Sprint) (*dto.SprintResponse, error) {
+	counts, err := s.repo.CountBoards([]uuid.UUID{sprint.ID})
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 보드 조회 실패", 500)
+	}
+	response := toSprintResponse(sprint, counts[sprint.ID])
+	return &response, nil
+}
+
+// joinSprintScope records the board joining the sprint, reusing its history if it was in the sprint before
+func joinSprintScope(repo repository.SprintRepository, sprintID, boardID uuid.UUID, now time.Time) error {
+	entry, err := repo.FindScopeEntry(sprintID, boardID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 범위 조회 실패", 500)
+	}
+	if entry == nil {
+		entry = domain.NewSprintBoard(sprintID, boardID, now)
+	} else {
+		entry.Rejoin(now)
+	}
+	if err := repo.SaveScopeEntry(entry); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 범위 기록 실패", 500)
+	}
+	return nil
+}
+
+// parseOptionalDay parses an optional date (YYYY-MM-DD); nil or an empty string is no date
+func parseOptionalDay(value *string, fieldName string) (*time.Time, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	return validator.ValidateDayFormat(*value, fieldName)
+}
+
+// writeSprintEvent records a change of the sprint in the outbox
+func writeSprintEvent(outbox repository.OutboxWriter, eventType event.Type, sprint *domain.Sprint, actorID uuid.UUID, data map[string]interface{}) error {
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	data["sprint"] = toSprintResponse(sprint, 0)
+	if err := outbox.Write(event.New(eventType, sprint.ProjectID, actorID, data)); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "스프린트 이벤트 기록 실패", 500)
+	}
+	return nil
+}
+
+// sprintActivity records a board moving between sprints (nil is the backlog)
+func sprintActivity(board *domain.Board, actorID uuid.UUID, from, to *domain.Sprint) domain.BoardActivity {
+	snapshot := func(sprint *domain.Sprint) *string {
+		if sprint == nil {
+			return nil
+		}
+		return encodeActivityValue(map[string]interface{}{
+			"sprintId": sprint.ID.String(),
+			"name":     sprint.Name,
+		})
+	}
+	activity := domain.NewBoardActivity(board, actorID, domain.BoardActivityUpdated)
+	activity.SetChange(domain.BoardActivityFieldSprint, snapshot(from), snapshot(to))
+	return activity
+}
+
+func toSprintResponse(sprint *domain.Sprint, boardCount int) dto.SprintResponse {
+	return dto.SprintResponse{
+		SprintID:   sprint.ID.String(),
+		ProjectID:  sprint.ProjectID.String(),
+		Name:       sprint.Name,
+		Goal:       sprint.Goal,
+		StartDate:  formatOptionalDay(sprint.StartDate),
+		EndDate:    formatOptionalDay(sprint.EndDate),
+		State:      string(sprint.State),
+		StartedAt:  sprint.StartedAt,
+		ClosedAt:   sprint.ClosedAt,
+		BoardCount: boardCount,
+		CreatedBy:  sprint.CreatedBy.String(),
+		CreatedAt:  sprint.CreatedAt,
+		UpdatedAt:  sprint.UpdatedAt,
+	}
+}
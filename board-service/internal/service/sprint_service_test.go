@@ -0,0 +1,300 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"board-service/internal/testutil"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ==================== Test Suite Setup ====================
+
+type SprintServiceTestSuite struct {
+	projectRepo  *testutil.MockProjectRepository
+	db           *gorm.DB
+	service      *sprintService
+	projectID    uuid.UUID
+	adminID      uuid.UUID
+	memberID     uuid.UUID
+	stageFieldID uuid.UUID
+	stageOptions []uuid.UUID // 대기, 진행중, 완료
+}
+
+func setupSprintServiceTest(t *testing.T) *SprintServiceTestSuite {
+	db := NewMockDB()
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+
+	projectRepo := new(testutil.MockProjectRepository)
+	roleRepo := new(testutil.MockRoleRepository)
+	activityRepo := new(testutil.MockBoardActivityRepository)
+	adminRole, memberRole := testutil.NewAdminRole(), testutil.NewMemberRole()
+	roleRepo.On("FindByID", adminRole.ID).Return(adminRole, nil).Maybe()
+	roleRepo.On("FindByID", memberRole.ID).Return(memberRole, nil).Maybe()
+	activityRepo.On("BatchCreate", mock.Anything).Return(nil).Maybe()
+
+	service := NewSprintService(repository.NewSprintRepository(db), repository.NewBoardRepository(db), repository.NewFieldRepository(db),
+		projectRepo, roleRepo, activityRepo, zap.NewNop(), db)
+
+	suite := &SprintServiceTestSuite{
+		projectRepo:  projectRepo,
+		db:           db,
+		service:      service.(*sprintService),
+		projectID:    uuid.New(),
+		adminID:      uuid.New(),
+		memberID:     uuid.New(),
+		stageFieldID: uuid.New(),
+	}
+	suite.addMember(suite.adminID, adminRole.ID)
+	suite.addMember(suite.memberID, memberRole.ID)
+
+	require.NoError(t, db.Exec("INSERT INTO project_fields (id, project_id, name, field_type, is_system_default) VALUES (?, ?, 'Stage', 'single_select', true)",
+		suite.stageFieldID, suite.projectID).Error)
+	for order, label := range []string{"대기", "진행중", "완료"} {
+		optionID := uuid.New()
+		require.NoError(t, db.Exec("INSERT INTO field_options (id, field_id, label, display_order) VALUES (?, ?, ?, ?)",
+			optionID, suite.stageFieldID, label, order).Error)
+		suite.stageOptions = append(suite.stageOptions, optionID)
+	}
+	return suite
+}
+
+func (s *SprintServiceTestSuite) addMember(userID, roleID uuid.UUID) {
+	s.projectRepo.On("FindMemberByUserAndProject", userID, s.projectID).
+		Return(&domain.ProjectMember{ProjectID: s.projectID, UserID: userID, RoleID: roleID}, nil).Maybe()
+}
+
+func (s *SprintServiceTestSuite) board(t *testing.T, title string) uuid.UUID {
+	boardID := uuid.New()
+	require.NoError(t, s.db.Exec("INSERT INTO boards (id, project_id, title) VALUES (?, ?, ?)", boardID, s.projectID, title).Error)
+	return boardID
+}
+
+func (s *SprintServiceTestSuite) setStage(t *testing.T, boardID, optionID uuid.UUID) {
+	require.NoError(t, s.db.Exec("INSERT INTO board_field_values (id, board_id, field_id, value_option_id) VALUES (?, ?, ?, ?)",
+		uuid.New(), boardID, s.stageFieldID, optionID).Error)
+}
+
+func (s *SprintServiceTestSuite) createSprint(t *testing.T, name string) *dto.SprintResponse {
+	sprint, err := s.service.CreateSprint(s.adminID.String(), s.projectID.String(), &dto.CreateSprintRequest{Name: name})
+	require.NoError(t, err)
+	return sprint
+}
+
+func (s *SprintServiceTestSuite) plan(t *testing.T, boardID uuid.UUID, sprintID *string) {
+	_, err := s.service.SetBoardSprint(s.memberID.String(), boardID.String(), &dto.SetBoardSprintRequest{SprintID: sprintID})
+	require.NoError(t, err)
+}
+
+func (s *SprintServiceTestSuite) boardSprint(t *testing.T, boardID uuid.UUID) *string {
+	var sprintID *string
+	require.NoError(t, s.db.Raw("SELECT sprint_id FROM boards WHERE id = ?", boardID).Scan(&sprintID).Error)
+	return sprintID
+}
+
+// ==================== Sprint Tests ====================
+
+func TestSprintService_CreateSprint_RequiresAdmin(t *testing.T) {
+	suite := setupSprintServiceTest(t)
+
+	_, err := suite.service.CreateSprint(suite.memberID.String(), suite.projectID.String(), &dto.CreateSprintRequest{Name: "Sprint 1"})
+	assert.Equal(t, 403, appErrorStatus(t, err))
+
+	startDate, endDate := "2026-03-02", "2026-03-13"
+	sprint, err := suite.service.CreateSprint(suite.adminID.String(), suite.projectID.String(), &dto.CreateSprintRequest{
+		Name:      "Sprint 1",
+		StartDate: &startDate,
+		EndDate:   &endDate,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "planned", sprint.State)
+	assert.Equal(t, "2026-03-02", *sprint.StartDate)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "outbox_events", "event_type = ?", string(event.SprintCreated)))
+
+	_, err = suite.service.CreateSprint(suite.adminID.String(), suite.projectID.String(), &dto.CreateSprintRequest{
+		Name:      "Sprint 2",
+		StartDate: &endDate,
+		EndDate:   &startDate,
+	})
+	assert.Equal(t, 400, appErrorStatus(t, err))
+}
+
+func TestSprintService_StartSprint_OnlyOneActive(t *testing.T) {
+	suite := setupSprintServiceTest(t)
+	first := suite.createSprint(t, "Sprint 1")
+	second := suite.createSprint(t, "Sprint 2")
+
+	started, err := suite.service.StartSprint(suite.adminID.String(), first.SprintID)
+	require.NoError(t, err)
+	assert.Equal(t, "active", started.State)
+	assert.NotNil(t, started.StartDate, "start date defaults to today")
+
+	_, err = suite.service.StartSprint(suite.adminID.String(), second.SprintID)
+	assert.Equal(t, 409, appErrorStatus(t, err))
+
+	assert.Equal(t, 409, appErrorStatus(t, suite.service.DeleteSprint(suite.adminID.String(), first.SprintID)))
+}
+
+func TestSprintService_SetBoardSprint_ValidatesSprint(t *testing.T) {
+	suite := setupSprintServiceTest(t)
+	boardID := suite.board(t, "Login")
+
+	otherProjectSprint := uuid.New()
+	require.NoError(t, suite.db.Exec("INSERT INTO sprints (id, project_id, name, created_by) VALUES (?, ?, 'Other', ?)",
+		otherProjectSprint, uuid.New(), suite.adminID).Error)
+	otherID := otherProjectSprint.String()
+	_, err := suite.service.SetBoardSprint(suite.memberID.String(), boardID.String(), &dto.SetBoardSprintRequest{SprintID: &otherID})
+	assert.Equal(t, 400, appErrorStatus(t, err))
+
+	sprint := suite.createSprint(t, "Sprint 1")
+	suite.plan(t, boardID, &sprint.SprintID)
+	assert.Equal(t, sprint.SprintID, *suite.boardSprint(t, boardID))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "outbox_events", "event_type = ?", string(event.BoardSprintChanged)))
+
+	// Back to the backlog
+	suite.plan(t, boardID, nil)
+	assert.Nil(t, suite.boardSprint(t, boardID))
+
+	sprints, err := suite.service.GetSprints(suite.memberID.String(), suite.projectID.String(), &dto.GetSprintsRequest{})
+	require.NoError(t, err)
+	require.Len(t, sprints, 1)
+	assert.Zero(t, sprints[0].BoardCount)
+}
+
+// ==================== Report Tests ====================
+
+func TestSprintService_GetSprintReport_CommittedAndAddedScope(t *testing.T) {
+	suite := setupSprintServiceTest(t)
+	sprint := suite.createSprint(t, "Sprint 1")
+	done, removed, open := suite.board(t, "Done"), suite.board(t, "Removed"), suite.board(t, "Open")
+	for _, boardID := range []uuid.UUID{done, removed, open} {
+		suite.plan(t, boardID, &sprint.SprintID)
+	}
+
+	_, err := suite.service.GetSprintReport(suite.memberID.String(), sprint.SprintID)
+	assert.Equal(t, 409, appErrorStatus(t, err), "planned sprints have no report")
+
+	_, err = suite.service.StartSprint(suite.adminID.String(), sprint.SprintID)
+	require.NoError(t, err)
+
+	// Scope changes after the start
+	added := suite.board(t, "Added")
+	suite.plan(t, added, &sprint.SprintID)
+	suite.plan(t, removed, nil)
+	suite.setStage(t, done, suite.stageOptions[2])
+	suite.setStage(t, added, suite.stageOptions[2])
+	suite.setStage(t, open, suite.stageOptions[1])
+
+	report, err := suite.service.GetSprintReport(suite.memberID.String(), sprint.SprintID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.CommittedCount)
+	assert.Equal(t, 1, report.CommittedCompletedCount)
+	assert.Equal(t, 1, report.AddedCount)
+	assert.Equal(t, 1, report.AddedCompletedCount)
+	assert.Equal(t, 1, report.RemovedCount)
+	assert.Equal(t, 2, report.CompletedCount)
+	assert.Equal(t, 1, report.IncompleteCount)
+	assert.Equal(t, 3, report.Sprint.BoardCount)
+	assert.Len(t, report.Boards, 4)
+}
+
+// ==================== Close Tests ====================
+
+func TestSprintService_CloseSprint_RollsUnfinishedBoardsOver(t *testing.T) {
+	suite := setupSprintServiceTest(t)
+	sprint := suite.createSprint(t, "Sprint 1")
+	next := suite.createSprint(t, "Sprint 2")
+	done, open := suite.board(t, "Done"), suite.board(t, "Open")
+	suite.plan(t, done, &sprint.SprintID)
+	suite.plan(t, open, &sprint.SprintID)
+	_, err := suite.service.StartSprint(suite.adminID.String(), sprint.SprintID)
+	require.NoError(t, err)
+	suite.setStage(t, done, suite.stageOptions[2])
+
+	// When
+	result, err := suite.service.CloseSprint(suite.adminID.String(), sprint.SprintID, &dto.CloseSprintRequest{NextSprintID: &next.SprintID})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "closed", result.Sprint.State)
+	assert.Equal(t, []string{done.String()}, result.CompletedBoardIDs)
+	assert.Equal(t, []string{open.String()}, result.MovedBoardIDs)
+	assert.Equal(t, sprint.SprintID, *suite.boardSprint(t, done), "finished boards stay in the closed sprint")
+	assert.Equal(t, next.SprintID, *suite.boardSprint(t, open))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "sprint_boards", "sprint_id = ? AND board_id = ?", next.SprintID, open))
+
+	report, err := suite.service.GetSprintReport(suite.memberID.String(), sprint.SprintID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.CommittedCount)
+	assert.Equal(t, 1, report.CompletedCount)
+	assert.Equal(t, 1, report.CarriedOverCount)
+	assert.Zero(t, report.RemovedCount, "carried over boards are not removed scope")
+
+	// Moving a finished board later does not change the closed sprint
+	suite.plan(t, done, nil)
+	report, err = suite.service.GetSprintReport(suite.memberID.String(), sprint.SprintID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.CompletedCount)
+	assert.Zero(t, report.RemovedCount)
+}
+
+func TestSprintService_CloseSprint_WithoutNextSprintMovesToBacklog(t *testing.T) {
+	suite := setupSprintServiceTest(t)
+	sprint := suite.createSprint(t, "Sprint 1")
+	open := suite.board(t, "Open")
+	suite.plan(t, open, &sprint.SprintID)
+	_, err := suite.service.StartSprint(suite.adminID.String(), sprint.SprintID)
+	require.NoError(t, err)
+
+	// "진행중" also counts as finished for this close
+	suite.setStage(t, open, suite.stageOptions[1])
+	_, err = suite.service.CloseSprint(suite.adminID.String(), sprint.SprintID, &dto.CloseSprintRequest{
+		CompletedOptionIDs: []string{uuid.New().String()},
+	})
+	assert.Equal(t, 400, appErrorStatus(t, err), "options must belong to the Stage field")
+
+	result, err := suite.service.CloseSprint(suite.adminID.String(), sprint.SprintID, &dto.CloseSprintRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{open.String()}, result.MovedBoardIDs)
+	assert.Nil(t, result.NextSprintID)
+	assert.Nil(t, suite.boardSprint(t, open))
+
+	_, err = suite.service.CloseSprint(suite.adminID.String(), sprint.SprintID, &dto.CloseSprintRequest{})
+	assert.Equal(t, 409, appErrorStatus(t, err))
+}
+
+// ==================== View Filter Tests ====================
+
+func TestViewService_SprintFilter_CurrentSprint(t *testing.T) {
+	suite := setupSprintServiceTest(t)
+	sprint := suite.createSprint(t, "Sprint 1")
+	inSprint, backlog := suite.board(t, "In sprint"), suite.board(t, "Backlog")
+	suite.plan(t, inSprint, &sprint.SprintID)
+	compiler := &viewFilterCompiler{db: suite.db, projectID: suite.projectID, loc: time.UTC, strict: true}
+
+	filtered := func(operator string, value interface{}) []uuid.UUID {
+		var boardIDs []uuid.UUID
+		filter, err := compiler.compile(&domain.FilterNode{Field: "sprint", Operator: operator, Value: value})
+		require.NoError(t, err)
+		require.NoError(t, suite.db.Model(&domain.Board{}).Where(filter.sql, filter.args...).Pluck("id", &boardIDs).Error)
+		return boardIDs
+	}
+
+	assert.Empty(t, filtered("eq", "current"), "no active sprint yet")
+
+	_, err := suite.service.StartSprint(suite.adminID.String(), sprint.SprintID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{inSprint}, filtered("eq", "current"))
+	assert.Equal(t, []uuid.UUID{backlog}, filtered("eq", nil))
+}
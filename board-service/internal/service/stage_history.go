@@ -0,0 +1,117 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/cache"
+	"board-service/internal/domain"
+	"board-service/internal/repository"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// stageFieldName is the name of the system default field that holds the progress of a board
+const stageFieldName = "Stage"
+
+// Stage option labels of the default workflow; cycle time runs from the first to the second
+const (
+	inProgressStageLabel = "진행중"
+	completedStageLabel  = "완료"
+)
+
+// isStageField returns true for the system default Stage field of a project
+func isStageField(field *domain.ProjectField) bool {
+	return field.IsSystemDefault && field.Name == stageFieldName && field.FieldType == domain.FieldTypeSingleSelect
+}
+
+// findStageField returns the Stage field of the project, or nil if the project has none
+func findStageField(repo repository.FieldRepository, projectID uuid.UUID) (*domain.ProjectField, error) {
+	fields, err := repo.FindFieldsByProject(projectID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range fields {
+		if isStageField(&fields[i]) {
+			return &fields[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// currentStageOption returns the Stage option of the board, or nil if the board has no stage
+func currentStageOption(repo repository.FieldRepository, boardID, fieldID uuid.UUID) (*uuid.UUID, error) {
+	values, err := repo.FindFieldValuesByBoardAndField(boardID, fieldID)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		if value.ValueOptionID != nil {
+			optionID := *value.ValueOptionID
+			return &optionID, nil
+		}
+	}
+	return nil, nil
+}
+
+// logStageChange appends the change to the stage change log if the option changed
+// It returns true if a change was logged, so that the caller can drop the cached analytics after the commit
+func logStageChange(repo repository.StageChangeRepository, board *domain.Board, fieldID uuid.UUID, fromOptionID, toOptionID *uuid.UUID, actorID uuid.UUID) (bool, error) {
+	change := domain.NewBoardStageChange(board, fieldID, fromOptionID, toOptionID, actorID, time.Now())
+	if change == nil {
+		return false, nil
+	}
+	if err := repo.Create(change); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// invalidateProjectAnalytics drops the cached analytics of the project after a stage change
+// The reports also expire on their own, so a failure is only logged
+func invalidateProjectAnalytics(fieldCache cache.FieldCache, logger *zap.Logger, projectID uuid.UUID) {
+	if fieldCache == nil {
+		return
+	}
+	if err := fieldCache.InvalidateProjectAnalytics(context.Background(), projectID.String()); err != nil {
+		logger.Warn("Failed to invalidate project analytics cache", zap.Error(err), zap.String("project_id", projectID.String()))
+	}
+}
+
+// resolveStageOptions resolves the in-progress and completed Stage options by their labels
+// Without those labels the completed option is the last option and the in-progress option the one before it
+func resolveStageOptions(options []domain.FieldOption) (*uuid.UUID, uuid.UUID, error) {
+	if len(options) == 0 {
+		return nil, uuid.Nil, apperrors.New(apperrors.ErrCodeBadRequest, "Stage 필드에 옵션이 없습니다", 400)
+	}
+
+	ordered := make([]domain.FieldOption, len(options))
+	copy(ordered, options)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].DisplayOrder < ordered[j].DisplayOrder })
+
+	completedIndex, inProgressIndex := len(ordered)-1, -1
+	for i, option := range ordered {
+		if option.Label == completedStageLabel {
+			completedIndex = i
+			break
+		}
+	}
+	for i, option := range ordered {
+		if option.Label == inProgressStageLabel && i != completedIndex {
+			inProgressIndex = i
+			break
+		}
+	}
+	if inProgressIndex < 0 && completedIndex > 0 {
+		inProgressIndex = completedIndex - 1
+	}
+
+	completed := ordered[completedIndex].ID
+	if inProgressIndex < 0 {
+		return nil, completed, nil
+	}
+	inProgress := ordered[inProgressIndex].ID
+	return &inProgress, completed, nil
+}
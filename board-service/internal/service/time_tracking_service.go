@@ -0,0 +1,592 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/auth"
+	"board-service/internal/common/parser"
+	"board-service/internal/common/validator"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"board-service/internal/uow"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// workDateLayout is the format of work dates in requests and responses
+const workDateLayout = "2006-01-02"
+
+// TimeTrackingService는 보드의 예상 시간, 작업 시간 기록과 프로젝트 시간 리포트를 관리합니다
+// 프로젝트 멤버라면 누구나 예상 시간을 변경하고 자신의 작업 시간을 기록할 수 있으며,
+// 기록의 수정/삭제는 기록한 사용자 또는 ADMIN 이상만 가능합니다
+type TimeTrackingService interface {
+	UpdateEstimate(userID, boardID string, req *dto.UpdateEstimateRequest) (*dto.BoardTimeTrackingResponse, error)
+	GetWorkLogs(userID, boardID string) (*dto.BoardWorkLogsResponse, error)
+	CreateWorkLog(userID, boardID string, req *dto.CreateWorkLogRequest) (*dto.WorkLogResponse, error)
+	UpdateWorkLog(userID, boardID, workLogID string, req *dto.UpdateWorkLogRequest) (*dto.WorkLogResponse, error)
+	DeleteWorkLog(userID, boardID, workLogID string) error
+
+	// Project reports
+	GetProjectWorkLogs(userID, projectID string, req *dto.GetProjectWorkLogsRequest) (*dto.PaginatedWorkLogsResponse, error)
+	GetTimeReport(userID, projectID string, req *dto.GetTimeReportRequest) (*dto.TimeReportResponse, error)
+}
+
+type timeTrackingService struct {
+	repo       repository.WorkLogRepository
+	boardRepo  repository.BoardRepository
+	activities *boardActivityRecorder
+	authorizer auth.ProjectAuthorizer
+	logger     *zap.Logger
+	uow        uow.UnitOfWork
+}
+
+func NewTimeTrackingService(
+	repo repository.WorkLogRepository,
+	boardRepo repository.BoardRepository,
+	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
+	activityRepo repository.BoardActivityRepository,
+	logger *zap.Logger,
+	db *gorm.DB,
+) TimeTrackingService {
+	return &timeTrackingService{
+		repo:       repo,
+		boardRepo:  boardRepo,
+		activities: newBoardActivityRecorder(activityRepo, logger),
+		authorizer: auth.NewProjectAuthorizer(projectRepo, roleRepo),
+		logger:     logger,
+		uow:        uow.NewUnitOfWork(db),
+	}
+}
+
+// ==================== Estimates ====================
+
+// UpdateEstimate replaces the original and remaining estimates of the board
+func (s *timeTrackingService) UpdateEstimate(userID, boardID string, req *dto.UpdateEstimateRequest) (*dto.BoardTimeTrackingResponse, error) {
+	userUUID, board, err := s.findBoardForMember(userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep a copy of the current state for the activity history
+	before := *board
+	if err := board.SetEstimates(req.OriginalEstimateMinutes, req.RemainingEstimateMinutes); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	var response *dto.BoardTimeTrackingResponse
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.Board.UpdateEstimates(board); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "예상 시간 수정 실패", 500)
+		}
+		spent, err := repos.WorkLog.SumByBoards([]uuid.UUID{board.ID})
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "작업 시간 조회 실패", 500)
+		}
+		response = toBoardTimeTrackingResponse(board, spent[board.ID])
+
+		return writeTimeTrackingEvent(repos.Outbox, board, userUUID, "estimate_updated", map[string]interface{}{
+			"timeTracking": response,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	activity := domain.NewBoardActivity(board, userUUID, domain.BoardActivityUpdated)
+	activity.SetChange(domain.BoardActivityFieldEstimate, estimateActivityValue(&before), estimateActivityValue(board))
+	if activity.HasChanged() {
+		s.activities.record(activity)
+	}
+
+	return response, nil
+}
+
+// ==================== Work Logs ====================
+
+func (s *timeTrackingService) GetWorkLogs(userID, boardID string) (*dto.BoardWorkLogsResponse, error) {
+	_, board, err := s.findBoardForMember(userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	workLogs, err := s.repo.FindByBoard(board.ID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "작업 시간 조회 실패", 500)
+	}
+
+	response := &dto.BoardWorkLogsResponse{
+		WorkLogs: make([]dto.WorkLogResponse, 0, len(workLogs)),
+	}
+	spent := 0
+	for i := range workLogs {
+		response.WorkLogs = append(response.WorkLogs, toWorkLogResponse(&workLogs[i]))
+		spent += workLogs[i].DurationMinutes
+	}
+	response.TimeTracking = *toBoardTimeTrackingResponse(board, spent)
+	return response, nil
+}
+
+// CreateWorkLog logs time the user spent on the board
+func (s *timeTrackingService) CreateWorkLog(userID, boardID string, req *dto.CreateWorkLogRequest) (*dto.WorkLogResponse, error) {
+	userUUID, board, err := s.findBoardForMember(userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+	workDate, err := validator.ValidateDayFormat(req.WorkDate, "작업일")
+	if err != nil {
+		return nil, err
+	}
+
+	workLog, err := domain.NewWorkLog(board.ID, userUUID, req.DurationMinutes, *workDate, req.Note)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.WorkLog.Create(workLog); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "작업 시간 기록 실패", 500)
+		}
+		return writeWorkLogEvent(repos.Outbox, board, userUUID, "work_log_created", workLog)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.activities.record(workLogActivity(board, userUUID, nil, workLog))
+
+	response := toWorkLogResponse(workLog)
+	return &response, nil
+}
+
+func (s *timeTrackingService) UpdateWorkLog(userID, boardID, workLogID string, req *dto.UpdateWorkLogRequest) (*dto.WorkLogResponse, error) {
+	userUUID, board, err := s.findBoardForMember(userID, boardID)
+	if err != nil {
+		return nil, err
+	}
+	workLog, err := s.findWorkLog(board, workLogID)
+	if err != nil {
+		return nil, err
+	}
+
+	canEdit, err := s.authorizer.CanEdit(userUUID, board.ProjectID, workLog.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !canEdit {
+		return nil, apperrors.New(apperrors.ErrCodeForbidden, "작업 시간 기록을 수정할 권한이 없습니다", 403)
+	}
+
+	before := *workLog
+	if req.DurationMinutes != nil {
+		if err := workLog.UpdateDuration(*req.DurationMinutes); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+	if req.WorkDate != nil {
+		workDate, err := validator.ValidateDayFormat(*req.WorkDate, "작업일")
+		if err != nil {
+			return nil, err
+		}
+		workLog.UpdateWorkDate(*workDate)
+	}
+	if req.Note != nil {
+		if err := workLog.UpdateNote(*req.Note); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.WorkLog.Update(workLog); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "작업 시간 기록 수정 실패", 500)
+		}
+		return writeWorkLogEvent(repos.Outbox, board, userUUID, "work_log_updated", workLog)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if activity := workLogActivity(board, userUUID, &before, workLog); activity.HasChanged() {
+		s.activities.record(activity)
+	}
+
+	response := toWorkLogResponse(workLog)
+	return &response, nil
+}
+
+func (s *timeTrackingService) DeleteWorkLog(userID, boardID, workLogID string) error {
+	userUUID, board, err := s.findBoardForMember(userID, boardID)
+	if err != nil {
+		return err
+	}
+	workLog, err := s.findWorkLog(board, workLogID)
+	if err != nil {
+		return err
+	}
+
+	canDelete, err := s.authorizer.CanDelete(userUUID, board.ProjectID, workLog.UserID)
+	if err != nil {
+		return err
+	}
+	if !canDelete {
+		return apperrors.New(apperrors.ErrCodeForbidden, "작업 시간 기록을 삭제할 권한이 없습니다", 403)
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		if err := repos.WorkLog.Delete(workLog.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "작업 시간 기록 삭제 실패", 500)
+		}
+		return writeWorkLogEvent(repos.Outbox, board, userUUID, "work_log_deleted", workLog)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.activities.record(workLogActivity(board, userUUID, workLog, nil))
+	return nil
+}
+
+// ==================== Project Reports ====================
+
+// GetProjectWorkLogs lists the work logs of the project's boards that are not deleted, latest work date first
+func (s *timeTrackingService) GetProjectWorkLogs(userID, projectID string, req *dto.GetProjectWorkLogsRequest) (*dto.PaginatedWorkLogsResponse, error) {
+	projectUUID, err := s.requireProjectMember(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := parseWorkLogFilter(req.From, req.To, req.AssigneeID)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserID != "" {
+		userUUID, err := parser.ParseUUID(req.UserID, "사용자")
+		if err != nil {
+			return nil, err
+		}
+		filter.UserID = &userUUID
+	}
+
+	page, limit := req.Page, req.Limit
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 20
+	}
+
+	workLogs, total, err := s.repo.FindByProject(projectUUID, filter, page, limit)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "작업 시간 조회 실패", 500)
+	}
+
+	response := &dto.PaginatedWorkLogsResponse{
+		WorkLogs: make([]dto.WorkLogResponse, 0, len(workLogs)),
+		Total:    total,
+		Page:     page,
+		Limit:    limit,
+	}
+	for i := range workLogs {
+		response.WorkLogs = append(response.WorkLogs, toWorkLogResponse(&workLogs[i]))
+	}
+	return response, nil
+}
+
+// GetTimeReport sums the logged time of the project per assignee, user and day
+// The date range only applies to the logged time; estimates are those of the boards that are not deleted
+func (s *timeTrackingService) GetTimeReport(userID, projectID string, req *dto.GetTimeReportRequest) (*dto.TimeReportResponse, error) {
+	projectUUID, err := s.requireProjectMember(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := parseWorkLogFilter(req.From, req.To, req.AssigneeID)
+	if err != nil {
+		return nil, err
+	}
+
+	byAssignee, err := s.repo.SumByProject(projectUUID, filter, repository.WorkLogGroupAssignee)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "작업 시간 집계 실패", 500)
+	}
+	byUser, err := s.repo.SumByProject(projectUUID, filter, repository.WorkLogGroupUser)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "작업 시간 집계 실패", 500)
+	}
+	byDate, err := s.repo.SumByProject(projectUUID, filter, repository.WorkLogGroupDate)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "작업 시간 집계 실패", 500)
+	}
+	estimates, err := s.repo.SumEstimatesByAssignee(projectUUID, filter.AssigneeID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "예상 시간 집계 실패", 500)
+	}
+
+	response := &dto.TimeReportResponse{
+		From:       formatOptionalDay(filter.From),
+		To:         formatOptionalDay(filter.To),
+		ByAssignee: mergeAssigneeTimeReports(byAssignee, estimates),
+		ByUser:     make([]dto.UserTimeReport, 0, len(byUser)),
+		ByDate:     make([]dto.DateTimeReport, 0, len(byDate)),
+	}
+	for _, sum := range byUser {
+		if sum.UserID == nil {
+			continue
+		}
+		response.ByUser = append(response.ByUser, dto.UserTimeReport{UserID: sum.UserID.String(), TimeSpentMinutes: sum.Minutes})
+		response.TimeSpentMinutes += sum.Minutes
+	}
+	for _, sum := range byDate {
+		if sum.WorkDate == nil {
+			continue
+		}
+		response.ByDate = append(response.ByDate, dto.DateTimeReport{Date: sum.WorkDate.Format(workDateLayout), TimeSpentMinutes: sum.Minutes})
+	}
+	for _, estimate := range estimates {
+		response.OriginalEstimateMinutes += estimate.OriginalMinutes
+		response.RemainingEstimateMinutes += estimate.RemainingMinutes
+	}
+	return response, nil
+}
+
+// ==================== Helper Methods ====================
+
+// findBoardForMember finds the board and checks that the user is a member of its project
+func (s *timeTrackingService) findBoardForMember(userID, boardID string) (uuid.UUID, *domain.Board, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+	boardUUID, err := parser.ParseBoardID(boardID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	board, err := s.boardRepo.FindByID(boardUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, nil, apperrors.New(apperrors.ErrCodeNotFound, "보드를 찾을 수 없습니다", 404)
+		}
+		return uuid.Nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	if _, err := s.authorizer.RequireMember(userUUID, board.ProjectID); err != nil {
+		return uuid.Nil, nil, err
+	}
+	return userUUID, board, nil
+}
+
+// findWorkLog finds a work log of the board
+func (s *timeTrackingService) findWorkLog(board *domain.Board, workLogID string) (*domain.WorkLog, error) {
+	workLogUUID, err := parser.ParseUUID(workLogID, "작업 시간 기록")
+	if err != nil {
+		return nil, err
+	}
+
+	workLog, err := s.repo.FindByID(workLogUUID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "작업 시간 기록 조회 실패", 500)
+	}
+	if workLog == nil || workLog.BoardID != board.ID {
+		return nil, apperrors.New(apperrors.ErrCodeNotFound, "작업 시간 기록을 찾을 수 없습니다", 404)
+	}
+	return workLog, nil
+}
+
+// requireProjectMember checks that the user is a member of the project
+func (s *timeTrackingService) requireProjectMember(userID, projectID string) (uuid.UUID, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if _, err := s.authorizer.RequireMember(userUUID, projectUUID); err != nil {
+		return uuid.Nil, err
+	}
+	return projectUUID, nil
+}
+
+// parseWorkLogFilter parses the work date range (YYYY-MM-DD, inclusive) and the board assignee of a report
+func parseWorkLogFilter(from, to, assigneeID string) (repository.WorkLogFilter, error) {
+	var filter repository.WorkLogFilter
+	var err error
+	if from != "" {
+		if filter.From, err = validator.ValidateDayFormat(from, "시작일"); err != nil {
+			return filter, err
+		}
+	}
+	if to != "" {
+		if filter.To, err = validator.ValidateDayFormat(to, "종료일"); err != nil {
+			return filter, err
+		}
+	}
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return filter, apperrors.New(apperrors.ErrCodeBadRequest, "시작일은 종료일보다 늦을 수 없습니다", 400)
+	}
+	if assigneeID != "" {
+		assigneeUUID, err := parser.ParseUUID(assigneeID, "담당자")
+		if err != nil {
+			return filter, err
+		}
+		filter.AssigneeID = &assigneeUUID
+	}
+	return filter, nil
+}
+
+// mergeAssigneeTimeReports combines the logged time and the estimates of each assignee
+// Assignees are listed in the order of the logged time sums, followed by those with estimates only
+func mergeAssigneeTimeReports(spent []repository.WorkLogSum, estimates []repository.EstimateSum) []dto.AssigneeTimeReport {
+	reports := make([]dto.AssigneeTimeReport, 0, len(spent))
+	index := make(map[uuid.UUID]int)
+	unassigned := -1
+
+	find := func(assigneeID *uuid.UUID) *dto.AssigneeTimeReport {
+		if assigneeID == nil {
+			if unassigned < 0 {
+				unassigned = len(reports)
+				reports = append(reports, dto.AssigneeTimeReport{})
+			}
+			return &reports[unassigned]
+		}
+		i, ok := index[*assigneeID]
+		if !ok {
+			i = len(reports)
+			index[*assigneeID] = i
+			id := assigneeID.String()
+			reports = append(reports, dto.AssigneeTimeReport{AssigneeID: &id})
+		}
+		return &reports[i]
+	}
+
+	for _, sum := range spent {
+		find(sum.AssigneeID).TimeSpentMinutes += sum.Minutes
+	}
+	for _, estimate := range estimates {
+		report := find(estimate.AssigneeID)
+		report.OriginalEstimateMinutes += estimate.OriginalMinutes
+		report.RemainingEstimateMinutes += estimate.RemainingMinutes
+		report.EstimatedBoardCount += estimate.BoardCount
+	}
+	return reports
+}
+
+func formatOptionalDay(day *time.Time) *string {
+	if day == nil {
+		return nil
+	}
+	formatted := day.Format(workDateLayout)
+	return &formatted
+}
+
+// writeWorkLogEvent records a work log change of the board in the outbox
+func writeWorkLogEvent(outbox repository.OutboxWriter, board *domain.Board, actorID uuid.UUID, action string, workLog *domain.WorkLog) error {
+	return writeTimeTrackingEvent(outbox, board, actorID, action, map[string]interface{}{
+		"workLog": toWorkLogResponse(workLog),
+	})
+}
+
+// writeTimeTrackingEvent records a time tracking change of the board in the outbox
+func writeTimeTrackingEvent(outbox repository.OutboxWriter, board *domain.Board, actorID uuid.UUID, action string, data map[string]interface{}) error {
+	data["action"] = action
+	if err := outbox.Write(event.NewBoardEvent(event.BoardTimeTrackingChanged, board.ProjectID, board.ID, actorID, data)); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "시간 추적 이벤트 기록 실패", 500)
+	}
+	return nil
+}
+
+// estimateActivityValue encodes the estimates of a board for the activity history (nil without estimates)
+func estimateActivityValue(board *domain.Board) *string {
+	if board.OriginalEstimateMinutes == nil && board.RemainingEstimateMinutes == nil {
+		return nil
+	}
+	return encodeActivityValue(map[string]interface{}{
+		"originalEstimateMinutes":  board.OriginalEstimateMinutes,
+		"remainingEstimateMinutes": board.RemainingEstimateMinutes,
+	})
+}
+
+// workLogActivity records an added (old nil), changed or removed (new nil) work log
+// The note is not kept, so changing only the note is not recorded
+func workLogActivity(board *domain.Board, actorID uuid.UUID, oldLog, newLog *domain.WorkLog) domain.BoardActivity {
+	snapshot := func(workLog *domain.WorkLog) *string {
+		if workLog == nil {
+			return nil
+		}
+		return encodeActivityValue(map[string]interface{}{
+			"workLogId":       workLog.ID.String(),
+			"userId":          workLog.UserID.String(),
+			"durationMinutes": workLog.DurationMinutes,
+			"workDate":        workLog.WorkDate.Format(workDateLayout),
+		})
+	}
+
+	activity := domain.NewBoardActivity(board, actorID, domain.BoardActivityUpdated)
+	activity.SetChange(domain.BoardActivityFieldWorkLog, snapshot(oldLog), snapshot(newLog))
+	return activity
+}
+
+func toWorkLogResponse(workLog *domain.WorkLog) dto.WorkLogResponse {
+	return dto.WorkLogResponse{
+		WorkLogID:       workLog.ID.String(),
+		BoardID:         workLog.BoardID.String(),
+		UserID:          workLog.UserID.String(),
+		DurationMinutes: workLog.DurationMinutes,
+		WorkDate:        workLog.WorkDate.Format(workDateLayout),
+		Note:            workLog.Note,
+		CreatedAt:       workLog.CreatedAt,
+		UpdatedAt:       workLog.UpdatedAt,
+	}
+}
+
+func toBoardTimeTrackingResponse(board *domain.Board, spentMinutes int) *dto.BoardTimeTrackingResponse {
+	return &dto.BoardTimeTrackingResponse{
+		OriginalEstimateMinutes:  board.OriginalEstimateMinutes,
+		RemainingEstimateMinutes: board.RemainingEstimateMinutes,
+		TimeSpentMinutes:         spentMinutes,
+	}
+}
+
+// ==================== Time Tracking Reader ====================
+
+// boardTimeReader는 보드 응답에 포함되는 시간 추적 정보(예상 시간과 기록된 작업 시간)를 조회합니다
+// 보드 서비스와 뷰 서비스의 응답에 사용되며, 실패해도 보드 조회를 실패시키지 않습니다
+type boardTimeReader struct {
+	repo   repository.WorkLogRepository
+	logger *zap.Logger
+}
+
+func newBoardTimeReader(repo repository.WorkLogRepository, logger *zap.Logger) *boardTimeReader {
+	return &boardTimeReader{repo: repo, logger: logger}
+}
+
+// attach adds the time tracking of a single board to its response (best-effort)
+func (r *boardTimeReader) attach(response *dto.BoardResponse, board *domain.Board) {
+	timeTracking := r.list([]domain.Board{*board})
+	response.TimeTracking = timeTracking[board.ID]
+}
+
+// list returns the time tracking of the given boards (best-effort, empty on failure)
+func (r *boardTimeReader) list(boards []domain.Board) map[uuid.UUID]*dto.BoardTimeTrackingResponse {
+	boardIDs := make([]uuid.UUID, len(boards))
+	for i, board := range boards {
+		boardIDs[i] = board.ID
+	}
+	spent, err := r.repo.SumByBoards(boardIDs)
+	if err != nil {
+		r.logger.Warn("Failed to fetch logged time", zap.Error(err))
+		return make(map[uuid.UUID]*dto.BoardTimeTrackingResponse)
+	}
+
+	result := make(map[uuid.UUID]*dto.BoardTimeTrackingResponse, len(boards))
+	for i := range boards {
+		result[boards[i].ID] = toBoardTimeTrackingResponse(&boards[i], spent[boards[i].ID])
+	}
+	return result
+}
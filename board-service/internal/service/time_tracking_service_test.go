@@ -0,0 +1,285 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"board-service/internal/testutil"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ==================== Test Suite Setup ====================
+
+type TimeTrackingServiceTestSuite struct {
+	projectRepo *testutil.MockProjectRepository
+	db          *gorm.DB
+	service     *timeTrackingService
+	projectID   uuid.UUID
+	memberID    uuid.UUID
+}
+
+func setupTimeTrackingServiceTest(t *testing.T) *TimeTrackingServiceTestSuite {
+	db := NewMockDB()
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+
+	projectRepo := new(testutil.MockProjectRepository)
+	roleRepo := new(testutil.MockRoleRepository)
+	activityRepo := new(testutil.MockBoardActivityRepository)
+	roleRepo.On("FindByID", mock.Anything).Return(testutil.NewMemberRole(), nil).Maybe()
+	activityRepo.On("BatchCreate", mock.Anything).Return(nil).Maybe()
+
+	service := NewTimeTrackingService(repository.NewWorkLogRepository(db), repository.NewBoardRepository(db),
+		projectRepo, roleRepo, activityRepo, zap.NewNop(), db)
+
+	suite := &TimeTrackingServiceTestSuite{
+		projectRepo: projectRepo,
+		db:          db,
+		service:     service.(*timeTrackingService),
+		projectID:   uuid.New(),
+		memberID:    uuid.New(),
+	}
+	suite.addMember(suite.memberID)
+	return suite
+}
+
+func (s *TimeTrackingServiceTestSuite) addMember(userID uuid.UUID) {
+	s.projectRepo.On("FindMemberByUserAndProject", userID, s.projectID).
+		Return(&domain.ProjectMember{ProjectID: s.projectID, UserID: userID, RoleID: uuid.New()}, nil).Maybe()
+}
+
+// board inserts a board of the suite's project with the given assignee (nil for unassigned)
+func (s *TimeTrackingServiceTestSuite) board(t *testing.T, assigneeID *uuid.UUID) uuid.UUID {
+	boardID := uuid.New()
+	require.NoError(t, s.db.Exec("INSERT INTO boards (id, project_id, assignee_id) VALUES (?, ?, ?)", boardID, s.projectID, assigneeID).Error)
+	return boardID
+}
+
+func (s *TimeTrackingServiceTestSuite) logWork(t *testing.T, userID, boardID uuid.UUID, minutes int, workDate string) *dto.WorkLogResponse {
+	workLog, err := s.service.CreateWorkLog(userID.String(), boardID.String(), &dto.CreateWorkLogRequest{
+		DurationMinutes: minutes,
+		WorkDate:        workDate,
+	})
+	require.NoError(t, err)
+	return workLog
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+// ==================== Work Log Tests ====================
+
+func TestTimeTrackingService_CreateWorkLog_SumsLoggedTime(t *testing.T) {
+	suite := setupTimeTrackingServiceTest(t)
+	boardID := suite.board(t, nil)
+
+	// When
+	first := suite.logWork(t, suite.memberID, boardID, 90, "2026-03-02")
+	suite.logWork(t, suite.memberID, boardID, 30, "2026-03-03")
+
+	// Then
+	assert.Equal(t, "2026-03-02", first.WorkDate)
+	workLogs, err := suite.service.GetWorkLogs(suite.memberID.String(), boardID.String())
+	require.NoError(t, err)
+	require.Len(t, workLogs.WorkLogs, 2)
+	assert.Equal(t, "2026-03-03", workLogs.WorkLogs[0].WorkDate, "latest work date first")
+	assert.Equal(t, 120, workLogs.TimeTracking.TimeSpentMinutes)
+	assert.Equal(t, int64(2), countRows(t, suite.db, "outbox_events", "event_type = ?", string(event.BoardTimeTrackingChanged)))
+}
+
+func TestTimeTrackingService_CreateWorkLog_InvalidWorkDate(t *testing.T) {
+	suite := setupTimeTrackingServiceTest(t)
+
+	_, err := suite.service.CreateWorkLog(suite.memberID.String(), suite.board(t, nil).String(), &dto.CreateWorkLogRequest{
+		DurationMinutes: 30,
+		WorkDate:        "03/02/2026",
+	})
+
+	assert.Equal(t, 400, appErrorStatus(t, err))
+}
+
+func TestTimeTrackingService_UpdateWorkLog(t *testing.T) {
+	suite := setupTimeTrackingServiceTest(t)
+	boardID := suite.board(t, nil)
+	workLog := suite.logWork(t, suite.memberID, boardID, 60, "2026-03-02")
+	note := "Code review"
+
+	updated, err := suite.service.UpdateWorkLog(suite.memberID.String(), boardID.String(), workLog.WorkLogID, &dto.UpdateWorkLogRequest{
+		DurationMinutes: intPtr(45),
+		Note:            &note,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 45, updated.DurationMinutes)
+	assert.Equal(t, "Code review", updated.Note)
+	assert.Equal(t, "2026-03-02", updated.WorkDate)
+}
+
+func TestTimeTrackingService_UpdateAndDeleteWorkLog_OtherMemberForbidden(t *testing.T) {
+	suite := setupTimeTrackingServiceTest(t)
+	boardID := suite.board(t, nil)
+	workLog := suite.logWork(t, suite.memberID, boardID, 60, "2026-03-02")
+	otherMember := uuid.New()
+	suite.addMember(otherMember)
+
+	_, err := suite.service.UpdateWorkLog(otherMember.String(), boardID.String(), workLog.WorkLogID, &dto.UpdateWorkLogRequest{DurationMinutes: intPtr(10)})
+	assert.Equal(t, 403, appErrorStatus(t, err))
+
+	err = suite.service.DeleteWorkLog(otherMember.String(), boardID.String(), workLog.WorkLogID)
+	assert.Equal(t, 403, appErrorStatus(t, err))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "work_logs", "1 = 1"))
+
+	// The author can delete it
+	require.NoError(t, suite.service.DeleteWorkLog(suite.memberID.String(), boardID.String(), workLog.WorkLogID))
+	assert.Zero(t, countRows(t, suite.db, "work_logs", "1 = 1"))
+}
+
+func TestTimeTrackingService_DeleteWorkLog_OfAnotherBoard(t *testing.T) {
+	suite := setupTimeTrackingServiceTest(t)
+	workLog := suite.logWork(t, suite.memberID, suite.board(t, nil), 60, "2026-03-02")
+
+	err := suite.service.DeleteWorkLog(suite.memberID.String(), suite.board(t, nil).String(), workLog.WorkLogID)
+
+	assert.Equal(t, 404, appErrorStatus(t, err))
+}
+
+// ==================== Estimate Tests ====================
+
+func TestTimeTrackingService_UpdateEstimate(t *testing.T) {
+	suite := setupTimeTrackingServiceTest(t)
+	boardID := suite.board(t, nil)
+	suite.logWork(t, suite.memberID, boardID, 30, "2026-03-02")
+
+	// When
+	timeTracking, err := suite.service.UpdateEstimate(suite.memberID.String(), boardID.String(), &dto.UpdateEstimateRequest{
+		OriginalEstimateMinutes:  intPtr(240),
+		RemainingEstimateMinutes: intPtr(210),
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 240, *timeTracking.OriginalEstimateMinutes)
+	assert.Equal(t, 210, *timeTracking.RemainingEstimateMinutes)
+	assert.Equal(t, 30, timeTracking.TimeSpentMinutes)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "boards", "id = ? AND original_estimate_minutes = 240", boardID))
+
+	// When: Clearing the original estimate
+	timeTracking, err = suite.service.UpdateEstimate(suite.memberID.String(), boardID.String(), &dto.UpdateEstimateRequest{
+		RemainingEstimateMinutes: intPtr(210),
+	})
+	require.NoError(t, err)
+	assert.Nil(t, timeTracking.OriginalEstimateMinutes)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "boards", "id = ? AND original_estimate_minutes IS NULL", boardID))
+}
+
+func TestTimeTrackingService_UpdateEstimate_NotMember(t *testing.T) {
+	suite := setupTimeTrackingServiceTest(t)
+	outsider := uuid.New()
+	suite.projectRepo.On("FindMemberByUserAndProject", outsider, suite.projectID).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := suite.service.UpdateEstimate(outsider.String(), suite.board(t, nil).String(), &dto.UpdateEstimateRequest{
+		OriginalEstimateMinutes: intPtr(60),
+	})
+
+	assert.Equal(t, 403, appErrorStatus(t, err))
+}
+
+// ==================== Report Tests ====================
+
+func TestTimeTrackingService_GetTimeReport(t *testing.T) {
+	suite := setupTimeTrackingServiceTest(t)
+	assigneeID := uuid.New()
+	otherMember := uuid.New()
+	suite.addMember(otherMember)
+
+	assigned := suite.board(t, &assigneeID)
+	unassigned := suite.board(t, nil)
+	deleted := suite.board(t, &assigneeID)
+
+	suite.logWork(t, suite.memberID, assigned, 60, "2026-03-02")
+	suite.logWork(t, otherMember, assigned, 30, "2026-03-03")
+	suite.logWork(t, suite.memberID, unassigned, 15, "2026-03-03")
+	suite.logWork(t, suite.memberID, assigned, 120, "2026-02-20") // Before the range
+	suite.logWork(t, suite.memberID, deleted, 45, "2026-03-02")
+	_, err := suite.service.UpdateEstimate(suite.memberID.String(), assigned.String(), &dto.UpdateEstimateRequest{
+		OriginalEstimateMinutes: intPtr(300), RemainingEstimateMinutes: intPtr(100),
+	})
+	require.NoError(t, err)
+	require.NoError(t, suite.db.Exec("UPDATE boards SET is_deleted = true WHERE id = ?", deleted).Error)
+
+	// When
+	report, err := suite.service.GetTimeReport(suite.memberID.String(), suite.projectID.String(), &dto.GetTimeReportRequest{
+		From: "2026-03-01",
+		To:   "2026-03-31",
+	})
+
+	// Then: Only the logged time in the range of boards that are not deleted is counted
+	require.NoError(t, err)
+	assert.Equal(t, "2026-03-01", *report.From)
+	assert.Equal(t, 105, report.TimeSpentMinutes)
+	assert.Equal(t, 300, report.OriginalEstimateMinutes)
+	assert.Equal(t, 100, report.RemainingEstimateMinutes)
+
+	spentByAssignee := make(map[string]int)
+	for _, row := range report.ByAssignee {
+		key := "unassigned"
+		if row.AssigneeID != nil {
+			key = *row.AssigneeID
+			assert.Equal(t, 1, row.EstimatedBoardCount)
+			assert.Equal(t, 300, row.OriginalEstimateMinutes)
+		}
+		spentByAssignee[key] = row.TimeSpentMinutes
+	}
+	assert.Equal(t, map[string]int{assigneeID.String(): 90, "unassigned": 15}, spentByAssignee)
+
+	spentByUser := make(map[string]int)
+	for _, row := range report.ByUser {
+		spentByUser[row.UserID] = row.TimeSpentMinutes
+	}
+	assert.Equal(t, map[string]int{suite.memberID.String(): 75, otherMember.String(): 30}, spentByUser)
+
+	assert.Equal(t, []dto.DateTimeReport{
+		{Date: "2026-03-02", TimeSpentMinutes: 60},
+		{Date: "2026-03-03", TimeSpentMinutes: 45},
+	}, report.ByDate)
+}
+
+func TestTimeTrackingService_GetTimeReport_FromAfterTo(t *testing.T) {
+	suite := setupTimeTrackingServiceTest(t)
+
+	_, err := suite.service.GetTimeReport(suite.memberID.String(), suite.projectID.String(), &dto.GetTimeReportRequest{
+		From: "2026-03-31",
+		To:   "2026-03-01",
+	})
+
+	assert.Equal(t, 400, appErrorStatus(t, err))
+}
+
+func TestTimeTrackingService_GetProjectWorkLogs_FiltersByUser(t *testing.T) {
+	suite := setupTimeTrackingServiceTest(t)
+	otherMember := uuid.New()
+	suite.addMember(otherMember)
+	boardID := suite.board(t, nil)
+	suite.logWork(t, suite.memberID, boardID, 60, "2026-03-02")
+	suite.logWork(t, otherMember, boardID, 30, "2026-03-03")
+
+	workLogs, err := suite.service.GetProjectWorkLogs(suite.memberID.String(), suite.projectID.String(), &dto.GetProjectWorkLogsRequest{
+		UserID: otherMember.String(),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), workLogs.Total)
+	require.Len(t, workLogs.WorkLogs, 1)
+	assert.Equal(t, otherMember.String(), workLogs.WorkLogs[0].UserID)
+	assert.Equal(t, 20, workLogs.Limit)
+}
@@ -0,0 +1,427 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/auth"
+	"board-service/internal/common/pagination"
+	"board-service/internal/common/parser"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"board-service/internal/storage"
+	"board-service/internal/uow"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// trashPurgeBatchSize limits how many expired items one PurgeExpired call removes
+const trashPurgeBatchSize = 100
+
+// TrashRetention is how long deleted items stay in the trash before PurgeExpired removes them
+// Zero disables automatic purging
+type TrashRetention time.Duration
+
+// TrashService는 삭제된 보드/댓글/프로젝트의 휴지통 조회, 복원, 영구 삭제를 담당합니다
+// 휴지통 항목은 BoardService.DeleteBoard, CommentService.DeleteComment,
+// ProjectService.DeleteProject(soft 모드)가 삭제와 같은 트랜잭션으로 기록합니다
+type TrashService interface {
+	// Boards and comments of a project
+	GetProjectTrash(userID, projectID string, req *dto.GetTrashRequest) (*dto.PaginatedTrashItemsResponse, error)
+	RestoreItem(userID, projectID, trashID string) error
+	PurgeItem(userID, projectID, trashID string) error
+
+	// Projects (OWNER only)
+	GetTrashedProjects(userID string, req *dto.GetTrashedProjectsRequest) (*dto.PaginatedTrashItemsResponse, error)
+	RestoreProject(userID, projectID string) error
+	PurgeProject(userID, projectID string) error
+
+	// PurgeExpired permanently deletes items older than the retention period and returns how many were purged
+	PurgeExpired() (int, error)
+}
+
+type trashService struct {
+	repo       repository.TrashRepository
+	blobs      *attachmentBlobs
+	authorizer auth.ProjectAuthorizer
+	retention  time.Duration
+	logger     *zap.Logger
+	uow        uow.UnitOfWork
+	now        func() time.Time
+}
+
+func NewTrashService(
+	repo repository.TrashRepository,
+	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
+	attachmentRepo repository.AttachmentRepository,
+	store storage.Storage,
+	retention TrashRetention,
+	logger *zap.Logger,
+	db *gorm.DB,
+) TrashService {
+	return &trashService{
+		repo:       repo,
+		blobs:      newAttachmentBlobs(attachmentRepo, store, logger),
+		authorizer: auth.NewProjectAuthorizer(projectRepo, roleRepo),
+		retention:  time.Duration(retention),
+		logger:     logger,
+		uow:        uow.NewUnitOfWork(db),
+		now:        time.Now,
+	}
+}
+
+// ==================== Project Trash (Boards, Comments) ====================
+
+func (s *trashService) GetProjectTrash(userID, projectID string, req *dto.GetTrashRequest) (*dto.PaginatedTrashItemsResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorizer.RequireMember(userUUID, projectUUID); err != nil {
+		return nil, err
+	}
+
+	page, limit := pagination.ValidatePaginationParams(req.Page, req.Limit)
+	items, total, err := s.repo.FindByProject(projectUUID, domain.TrashItemType(req.Type), page, limit)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "휴지통 조회 실패", 500)
+	}
+
+	return s.toPaginatedResponse(items, total, page, limit), nil
+}
+
+func (s *trashService) RestoreItem(userID, projectID, trashID string) error {
+	userUUID, item, err := s.findProjectTrashItem(userID, projectID, trashID)
+	if err != nil {
+		return err
+	}
+
+	// Whoever deleted the item or an ADMIN+ may restore it, as long as they are still a member
+	if _, err := s.authorizer.RequireMember(userUUID, item.ProjectID); err != nil {
+		return err
+	}
+	canRestore, err := s.authorizer.CanDelete(userUUID, item.ProjectID, item.DeletedBy)
+	if err != nil {
+		return err
+	}
+	if !canRestore {
+		return apperrors.New(apperrors.ErrCodeForbidden, "복원 권한이 없습니다", 403)
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		var evt event.Event
+
+		switch item.ItemType {
+		case domain.TrashItemBoard:
+			if _, err := repos.Trash.FindDeletedBoard(item.ItemID); err != nil {
+				return deletedEntityError(err, "삭제된 보드를 찾을 수 없습니다")
+			}
+			if err := repos.Trash.RestoreBoard(item.ItemID, item.DeletedAt); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 복원 실패", 500)
+			}
+			evt = event.NewBoardEvent(event.BoardRestored, item.ProjectID, item.ItemID, userUUID, nil)
+
+		case domain.TrashItemComment:
+			comment, err := repos.Trash.FindDeletedComment(item.ItemID)
+			if err != nil {
+				return deletedEntityError(err, "삭제된 댓글을 찾을 수 없습니다")
+			}
+			// A comment cannot be restored onto a board that is still in the trash
+			if _, err := repos.Board.FindByID(comment.BoardID); err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return apperrors.New(apperrors.ErrCodeConflict, "보드가 삭제된 상태입니다. 보드를 먼저 복원해주세요", 409)
+				}
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+			}
+			// Likewise a reply needs its parent comment
+			if comment.IsReply() {
+				if _, err := repos.Comment.FindByID(*comment.ParentCommentID); err != nil {
+					if errors.Is(err, gorm.ErrRecordNotFound) {
+						return apperrors.New(apperrors.ErrCodeConflict, "상위 댓글이 삭제된 상태입니다. 상위 댓글을 먼저 복원해주세요", 409)
+					}
+					return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "상위 댓글 조회 실패", 500)
+				}
+			}
+			if err := repos.Trash.RestoreComment(item.ItemID, item.DeletedAt); err != nil {
+				return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "댓글 복원 실패", 500)
+			}
+			evt = event.NewBoardEvent(event.CommentRestored, item.ProjectID, comment.BoardID, userUUID,
+				map[string]interface{}{"commentId": comment.ID.String()})
+		}
+
+		if err := repos.Trash.Delete(item.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "휴지통 항목 삭제 실패", 500)
+		}
+		if err := repos.Outbox.Write(evt); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "복원 이벤트 기록 실패", 500)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Trash item restored",
+		zap.String("trash_id", trashID),
+		zap.String("item_type", string(item.ItemType)),
+		zap.String("item_id", item.ItemID.String()),
+	)
+	return nil
+}
+
+func (s *trashService) PurgeItem(userID, projectID, trashID string) error {
+	userUUID, item, err := s.findProjectTrashItem(userID, projectID, trashID)
+	if err != nil {
+		return err
+	}
+
+	// Permanent deletion cannot be undone, so it is limited to ADMIN+
+	if _, err := s.authorizer.RequireAdmin(userUUID, item.ProjectID); err != nil {
+		return err
+	}
+
+	if err := s.purge(item); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "영구 삭제 실패", 500)
+	}
+	return nil
+}
+
+// ==================== Project Trash ====================
+
+func (s *trashService) GetTrashedProjects(userID string, req *dto.GetTrashedProjectsRequest) (*dto.PaginatedTrashItemsResponse, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, limit := pagination.ValidatePaginationParams(req.Page, req.Limit)
+	items, total, err := s.repo.FindProjectsByOwner(userUUID, page, limit)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "휴지통 조회 실패", 500)
+	}
+
+	return s.toPaginatedResponse(items, total, page, limit), nil
+}
+
+func (s *trashService) RestoreProject(userID, projectID string) error {
+	userUUID, item, err := s.findTrashedProject(userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	err = s.uow.Do(func(repos *uow.Repositories) error {
+		result, err := repos.ProjectCascade.RestoreProjectData(item.ItemID, item.DeletedAt)
+		if err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 복원 실패", 500)
+		}
+		if err := repos.Trash.Delete(item.ID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "휴지통 항목 삭제 실패", 500)
+		}
+		if err := repos.Outbox.Write(event.New(event.ProjectRestored, item.ItemID, userUUID, nil)); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "복원 이벤트 기록 실패", 500)
+		}
+
+		s.logger.Info("Project restored",
+			zap.String("project_id", projectID),
+			zap.Any("restored_rows", result),
+		)
+		return nil
+	})
+	return err
+}
+
+func (s *trashService) PurgeProject(userID, projectID string) error {
+	_, item, err := s.findTrashedProject(userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.purge(item); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 영구 삭제 실패", 500)
+	}
+	return nil
+}
+
+// ==================== Retention ====================
+
+func (s *trashService) PurgeExpired() (int, error) {
+	if s.retention <= 0 {
+		return 0, nil
+	}
+
+	items, err := s.repo.FindDeletedBefore(s.now().Add(-s.retention), trashPurgeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for i := range items {
+		// One failing item must not block the others; it is retried on the next run
+		if err := s.purge(&items[i]); err != nil {
+			s.logger.Warn("Failed to purge expired trash item",
+				zap.Error(err),
+				zap.String("trash_id", items[i].ID.String()),
+				zap.String("item_type", string(items[i].ItemType)),
+			)
+			continue
+		}
+		purged++
+	}
+
+	return purged, nil
+}
+
+// ==================== Helpers ====================
+
+// purge permanently deletes a trashed entity together with its data and the trash item
+// Stored attachment content is released after the commit, once no other attachment uses it
+func (s *trashService) purge(item *domain.TrashItem) error {
+	var storageKeys []string
+	err := s.uow.Do(func(repos *uow.Repositories) error {
+		var err error
+		switch item.ItemType {
+		case domain.TrashItemBoard:
+			if storageKeys, err = repos.Attachment.FindStorageKeysByBoard(item.ItemID); err != nil {
+				return err
+			}
+			if err := repos.Trash.PurgeBoard(item.ItemID); err != nil {
+				return err
+			}
+		case domain.TrashItemComment:
+			if storageKeys, err = repos.Attachment.FindStorageKeysByCommentThread(item.ItemID); err != nil {
+				return err
+			}
+			if err := repos.Trash.PurgeComment(item.ItemID); err != nil {
+				return err
+			}
+		case domain.TrashItemProject:
+			if storageKeys, err = repos.Attachment.FindStorageKeysByProject(item.ItemID); err != nil {
+				return err
+			}
+			if _, err := repos.ProjectCascade.PurgeProjectData(item.ItemID); err != nil {
+				return err
+			}
+		}
+		return repos.Trash.Delete(item.ID)
+	})
+	if err != nil {
+		return err
+	}
+	s.blobs.release(storageKeys)
+
+	s.logger.Info("Trash item purged",
+		zap.String("trash_id", item.ID.String()),
+		zap.String("item_type", string(item.ItemType)),
+		zap.String("item_id", item.ItemID.String()),
+	)
+	return nil
+}
+
+// findProjectTrashItem loads a board or comment trash item and makes sure it belongs to the project
+func (s *trashService) findProjectTrashItem(userID, projectID, trashID string) (uuid.UUID, *domain.TrashItem, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	trashUUID, err := parser.ParseUUID(trashID, "휴지통 항목")
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	item, err := s.repo.FindByID(trashUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, nil, apperrors.New(apperrors.ErrCodeNotFound, "휴지통 항목을 찾을 수 없습니다", 404)
+		}
+		return uuid.Nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "휴지통 항목 조회 실패", 500)
+	}
+	if !item.BelongsToProject(projectUUID) || item.ItemType == domain.TrashItemProject {
+		return uuid.Nil, nil, apperrors.New(apperrors.ErrCodeNotFound, "휴지통 항목을 찾을 수 없습니다", 404)
+	}
+
+	return userUUID, item, nil
+}
+
+// findTrashedProject loads the trash item of a deleted project; only the project owner may access it
+func (s *trashService) findTrashedProject(userID, projectID string) (uuid.UUID, *domain.TrashItem, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	item, err := s.repo.FindByItem(domain.TrashItemProject, projectUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, nil, apperrors.New(apperrors.ErrCodeNotFound, "휴지통에서 프로젝트를 찾을 수 없습니다", 404)
+		}
+		return uuid.Nil, nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "휴지통 항목 조회 실패", 500)
+	}
+
+	project, err := s.repo.FindDeletedProject(projectUUID)
+	if err != nil {
+		return uuid.Nil, nil, deletedEntityError(err, "휴지통에서 프로젝트를 찾을 수 없습니다")
+	}
+	if !project.IsOwnedBy(userUUID) {
+		return uuid.Nil, nil, apperrors.New(apperrors.ErrCodeForbidden, "프로젝트 소유자만 복원하거나 영구 삭제할 수 있습니다", 403)
+	}
+
+	return userUUID, item, nil
+}
+
+func (s *trashService) toPaginatedResponse(items []domain.TrashItem, total int64, page, limit int) *dto.PaginatedTrashItemsResponse {
+	responses := make([]dto.TrashItemResponse, 0, len(items))
+	for i := range items {
+		item := &items[i]
+		response := dto.TrashItemResponse{
+			ID:        item.ID.String(),
+			ItemType:  string(item.ItemType),
+			ItemID:    item.ItemID.String(),
+			ProjectID: item.ProjectID.String(),
+			Title:     item.Title,
+			DeletedBy: item.DeletedBy.String(),
+			DeletedAt: item.DeletedAt,
+		}
+		if s.retention > 0 {
+			purgeAt := item.PurgeAt(s.retention)
+			response.PurgeAt = &purgeAt
+		}
+		responses = append(responses, response)
+	}
+
+	return &dto.PaginatedTrashItemsResponse{
+		Items: responses,
+		Total: total,
+		Page:  page,
+		Limit: limit,
+	}
+}
+
+// deletedEntityError maps a failed lookup of a trashed entity to 404 or 500
+func deletedEntityError(err error, notFoundMsg string) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return apperrors.New(apperrors.ErrCodeNotFound, notFoundMsg, 404)
+	}
+	return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "삭제된 항목 조회 실패", 500)
+}
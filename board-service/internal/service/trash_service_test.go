@@ -0,0 +1,404 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"board-service/internal/storage"
+	"board-service/internal/testutil"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ==================== Test Suite Setup ====================
+
+type TrashServiceTestSuite struct {
+	projectRepo *testutil.MockProjectRepository
+	roleRepo    *testutil.MockRoleRepository
+	trashRepo   repository.TrashRepository
+	store       storage.Storage
+	db          *gorm.DB
+	service     TrashService
+}
+
+func setupTrashServiceTest(t *testing.T) *TrashServiceTestSuite {
+	projectRepo := new(testutil.MockProjectRepository)
+	roleRepo := new(testutil.MockRoleRepository)
+
+	db := NewMockDB()
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+	trashRepo := repository.NewTrashRepository(db)
+	store, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	service := NewTrashService(trashRepo, projectRepo, roleRepo, repository.NewAttachmentRepository(db), store,
+		TrashRetention(30*24*time.Hour), zap.NewNop(), db)
+
+	return &TrashServiceTestSuite{
+		projectRepo: projectRepo,
+		roleRepo:    roleRepo,
+		trashRepo:   trashRepo,
+		store:       store,
+		db:          db,
+		service:     service,
+	}
+}
+
+func (suite *TrashServiceTestSuite) expectMember(userID, projectID uuid.UUID, role *domain.Role) {
+	roleID := uuid.New()
+	suite.projectRepo.On("FindMemberByUserAndProject", userID, projectID).
+		Return(&domain.ProjectMember{ProjectID: projectID, UserID: userID, RoleID: roleID}, nil)
+	suite.roleRepo.On("FindByID", roleID).Return(role, nil)
+}
+
+// trashedBoard is a board moved to the trash together with one comment and one field value,
+// plus a comment that had already been deleted on its own before the board
+type trashedBoard struct {
+	projectID      uuid.UUID
+	boardID        uuid.UUID
+	commentID      uuid.UUID
+	earlierComment uuid.UUID
+	fieldValueID   uuid.UUID
+	deletedBy      uuid.UUID
+	trashID        uuid.UUID
+	earlierTrashID uuid.UUID
+	boardDeletedAt time.Time
+}
+
+func seedTrashedBoard(t *testing.T, suite *TrashServiceTestSuite) trashedBoard {
+	b := trashedBoard{
+		projectID:      uuid.New(),
+		boardID:        uuid.New(),
+		commentID:      uuid.New(),
+		earlierComment: uuid.New(),
+		fieldValueID:   uuid.New(),
+		deletedBy:      uuid.New(),
+	}
+	earlier := time.Now().Add(-time.Hour)
+
+	require.NoError(t, suite.db.Exec("INSERT INTO boards (id, project_id) VALUES (?, ?)", b.boardID, b.projectID).Error)
+	require.NoError(t, suite.db.Exec("INSERT INTO comments (id, board_id, content, updated_at) VALUES (?, ?, 'kept', ?), (?, ?, 'removed earlier', ?)",
+		b.commentID, b.boardID, earlier, b.earlierComment, b.boardID, earlier).Error)
+	require.NoError(t, suite.db.Exec("INSERT INTO board_field_values (id, board_id, field_id, updated_at) VALUES (?, ?, ?, ?)",
+		b.fieldValueID, b.boardID, uuid.New(), earlier).Error)
+
+	// The comment deleted on its own an hour ago
+	earlierItem := domain.NewTrashItem(domain.TrashItemComment, b.earlierComment, b.projectID, b.deletedBy, "removed earlier")
+	earlierItem.DeletedAt = earlier
+	require.NoError(t, suite.trashRepo.Create(earlierItem))
+	require.NoError(t, suite.db.Exec("UPDATE comments SET is_deleted = true WHERE id = ?", b.earlierComment).Error)
+	b.earlierTrashID = earlierItem.ID
+
+	// The board deletion (as done by BoardService.DeleteBoard)
+	boardItem := domain.NewTrashItem(domain.TrashItemBoard, b.boardID, b.projectID, b.deletedBy, "Board")
+	require.NoError(t, suite.trashRepo.Create(boardItem))
+	for _, table := range []string{"comments", "board_field_values"} {
+		require.NoError(t, suite.db.Table(table).
+			Where("board_id = ? AND is_deleted = ?", b.boardID, false).
+			Updates(map[string]interface{}{"is_deleted": true, "updated_at": time.Now()}).Error)
+	}
+	require.NoError(t, suite.db.Exec("UPDATE boards SET is_deleted = true, updated_at = ? WHERE id = ?", time.Now(), b.boardID).Error)
+	b.trashID = boardItem.ID
+	b.boardDeletedAt = boardItem.DeletedAt
+
+	return b
+}
+
+// ==================== GetProjectTrash Tests ====================
+
+func TestTrashService_GetProjectTrash_FiltersByType(t *testing.T) {
+	suite := setupTrashServiceTest(t)
+	b := seedTrashedBoard(t, suite)
+	suite.expectMember(b.deletedBy, b.projectID, &domain.Role{Name: "MEMBER", Level: 10})
+
+	// When
+	all, err := suite.service.GetProjectTrash(b.deletedBy.String(), b.projectID.String(), &dto.GetTrashRequest{})
+	require.NoError(t, err)
+	boards, err := suite.service.GetProjectTrash(b.deletedBy.String(), b.projectID.String(), &dto.GetTrashRequest{Type: "board"})
+	require.NoError(t, err)
+
+	// Then: newest first, with the purge date derived from the retention
+	require.Len(t, all.Items, 2)
+	assert.Equal(t, int64(2), all.Total)
+	assert.Equal(t, b.trashID.String(), all.Items[0].ID)
+	assert.Equal(t, "comment", all.Items[1].ItemType)
+	require.Len(t, boards.Items, 1)
+	assert.Equal(t, b.boardID.String(), boards.Items[0].ItemID)
+	require.NotNil(t, boards.Items[0].PurgeAt)
+	assert.WithinDuration(t, b.boardDeletedAt.Add(30*24*time.Hour), *boards.Items[0].PurgeAt, time.Second)
+}
+
+func TestTrashService_GetProjectTrash_Paginates(t *testing.T) {
+	suite := setupTrashServiceTest(t)
+	b := seedTrashedBoard(t, suite)
+	suite.expectMember(b.deletedBy, b.projectID, &domain.Role{Name: "MEMBER", Level: 10})
+
+	// When
+	first, err := suite.service.GetProjectTrash(b.deletedBy.String(), b.projectID.String(), &dto.GetTrashRequest{Page: 1, Limit: 1})
+	require.NoError(t, err)
+	second, err := suite.service.GetProjectTrash(b.deletedBy.String(), b.projectID.String(), &dto.GetTrashRequest{Page: 2, Limit: 1})
+	require.NoError(t, err)
+
+	// Then: Each page has one item and reports the total
+	require.Len(t, first.Items, 1)
+	require.Len(t, second.Items, 1)
+	assert.Equal(t, int64(2), first.Total)
+	assert.Equal(t, 1, first.Limit)
+	assert.Equal(t, 2, second.Page)
+	assert.NotEqual(t, first.Items[0].ID, second.Items[0].ID)
+}
+
+// ==================== RestoreItem Tests ====================
+
+func TestTrashService_RestoreItem_BoardRestoresRowsDeletedWithIt(t *testing.T) {
+	suite := setupTrashServiceTest(t)
+	b := seedTrashedBoard(t, suite)
+	suite.expectMember(b.deletedBy, b.projectID, &domain.Role{Name: "MEMBER", Level: 10})
+
+	// When
+	err := suite.service.RestoreItem(b.deletedBy.String(), b.projectID.String(), b.trashID.String())
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "boards", "id = ? AND is_deleted = ?", b.boardID, false))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "comments", "id = ? AND is_deleted = ?", b.commentID, false))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "board_field_values", "id = ? AND is_deleted = ?", b.fieldValueID, false))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "comments", "id = ? AND is_deleted = ?", b.earlierComment, true),
+		"a comment deleted before the board stays in the trash")
+	assert.Zero(t, countRows(t, suite.db, "trash_items", "id = ?", b.trashID))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "trash_items", "id = ?", b.earlierTrashID))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "outbox_events", "event_type = ?", string(event.BoardRestored)))
+}
+
+func TestTrashService_RestoreItem_CommentOfTrashedBoard(t *testing.T) {
+	suite := setupTrashServiceTest(t)
+	b := seedTrashedBoard(t, suite)
+	suite.expectMember(b.deletedBy, b.projectID, &domain.Role{Name: "MEMBER", Level: 10})
+
+	// When
+	err := suite.service.RestoreItem(b.deletedBy.String(), b.projectID.String(), b.earlierTrashID.String())
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "보드를 먼저 복원해주세요")
+	assert.Equal(t, int64(1), countRows(t, suite.db, "trash_items", "id = ?", b.earlierTrashID))
+}
+
+func TestTrashService_RestoreItem_ReplyOfDeletedComment(t *testing.T) {
+	suite := setupTrashServiceTest(t)
+	b := seedTrashedBoard(t, suite)
+	suite.expectMember(b.deletedBy, b.projectID, &domain.Role{Name: "MEMBER", Level: 10})
+
+	// Given: a reply to the earlier deleted comment, deleted on its own while the board was live
+	replyID := uuid.New()
+	require.NoError(t, suite.db.Exec("UPDATE boards SET is_deleted = false WHERE id = ?", b.boardID).Error)
+	require.NoError(t, suite.db.Exec("INSERT INTO comments (id, board_id, content, parent_comment_id, depth, is_deleted) VALUES (?, ?, 'reply', ?, 1, true)",
+		replyID, b.boardID, b.earlierComment).Error)
+	replyItem := domain.NewTrashItem(domain.TrashItemComment, replyID, b.projectID, b.deletedBy, "reply")
+	require.NoError(t, suite.trashRepo.Create(replyItem))
+
+	// When
+	err := suite.service.RestoreItem(b.deletedBy.String(), b.projectID.String(), replyItem.ID.String())
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "상위 댓글을 먼저 복원해주세요")
+	assert.Equal(t, int64(1), countRows(t, suite.db, "comments", "id = ? AND is_deleted = ?", replyID, true))
+}
+
+func TestTrashService_RestoreItem_NotDeleterNorAdmin(t *testing.T) {
+	suite := setupTrashServiceTest(t)
+	b := seedTrashedBoard(t, suite)
+	otherUser := uuid.New()
+	suite.expectMember(otherUser, b.projectID, &domain.Role{Name: "MEMBER", Level: 10})
+
+	// When
+	err := suite.service.RestoreItem(otherUser.String(), b.projectID.String(), b.trashID.String())
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "복원 권한이 없습니다")
+	assert.Equal(t, int64(1), countRows(t, suite.db, "boards", "id = ? AND is_deleted = ?", b.boardID, true))
+}
+
+func TestTrashService_RestoreItem_OtherProject(t *testing.T) {
+	suite := setupTrashServiceTest(t)
+	b := seedTrashedBoard(t, suite)
+
+	// When
+	err := suite.service.RestoreItem(b.deletedBy.String(), uuid.New().String(), b.trashID.String())
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "휴지통 항목을 찾을 수 없습니다")
+}
+
+// ==================== PurgeItem Tests ====================
+
+func TestTrashService_PurgeItem_RemovesBoardAndItsData(t *testing.T) {
+	suite := setupTrashServiceTest(t)
+	b := seedTrashedBoard(t, suite)
+	adminID := uuid.New()
+	suite.expectMember(adminID, b.projectID, &domain.Role{Name: "ADMIN", Level: 50})
+
+	// When
+	err := suite.service.PurgeItem(adminID.String(), b.projectID.String(), b.trashID.String())
+
+	// Then
+	require.NoError(t, err)
+	assert.Zero(t, countRows(t, suite.db, "boards", "id = ?", b.boardID))
+	assert.Zero(t, countRows(t, suite.db, "comments", "board_id = ?", b.boardID))
+	assert.Zero(t, countRows(t, suite.db, "board_field_values", "board_id = ?", b.boardID))
+	assert.Zero(t, countRows(t, suite.db, "trash_items", "1 = 1"), "trash items of the board's comments are purged too")
+}
+
+func TestTrashService_PurgeItem_ReleasesUnsharedAttachmentContent(t *testing.T) {
+	suite := setupTrashServiceTest(t)
+	b := seedTrashedBoard(t, suite)
+	adminID := uuid.New()
+	suite.expectMember(adminID, b.projectID, &domain.Role{Name: "ADMIN", Level: 50})
+
+	// Given: Two files of the trashed board, one of them also attached to another board
+	ctx := context.Background()
+	onlyHere, shared := storage.ObjectKey(strings.Repeat("a", 64)), storage.ObjectKey(strings.Repeat("b", 64))
+	for _, key := range []string{onlyHere, shared} {
+		require.NoError(t, suite.store.Put(ctx, key, strings.NewReader("x"), 1, "text/plain"))
+	}
+	attach := func(boardID uuid.UUID, key string) {
+		require.NoError(t, suite.db.Exec("INSERT INTO attachments (id, board_id, file_name, storage_key) VALUES (?, ?, 'file.txt', ?)",
+			uuid.New(), boardID, key).Error)
+	}
+	attach(b.boardID, onlyHere)
+	attach(b.boardID, shared)
+	attach(uuid.New(), shared)
+
+	// When
+	err := suite.service.PurgeItem(adminID.String(), b.projectID.String(), b.trashID.String())
+
+	// Then: The shared content is kept for the other board
+	require.NoError(t, err)
+	assert.Zero(t, countRows(t, suite.db, "attachments", "board_id = ?", b.boardID))
+	exists, err := suite.store.Exists(ctx, onlyHere)
+	require.NoError(t, err)
+	assert.False(t, exists)
+	exists, err = suite.store.Exists(ctx, shared)
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestTrashService_PurgeItem_MemberForbidden(t *testing.T) {
+	suite := setupTrashServiceTest(t)
+	b := seedTrashedBoard(t, suite)
+	suite.expectMember(b.deletedBy, b.projectID, &domain.Role{Name: "MEMBER", Level: 10})
+
+	// When
+	err := suite.service.PurgeItem(b.deletedBy.String(), b.projectID.String(), b.trashID.String())
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "ADMIN 이상의 권한이 필요합니다")
+	assert.Equal(t, int64(1), countRows(t, suite.db, "boards", "id = ?", b.boardID))
+}
+
+// ==================== Project Trash Tests ====================
+
+func TestTrashService_RestoreProject_Owner(t *testing.T) {
+	suite := setupTrashServiceTest(t)
+	b := seedTrashedBoard(t, suite)
+	ownerID := uuid.New()
+	require.NoError(t, suite.db.Exec("INSERT INTO projects (id, owner_id, name) VALUES (?, ?, 'Project')", b.projectID, ownerID).Error)
+	require.NoError(t, suite.db.Exec("INSERT INTO project_members (id, project_id, user_id) VALUES (?, ?, ?)", uuid.New(), b.projectID, ownerID).Error)
+	viewID := uuid.New()
+	require.NoError(t, suite.db.Exec("INSERT INTO saved_views (id, project_id) VALUES (?, ?)", viewID, b.projectID).Error)
+	require.NoError(t, suite.db.Exec("INSERT INTO user_board_order (id, view_id, user_id, board_id, position) VALUES (?, ?, ?, ?, 'a0')",
+		uuid.New(), viewID, ownerID, b.boardID).Error)
+
+	// Given: the project is soft deleted (as done by ProjectService.DeleteProject)
+	projectItem := domain.NewTrashItem(domain.TrashItemProject, b.projectID, b.projectID, ownerID, "Project")
+	_, err := repository.NewProjectCascadeRepository(suite.db).SoftDeleteProjectData(b.projectID)
+	require.NoError(t, err)
+	require.NoError(t, suite.trashRepo.Create(projectItem))
+
+	// When
+	trashed, err := suite.service.GetTrashedProjects(ownerID.String(), &dto.GetTrashedProjectsRequest{})
+	require.NoError(t, err)
+	err = suite.service.RestoreProject(ownerID.String(), b.projectID.String())
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, trashed.Items, 1)
+	assert.Equal(t, int64(1), trashed.Total)
+	assert.Equal(t, b.projectID.String(), trashed.Items[0].ItemID)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "projects", "id = ? AND is_deleted = ?", b.projectID, false))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "project_members", "project_id = ? AND is_deleted = ?", b.projectID, false))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "boards", "id = ? AND is_deleted = ?", b.boardID, true),
+		"a board deleted before the project stays in the trash")
+	assert.Equal(t, int64(1), countRows(t, suite.db, "saved_views", "id = ? AND is_deleted = ?", viewID, false))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "user_board_order", "view_id = ?", viewID), "manual board order survives the restore")
+	assert.Zero(t, countRows(t, suite.db, "trash_items", "item_type = ?", string(domain.TrashItemProject)))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "outbox_events", "event_type = ?", string(event.ProjectRestored)))
+}
+
+func TestTrashService_RestoreProject_NotOwner(t *testing.T) {
+	suite := setupTrashServiceTest(t)
+	projectID := uuid.New()
+	ownerID := uuid.New()
+	require.NoError(t, suite.db.Exec("INSERT INTO projects (id, owner_id, is_deleted) VALUES (?, ?, true)", projectID, ownerID).Error)
+	require.NoError(t, suite.trashRepo.Create(domain.NewTrashItem(domain.TrashItemProject, projectID, projectID, ownerID, "Project")))
+
+	// When
+	err := suite.service.RestoreProject(uuid.New().String(), projectID.String())
+
+	// Then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "프로젝트 소유자만")
+	assert.Equal(t, int64(1), countRows(t, suite.db, "projects", "id = ? AND is_deleted = ?", projectID, true))
+}
+
+// ==================== PurgeExpired Tests ====================
+
+func TestTrashService_PurgeExpired_OnlyItemsPastRetention(t *testing.T) {
+	suite := setupTrashServiceTest(t)
+	b := seedTrashedBoard(t, suite)
+
+	// Given: the earlier comment's item is past the 30 day retention
+	require.NoError(t, suite.db.Exec("UPDATE trash_items SET deleted_at = ? WHERE id = ?",
+		time.Now().Add(-31*24*time.Hour), b.earlierTrashID).Error)
+
+	// When
+	purged, err := suite.service.PurgeExpired()
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+	assert.Zero(t, countRows(t, suite.db, "comments", "id = ?", b.earlierComment))
+	assert.Zero(t, countRows(t, suite.db, "trash_items", "id = ?", b.earlierTrashID))
+	assert.Equal(t, int64(1), countRows(t, suite.db, "trash_items", "id = ?", b.trashID))
+}
+
+func TestTrashService_PurgeExpired_DisabledRetention(t *testing.T) {
+	suite := setupTrashServiceTest(t)
+	b := seedTrashedBoard(t, suite)
+	service := NewTrashService(suite.trashRepo, suite.projectRepo, suite.roleRepo, repository.NewAttachmentRepository(suite.db), suite.store,
+		0, zap.NewNop(), suite.db)
+	require.NoError(t, suite.db.Exec("UPDATE trash_items SET deleted_at = ?", time.Now().Add(-365*24*time.Hour)).Error)
+
+	// When
+	purged, err := service.PurgeExpired()
+
+	// Then
+	require.NoError(t, err)
+	assert.Zero(t, purged)
+	assert.Equal(t, int64(1), countRows(t, suite.db, "boards", "id = ?", b.boardID))
+}
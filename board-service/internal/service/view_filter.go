@@ -0,0 +1,538 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/repository"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// filterKind is how a filtered field is stored and compared
+type filterKind int
+
+const (
+	filterKindText     filterKind = iota // Text and URL fields, the title
+	filterKindNumber                     // Number fields
+	filterKindDate                       // Date and date-time fields, the due date
+	filterKindID                         // Single-select and single-user fields, milestone and assignee (UUID values)
+	filterKindIDs                        // Multi-select and multi-user fields (arrays of UUIDs)
+	filterKindCheckbox                   // Checkbox fields
+	filterKindFlag                       // The blocked and subtask flags
+	filterKindSprint                     // The sprint: "current", a sprint ID or null
+)
+
+// filterOperators lists the operators each kind of field supports
+var filterOperators = map[filterKind][]string{
+	filterKindText: {domain.FilterOpEq, domain.FilterOpNe, domain.FilterOpIn, domain.FilterOpNotIn, domain.FilterOpContains,
+		domain.FilterOpIsNull, domain.FilterOpIsNotNull},
+	filterKindNumber: {domain.FilterOpEq, domain.FilterOpNe, domain.FilterOpIn, domain.FilterOpNotIn, domain.FilterOpGt, domain.FilterOpGte,
+		domain.FilterOpLt, domain.FilterOpLte, domain.FilterOpBetween, domain.FilterOpIsNull, domain.FilterOpIsNotNull},
+	filterKindDate: {domain.FilterOpEq, domain.FilterOpNe, domain.FilterOpGt, domain.FilterOpGte, domain.FilterOpLt, domain.FilterOpLte,
+		domain.FilterOpBetween, domain.FilterOpIsNull, domain.FilterOpIsNotNull},
+	filterKindID: {domain.FilterOpEq, domain.FilterOpNe, domain.FilterOpIn, domain.FilterOpNotIn, domain.FilterOpIsNull, domain.FilterOpIsNotNull},
+	filterKindIDs: {domain.FilterOpEq, domain.FilterOpNe, domain.FilterOpContains, domain.FilterOpIn, domain.FilterOpNotIn,
+		domain.FilterOpIsNull, domain.FilterOpIsNotNull},
+	filterKindCheckbox: {domain.FilterOpEq, domain.FilterOpNe, domain.FilterOpIsNull, domain.FilterOpIsNotNull},
+	filterKindFlag:     {domain.FilterOpEq, domain.FilterOpNe},
+	filterKindSprint:   {domain.FilterOpEq, domain.FilterOpNe, domain.FilterOpIsNull, domain.FilterOpIsNotNull},
+}
+
+// builtInFilterFields are the board columns a view can filter on besides the custom fields
+var builtInFilterFields = map[string]struct {
+	kind   filterKind
+	column string
+}{
+	"title":     {filterKindText, "title"},
+	"dueDate":   {filterKindDate, "due_date"},
+	"milestone": {filterKindID, "milestone_id"},
+	"assignee":  {filterKindID, "assignee_id"},
+	"blocked":   {filterKindFlag, ""},
+	"subtask":   {filterKindFlag, ""},
+	"sprint":    {filterKindSprint, "sprint_id"},
+}
+
+// customFilterKinds maps custom field types to how they are filtered
+var customFilterKinds = map[domain.FieldType]filterKind{
+	domain.FieldTypeText:         filterKindText,
+	domain.FieldTypeURL:          filterKindText,
+	domain.FieldTypeNumber:       filterKindNumber,
+	domain.FieldTypeDate:         filterKindDate,
+	domain.FieldTypeDateTime:     filterKindDate,
+	domain.FieldTypeSingleSelect: filterKindID,
+	domain.FieldTypeSingleUser:   filterKindID,
+	domain.FieldTypeMultiSelect:  filterKindIDs,
+	domain.FieldTypeMultiUser:    filterKindIDs,
+	domain.FieldTypeCheckbox:     filterKindCheckbox,
+}
+
+// sqlClause is a parameterized SQL condition; an empty clause matches every board
+type sqlClause struct {
+	sql  string
+	args []interface{}
+}
+
+func (c sqlClause) isEmpty() bool {
+	return c.sql == ""
+}
+
+// viewFilterCompiler compiles a view filter tree into a WHERE clause on boards
+// Custom fields are read from custom_fields_cache (JSONB); every value is passed as a parameter
+type viewFilterCompiler struct {
+	db        *gorm.DB
+	projectID uuid.UUID
+	fields    map[uuid.UUID]*domain.ProjectField
+	now       time.Time
+	loc       *time.Location // Days and relative dates are read in the project time zone
+	// strict rejects conditions that cannot be compiled (when a view is saved);
+	// otherwise they are dropped (when a view is applied, e.g. after its field was deleted)
+	strict  bool
+	dropped []string
+}
+
+func newViewFilterCompiler(fieldRepo repository.FieldRepository, db *gorm.DB, project *domain.Project, now time.Time, strict bool) (*viewFilterCompiler, error) {
+	fields, err := fieldRepo.FindFieldsByProject(project.ID)
+	if err != nil {
+		return nil, err
+	}
+	compiler := &viewFilterCompiler{
+		db:        db,
+		projectID: project.ID,
+		fields:    make(map[uuid.UUID]*domain.ProjectField, len(fields)),
+		now:       now,
+		loc:       project.Location(),
+		strict:    strict,
+	}
+	for i := range fields {
+		compiler.fields[fields[i].ID] = &fields[i]
+	}
+	return compiler, nil
+}
+
+// compile returns the clause of the tree; root may be nil
+func (c *viewFilterCompiler) compile(root *domain.FilterNode) (sqlClause, error) {
+	if root == nil {
+		return sqlClause{}, nil
+	}
+	if err := root.Validate(); err != nil {
+		return sqlClause{}, err
+	}
+	return c.compileNode(root)
+}
+
+func (c *viewFilterCompiler) compileNode(node *domain.FilterNode) (sqlClause, error) {
+	if !node.IsGroup() {
+		clause, err := c.compileCondition(node)
+		if err != nil {
+			if c.strict {
+				return sqlClause{}, err
+			}
+			c.dropped = append(c.dropped, node.Field)
+			return sqlClause{}, nil
+		}
+		return clause, nil
+	}
+
+	clauses := make([]sqlClause, 0, len(node.Conditions))
+	for i := range node.Conditions {
+		clause, err := c.compileNode(&node.Conditions[i])
+		if err != nil {
+			return sqlClause{}, err
+		}
+		if !clause.isEmpty() {
+			clauses = append(clauses, clause)
+		}
+	}
+	if len(clauses) == 0 {
+		return sqlClause{}, nil
+	}
+	if node.Group == domain.FilterGroupNot {
+		return negate(clauses[0]), nil
+	}
+
+	separator := " AND "
+	if node.Group == domain.FilterGroupOr {
+		separator = " OR "
+	}
+	parts := make([]string, 0, len(clauses))
+	var args []interface{}
+	for _, clause := range clauses {
+		parts = append(parts, "("+clause.sql+")")
+		args = append(args, clause.args...)
+	}
+	return sqlClause{strings.Join(parts, separator), args}, nil
+}
+
+// negate inverts a clause; a condition on a missing value (NULL) counts as not matching
+func negate(clause sqlClause) sqlClause {
+	return sqlClause{"NOT COALESCE((" + clause.sql + "), FALSE)", clause.args}
+}
+
+func (c *viewFilterCompiler) compileCondition(node *domain.FilterNode) (sqlClause, error) {
+	kind, operand, name, err := c.resolveField(node.Field)
+	if err != nil {
+		return sqlClause{}, err
+	}
+
+	operator := node.Operator
+	// Legacy views used eq/ne with null for "has no value"
+	if node.Value == nil {
+		switch operator {
+		case domain.FilterOpEq:
+			operator = domain.FilterOpIsNull
+		case domain.FilterOpNe:
+			operator = domain.FilterOpIsNotNull
+		}
+	}
+	if !supportsOperator(kind, operator) {
+		return sqlClause{}, filterError(name, fmt.Sprintf("지원하지 않는 연산자입니다 (%s)", operator))
+	}
+
+	switch kind {
+	case filterKindFlag:
+		return c.compileFlag(node.Field, operator, node.Value, name)
+	case filterKindSprint:
+		return c.compileSprint(operand, operator, node.Value, name)
+	case filterKindIDs:
+		return c.compileIDs(operand, operator, node.Value, name)
+	}
+
+	switch operator {
+	case domain.FilterOpIsNull:
+		return sqlClause{operand.sql + " IS NULL", operand.args}, nil
+	case domain.FilterOpIsNotNull:
+		return sqlClause{operand.sql + " IS NOT NULL", operand.args}, nil
+	}
+	if kind == filterKindDate {
+		return c.compileDate(operand, operator, node.Value, name)
+	}
+
+	switch operator {
+	case domain.FilterOpIn, domain.FilterOpNotIn:
+		values, err := c.listValues(kind, node.Value, name)
+		if err != nil {
+			return sqlClause{}, err
+		}
+		in := sqlClause{operand.sql + " IN ?", append(operand.args, values)}
+		if operator == domain.FilterOpNotIn {
+			return orNull(operand, negate(in)), nil
+		}
+		return in, nil
+	case domain.FilterOpContains:
+		text, ok := node.Value.(string)
+		if !ok || text == "" {
+			return sqlClause{}, filterError(name, "contains 값은 빈 문자열이 아니어야 합니다")
+		}
+		return sqlClause{"LOWER(" + operand.sql + ") LIKE ? ESCAPE '\\'", append(operand.args, "%"+escapeLike(strings.ToLower(text))+"%")}, nil
+	case domain.FilterOpBetween:
+		bounds, ok := node.Value.([]interface{})
+		if !ok || len(bounds) != 2 {
+			return sqlClause{}, filterError(name, "between 값은 [시작, 끝] 배열이어야 합니다")
+		}
+		from, err := c.scalarValue(kind, bounds[0], name)
+		if err != nil {
+			return sqlClause{}, err
+		}
+		to, err := c.scalarValue(kind, bounds[1], name)
+		if err != nil {
+			return sqlClause{}, err
+		}
+		return sqlClause{operand.sql + " BETWEEN ? AND ?", append(operand.args, from, to)}, nil
+	}
+
+	value, err := c.scalarValue(kind, node.Value, name)
+	if err != nil {
+		return sqlClause{}, err
+	}
+	switch operator {
+	case domain.FilterOpNe:
+		return orNull(operand, sqlClause{operand.sql + " <> ?", append(operand.args, value)}), nil
+	case domain.FilterOpGt:
+		return sqlClause{operand.sql + " > ?", append(operand.args, value)}, nil
+	case domain.FilterOpGte:
+		return sqlClause{operand.sql + " >= ?", append(operand.args, value)}, nil
+	case domain.FilterOpLt:
+		return sqlClause{operand.sql + " < ?", append(operand.args, value)}, nil
+	case domain.FilterOpLte:
+		return sqlClause{operand.sql + " <= ?", append(operand.args, value)}, nil
+	default:
+		return sqlClause{operand.sql + " = ?", append(operand.args, value)}, nil
+	}
+}
+
+// resolveField returns the kind, the SQL operand and the display name of a filtered field
+func (c *viewFilterCompiler) resolveField(key string) (filterKind, sqlClause, string, error) {
+	if builtIn, ok := builtInFilterFields[key]; ok {
+		return builtIn.kind, sqlClause{sql: builtIn.column}, key, nil
+	}
+
+	fieldID, err := uuid.Parse(key)
+	if err != nil {
+		return 0, sqlClause{}, key, filterError(key, "알 수 없는 필터 필드입니다")
+	}
+	field, ok := c.fields[fieldID]
+	if !ok {
+		return 0, sqlClause{}, key, filterError(key, "프로젝트에 없는 필드입니다")
+	}
+	kind, ok := customFilterKinds[field.FieldType]
+	if !ok {
+		return 0, sqlClause{}, field.Name, filterError(field.Name, "필터를 지원하지 않는 필드 유형입니다")
+	}
+
+	// Values of other JSON types (e.g. left over from a changed field type) compare as missing
+	key = field.ID.String()
+	switch kind {
+	case filterKindNumber:
+		return kind, sqlClause{"(CASE WHEN jsonb_typeof(custom_fields_cache->?) = 'number' THEN (custom_fields_cache->>?)::numeric END)", []interface{}{key, key}}, field.Name, nil
+	case filterKindDate:
+		return kind, sqlClause{"(CASE WHEN jsonb_typeof(custom_fields_cache->?) = 'string' THEN (custom_fields_cache->>?)::timestamptz END)", []interface{}{key, key}}, field.Name, nil
+	case filterKindCheckbox:
+		return kind, sqlClause{"(CASE WHEN jsonb_typeof(custom_fields_cache->?) = 'boolean' THEN (custom_fields_cache->>?)::boolean END)", []interface{}{key, key}}, field.Name, nil
+	case filterKindIDs:
+		return kind, sqlClause{"custom_fields_cache->?", []interface{}{key}}, field.Name, nil
+	default:
+		return kind, sqlClause{"custom_fields_cache->>?", []interface{}{key}}, field.Name, nil
+	}
+}
+
+// compileDate compares a date operand with a day, a date-time or a relative range (see domain.ParseFilterDate)
+// A day matches all of it: "gt 2030-01-31" starts on February 1st and "lte 2030-01-31" ends with January 31st
+func (c *viewFilterCompiler) compileDate(operand sqlClause, operator string, value interface{}, name string) (sqlClause, error) {
+	if operator == domain.FilterOpBetween {
+		bounds, ok := value.([]interface{})
+		if !ok || len(bounds) != 2 {
+			return sqlClause{}, filterError(name, "between 값은 [시작, 끝] 배열이어야 합니다")
+		}
+		from, err := c.parseDate(bounds[0], name)
+		if err != nil {
+			return sqlClause{}, err
+		}
+		to, err := c.parseDate(bounds[1], name)
+		if err != nil {
+			return sqlClause{}, err
+		}
+		if to.IsInstant() {
+			return sqlClause{operand.sql + " >= ? AND " + operand.sql + " <= ?", concatArgs(operand.args, from.Start, operand.args, to.End)}, nil
+		}
+		return sqlClause{operand.sql + " >= ? AND " + operand.sql + " < ?", concatArgs(operand.args, from.Start, operand.args, to.End)}, nil
+	}
+
+	date, err := c.parseDate(value, name)
+	if err != nil {
+		return sqlClause{}, err
+	}
+	within := sqlClause{operand.sql + " >= ? AND " + operand.sql + " < ?", concatArgs(operand.args, date.Start, operand.args, date.End)}
+	if date.IsInstant() {
+		within = sqlClause{operand.sql + " = ?", append(operand.args, date.Start)}
+	}
+
+	switch operator {
+	case domain.FilterOpNe:
+		return orNull(operand, negate(within)), nil
+	case domain.FilterOpGt:
+		if date.IsInstant() {
+			return sqlClause{operand.sql + " > ?", append(operand.args, date.End)}, nil
+		}
+		return sqlClause{operand.sql + " >= ?", append(operand.args, date.End)}, nil
+	case domain.FilterOpGte:
+		return sqlClause{operand.sql + " >= ?", append(operand.args, date.Start)}, nil
+	case domain.FilterOpLt:
+		return sqlClause{operand.sql + " < ?", append(operand.args, date.Start)}, nil
+	case domain.FilterOpLte:
+		if date.IsInstant() {
+			return sqlClause{operand.sql + " <= ?", append(operand.args, date.End)}, nil
+		}
+		return sqlClause{operand.sql + " < ?", append(operand.args, date.End)}, nil
+	default:
+		return within, nil
+	}
+}
+
+func (c *viewFilterCompiler) parseDate(value interface{}, name string) (domain.FilterDate, error) {
+	date, err := domain.ParseFilterDate(value, c.now, c.loc)
+	if err != nil {
+		return domain.FilterDate{}, filterError(name, err.(*domain.DomainError).Message)
+	}
+	return date, nil
+}
+
+// compileIDs matches multi-select and multi-user fields; eq and contains match boards having the value,
+// in boards having any of the values
+// A field with a single value may be cached as a plain string, which JSONB containment also matches
+func (c *viewFilterCompiler) compileIDs(operand sqlClause, operator string, value interface{}, name string) (sqlClause, error) {
+	switch operator {
+	case domain.FilterOpIsNull, domain.FilterOpIsNotNull:
+		empty := sqlClause{"COALESCE(" + operand.sql + ", 'null'::jsonb) IN ('null'::jsonb, '[]'::jsonb)", operand.args}
+		if operator == domain.FilterOpIsNotNull {
+			return negate(empty), nil
+		}
+		return empty, nil
+	}
+
+	var values []interface{}
+	if operator == domain.FilterOpIn || operator == domain.FilterOpNotIn {
+		list, err := c.listValues(filterKindID, value, name)
+		if err != nil {
+			return sqlClause{}, err
+		}
+		values = list
+	} else {
+		single, err := c.scalarValue(filterKindID, value, name)
+		if err != nil {
+			return sqlClause{}, err
+		}
+		values = []interface{}{single}
+	}
+
+	parts := make([]string, 0, len(values))
+	var args []interface{}
+	for _, id := range values {
+		encoded, _ := json.Marshal(id)
+		parts = append(parts, operand.sql+" @> ?::jsonb")
+		args = append(append(args, operand.args...), string(encoded))
+	}
+	has := sqlClause{strings.Join(parts, " OR "), args}
+	if operator == domain.FilterOpNe || operator == domain.FilterOpNotIn {
+		return negate(has), nil
+	}
+	return has, nil
+}
+
+// compileFlag keeps the boards that are (true) or are not (false) blocked by a board that is not deleted,
+// or only sub-tasks (true) or only top-level boards (false)
+func (c *viewFilterCompiler) compileFlag(field, operator string, value interface{}, name string) (sqlClause, error) {
+	flag, ok := value.(bool)
+	if !ok {
+		return sqlClause{}, filterError(name, "값은 true 또는 false여야 합니다")
+	}
+	if operator == domain.FilterOpNe {
+		flag = !flag
+	}
+
+	if field == "blocked" {
+		if flag {
+			return sqlClause{"id IN (?)", []interface{}{repository.BlockedBoardIDs(c.db)}}, nil
+		}
+		return sqlClause{"id NOT IN (?)", []interface{}{repository.BlockedBoardIDs(c.db)}}, nil
+	}
+	// A board whose parent is deleted counts as a top-level board
+	if flag {
+		return sqlClause{"parent_board_id IN (?)", []interface{}{repository.ActiveBoardIDs(c.db)}}, nil
+	}
+	return sqlClause{"(parent_board_id IS NULL OR parent_board_id NOT IN (?))", []interface{}{repository.ActiveBoardIDs(c.db)}}, nil
+}
+
+// compileSprint filters on the project's active sprint ("current"), a sprint ID or the backlog (null)
+// Without an active sprint, "current" matches no board
+func (c *viewFilterCompiler) compileSprint(operand sqlClause, operator string, value interface{}, name string) (sqlClause, error) {
+	switch operator {
+	case domain.FilterOpIsNull:
+		return sqlClause{operand.sql + " IS NULL", nil}, nil
+	case domain.FilterOpIsNotNull:
+		return sqlClause{operand.sql + " IS NOT NULL", nil}, nil
+	}
+
+	var in sqlClause
+	if sprint, ok := value.(string); ok && sprint == "current" {
+		in = sqlClause{operand.sql + " IN (?)", []interface{}{repository.ActiveSprintIDs(c.db, c.projectID)}}
+	} else {
+		sprintID, err := c.scalarValue(filterKindID, value, name)
+		if err != nil {
+			return sqlClause{}, filterError(name, "값은 current, 스프린트 ID 또는 null이어야 합니다")
+		}
+		in = sqlClause{operand.sql + " = ?", []interface{}{sprintID}}
+	}
+	if operator == domain.FilterOpNe {
+		return orNull(operand, negate(in)), nil
+	}
+	return in, nil
+}
+
+// scalarValue checks a single filter value against the kind of its field
+func (c *viewFilterCompiler) scalarValue(kind filterKind, value interface{}, name string) (interface{}, error) {
+	switch kind {
+	case filterKindText:
+		if text, ok := value.(string); ok {
+			return text, nil
+		}
+		return nil, filterError(name, "값은 문자열이어야 합니다")
+	case filterKindNumber:
+		if number, ok := value.(float64); ok {
+			return number, nil
+		}
+		return nil, filterError(name, "값은 숫자여야 합니다")
+	case filterKindCheckbox:
+		if checked, ok := value.(bool); ok {
+			return checked, nil
+		}
+		return nil, filterError(name, "값은 true 또는 false여야 합니다")
+	case filterKindID:
+		if text, ok := value.(string); ok {
+			if id, err := uuid.Parse(text); err == nil {
+				return id.String(), nil
+			}
+		}
+		return nil, filterError(name, "값은 UUID여야 합니다")
+	}
+	return nil, filterError(name, "지원하지 않는 값입니다")
+}
+
+// listValues checks the values of an in/not_in condition
+func (c *viewFilterCompiler) listValues(kind filterKind, value interface{}, name string) ([]interface{}, error) {
+	list, ok := value.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil, filterError(name, "in/not_in 값은 비어 있지 않은 배열이어야 합니다")
+	}
+	if len(list) > domain.MaxFilterValues {
+		return nil, filterError(name, fmt.Sprintf("in/not_in 값은 %d개를 넘을 수 없습니다", domain.MaxFilterValues))
+	}
+	values := make([]interface{}, 0, len(list))
+	for _, item := range list {
+		value, err := c.scalarValue(kind, item, name)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+func supportsOperator(kind filterKind, operator string) bool {
+	for _, supported := range filterOperators[kind] {
+		if supported == operator {
+			return true
+		}
+	}
+	return false
+}
+
+// orNull makes a negative condition (ne, not_in) also match boards without a value
+func orNull(operand, clause sqlClause) sqlClause {
+	return sqlClause{operand.sql + " IS NULL OR " + clause.sql, concatArgs(operand.args, clause.args)}
+}
+
+// concatArgs flattens operand arguments (slices) and values into one argument list
+func concatArgs(parts ...interface{}) []interface{} {
+	var args []interface{}
+	for _, part := range parts {
+		if list, ok := part.([]interface{}); ok {
+			args = append(args, list...)
+			continue
+		}
+		args = append(args, part)
+	}
+	return args
+}
+
+// escapeLike escapes the LIKE wildcards of a contains value
+func escapeLike(text string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(text)
+}
+
+func filterError(name, message string) error {
+	return domain.NewValidationError("filters", fmt.Sprintf("필터 조건이 올바르지 않습니다 (%s): %s", name, message))
+}
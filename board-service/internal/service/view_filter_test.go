@@ -0,0 +1,222 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/repository"
+	"board-service/internal/testutil"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type ViewFilterTestSuite struct {
+	db        *gorm.DB
+	views     ViewService
+	compiler  *viewFilterCompiler
+	projectID uuid.UUID
+	memberID  uuid.UUID
+	fields    map[string]uuid.UUID
+	options   map[string]uuid.UUID
+}
+
+func setupViewFilterTest(t *testing.T) *ViewFilterTestSuite {
+	db := NewMockDB()
+	for _, stmt := range projectDeletionTablesSQL {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+
+	suite := &ViewFilterTestSuite{
+		db:        db,
+		projectID: uuid.New(),
+		memberID:  uuid.New(),
+		fields:    map[string]uuid.UUID{},
+		options:   map[string]uuid.UUID{},
+	}
+	project := &domain.Project{BaseModel: domain.BaseModel{ID: suite.projectID}, Name: "Web", Key: "WEB", TimeZone: "Asia/Seoul"}
+	for name, fieldType := range map[string]domain.FieldType{
+		"Points": domain.FieldTypeNumber, "Labels": domain.FieldTypeMultiSelect, "Release": domain.FieldTypeDate,
+		"Reviewed": domain.FieldTypeCheckbox, "Notes": domain.FieldTypeText, "Priority": domain.FieldTypeSingleSelect,
+	} {
+		fieldID := uuid.New()
+		require.NoError(t, db.Exec("INSERT INTO project_fields (id, project_id, name, field_type) VALUES (?, ?, ?, ?)", fieldID, suite.projectID, name, fieldType).Error)
+		suite.fields[name] = fieldID
+	}
+	for order, label := range []string{"bug", "ui"} {
+		optionID := uuid.New()
+		require.NoError(t, db.Exec("INSERT INTO field_options (id, field_id, label, display_order) VALUES (?, ?, ?, ?)",
+			optionID, suite.fields["Labels"], label, order).Error)
+		suite.options[label] = optionID
+	}
+	for order, label := range []string{"high", "medium", "low"} {
+		optionID := uuid.New()
+		require.NoError(t, db.Exec("INSERT INTO field_options (id, field_id, label, display_order) VALUES (?, ?, ?, ?)",
+			optionID, suite.fields["Priority"], label, order).Error)
+		suite.options[label] = optionID
+	}
+
+	projectRepo := new(testutil.MockProjectRepository)
+	projectRepo.On("FindByID", suite.projectID).Return(project, nil).Maybe()
+	projectRepo.On("FindMemberByUserAndProject", suite.memberID, suite.projectID).
+		Return(&domain.ProjectMember{ProjectID: suite.projectID, UserID: suite.memberID}, nil).Maybe()
+
+	fieldRepo := repository.NewFieldRepository(db)
+	suite.views = NewViewService(fieldRepo, repository.NewBoardRepository(db), projectRepo, repository.NewBoardRelationRepository(db),
+		repository.NewChecklistRepository(db), repository.NewWorkLogRepository(db), nil, zap.NewNop(), db)
+
+	// Wednesday 2030-01-09 01:00 in Seoul
+	compiler, err := newViewFilterCompiler(fieldRepo, db, project, time.Date(2030, 1, 8, 16, 0, 0, 0, time.UTC), true)
+	require.NoError(t, err)
+	suite.compiler = compiler
+	return suite
+}
+
+func (s *ViewFilterTestSuite) field(name string) string {
+	return s.fields[name].String()
+}
+
+func (s *ViewFilterTestSuite) board(t *testing.T, title string, dueDate *time.Time) uuid.UUID {
+	boardID := uuid.New()
+	require.NoError(t, s.db.Exec("INSERT INTO boards (id, project_id, title, due_date, is_deleted) VALUES (?, ?, ?, ?, false)", boardID, s.projectID, title, dueDate).Error)
+	return boardID
+}
+
+func TestViewFilterCompiler_CustomFields(t *testing.T) {
+	suite := setupViewFilterTest(t)
+	points, labels, release := suite.field("Points"), suite.field("Labels"), suite.field("Release")
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	require.NoError(t, err)
+
+	clause, err := suite.compiler.compile(&domain.FilterNode{Field: points, Operator: "gte", Value: float64(3)})
+	require.NoError(t, err)
+	assert.Equal(t, "(CASE WHEN jsonb_typeof(custom_fields_cache->?) = 'number' THEN (custom_fields_cache->>?)::numeric END) >= ?", clause.sql)
+	assert.Equal(t, []interface{}{points, points, float64(3)}, clause.args)
+
+	clause, err = suite.compiler.compile(&domain.FilterNode{Field: labels, Operator: "in", Value: []interface{}{suite.options["bug"].String(), suite.options["ui"].String()}})
+	require.NoError(t, err)
+	assert.Equal(t, "custom_fields_cache->? @> ?::jsonb OR custom_fields_cache->? @> ?::jsonb", clause.sql)
+	assert.Equal(t, []interface{}{labels, `"` + suite.options["bug"].String() + `"`, labels, `"` + suite.options["ui"].String() + `"`}, clause.args)
+
+	// Relative dates are read in the project time zone
+	clause, err = suite.compiler.compile(&domain.FilterNode{Field: release, Operator: "eq", Value: "next_7_days"})
+	require.NoError(t, err)
+	require.Len(t, clause.args, 6)
+	assert.True(t, time.Date(2030, 1, 9, 0, 0, 0, 0, seoul).Equal(clause.args[2].(time.Time)))
+	assert.True(t, time.Date(2030, 1, 16, 0, 0, 0, 0, seoul).Equal(clause.args[5].(time.Time)))
+
+	clause, err = suite.compiler.compile(&domain.FilterNode{Group: "or", Conditions: []domain.FilterNode{
+		{Field: "title", Operator: "contains", Value: "50%"},
+		{Group: "not", Conditions: []domain.FilterNode{{Field: suite.field("Reviewed"), Operator: "eq", Value: true}}},
+	}})
+	require.NoError(t, err)
+	reviewed := suite.field("Reviewed")
+	assert.Equal(t, `(LOWER(title) LIKE ? ESCAPE '\') OR (NOT COALESCE(((CASE WHEN jsonb_typeof(custom_fields_cache->?) = 'boolean' THEN (custom_fields_cache->>?)::boolean END) = ?), FALSE))`, clause.sql)
+	assert.Equal(t, []interface{}{`%50\%%`, reviewed, reviewed, true}, clause.args)
+}
+
+func TestViewFilterCompiler_RejectsInvalidConditions(t *testing.T) {
+	suite := setupViewFilterTest(t)
+
+	tests := []struct {
+		name string
+		node domain.FilterNode
+	}{
+		{"number compared with text", domain.FilterNode{Field: suite.field("Points"), Operator: "gt", Value: "3"}},
+		{"contains on a number", domain.FilterNode{Field: suite.field("Points"), Operator: "contains", Value: "3"}},
+		{"date range on text", domain.FilterNode{Field: suite.field("Notes"), Operator: "between", Value: []interface{}{"a", "z"}}},
+		{"unknown relative date", domain.FilterNode{Field: suite.field("Release"), Operator: "lt", Value: "someday"}},
+		{"option that is not a UUID", domain.FilterNode{Field: suite.field("Labels"), Operator: "eq", Value: "bug"}},
+		{"empty in list", domain.FilterNode{Field: "milestone", Operator: "in", Value: []interface{}{}}},
+		{"checkbox with a number", domain.FilterNode{Field: suite.field("Reviewed"), Operator: "eq", Value: float64(1)}},
+		{"between with one bound", domain.FilterNode{Field: "dueDate", Operator: "between", Value: []interface{}{"2030-01-01"}}},
+		{"field of another project", domain.FilterNode{Field: uuid.New().String(), Operator: "eq", Value: "x"}},
+		{"unknown built-in field", domain.FilterNode{Field: "priority", Operator: "eq", Value: "high"}},
+		{"invalid tree", domain.FilterNode{Group: "not"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := suite.compiler.compile(&tt.node)
+			require.Error(t, err)
+			assert.Equal(t, domain.ErrCodeValidation, err.(*domain.DomainError).Code)
+		})
+	}
+
+	// Views being applied leave out the conditions that no longer compile
+	suite.compiler.strict = false
+	clause, err := suite.compiler.compile(&domain.FilterNode{Group: "and", Conditions: []domain.FilterNode{
+		{Field: uuid.Nil.String(), Operator: "eq", Value: "x"},
+		{Field: "title", Operator: "eq", Value: "Login"},
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, "(title = ?)", clause.sql)
+	assert.Equal(t, []string{uuid.Nil.String()}, suite.compiler.dropped)
+}
+
+func TestViewFilterCompiler_BuiltInFields(t *testing.T) {
+	suite := setupViewFilterTest(t)
+	due := func(day int) *time.Time {
+		date := time.Date(2030, 1, day, 3, 0, 0, 0, time.UTC) // 12:00 in Seoul
+		return &date
+	}
+	login := suite.board(t, "Fix LOGIN redirect", due(9))
+	signup := suite.board(t, "Signup form", due(20))
+	undated := suite.board(t, "Docs", nil)
+	suite.board(t, "Profile page", due(2))
+
+	filtered := func(node domain.FilterNode) []uuid.UUID {
+		clause, err := suite.compiler.compile(&node)
+		require.NoError(t, err)
+		var boardIDs []uuid.UUID
+		require.NoError(t, suite.db.Model(&domain.Board{}).Where(clause.sql, clause.args...).Order("title").Pluck("id", &boardIDs).Error)
+		return boardIDs
+	}
+
+	assert.Equal(t, []uuid.UUID{login}, filtered(domain.FilterNode{Field: "title", Operator: "contains", Value: "login"}), "case-insensitive")
+	assert.Equal(t, []uuid.UUID{login}, filtered(domain.FilterNode{Field: "dueDate", Operator: "eq", Value: "today"}))
+	assert.Equal(t, []uuid.UUID{login}, filtered(domain.FilterNode{Field: "dueDate", Operator: "between", Value: []interface{}{"2030-01-03", "next_7_days"}}))
+	assert.Equal(t, []uuid.UUID{signup}, filtered(domain.FilterNode{Field: "dueDate", Operator: "gt", Value: "2030-01-09"}))
+	assert.Equal(t, []uuid.UUID{undated}, filtered(domain.FilterNode{Field: "dueDate", Operator: "is_null"}))
+
+	// Docs has no due date: it is not "due this week", so NOT matches it
+	assert.Equal(t, []uuid.UUID{undated, signup}, filtered(domain.FilterNode{Group: "and", Conditions: []domain.FilterNode{
+		{Group: "not", Conditions: []domain.FilterNode{{Field: "dueDate", Operator: "lte", Value: "this_week"}}},
+		{Group: "or", Conditions: []domain.FilterNode{
+			{Field: "title", Operator: "in", Value: []interface{}{"Docs", "Signup form"}},
+			{Field: "blocked", Operator: "eq", Value: true},
+		}},
+	}}))
+}
+
+func TestViewService_CreateView_ValidatesFilterTree(t *testing.T) {
+	suite := setupViewFilterTest(t)
+	login := suite.board(t, "Fix login redirect", nil)
+	suite.board(t, "Signup form", nil)
+
+	_, err := suite.views.CreateView(suite.memberID.String(), &dto.CreateViewRequest{
+		ProjectID: suite.projectID.String(),
+		Name:      "Broken",
+		Filters:   map[string]interface{}{"field": suite.field("Points"), "operator": "contains", "value": "3"},
+	})
+	assert.Equal(t, 400, appErrorStatus(t, err))
+
+	// Flat filters are still accepted and stored as a tree
+	view, err := suite.views.CreateView(suite.memberID.String(), &dto.CreateViewRequest{
+		ProjectID: suite.projectID.String(),
+		Name:      "Login",
+		Filters:   map[string]interface{}{"title": map[string]interface{}{"operator": "contains", "value": "login"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "and", view.Filters["group"])
+	assert.Len(t, view.Filters["conditions"], 1)
+
+	result, err := suite.views.ApplyView(suite.memberID.String(), view.ViewID, dto.ApplyViewRequest{})
+	require.NoError(t, err)
+	boards := result.(map[string]interface{})["boards"].([]dto.BoardResponse)
+	require.Len(t, boards, 1)
+	assert.Equal(t, login.String(), boards[0].ID)
+}
@@ -3,14 +3,17 @@ package service
 import (
 	"board-service/internal/apperrors"
 	"board-service/internal/cache"
+	"board-service/internal/common/pagination"
 	"board-service/internal/domain"
 	"board-service/internal/dto"
+	"board-service/internal/event"
 	"board-service/internal/repository"
+	"board-service/internal/uow"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -26,8 +29,8 @@ type ViewService interface {
 	DeleteView(userID, viewID string) error
 
 	// Apply view (filter + sort + group)
-	ApplyView(userID, viewID string, page, limit int) (interface{}, error)
-	ApplyViewWithFilters(userID, projectID, viewID string, filters map[string]interface{}, sortBy, sortDir string, groupByFieldID *string, page, limit int) (interface{}, error)
+	ApplyView(userID, viewID string, req dto.ApplyViewRequest) (interface{}, error)
+	ApplyViewWithFilters(userID, projectID, viewID string, filters map[string]interface{}, sortKeys []domain.ViewSortKey, groupByFieldID *string, req dto.ApplyViewRequest) (interface{}, error)
 
 	// Board order management
 	UpdateBoardOrder(userID string, req *dto.UpdateBoardOrderRequest) error
@@ -37,15 +40,22 @@ type viewService struct {
 	repo        repository.FieldRepository
 	boardRepo   repository.BoardRepository
 	projectRepo repository.ProjectRepository
+	relations   *boardRelationReader // Blocked flag of the listed boards
+	progress    *boardProgressReader // Checklist progress of the listed boards
+	time        *boardTimeReader     // Estimates and logged time of the listed boards
 	cache       cache.FieldCache
 	logger      *zap.Logger
 	db          *gorm.DB
+	uow         uow.UnitOfWork
 }
 
 func NewViewService(
 	repo repository.FieldRepository,
 	boardRepo repository.BoardRepository,
 	projectRepo repository.ProjectRepository,
+	relationRepo repository.BoardRelationRepository,
+	checklistRepo repository.ChecklistRepository,
+	workLogRepo repository.WorkLogRepository,
 	cache cache.FieldCache,
 	logger *zap.Logger,
 	db *gorm.DB,
@@ -54,9 +64,13 @@ func NewViewService(
 		repo:        repo,
 		boardRepo:   boardRepo,
 		projectRepo: projectRepo,
+		relations:   newBoardRelationReader(relationRepo, projectRepo, logger),
+		progress:    newBoardProgressReader(checklistRepo, boardRepo, logger),
+		time:        newBoardTimeReader(workLogRepo, logger),
 		cache:       cache,
 		logger:      logger,
 		db:          db,
+		uow:         uow.NewUnitOfWork(db),
 	}
 }
 
@@ -82,10 +96,18 @@ func (s *viewService) CreateView(userID string, req *dto.CreateViewRequest) (*dt
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
 	}
 
-	// Serialize filters
-	filtersJSON, err := json.Marshal(req.Filters)
+	// Validate the filter tree
+	filtersJSON, err := s.normalizeFilters(projectUUID, req.Filters)
 	if err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "필터가 유효하지 않습니다", 400)
+		return nil, err
+	}
+	sortKeys := sortKeysFromRequest(req.Sort)
+	if len(sortKeys) == 0 && req.SortBy != "" {
+		sortKeys = []domain.ViewSortKey{{Field: req.SortBy, Direction: req.SortDirection}}
+	}
+	sortBy, sortDirection, err := s.normalizeSort(projectUUID, sortKeys)
+	if err != nil {
+		return nil, err
 	}
 
 	// Validate group by field if specified
@@ -121,20 +143,27 @@ func (s *viewService) CreateView(userID string, req *dto.CreateViewRequest) (*dt
 		Description:    req.Description,
 		IsDefault:      req.IsDefault,
 		IsShared:       isShared, // Default: true (team-shared view)
-		Filters:        string(filtersJSON),
-		SortDirection:  req.SortDirection,
+		Filters:        filtersJSON,
+		SortBy:         sortBy,
+		SortDirection:  sortDirection,
 		GroupByFieldID: groupByFieldID,
 	}
 
-	if req.SortBy != "" {
-		view.SortBy = &req.SortBy
-	}
-
-	if err := s.repo.CreateView(view); err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "뷰 생성 실패", 500)
+	var response *dto.ViewResponse
+	err = s.saveWithEvent(func(repo repository.FieldRepository) error {
+		if err := repo.CreateView(view); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "뷰 생성 실패", 500)
+		}
+		response = s.buildViewResponse(view)
+		return nil
+	}, view.IsShared, func() event.Event {
+		return event.New(event.ViewCreated, view.ProjectID, userUUID, response)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return s.buildViewResponse(view), nil
+	return response, nil
 }
 
 func (s *viewService) GetViewsByProject(userID, projectID string) ([]dto.ViewResponse, error) {
@@ -241,6 +270,8 @@ func (s *viewService) UpdateView(userID, viewID string, req *dto.UpdateViewReque
 		return nil, apperrors.New(apperrors.ErrCodeForbidden, "뷰 수정 권한이 없습니다 (작성자만 가능)", 403)
 	}
 
+	wasShared := view.IsShared
+
 	// Update fields
 	if req.Name != "" {
 		view.Name = req.Name
@@ -255,17 +286,21 @@ func (s *viewService) UpdateView(userID, viewID string, req *dto.UpdateViewReque
 		view.IsShared = *req.IsShared
 	}
 	if req.Filters != nil {
-		filtersJSON, err := json.Marshal(req.Filters)
+		filtersJSON, err := s.normalizeFilters(view.ProjectID, req.Filters)
 		if err != nil {
-			return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "필터가 유효하지 않습니다", 400)
+			return nil, err
 		}
-		view.Filters = string(filtersJSON)
+		view.Filters = filtersJSON
 	}
-	if req.SortBy != nil {
-		view.SortBy = req.SortBy
-	}
-	if req.SortDirection != "" {
-		view.SortDirection = req.SortDirection
+	if req.Sort != nil || req.SortBy != nil || req.SortDirection != "" {
+		sortKeys, err := s.updatedSortKeys(view, req)
+		if err != nil {
+			return nil, err
+		}
+		view.SortBy, view.SortDirection, err = s.normalizeSort(view.ProjectID, sortKeys)
+		if err != nil {
+			return nil, err
+		}
 	}
 	if req.GroupByFieldID != nil {
 		if *req.GroupByFieldID == "" {
@@ -279,11 +314,21 @@ func (s *viewService) UpdateView(userID, viewID string, req *dto.UpdateViewReque
 		}
 	}
 
-	if err := s.repo.UpdateView(view); err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "뷰 수정 실패", 500)
+	var response *dto.ViewResponse
+	err = s.saveWithEvent(func(repo repository.FieldRepository) error {
+		if err := repo.UpdateView(view); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "뷰 수정 실패", 500)
+		}
+		response = s.buildViewResponse(view)
+		return nil
+	}, wasShared || view.IsShared, func() event.Event {
+		return event.New(event.ViewUpdated, view.ProjectID, userUUID, response)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return s.buildViewResponse(view), nil
+	return response, nil
 }
 
 func (s *viewService) DeleteView(userID, viewID string) error {
@@ -311,8 +356,16 @@ func (s *viewService) DeleteView(userID, viewID string) error {
 		return apperrors.New(apperrors.ErrCodeForbidden, "뷰 삭제 권한이 없습니다 (작성자만 가능)", 403)
 	}
 
-	if err := s.repo.DeleteView(viewUUID); err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "뷰 삭제 실패", 500)
+	err = s.saveWithEvent(func(repo repository.FieldRepository) error {
+		if err := repo.DeleteView(viewUUID); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "뷰 삭제 실패", 500)
+		}
+		return nil
+	}, view.IsShared, func() event.Event {
+		return event.New(event.ViewDeleted, view.ProjectID, userUUID, map[string]interface{}{"viewId": viewID})
+	})
+	if err != nil {
+		return err
 	}
 
 	// Invalidate view results cache
@@ -326,7 +379,7 @@ func (s *viewService) DeleteView(userID, viewID string) error {
 
 // ==================== Apply View (Filter + Sort + Group) ====================
 
-func (s *viewService) ApplyView(userID, viewID string, page, limit int) (interface{}, error) {
+func (s *viewService) ApplyView(userID, viewID string, req dto.ApplyViewRequest) (interface{}, error) {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
@@ -369,9 +422,11 @@ func (s *viewService) ApplyView(userID, viewID string, page, limit int) (interfa
 		}
 	}
 
-	var sortBy string
-	if view.SortBy != nil {
-		sortBy = *view.SortBy
+	// Like unparsable filters, an unparsable sort falls back to the default order
+	sortKeys, err := domain.ParseViewSort(view.SortBy, view.SortDirection)
+	if err != nil {
+		s.logger.Warn("Failed to parse view sort", zap.Error(err))
+		sortKeys = nil
 	}
 
 	var groupByFieldIDStr *string
@@ -380,10 +435,10 @@ func (s *viewService) ApplyView(userID, viewID string, page, limit int) (interfa
 		groupByFieldIDStr = &str
 	}
 
-	return s.ApplyViewWithFilters(userID, view.ProjectID.String(), viewUUID.String(), filters, sortBy, view.SortDirection, groupByFieldIDStr, page, limit)
+	return s.ApplyViewWithFilters(userID, view.ProjectID.String(), viewUUID.String(), filters, sortKeys, groupByFieldIDStr, req)
 }
 
-func (s *viewService) ApplyViewWithFilters(userID, projectID, viewID string, filters map[string]interface{}, sortBy, sortDir string, groupByFieldID *string, page, limit int) (interface{}, error) {
+func (s *viewService) ApplyViewWithFilters(userID, projectID, viewID string, filters map[string]interface{}, sortKeys []domain.ViewSortKey, groupByFieldID *string, req dto.ApplyViewRequest) (interface{}, error) {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 사용자 ID", 400)
@@ -408,76 +463,50 @@ func (s *viewService) ApplyViewWithFilters(userID, projectID, viewID string, fil
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "멤버 확인 실패", 500)
 	}
 
-	// Build query with filters
-	query := s.db.Model(&domain.Board{}).Where("project_id = ? AND is_deleted = ?", projectUUID, false)
-
-	// Apply filters
-	for fieldIDStr, filterConfig := range filters {
-		// Parse filter condition
-		filterMap, ok := filterConfig.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		operator, _ := filterMap["operator"].(string)
-		value := filterMap["value"]
-
-		// Special handling for built-in fields
-		if fieldIDStr == "title" {
-			query = s.applyBuiltInFilter(query, "title", operator, value)
-			continue
-		}
-
-		// Custom field filtering via custom_fields_cache
-		fieldUUID, err := uuid.Parse(fieldIDStr)
-		if err != nil {
-			continue
+	project, err := s.projectRepo.FindByID(projectUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", 404)
 		}
-
-		query = s.applyCustomFieldFilter(query, fieldUUID, operator, value)
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 조회 실패", 500)
 	}
 
-	// Apply sorting
-	if sortBy != "" {
-		if sortDir == "" {
-			sortDir = "asc"
-		}
-		query = query.Order(fmt.Sprintf("%s %s", sortBy, strings.ToUpper(sortDir)))
-	} else {
-		query = query.Order("created_at DESC")
+	// Build query with filters
+	query := s.db.Model(&domain.Board{}).Where("project_id = ? AND is_deleted = ?", projectUUID, false)
+	filter, err := s.compileFilters(project, filters)
+	if err != nil {
+		return nil, err
 	}
-
-	// Count total
-	var total int64
-	if err := query.Count(&total).Error; err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 카운트 실패", 500)
+	if !filter.isEmpty() {
+		query = query.Where("("+filter.sql+")", filter.args...)
 	}
 
-	// Pagination
-	if page < 1 {
-		page = 1
+	keyset, err := s.compileSort(project, sortKeys)
+	if err != nil {
+		return nil, err
 	}
-	if limit < 1 {
-		limit = 20
+	page, err := s.findBoardPage(query, keyset, req)
+	if err != nil {
+		return nil, err
 	}
-	offset := (page - 1) * limit
+	boards := page.boards
 
-	// Fetch boards
-	var boards []domain.Board
-	if err := query.Offset(offset).Limit(limit).Find(&boards).Error; err != nil {
-		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	boardIDs := make([]uuid.UUID, len(boards))
+	for i, board := range boards {
+		boardIDs[i] = board.ID
+	}
+	extras := viewBoardExtras{
+		blocked:  s.relations.blocked(boardIDs),
+		progress: s.progress.list(boardIDs),
+		time:     s.time.list(boards),
 	}
 
 	// If grouping requested, apply grouping
 	if groupByFieldID != nil && *groupByFieldID != "" {
-		return s.applyGrouping(boards, *groupByFieldID, total)
+		return s.applyGrouping(boards, *groupByFieldID, page, extras)
 	}
 
 	// Fetch board positions for this view and user
-	boardIDs := make([]uuid.UUID, len(boards))
-	for i, board := range boards {
-		boardIDs[i] = board.ID
-	}
 
 	var userBoardOrders []domain.UserBoardOrder
 	if len(boardIDs) > 0 {
@@ -512,6 +541,8 @@ func (s *viewService) ApplyViewWithFilters(userID, projectID, viewID string, fil
 		boardResponses = append(boardResponses, dto.BoardResponse{
 			ID:           board.ID.String(),
 			ProjectID:    board.ProjectID.String(),
+			Key:          board.Key,
+			Number:       board.Number,
 			Title:        board.Title,
 			Content:      board.Description,
 			CustomFields: customFields,
@@ -519,14 +550,87 @@ func (s *viewService) ApplyViewWithFilters(userID, projectID, viewID string, fil
 			CreatedAt:    board.CreatedAt,
 			UpdatedAt:    board.UpdatedAt,
 		})
+		extras.apply(&boardResponses[len(boardResponses)-1], &board)
 	}
 
-	return map[string]interface{}{
-		"boards": boardResponses,
-		"total":  total,
-		"page":   page,
-		"limit":  limit,
-	}, nil
+	response := map[string]interface{}{
+		"boards":      boardResponses,
+		"limit":       page.limit,
+		"next_cursor": pagination.EncodeCursor(page.next),
+		"prev_cursor": pagination.EncodeCursor(page.prev),
+	}
+	if page.total != nil {
+		response["total"] = *page.total
+	}
+	if page.number > 0 {
+		response["page"] = page.number
+	}
+	return response, nil
+}
+
+// viewBoardPage is one page of the boards of a view
+type viewBoardPage struct {
+	boards     []domain.Board
+	total      *int64 // nil if not counted
+	number     int    // Page number; 0 for a page read by cursor
+	limit      int
+	next, prev *pagination.Cursor
+}
+
+// findBoardPage reads the page of boards after (or before) the cursor of the request,
+// or the page with its number when there is no cursor
+func (s *viewService) findBoardPage(query *gorm.DB, keyset repository.Keyset, req dto.ApplyViewRequest) (*viewBoardPage, error) {
+	cursorReq, err := pagination.NewCursorRequest(req.Cursor, req.Limit, req.IncludeTotal)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 커서", 400)
+	}
+	query = query.Session(&gorm.Session{})
+	page := &viewBoardPage{limit: cursorReq.Limit}
+
+	if cursorReq.IncludeTotal {
+		var total int64
+		if err := query.Count(&total).Error; err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 카운트 실패", 500)
+		}
+		page.total = &total
+	}
+
+	if cursorReq.Cursor != nil {
+		result, err := repository.FindKeysetPage(query, keyset, cursorReq.Cursor, cursorReq.Limit, func(board *domain.Board) uuid.UUID { return board.ID })
+		if errors.Is(err, pagination.ErrInvalidCursor) {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 커서", 400)
+		}
+		if err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+		}
+		page.boards, page.next, page.prev = result.Items, result.Next, result.Prev
+		return page, nil
+	}
+
+	// A page read by number carries the cursors of its first and last boards
+	page.number, _ = pagination.ValidatePaginationParams(req.Page, req.Limit)
+	offset := pagination.CalculateOffset(page.number, page.limit)
+	if err := query.Clauses(keyset.OrderBy()).Offset(offset).Limit(page.limit + 1).Find(&page.boards).Error; err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+	}
+	more := len(page.boards) > page.limit
+	if more {
+		page.boards = page.boards[:page.limit]
+	}
+	if len(page.boards) == 0 {
+		return page, nil
+	}
+	if more {
+		if page.next, err = repository.KeysetCursor(query, keyset, page.boards[len(page.boards)-1].ID, false); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+		}
+	}
+	if page.number > 1 {
+		if page.prev, err = repository.KeysetCursor(query, keyset, page.boards[0].ID, true); err != nil {
+			return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 조회 실패", 500)
+		}
+	}
+	return page, nil
 }
 
 // ==================== Board Order Management ====================
@@ -577,14 +681,183 @@ func (s *viewService) UpdateBoardOrder(userID string, req *dto.UpdateBoardOrderR
 	}
 
 	// Batch update
-	if err := s.repo.BatchUpdateBoardOrders(orders); err != nil {
-		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 순서 업데이트 실패", 500)
+	// Board orders are per user: clients apply this only when actorId is themselves (e.g. another tab)
+	return s.saveWithEvent(func(repo repository.FieldRepository) error {
+		if err := repo.BatchUpdateBoardOrders(orders); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "보드 순서 업데이트 실패", 500)
+		}
+		return nil
+	}, true, func() event.Event {
+		return event.New(event.BoardOrderChanged, view.ProjectID, userUUID, map[string]interface{}{
+			"viewId":      req.ViewID,
+			"boardOrders": req.BoardOrders,
+		})
+	})
+}
+
+// ==================== Helper Methods ====================
+
+// saveWithEvent runs a view change and records its project event in one transaction
+// Private views are only visible to their creator, so their changes are not broadcast (visible is false)
+func (s *viewService) saveWithEvent(change func(repo repository.FieldRepository) error, visible bool, newEvent func() event.Event) error {
+	return s.uow.Do(func(repos *uow.Repositories) error {
+		if err := change(repos.Field); err != nil {
+			return err
+		}
+		if !visible {
+			return nil
+		}
+		if err := repos.Outbox.Write(newEvent()); err != nil {
+			return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "뷰 변경 이벤트 기록 실패", 500)
+		}
+		return nil
+	})
+}
+
+// normalizeFilters validates the filter tree of a view against the project's fields
+// and returns it as stored in SavedView.Filters; flat (pre-tree) filters are stored as an "and" group
+func (s *viewService) normalizeFilters(projectID uuid.UUID, filters map[string]interface{}) (string, error) {
+	data, err := json.Marshal(filters)
+	if err != nil {
+		return "", apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "필터가 유효하지 않습니다", 400)
+	}
+	root, err := domain.ParseViewFilters(data)
+	if err != nil {
+		return "", apperrors.FromDomainError(err)
+	}
+	if root == nil {
+		return "{}", nil
 	}
 
-	return nil
+	project, err := s.projectRepo.FindByID(projectID)
+	if err != nil {
+		return "", apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 조회 실패", 500)
+	}
+	compiler, err := newViewFilterCompiler(s.repo, s.db, project, time.Now(), true)
+	if err != nil {
+		return "", apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	if _, err := compiler.compile(root); err != nil {
+		return "", apperrors.FromDomainError(err)
+	}
+
+	encoded, err := json.Marshal(root)
+	if err != nil {
+		return "", apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필터 저장 실패", 500)
+	}
+	return string(encoded), nil
 }
 
-// ==================== Helper Methods ====================
+// compileFilters compiles the filters of a view being applied
+// Conditions that no longer compile (e.g. on a deleted field) are left out, like unparsable filters
+func (s *viewService) compileFilters(project *domain.Project, filters map[string]interface{}) (sqlClause, error) {
+	if len(filters) == 0 {
+		return sqlClause{}, nil
+	}
+	data, err := json.Marshal(filters)
+	if err != nil {
+		return sqlClause{}, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "필터가 유효하지 않습니다", 400)
+	}
+	root, err := domain.ParseViewFilters(data)
+	if err != nil {
+		s.logger.Warn("Failed to parse view filters", zap.Error(err))
+		return sqlClause{}, nil
+	}
+
+	compiler, err := newViewFilterCompiler(s.repo, s.db, project, time.Now(), false)
+	if err != nil {
+		return sqlClause{}, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	clause, err := compiler.compile(root)
+	if err != nil {
+		s.logger.Warn("Ignoring invalid view filters", zap.Error(err))
+		return sqlClause{}, nil
+	}
+	if len(compiler.dropped) > 0 {
+		s.logger.Warn("Ignoring view filter conditions that no longer apply", zap.Strings("fields", compiler.dropped))
+	}
+	return clause, nil
+}
+
+// sortKeysFromRequest converts the sort keys of a request
+func sortKeysFromRequest(keys []dto.ViewSortKey) []domain.ViewSortKey {
+	sortKeys := make([]domain.ViewSortKey, 0, len(keys))
+	for _, key := range keys {
+		sortKeys = append(sortKeys, domain.ViewSortKey{Field: key.Field, Direction: key.Direction, Nulls: key.Nulls})
+	}
+	return sortKeys
+}
+
+// updatedSortKeys returns the sort of a view being updated
+// sort replaces the keys, sortBy replaces them with a single key, and sortDirection alone changes the direction of the first key
+func (s *viewService) updatedSortKeys(view *domain.SavedView, req *dto.UpdateViewRequest) ([]domain.ViewSortKey, error) {
+	if req.Sort != nil {
+		return sortKeysFromRequest(req.Sort), nil
+	}
+	direction := req.SortDirection
+	if direction == "" {
+		direction = view.SortDirection
+	}
+	if req.SortBy != nil {
+		if *req.SortBy == "" {
+			return nil, nil
+		}
+		return []domain.ViewSortKey{{Field: *req.SortBy, Direction: direction}}, nil
+	}
+
+	sortKeys, err := domain.ParseViewSort(view.SortBy, view.SortDirection)
+	if err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+	if len(sortKeys) > 0 {
+		sortKeys[0].Direction = direction
+	}
+	return sortKeys, nil
+}
+
+// normalizeSort validates the sort keys of a view being saved
+// It returns the spec stored in SavedView.SortBy and the direction of the first key for SortDirection
+func (s *viewService) normalizeSort(projectID uuid.UUID, keys []domain.ViewSortKey) (*string, string, error) {
+	if len(keys) == 0 {
+		return nil, domain.SortAsc, nil
+	}
+
+	project, err := s.projectRepo.FindByID(projectID)
+	if err != nil {
+		return nil, "", apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "프로젝트 조회 실패", 500)
+	}
+	compiler, err := newViewSortCompiler(s.repo, project, true)
+	if err != nil {
+		return nil, "", apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	keys, _, err = compiler.resolve(keys)
+	if err != nil {
+		return nil, "", apperrors.FromDomainError(err)
+	}
+	return domain.EncodeViewSort(keys), keys[0].Direction, nil
+}
+
+// compileSort compiles the sort of a view being applied
+// Keys that no longer compile (e.g. on a deleted field) are left out
+func (s *viewService) compileSort(project *domain.Project, keys []domain.ViewSortKey) (repository.Keyset, error) {
+	compiler, err := newViewSortCompiler(s.repo, project, false)
+	if err != nil {
+		return repository.Keyset{}, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+	}
+	order, err := compiler.compile(keys)
+	if err != nil {
+		var domainErr *domain.DomainError
+		if !errors.As(err, &domainErr) {
+			return repository.Keyset{}, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "필드 조회 실패", 500)
+		}
+		s.logger.Warn("Ignoring invalid view sort", zap.Error(err))
+		return compiler.compile(nil)
+	}
+	if len(compiler.dropped) > 0 {
+		s.logger.Warn("Ignoring view sort keys that no longer apply", zap.Strings("fields", compiler.dropped))
+	}
+	return order, nil
+}
 
 func (s *viewService) buildViewResponse(view *domain.SavedView) *dto.ViewResponse {
 	var filters map[string]interface{}
@@ -597,9 +870,19 @@ func (s *viewService) buildViewResponse(view *domain.SavedView) *dto.ViewRespons
 		filters = make(map[string]interface{})
 	}
 
+	sortKeys, err := domain.ParseViewSort(view.SortBy, view.SortDirection)
+	if err != nil {
+		s.logger.Warn("Failed to parse view sort", zap.Error(err))
+	}
+	sortResponse := make([]dto.ViewSortKey, 0, len(sortKeys))
+	for _, key := range sortKeys {
+		sortResponse = append(sortResponse, dto.ViewSortKey{Field: key.Field, Direction: key.Direction, Nulls: key.Nulls})
+	}
 	var sortBy string
-	if view.SortBy != nil {
-		sortBy = *view.SortBy
+	sortDirection := view.SortDirection
+	if len(sortKeys) > 0 {
+		sortBy = sortKeys[0].Field
+		sortDirection = sortKeys[0].Direction
 	}
 
 	var groupByFieldID string
@@ -616,49 +899,42 @@ func (s *viewService) buildViewResponse(view *domain.SavedView) *dto.ViewRespons
 		IsDefault:      view.IsDefault,
 		IsShared:       view.IsShared,
 		Filters:        filters,
+		Sort:           sortResponse,
 		SortBy:         sortBy,
-		SortDirection:  view.SortDirection,
+		SortDirection:  sortDirection,
 		GroupByFieldID: groupByFieldID,
 		CreatedAt:      view.CreatedAt,
 		UpdatedAt:      view.UpdatedAt,
 	}
 }
 
-func (s *viewService) applyBuiltInFilter(query *gorm.DB, field, operator string, value interface{}) *gorm.DB {
-	switch operator {
-	case "contains":
-		if strVal, ok := value.(string); ok {
-			return query.Where(field+" LIKE ?", "%"+strVal+"%")
-		}
-	case "eq":
-		return query.Where(field+" = ?", value)
-	case "ne":
-		return query.Where(field+" != ?", value)
-	}
-	return query
+// viewBoardExtras holds the per-board data that view responses fetch in batch
+type viewBoardExtras struct {
+	blocked  map[uuid.UUID]bool
+	progress map[uuid.UUID]*dto.BoardProgressResponse
+	time     map[uuid.UUID]*dto.BoardTimeTrackingResponse
 }
 
-func (s *viewService) applyCustomFieldFilter(query *gorm.DB, fieldID uuid.UUID, operator string, value interface{}) *gorm.DB {
-	// Use JSONB operators on custom_fields_cache
-	fieldKey := fieldID.String()
-
-	switch operator {
-	case "contains":
-		if strVal, ok := value.(string); ok {
-			return query.Where("custom_fields_cache->? LIKE ?", fieldKey, "%"+strVal+"%")
-		}
-	case "in":
-		if arr, ok := value.([]interface{}); ok {
-			return query.Where("custom_fields_cache->? ?| ARRAY[?]", fieldKey, arr)
-		}
-	case "eq":
-		return query.Where("custom_fields_cache->>? = ?", fieldKey, value)
+// apply fills the blocked flag, the parent, the sprint, the milestone, the progress and the time tracking of a view board response
+func (e viewBoardExtras) apply(response *dto.BoardResponse, board *domain.Board) {
+	response.IsBlocked = e.blocked[board.ID]
+	response.Progress = e.progress[board.ID]
+	response.TimeTracking = e.time[board.ID]
+	if board.ParentBoardID != nil {
+		parentID := board.ParentBoardID.String()
+		response.ParentBoardID = &parentID
+	}
+	if board.SprintID != nil {
+		sprintID := board.SprintID.String()
+		response.SprintID = &sprintID
+	}
+	if board.MilestoneID != nil {
+		milestoneID := board.MilestoneID.String()
+		response.MilestoneID = &milestoneID
 	}
-
-	return query
 }
 
-func (s *viewService) applyGrouping(boards []domain.Board, groupByFieldID string, total int64) (interface{}, error) {
+func (s *viewService) applyGrouping(boards []domain.Board, groupByFieldID string, page *viewBoardPage, extras viewBoardExtras) (interface{}, error) {
 	fieldUUID, err := uuid.Parse(groupByFieldID)
 	if err != nil {
 		return nil, apperrors.Wrap(err, apperrors.ErrCodeBadRequest, "잘못된 그룹핑 필드 ID", 400)
@@ -689,28 +965,36 @@ func (s *viewService) applyGrouping(boards []domain.Board, groupByFieldID string
 					if arr, ok := fieldVal.([]interface{}); ok {
 						for _, optionID := range arr {
 							optionIDStr := fmt.Sprintf("%v", optionID)
-							groups[optionIDStr] = append(groups[optionIDStr], dto.BoardResponse{
+							response := dto.BoardResponse{
 								ID:           board.ID.String(),
 								ProjectID:    board.ProjectID.String(),
+								Key:          board.Key,
+								Number:       board.Number,
 								Title:        board.Title,
 								Content:      board.Description,
 								CustomFields: cache,
 								CreatedAt:    board.CreatedAt,
 								UpdatedAt:    board.UpdatedAt,
-							})
+							}
+							extras.apply(&response, &board)
+							groups[optionIDStr] = append(groups[optionIDStr], response)
 						}
 					} else {
 						// Single value
 						optionIDStr := fmt.Sprintf("%v", fieldVal)
-						groups[optionIDStr] = append(groups[optionIDStr], dto.BoardResponse{
+						response := dto.BoardResponse{
 							ID:           board.ID.String(),
 							ProjectID:    board.ProjectID.String(),
+							Key:          board.Key,
+							Number:       board.Number,
 							Title:        board.Title,
 							Content:      board.Description,
 							CustomFields: cache,
 							CreatedAt:    board.CreatedAt,
 							UpdatedAt:    board.UpdatedAt,
-						})
+						}
+						extras.apply(&response, &board)
+						groups[optionIDStr] = append(groups[optionIDStr], response)
 					}
 				}
 			}
@@ -747,6 +1031,8 @@ func (s *viewService) applyGrouping(boards []domain.Board, groupByFieldID string
 	return dto.GroupedBoardsResponse{
 		GroupByField: fieldResponse,
 		Groups:       groupResponses,
-		Total:        total,
+		Total:        page.total,
+		NextCursor:   pagination.EncodeCursor(page.next),
+		PrevCursor:   pagination.EncodeCursor(page.prev),
 	}, nil
 }
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/repository"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// builtInSortFields are the board columns a view can sort by besides the custom fields
+// Boards are sorted by assignee ID, which keeps the boards of each assignee together
+var builtInSortFields = map[string]string{
+	"title":             "title",
+	"createdAt":         "created_at",
+	"updatedAt":         "updated_at",
+	"dueDate":           "due_date",
+	"assignee":          "assignee_id",
+	"originalEstimate":  "original_estimate_minutes",
+	"remainingEstimate": "remaining_estimate_minutes",
+	// Logged time is summed from the work logs of each board
+	"timeSpent": "(SELECT COALESCE(SUM(work_logs.duration_minutes), 0) FROM work_logs WHERE work_logs.board_id = boards.id)",
+}
+
+// sortFieldAliases are the column names views used before sort keys were checked
+var sortFieldAliases = map[string]string{
+	"created_at":  "createdAt",
+	"updated_at":  "updatedAt",
+	"due_date":    "dueDate",
+	"assignee_id": "assignee",
+}
+
+// viewSortCompiler compiles the sort keys of a view into an ORDER BY clause on boards
+// Only whitelisted columns are written into the SQL; custom field IDs and option IDs are passed as parameters
+type viewSortCompiler struct {
+	repo   repository.FieldRepository
+	fields map[uuid.UUID]*domain.ProjectField
+	// strict rejects keys that cannot be compiled (when a view is saved);
+	// otherwise they are dropped (when a view is applied, e.g. after its field was deleted)
+	strict  bool
+	dropped []string
+}
+
+func newViewSortCompiler(fieldRepo repository.FieldRepository, project *domain.Project, strict bool) (*viewSortCompiler, error) {
+	fields, err := fieldRepo.FindFieldsByProject(project.ID)
+	if err != nil {
+		return nil, err
+	}
+	compiler := &viewSortCompiler{
+		repo:   fieldRepo,
+		fields: make(map[uuid.UUID]*domain.ProjectField, len(fields)),
+		strict: strict,
+	}
+	for i := range fields {
+		compiler.fields[fields[i].ID] = &fields[i]
+	}
+	return compiler, nil
+}
+
+// resolve checks the keys and returns them, with defaults filled in and legacy field names replaced,
+// along with the expression of each key
+func (c *viewSortCompiler) resolve(keys []domain.ViewSortKey) ([]domain.ViewSortKey, []sqlClause, error) {
+	keys, err := domain.NormalizeViewSort(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	resolved := make([]domain.ViewSortKey, 0, len(keys))
+	operands := make([]sqlClause, 0, len(keys))
+	for _, key := range keys {
+		if alias, ok := sortFieldAliases[key.Field]; ok {
+			key.Field = alias
+		}
+		operand, err := c.expression(key.Field)
+		if err != nil {
+			var domainErr *domain.DomainError
+			if c.strict || !errors.As(err, &domainErr) {
+				return nil, nil, err
+			}
+			c.dropped = append(c.dropped, key.Field)
+			continue
+		}
+		resolved = append(resolved, key)
+		operands = append(operands, operand)
+	}
+	// An alias may now repeat a field given by its current name
+	if _, err := domain.NormalizeViewSort(resolved); err != nil {
+		return nil, nil, err
+	}
+	return resolved, operands, nil
+}
+
+// compile returns the order of the keys for keyset pagination
+// Boards are sorted by their ID last so that pages do not overlap; without keys the newest boards come first
+func (c *viewSortCompiler) compile(keys []domain.ViewSortKey) (repository.Keyset, error) {
+	keys, operands, err := c.resolve(keys)
+	if err != nil {
+		return repository.Keyset{}, err
+	}
+	if len(keys) == 0 {
+		return repository.Keyset{Keys: []repository.KeysetKey{{Expr: "created_at", Desc: true}}, IDDesc: true}, nil
+	}
+
+	keyset := repository.Keyset{Keys: make([]repository.KeysetKey, 0, len(keys))}
+	for i, key := range keys {
+		keyset.Keys = append(keyset.Keys, repository.KeysetKey{
+			Expr:       operands[i].sql,
+			Args:       operands[i].args,
+			Desc:       key.Direction == domain.SortDesc,
+			NullsFirst: key.Nulls == domain.SortNullsFirst,
+		})
+	}
+	return keyset, nil
+}
+
+// expression returns the SQL expression a field is sorted by
+func (c *viewSortCompiler) expression(key string) (sqlClause, error) {
+	if column, ok := builtInSortFields[key]; ok {
+		return sqlClause{sql: column}, nil
+	}
+
+	fieldID, err := uuid.Parse(key)
+	if err != nil {
+		return sqlClause{}, sortError(key, "알 수 없는 정렬 필드입니다")
+	}
+	field, ok := c.fields[fieldID]
+	if !ok {
+		return sqlClause{}, sortError(key, "프로젝트에 없는 필드입니다")
+	}
+
+	// Values of other JSON types (e.g. left over from a changed field type) sort as missing
+	key = field.ID.String()
+	switch field.FieldType {
+	case domain.FieldTypeNumber:
+		return sqlClause{"(CASE WHEN jsonb_typeof(custom_fields_cache->?) = 'number' THEN (custom_fields_cache->>?)::numeric END)", []interface{}{key, key}}, nil
+	case domain.FieldTypeDate, domain.FieldTypeDateTime:
+		return sqlClause{"(CASE WHEN jsonb_typeof(custom_fields_cache->?) = 'string' THEN (custom_fields_cache->>?)::timestamptz END)", []interface{}{key, key}}, nil
+	case domain.FieldTypeCheckbox:
+		return sqlClause{"(CASE WHEN jsonb_typeof(custom_fields_cache->?) = 'boolean' THEN (custom_fields_cache->>?)::boolean END)", []interface{}{key, key}}, nil
+	case domain.FieldTypeSingleSelect:
+		return c.optionOrder(field)
+	case domain.FieldTypeText, domain.FieldTypeURL, domain.FieldTypeSingleUser:
+		return sqlClause{"custom_fields_cache->>?", []interface{}{key}}, nil
+	default:
+		return sqlClause{}, sortError(field.Name, "정렬을 지원하지 않는 필드 유형입니다")
+	}
+}
+
+// optionOrder sorts a single-select field in the display order of its options
+// Values that are not an option of the field (e.g. a deleted one) sort as missing
+func (c *viewSortCompiler) optionOrder(field *domain.ProjectField) (sqlClause, error) {
+	options, err := c.repo.FindOptionsByField(field.ID)
+	if err != nil {
+		return sqlClause{}, err
+	}
+	if len(options) == 0 {
+		return sqlClause{sql: "CAST(NULL AS INTEGER)"}, nil
+	}
+
+	var sql strings.Builder
+	args := []interface{}{field.ID.String()}
+	sql.WriteString("(CASE custom_fields_cache->>?")
+	for position, option := range options {
+		// Positions are written as literals: untyped parameters would make the CASE sort as text
+		fmt.Fprintf(&sql, " WHEN ? THEN %d", position)
+		args = append(args, option.ID.String())
+	}
+	sql.WriteString(" END)")
+	return sqlClause{sql.String(), args}, nil
+}
+
+func sortError(name, message string) error {
+	return domain.NewValidationError("sort", fmt.Sprintf("정렬 기준이 올바르지 않습니다 (%s): %s", name, message))
+}
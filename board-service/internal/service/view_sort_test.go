@@ -0,0 +1,209 @@
+package service
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/clause"
+)
+
+func (s *ViewFilterTestSuite) sortCompiler(t *testing.T, strict bool) *viewSortCompiler {
+	project := &domain.Project{BaseModel: domain.BaseModel{ID: s.projectID}}
+	compiler, err := newViewSortCompiler(repository.NewFieldRepository(s.db), project, strict)
+	require.NoError(t, err)
+	return compiler
+}
+
+func (s *ViewFilterTestSuite) appliedTitles(t *testing.T, viewID string) []string {
+	result, err := s.views.ApplyView(s.memberID.String(), viewID, dto.ApplyViewRequest{})
+	require.NoError(t, err)
+	var titles []string
+	for _, board := range result.(map[string]interface{})["boards"].([]dto.BoardResponse) {
+		titles = append(titles, board.Title)
+	}
+	return titles
+}
+
+// orderBy returns the ORDER BY of a keyset
+func orderBy(keyset repository.Keyset) (string, []interface{}) {
+	expr := keyset.OrderBy().Expression.(clause.Expr)
+	return expr.SQL, expr.Vars
+}
+
+func TestViewSortCompiler_CustomFields(t *testing.T) {
+	suite := setupViewFilterTest(t)
+	compiler := suite.sortCompiler(t, true)
+	points, priority := suite.field("Points"), suite.field("Priority")
+
+	keyset, err := compiler.compile([]domain.ViewSortKey{{Field: points, Direction: "desc", Nulls: "first"}, {Field: "dueDate"}})
+	require.NoError(t, err)
+	sql, args := orderBy(keyset)
+	assert.Equal(t, "(CASE WHEN jsonb_typeof(custom_fields_cache->?) = 'number' THEN (custom_fields_cache->>?)::numeric END) DESC NULLS FIRST, due_date ASC NULLS LAST, id ASC", sql)
+	assert.Equal(t, []interface{}{points, points}, args)
+
+	// Options sort in their display order, not by ID or label
+	keyset, err = compiler.compile([]domain.ViewSortKey{{Field: priority}})
+	require.NoError(t, err)
+	sql, args = orderBy(keyset)
+	assert.Equal(t, "(CASE custom_fields_cache->>? WHEN ? THEN 0 WHEN ? THEN 1 WHEN ? THEN 2 END) ASC NULLS LAST, id ASC", sql)
+	assert.Equal(t, []interface{}{priority, suite.options["high"].String(), suite.options["medium"].String(), suite.options["low"].String()}, args)
+
+	keyset, err = compiler.compile(nil)
+	require.NoError(t, err)
+	sql, _ = orderBy(keyset)
+	assert.Equal(t, "created_at DESC NULLS LAST, id DESC", sql)
+}
+
+func TestViewSortCompiler_RejectsInvalidKeys(t *testing.T) {
+	suite := setupViewFilterTest(t)
+	compiler := suite.sortCompiler(t, true)
+
+	tests := []struct {
+		name string
+		keys []domain.ViewSortKey
+	}{
+		{"SQL in the field", []domain.ViewSortKey{{Field: "title; DROP TABLE boards"}}},
+		{"column that is not whitelisted", []domain.ViewSortKey{{Field: "description"}}},
+		{"multi-select field", []domain.ViewSortKey{{Field: suite.field("Labels")}}},
+		{"field of another project", []domain.ViewSortKey{{Field: uuid.New().String()}}},
+		{"legacy name of a field given twice", []domain.ViewSortKey{{Field: "due_date"}, {Field: "dueDate"}}},
+		{"unknown direction", []domain.ViewSortKey{{Field: "title", Direction: "sideways"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := compiler.compile(tt.keys)
+			require.Error(t, err)
+			assert.Equal(t, domain.ErrCodeValidation, err.(*domain.DomainError).Code)
+		})
+	}
+
+	// Views being applied leave out the keys that no longer compile
+	compiler = suite.sortCompiler(t, false)
+	keyset, err := compiler.compile([]domain.ViewSortKey{{Field: uuid.Nil.String()}, {Field: "created_at", Direction: "desc"}})
+	require.NoError(t, err)
+	sql, _ := orderBy(keyset)
+	assert.Equal(t, "created_at DESC NULLS LAST, id ASC", sql)
+	assert.Equal(t, []string{uuid.Nil.String()}, compiler.dropped)
+}
+
+func TestViewService_ApplyView_SortsByKeys(t *testing.T) {
+	suite := setupViewFilterTest(t)
+	for title, day := range map[string]int{"Alpha": 10, "Beta": 5, "Gamma": 0, "Delta": 0} {
+		var dueDate *time.Time
+		if day > 0 {
+			date := time.Date(2030, 1, day, 0, 0, 0, 0, time.UTC)
+			dueDate = &date
+		}
+		suite.board(t, title, dueDate)
+	}
+
+	view, err := suite.views.CreateView(suite.memberID.String(), &dto.CreateViewRequest{
+		ProjectID: suite.projectID.String(),
+		Name:      "Due first",
+		Sort:      []dto.ViewSortKey{{Field: "dueDate", Nulls: "first"}, {Field: "title", Direction: "desc"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []dto.ViewSortKey{{Field: "dueDate", Direction: "asc", Nulls: "first"}, {Field: "title", Direction: "desc", Nulls: "last"}}, view.Sort)
+	assert.Equal(t, "dueDate", view.SortBy)
+	assert.Equal(t, []string{"Gamma", "Delta", "Beta", "Alpha"}, suite.appliedTitles(t, view.ViewID))
+
+	// sortDirection alone turns the first key around
+	view, err = suite.views.UpdateView(suite.memberID.String(), view.ViewID, &dto.UpdateViewRequest{SortDirection: "desc"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Gamma", "Delta", "Alpha", "Beta"}, suite.appliedTitles(t, view.ViewID))
+
+	_, err = suite.views.UpdateView(suite.memberID.String(), view.ViewID, &dto.UpdateViewRequest{Sort: []dto.ViewSortKey{{Field: suite.field("Labels")}}})
+	assert.Equal(t, 400, appErrorStatus(t, err))
+
+	// A view saved before sort keys holds a column name
+	legacyID := uuid.New()
+	require.NoError(t, suite.db.Exec("INSERT INTO saved_views (id, project_id, created_by, name, is_shared, filters, sort_by, sort_direction) VALUES (?, ?, ?, ?, true, '{}', 'title', 'desc')",
+		legacyID, suite.projectID, suite.memberID, "Legacy").Error)
+	assert.Equal(t, []string{"Gamma", "Delta", "Beta", "Alpha"}, suite.appliedTitles(t, legacyID.String()))
+}
+
+func TestViewService_CreateView_RejectsUnknownSortColumn(t *testing.T) {
+	suite := setupViewFilterTest(t)
+
+	_, err := suite.views.CreateView(suite.memberID.String(), &dto.CreateViewRequest{
+		ProjectID: suite.projectID.String(),
+		Name:      "Injected",
+		SortBy:    "title; DROP TABLE boards",
+	})
+	assert.Equal(t, 400, appErrorStatus(t, err))
+
+	var count int64
+	require.NoError(t, suite.db.Model(&domain.SavedView{}).Count(&count).Error)
+	assert.Zero(t, count)
+}
+
+func TestViewService_ApplyView_PagesByCursor(t *testing.T) {
+	suite := setupViewFilterTest(t)
+	for title, day := range map[string]int{"Alpha": 10, "Beta": 5, "Gamma": 0, "Delta": 0, "Epsilon": 20} {
+		var dueDate *time.Time
+		if day > 0 {
+			date := time.Date(2030, 1, day, 0, 0, 0, 0, time.UTC)
+			dueDate = &date
+		}
+		suite.board(t, title, dueDate)
+	}
+	view, err := suite.views.CreateView(suite.memberID.String(), &dto.CreateViewRequest{
+		ProjectID: suite.projectID.String(),
+		Name:      "Due first",
+		Sort:      []dto.ViewSortKey{{Field: "dueDate", Nulls: "first"}, {Field: "title", Direction: "desc"}},
+	})
+	require.NoError(t, err)
+
+	page := func(req dto.ApplyViewRequest) ([]string, map[string]interface{}) {
+		result, err := suite.views.ApplyView(suite.memberID.String(), view.ViewID, req)
+		require.NoError(t, err)
+		response := result.(map[string]interface{})
+		var titles []string
+		for _, board := range response["boards"].([]dto.BoardResponse) {
+			titles = append(titles, board.Title)
+		}
+		return titles, response
+	}
+	cursor := func(response map[string]interface{}, key string) string {
+		value := response[key].(*string)
+		require.NotNil(t, value, key)
+		return *value
+	}
+
+	titles, first := page(dto.ApplyViewRequest{Limit: 2})
+	assert.Equal(t, []string{"Gamma", "Delta"}, titles)
+	assert.Equal(t, int64(5), first["total"])
+	assert.Nil(t, first["prev_cursor"])
+
+	titles, second := page(dto.ApplyViewRequest{Limit: 2, Cursor: cursor(first, "next_cursor")})
+	assert.Equal(t, []string{"Beta", "Alpha"}, titles)
+	assert.NotContains(t, second, "total")
+
+	titles, last := page(dto.ApplyViewRequest{Limit: 2, Cursor: cursor(second, "next_cursor")})
+	assert.Equal(t, []string{"Epsilon"}, titles)
+	assert.Nil(t, last["next_cursor"])
+
+	// prev_cursor reads the pages back in the same order
+	titles, back := page(dto.ApplyViewRequest{Limit: 2, Cursor: cursor(last, "prev_cursor")})
+	assert.Equal(t, []string{"Beta", "Alpha"}, titles)
+	titles, back = page(dto.ApplyViewRequest{Limit: 2, Cursor: cursor(back, "prev_cursor")})
+	assert.Equal(t, []string{"Gamma", "Delta"}, titles)
+	assert.Nil(t, back["prev_cursor"])
+
+	// A page read by number links to the pages around it
+	titles, numbered := page(dto.ApplyViewRequest{Page: 2, Limit: 2})
+	assert.Equal(t, []string{"Beta", "Alpha"}, titles)
+	titles, _ = page(dto.ApplyViewRequest{Limit: 2, Cursor: cursor(numbered, "next_cursor")})
+	assert.Equal(t, []string{"Epsilon"}, titles)
+	titles, _ = page(dto.ApplyViewRequest{Limit: 2, Cursor: cursor(numbered, "prev_cursor")})
+	assert.Equal(t, []string{"Gamma", "Delta"}, titles)
+
+	_, err = suite.views.ApplyView(suite.memberID.String(), view.ViewID, dto.ApplyViewRequest{Cursor: "not a cursor"})
+	assert.Equal(t, 400, appErrorStatus(t, err))
+}
@@ -0,0 +1,393 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/common/auth"
+	"board-service/internal/common/pagination"
+	"board-service/internal/common/parser"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/repository"
+	"board-service/internal/webhook"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// WebhookService는 프로젝트 Webhook 구독 관리와 전송 이력 조회/재전송을 담당합니다
+// 실제 전송은 webhook.Dispatcher(이벤트 → delivery 생성)와 webhook.Worker(HTTP 전송)가 수행합니다
+type WebhookService interface {
+	CreateWebhook(userID, projectID string, req *dto.CreateWebhookRequest) (*dto.WebhookResponse, error)
+	GetWebhooks(userID, projectID string) ([]dto.WebhookResponse, error)
+	GetWebhook(userID, projectID, webhookID string) (*dto.WebhookResponse, error)
+	UpdateWebhook(userID, projectID, webhookID string, req *dto.UpdateWebhookRequest) (*dto.WebhookResponse, error)
+	DeleteWebhook(userID, projectID, webhookID string) error
+
+	GetDeliveries(userID, projectID, webhookID string, req *dto.GetWebhookDeliveriesRequest) (*dto.PaginatedWebhookDeliveriesResponse, error)
+	GetDelivery(userID, projectID, webhookID, deliveryID string) (*dto.WebhookDeliveryResponse, error)
+	RedeliverDelivery(userID, projectID, webhookID, deliveryID string) (*dto.WebhookDeliveryResponse, error)
+}
+
+type webhookService struct {
+	repo       repository.WebhookRepository
+	authorizer auth.ProjectAuthorizer
+	logger     *zap.Logger
+}
+
+func NewWebhookService(
+	repo repository.WebhookRepository,
+	projectRepo repository.ProjectRepository,
+	roleRepo repository.RoleRepository,
+	logger *zap.Logger,
+) WebhookService {
+	return &webhookService{
+		repo:       repo,
+		authorizer: auth.NewProjectAuthorizer(projectRepo, roleRepo),
+		logger:     logger,
+	}
+}
+
+// ==================== Webhook CRUD ====================
+
+func (s *webhookService) CreateWebhook(userID, projectID string, req *dto.CreateWebhookRequest) (*dto.WebhookResponse, error) {
+	userUUID, projectUUID, err := s.requireAdmin(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Validate subscribed events
+	if err := validateWebhookEvents(req.Events); err != nil {
+		return nil, err
+	}
+
+	// 2. Generate signing secret
+	secret, err := webhook.GenerateSecret()
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 시크릿 생성 실패", 500)
+	}
+
+	hook := &domain.Webhook{
+		ProjectID:   projectUUID,
+		URL:         req.URL,
+		Secret:      secret,
+		Description: req.Description,
+		IsActive:    true,
+		CreatedBy:   userUUID,
+	}
+	hook.SetEventTypes(req.Events)
+
+	if err := hook.ValidateURL(); err != nil {
+		return nil, apperrors.FromDomainError(err)
+	}
+
+	// 3. Save
+	if err := s.repo.CreateWebhook(hook); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 생성 실패", 500)
+	}
+
+	s.logger.Info("Webhook created",
+		zap.String("webhook_id", hook.ID.String()),
+		zap.String("project_id", projectID),
+	)
+
+	// The secret is only shown once, right after creation
+	response := toWebhookResponse(hook)
+	response.Secret = hook.Secret
+	return response, nil
+}
+
+func (s *webhookService) GetWebhooks(userID, projectID string) ([]dto.WebhookResponse, error) {
+	_, projectUUID, err := s.requireAdmin(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := s.repo.FindWebhooksByProject(projectUUID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 목록 조회 실패", 500)
+	}
+
+	responses := make([]dto.WebhookResponse, 0, len(hooks))
+	for i := range hooks {
+		responses = append(responses, *toWebhookResponse(&hooks[i]))
+	}
+	return responses, nil
+}
+
+func (s *webhookService) GetWebhook(userID, projectID, webhookID string) (*dto.WebhookResponse, error) {
+	_, projectUUID, err := s.requireAdmin(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	hook, err := s.findWebhook(projectUUID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhookResponse(hook), nil
+}
+
+func (s *webhookService) UpdateWebhook(userID, projectID, webhookID string, req *dto.UpdateWebhookRequest) (*dto.WebhookResponse, error) {
+	_, projectUUID, err := s.requireAdmin(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	hook, err := s.findWebhook(projectUUID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		hook.URL = *req.URL
+		if err := hook.ValidateURL(); err != nil {
+			return nil, apperrors.FromDomainError(err)
+		}
+	}
+	if req.Events != nil {
+		if err := validateWebhookEvents(req.Events); err != nil {
+			return nil, err
+		}
+		hook.SetEventTypes(req.Events)
+	}
+	if req.Description != nil {
+		hook.Description = *req.Description
+	}
+	if req.IsActive != nil {
+		hook.IsActive = *req.IsActive
+	}
+
+	if err := s.repo.UpdateWebhook(hook); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 수정 실패", 500)
+	}
+
+	return toWebhookResponse(hook), nil
+}
+
+func (s *webhookService) DeleteWebhook(userID, projectID, webhookID string) error {
+	_, projectUUID, err := s.requireAdmin(userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	hook, err := s.findWebhook(projectUUID, webhookID)
+	if err != nil {
+		return err
+	}
+
+	// Pending deliveries of a deleted webhook are dropped by the worker
+	if err := s.repo.DeleteWebhook(hook.ID); err != nil {
+		return apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 삭제 실패", 500)
+	}
+
+	return nil
+}
+
+// ==================== Deliveries ====================
+
+func (s *webhookService) GetDeliveries(userID, projectID, webhookID string, req *dto.GetWebhookDeliveriesRequest) (*dto.PaginatedWebhookDeliveriesResponse, error) {
+	_, projectUUID, err := s.requireAdmin(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	hook, err := s.findWebhook(projectUUID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, limit := pagination.ValidatePaginationParams(req.Page, req.Limit)
+	deliveries, total, err := s.repo.FindDeliveriesByWebhook(hook.ID, page, limit)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 전송 이력 조회 실패", 500)
+	}
+
+	responses := make([]dto.WebhookDeliveryResponse, 0, len(deliveries))
+	for i := range deliveries {
+		responses = append(responses, *toWebhookDeliveryResponse(&deliveries[i]))
+	}
+
+	return &dto.PaginatedWebhookDeliveriesResponse{
+		Deliveries: responses,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+	}, nil
+}
+
+func (s *webhookService) GetDelivery(userID, projectID, webhookID, deliveryID string) (*dto.WebhookDeliveryResponse, error) {
+	_, projectUUID, err := s.requireAdmin(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.findDelivery(projectUUID, webhookID, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts, err := s.repo.FindAttemptsByDelivery(delivery.ID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 전송 시도 조회 실패", 500)
+	}
+
+	response := toWebhookDeliveryResponse(delivery)
+	response.Payload = delivery.Payload
+	response.Attempts = make([]dto.WebhookDeliveryAttemptResponse, 0, len(attempts))
+	for _, attempt := range attempts {
+		response.Attempts = append(response.Attempts, dto.WebhookDeliveryAttemptResponse{
+			AttemptNumber: attempt.AttemptNumber,
+			StatusCode:    attempt.StatusCode,
+			ResponseBody:  attempt.ResponseBody,
+			Error:         attempt.Error,
+			DurationMs:    attempt.DurationMs,
+			Succeeded:     attempt.Succeeded,
+			CreatedAt:     attempt.CreatedAt,
+		})
+	}
+
+	return response, nil
+}
+
+// RedeliverDelivery queues a new delivery with the same payload
+// The original delivery and its attempts are kept untouched for the log
+func (s *webhookService) RedeliverDelivery(userID, projectID, webhookID, deliveryID string) (*dto.WebhookDeliveryResponse, error) {
+	_, projectUUID, err := s.requireAdmin(userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.findDelivery(projectUUID, webhookID, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !delivery.IsFinished() {
+		return nil, apperrors.New(apperrors.ErrCodeConflict, "아직 전송 중인 Webhook입니다", 409)
+	}
+
+	redelivery := delivery.Redeliver()
+	if err := s.repo.CreateDelivery(redelivery); err != nil {
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 재전송 실패", 500)
+	}
+
+	return toWebhookDeliveryResponse(redelivery), nil
+}
+
+// ==================== Helper Methods ====================
+
+// requireAdmin parses the IDs and checks that the user is ADMIN+ of the project
+func (s *webhookService) requireAdmin(userID, projectID string) (uuid.UUID, uuid.UUID, error) {
+	userUUID, err := parser.ParseUserID(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	projectUUID, err := parser.ParseProjectID(projectID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	if _, err := s.authorizer.RequireAdmin(userUUID, projectUUID); err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return userUUID, projectUUID, nil
+}
+
+// findWebhook loads a webhook and makes sure it belongs to the project
+func (s *webhookService) findWebhook(projectID uuid.UUID, webhookID string) (*domain.Webhook, error) {
+	webhookUUID, err := parser.ParseUUID(webhookID, "Webhook")
+	if err != nil {
+		return nil, err
+	}
+
+	hook, err := s.repo.FindWebhookByID(webhookUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "Webhook을 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 조회 실패", 500)
+	}
+
+	if hook.ProjectID != projectID {
+		return nil, apperrors.New(apperrors.ErrCodeNotFound, "Webhook을 찾을 수 없습니다", 404)
+	}
+
+	return hook, nil
+}
+
+// findDelivery loads a delivery and makes sure it belongs to the webhook of the project
+func (s *webhookService) findDelivery(projectID uuid.UUID, webhookID, deliveryID string) (*domain.WebhookDelivery, error) {
+	hook, err := s.findWebhook(projectID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveryUUID, err := parser.ParseUUID(deliveryID, "전송")
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.repo.FindDeliveryByID(deliveryUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "Webhook 전송 이력을 찾을 수 없습니다", 404)
+		}
+		return nil, apperrors.Wrap(err, apperrors.ErrCodeInternalServer, "Webhook 전송 이력 조회 실패", 500)
+	}
+
+	if delivery.WebhookID != hook.ID {
+		return nil, apperrors.New(apperrors.ErrCodeNotFound, "Webhook 전송 이력을 찾을 수 없습니다", 404)
+	}
+
+	return delivery, nil
+}
+
+func validateWebhookEvents(events []string) error {
+	for _, eventType := range events {
+		if !webhook.IsSupportedEvent(eventType) {
+			return apperrors.New(apperrors.ErrCodeBadRequest, fmt.Sprintf("지원하지 않는 이벤트입니다: %s", eventType), 400)
+		}
+	}
+	return nil
+}
+
+func toWebhookResponse(hook *domain.Webhook) *dto.WebhookResponse {
+	return &dto.WebhookResponse{
+		ID:          hook.ID.String(),
+		ProjectID:   hook.ProjectID.String(),
+		URL:         hook.URL,
+		Events:      hook.GetEventTypes(),
+		Description: hook.Description,
+		IsActive:    hook.IsActive,
+		CreatedBy:   hook.CreatedBy.String(),
+		CreatedAt:   hook.CreatedAt,
+		UpdatedAt:   hook.UpdatedAt,
+	}
+}
+
+func toWebhookDeliveryResponse(delivery *domain.WebhookDelivery) *dto.WebhookDeliveryResponse {
+	response := &dto.WebhookDeliveryResponse{
+		ID:             delivery.ID.String(),
+		WebhookID:      delivery.WebhookID.String(),
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Status:         string(delivery.Status),
+		AttemptCount:   delivery.AttemptCount,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastAttemptAt:  delivery.LastAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		CreatedAt:      delivery.CreatedAt,
+	}
+	if delivery.RedeliveredFrom != nil {
+		original := delivery.RedeliveredFrom.String()
+		response.RedeliveredFrom = &original
+	}
+	return response
+}
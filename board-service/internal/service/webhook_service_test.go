@@ -0,0 +1,286 @@
+package service
+
+import (
+	"board-service/internal/apperrors"
+	"board-service/internal/domain"
+	"board-service/internal/dto"
+	"board-service/internal/testutil"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// ==================== Test Suite Setup ====================
+
+type WebhookServiceTestSuite struct {
+	webhookRepo *testutil.MockWebhookRepository
+	projectRepo *testutil.MockProjectRepository
+	roleRepo    *testutil.MockRoleRepository
+	service     WebhookService
+}
+
+func setupWebhookServiceTest(t *testing.T) *WebhookServiceTestSuite {
+	suite := &WebhookServiceTestSuite{
+		webhookRepo: new(testutil.MockWebhookRepository),
+		projectRepo: new(testutil.MockProjectRepository),
+		roleRepo:    new(testutil.MockRoleRepository),
+	}
+
+	suite.service = NewWebhookService(
+		suite.webhookRepo,
+		suite.projectRepo,
+		suite.roleRepo,
+		zap.NewNop(),
+	)
+
+	return suite
+}
+
+// expectRole makes the user a project member with the given role level
+func (s *WebhookServiceTestSuite) expectRole(userID, projectID uuid.UUID, name string, level int) {
+	s.projectRepo.On("FindMemberByUserAndProject", userID, projectID).Return(&domain.ProjectMember{
+		ProjectID: projectID,
+		UserID:    userID,
+		Role:      &domain.Role{Name: name, Level: level},
+	}, nil)
+}
+
+func newTestWebhook(projectID uuid.UUID) *domain.Webhook {
+	hook := &domain.Webhook{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: projectID,
+		URL:       "https://example.com/hooks",
+		Secret:    "secret",
+		IsActive:  true,
+		CreatedBy: uuid.New(),
+	}
+	hook.SetEventTypes([]string{"board.created"})
+	return hook
+}
+
+// ==================== CreateWebhook Tests ====================
+
+func TestWebhookService_CreateWebhook_Success(t *testing.T) {
+	suite := setupWebhookServiceTest(t)
+
+	// Given
+	userID := uuid.New()
+	projectID := uuid.New()
+	suite.expectRole(userID, projectID, "ADMIN", 50)
+	suite.webhookRepo.On("CreateWebhook", mock.AnythingOfType("*domain.Webhook")).Return(nil)
+
+	req := &dto.CreateWebhookRequest{
+		URL:    "https://example.com/hooks",
+		Events: []string{"board.created", "member.joined"},
+	}
+
+	// When
+	result, err := suite.service.CreateWebhook(userID.String(), projectID.String(), req)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"board.created", "member.joined"}, result.Events)
+	assert.True(t, result.IsActive)
+	assert.Len(t, result.Secret, 64, "secret is returned once on creation")
+	suite.webhookRepo.AssertExpectations(t)
+}
+
+func TestWebhookService_CreateWebhook_NotAdmin(t *testing.T) {
+	suite := setupWebhookServiceTest(t)
+
+	// Given
+	userID := uuid.New()
+	projectID := uuid.New()
+	suite.expectRole(userID, projectID, "MEMBER", 10)
+
+	req := &dto.CreateWebhookRequest{URL: "https://example.com/hooks", Events: []string{"board.created"}}
+
+	// When
+	result, err := suite.service.CreateWebhook(userID.String(), projectID.String(), req)
+
+	// Then
+	assert.Nil(t, result)
+	appErr, ok := err.(*apperrors.AppError)
+	assert.True(t, ok)
+	assert.Equal(t, 403, appErr.HTTPStatus)
+	suite.webhookRepo.AssertNotCalled(t, "CreateWebhook", mock.Anything)
+}
+
+func TestWebhookService_CreateWebhook_UnsupportedEvent(t *testing.T) {
+	suite := setupWebhookServiceTest(t)
+
+	// Given
+	userID := uuid.New()
+	projectID := uuid.New()
+	suite.expectRole(userID, projectID, "OWNER", 100)
+
+	req := &dto.CreateWebhookRequest{URL: "https://example.com/hooks", Events: []string{"board.deleted"}}
+
+	// When
+	result, err := suite.service.CreateWebhook(userID.String(), projectID.String(), req)
+
+	// Then
+	assert.Nil(t, result)
+	appErr, ok := err.(*apperrors.AppError)
+	assert.True(t, ok)
+	assert.Equal(t, 400, appErr.HTTPStatus)
+}
+
+func TestWebhookService_CreateWebhook_InternalURL(t *testing.T) {
+	internalURLs := []string{
+		"http://localhost:8080/hooks",
+		"http://127.0.0.1/hooks",
+		"http://10.0.0.5/hooks",
+		"https://192.168.1.10/hooks",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[::1]/hooks",
+		"http://[::ffff:172.16.0.1]/hooks",
+	}
+
+	for _, url := range internalURLs {
+		suite := setupWebhookServiceTest(t)
+
+		// Given
+		userID := uuid.New()
+		projectID := uuid.New()
+		suite.expectRole(userID, projectID, "OWNER", 100)
+
+		req := &dto.CreateWebhookRequest{URL: url, Events: []string{"board.created"}}
+
+		// When
+		result, err := suite.service.CreateWebhook(userID.String(), projectID.String(), req)
+
+		// Then
+		assert.Nil(t, result, url)
+		appErr, ok := err.(*apperrors.AppError)
+		assert.True(t, ok, url)
+		assert.Equal(t, 400, appErr.HTTPStatus, url)
+		suite.webhookRepo.AssertNotCalled(t, "CreateWebhook", mock.Anything)
+	}
+}
+
+// ==================== Get / Update Tests ====================
+
+func TestWebhookService_GetWebhook_HidesSecret(t *testing.T) {
+	suite := setupWebhookServiceTest(t)
+
+	// Given
+	userID := uuid.New()
+	projectID := uuid.New()
+	hook := newTestWebhook(projectID)
+	suite.expectRole(userID, projectID, "ADMIN", 50)
+	suite.webhookRepo.On("FindWebhookByID", hook.ID).Return(hook, nil)
+
+	// When
+	result, err := suite.service.GetWebhook(userID.String(), projectID.String(), hook.ID.String())
+
+	// Then
+	assert.NoError(t, err)
+	assert.Empty(t, result.Secret)
+}
+
+func TestWebhookService_GetWebhook_OtherProject(t *testing.T) {
+	suite := setupWebhookServiceTest(t)
+
+	// Given: The webhook belongs to a different project
+	userID := uuid.New()
+	projectID := uuid.New()
+	hook := newTestWebhook(uuid.New())
+	suite.expectRole(userID, projectID, "ADMIN", 50)
+	suite.webhookRepo.On("FindWebhookByID", hook.ID).Return(hook, nil)
+
+	// When
+	result, err := suite.service.GetWebhook(userID.String(), projectID.String(), hook.ID.String())
+
+	// Then
+	assert.Nil(t, result)
+	appErr, ok := err.(*apperrors.AppError)
+	assert.True(t, ok)
+	assert.Equal(t, 404, appErr.HTTPStatus)
+}
+
+func TestWebhookService_UpdateWebhook_Deactivate(t *testing.T) {
+	suite := setupWebhookServiceTest(t)
+
+	// Given
+	userID := uuid.New()
+	projectID := uuid.New()
+	hook := newTestWebhook(projectID)
+	suite.expectRole(userID, projectID, "ADMIN", 50)
+	suite.webhookRepo.On("FindWebhookByID", hook.ID).Return(hook, nil)
+	suite.webhookRepo.On("UpdateWebhook", hook).Return(nil)
+
+	inactive := false
+	req := &dto.UpdateWebhookRequest{IsActive: &inactive, Events: []string{"board.moved"}}
+
+	// When
+	result, err := suite.service.UpdateWebhook(userID.String(), projectID.String(), hook.ID.String(), req)
+
+	// Then
+	assert.NoError(t, err)
+	assert.False(t, result.IsActive)
+	assert.Equal(t, []string{"board.moved"}, result.Events)
+	suite.webhookRepo.AssertExpectations(t)
+}
+
+// ==================== RedeliverDelivery Tests ====================
+
+func TestWebhookService_RedeliverDelivery_Success(t *testing.T) {
+	suite := setupWebhookServiceTest(t)
+
+	// Given: A delivery that gave up after its retries
+	userID := uuid.New()
+	projectID := uuid.New()
+	hook := newTestWebhook(projectID)
+	delivery := hook.NewDelivery("evt-1", "board.created", `{"type":"board.created"}`)
+	delivery.ID = uuid.New()
+	delivery.Status = domain.WebhookDeliveryFailed
+	delivery.AttemptCount = 8
+
+	suite.expectRole(userID, projectID, "ADMIN", 50)
+	suite.webhookRepo.On("FindWebhookByID", hook.ID).Return(hook, nil)
+	suite.webhookRepo.On("FindDeliveryByID", delivery.ID).Return(delivery, nil)
+	suite.webhookRepo.On("CreateDelivery", mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+		return d.Status == domain.WebhookDeliveryPending &&
+			d.AttemptCount == 0 &&
+			d.Payload == delivery.Payload &&
+			d.RedeliveredFrom != nil && *d.RedeliveredFrom == delivery.ID
+	})).Return(nil)
+
+	// When
+	result, err := suite.service.RedeliverDelivery(userID.String(), projectID.String(), hook.ID.String(), delivery.ID.String())
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "PENDING", result.Status)
+	assert.Equal(t, delivery.ID.String(), *result.RedeliveredFrom)
+	suite.webhookRepo.AssertExpectations(t)
+}
+
+func TestWebhookService_RedeliverDelivery_StillPending(t *testing.T) {
+	suite := setupWebhookServiceTest(t)
+
+	// Given
+	userID := uuid.New()
+	projectID := uuid.New()
+	hook := newTestWebhook(projectID)
+	delivery := hook.NewDelivery("evt-1", "board.created", `{}`)
+	delivery.ID = uuid.New()
+
+	suite.expectRole(userID, projectID, "ADMIN", 50)
+	suite.webhookRepo.On("FindWebhookByID", hook.ID).Return(hook, nil)
+	suite.webhookRepo.On("FindDeliveryByID", delivery.ID).Return(delivery, nil)
+
+	// When
+	result, err := suite.service.RedeliverDelivery(userID.String(), projectID.String(), hook.ID.String(), delivery.ID.String())
+
+	// Then
+	assert.Nil(t, result)
+	appErr, ok := err.(*apperrors.AppError)
+	assert.True(t, ok)
+	assert.Equal(t, 409, appErr.HTTPStatus)
+	suite.webhookRepo.AssertNotCalled(t, "CreateDelivery", mock.Anything)
+}
@@ -0,0 +1,30 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned when an object does not exist in the storage
+var ErrNotFound = errors.New("storage: object not found")
+
+// Storage keeps the content of attachments
+// Objects are immutable: the key of an object is derived from its SHA-256 checksum,
+// so writing the same key twice stores the same bytes (see ObjectKey).
+type Storage interface {
+	// Put stores size bytes read from r under the key
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Open returns the content of the object; the caller closes it
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Exists reports whether an object is stored under the key
+	Exists(ctx context.Context, key string) (bool, error)
+	// Delete removes the object; deleting a missing object is not an error
+	Delete(ctx context.Context, key string) error
+}
+
+// ObjectKey returns the key of the object with the given hex-encoded SHA-256 checksum
+// The first two characters are used as a prefix to spread objects over directories
+func ObjectKey(checksum string) string {
+	return "attachments/" + checksum[:2] + "/" + checksum
+}
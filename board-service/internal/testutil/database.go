@@ -104,6 +104,29 @@ func migrateTestModels(db *gorm.DB) error {
 		&domain.SavedView{},
 		&domain.UserBoardOrder{},
 		&domain.Comment{},
+		&domain.BoardActivity{},
+		&domain.Webhook{},
+		&domain.WebhookDelivery{},
+		&domain.WebhookDeliveryAttempt{},
+		&domain.OutboxEvent{},
+		&domain.TrashItem{},
+		&domain.CommentReaction{},
+		&domain.Notification{},
+		&domain.BoardWatcher{},
+		&domain.BoardKeyAlias{},
+		&domain.BoardRelation{},
+		&domain.ChecklistItem{},
+		&domain.Attachment{},
+		&domain.WorkLog{},
+		&domain.Sprint{},
+		&domain.SprintBoard{},
+		&domain.BoardStageChange{},
+		&domain.Milestone{},
+		&domain.ProjectTemplate{},
+		&domain.BoardTemplate{},
+		&domain.BoardRecurrence{},
+		&domain.BoardReminder{},
+		&domain.ReminderDigest{},
 	)
 }
 
@@ -124,6 +147,29 @@ func (t *TestDB) Teardown() {
 func (t *TestDB) Clean() {
 	// Order matters due to foreign keys
 	tables := []interface{}{
+		&domain.ReminderDigest{},
+		&domain.BoardReminder{},
+		&domain.BoardRecurrence{},
+		&domain.BoardTemplate{},
+		&domain.ProjectTemplate{},
+		&domain.Milestone{},
+		&domain.BoardStageChange{},
+		&domain.WorkLog{},
+		&domain.SprintBoard{},
+		&domain.Sprint{},
+		&domain.Attachment{},
+		&domain.ChecklistItem{},
+		&domain.BoardRelation{},
+		&domain.BoardKeyAlias{},
+		&domain.BoardWatcher{},
+		&domain.Notification{},
+		&domain.CommentReaction{},
+		&domain.TrashItem{},
+		&domain.OutboxEvent{},
+		&domain.WebhookDeliveryAttempt{},
+		&domain.WebhookDelivery{},
+		&domain.Webhook{},
+		&domain.BoardActivity{},
 		&domain.Comment{},
 		&domain.UserBoardOrder{},
 		&domain.SavedView{},
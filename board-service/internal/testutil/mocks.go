@@ -1,8 +1,12 @@
 package testutil
 
 import (
+	"board-service/internal/common/pagination"
 	"board-service/internal/domain"
+	"board-service/internal/event"
 	"board-service/internal/repository"
+	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -28,11 +32,32 @@ func (m *MockBoardRepository) FindByID(boardID uuid.UUID) (*domain.Board, error)
 	return args.Get(0).(*domain.Board), args.Error(1)
 }
 
+func (m *MockBoardRepository) FindByKey(key string) (*domain.Board, error) {
+	args := m.Called(key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Board), args.Error(1)
+}
+
 func (m *MockBoardRepository) FindByProject(projectID uuid.UUID, filters repository.BoardFilters, page, limit int) ([]domain.Board, int64, error) {
 	args := m.Called(projectID, filters, page, limit)
 	return args.Get(0).([]domain.Board), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockBoardRepository) FindPageByProject(projectID uuid.UUID, filters repository.BoardFilters, cursor *pagination.Cursor, limit int) (*repository.KeysetPage[domain.Board], error) {
+	args := m.Called(projectID, filters, cursor, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.KeysetPage[domain.Board]), args.Error(1)
+}
+
+func (m *MockBoardRepository) CountByProject(projectID uuid.UUID, filters repository.BoardFilters) (int64, error) {
+	args := m.Called(projectID, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *MockBoardRepository) Update(board *domain.Board) error {
 	args := m.Called(board)
 	return args.Error(0)
@@ -43,6 +68,34 @@ func (m *MockBoardRepository) Delete(boardID uuid.UUID) error {
 	return args.Error(0)
 }
 
+func (m *MockBoardRepository) CreateKeyAlias(alias *domain.BoardKeyAlias) error {
+	args := m.Called(alias)
+	return args.Error(0)
+}
+
+func (m *MockBoardRepository) FindParentID(id uuid.UUID) (*uuid.UUID, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*uuid.UUID), args.Error(1)
+}
+
+func (m *MockBoardRepository) FindChildIDs(parentIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	args := m.Called(parentIDs)
+	return args.Get(0).(map[uuid.UUID][]uuid.UUID), args.Error(1)
+}
+
+func (m *MockBoardRepository) DetachChildren(parentID uuid.UUID) error {
+	args := m.Called(parentID)
+	return args.Error(0)
+}
+
+func (m *MockBoardRepository) UpdateEstimates(board *domain.Board) error {
+	args := m.Called(board)
+	return args.Error(0)
+}
+
 // ==================== Mock ProjectRepository ====================
 
 type MockProjectRepository struct {
@@ -82,6 +135,20 @@ func (m *MockProjectRepository) Search(workspaceID uuid.UUID, query string, page
 	return args.Get(0).([]domain.Project), args.Get(1).(int64), args.Error(2)
 }
 
+// Board key methods
+func (m *MockProjectRepository) ExistsByKey(key string) (bool, error) {
+	args := m.Called(key)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockProjectRepository) IncrementBoardSequence(projectID uuid.UUID) (*domain.Project, error) {
+	args := m.Called(projectID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Project), args.Error(1)
+}
+
 // Project Member methods
 func (m *MockProjectRepository) CreateMember(member *domain.ProjectMember) error {
 	args := m.Called(member)
@@ -313,6 +380,11 @@ func (m *MockFieldRepository) BatchDeleteFieldValues(boardID, fieldID uuid.UUID)
 	return args.Error(0)
 }
 
+func (m *MockFieldRepository) DeleteFieldValuesByBoard(boardID uuid.UUID) error {
+	args := m.Called(boardID)
+	return args.Error(0)
+}
+
 func (m *MockFieldRepository) UpdateBoardFieldCache(boardID uuid.UUID) (string, error) {
 	args := m.Called(boardID)
 	return args.String(0), args.Error(1)
@@ -410,6 +482,380 @@ func (m *MockCommentRepository) Delete(id uuid.UUID) error {
 	return args.Error(0)
 }
 
+func (m *MockCommentRepository) FindRootsByBoardID(boardID uuid.UUID) ([]domain.Comment, error) {
+	args := m.Called(boardID)
+	return args.Get(0).([]domain.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepository) FindReplies(parentID uuid.UUID, page, limit int) ([]domain.Comment, int64, error) {
+	args := m.Called(parentID, page, limit)
+	return args.Get(0).([]domain.Comment), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockCommentRepository) FindRepliesPage(parentID uuid.UUID, cursor *pagination.Cursor, limit int) (*repository.KeysetPage[domain.Comment], error) {
+	args := m.Called(parentID, cursor, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.KeysetPage[domain.Comment]), args.Error(1)
+}
+
+func (m *MockCommentRepository) CountReplies(parentIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	args := m.Called(parentIDs)
+	return args.Get(0).(map[uuid.UUID]int64), args.Error(1)
+}
+
+func (m *MockCommentRepository) HasRepliesByOthers(commentID, authorID uuid.UUID) (bool, error) {
+	args := m.Called(commentID, authorID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCommentRepository) AddReaction(reaction *domain.CommentReaction) (bool, error) {
+	args := m.Called(reaction)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCommentRepository) RemoveReaction(commentID, userID uuid.UUID, emoji string) (bool, error) {
+	args := m.Called(commentID, userID, emoji)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCommentRepository) CountReactions(commentID uuid.UUID, emoji string) (int64, error) {
+	args := m.Called(commentID, emoji)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockCommentRepository) FindReactionSummaries(commentIDs []uuid.UUID, userID uuid.UUID) ([]repository.CommentReactionSummary, error) {
+	args := m.Called(commentIDs, userID)
+	return args.Get(0).([]repository.CommentReactionSummary), args.Error(1)
+}
+
+// ==================== Mock BoardActivityRepository ====================
+
+type MockBoardActivityRepository struct {
+	mock.Mock
+}
+
+func (m *MockBoardActivityRepository) Create(activity *domain.BoardActivity) error {
+	args := m.Called(activity)
+	return args.Error(0)
+}
+
+func (m *MockBoardActivityRepository) BatchCreate(activities []domain.BoardActivity) error {
+	args := m.Called(activities)
+	return args.Error(0)
+}
+
+func (m *MockBoardActivityRepository) FindByBoard(boardID uuid.UUID, page, limit int) ([]domain.BoardActivity, int64, error) {
+	args := m.Called(boardID, page, limit)
+	return args.Get(0).([]domain.BoardActivity), args.Get(1).(int64), args.Error(2)
+}
+
+// ==================== Mock WebhookRepository ====================
+
+type MockWebhookRepository struct {
+	mock.Mock
+}
+
+func (m *MockWebhookRepository) CreateWebhook(webhook *domain.Webhook) error {
+	args := m.Called(webhook)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) FindWebhookByID(webhookID uuid.UUID) (*domain.Webhook, error) {
+	args := m.Called(webhookID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) FindWebhooksByProject(projectID uuid.UUID) ([]domain.Webhook, error) {
+	args := m.Called(projectID)
+	return args.Get(0).([]domain.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) FindActiveWebhooksByProject(projectID uuid.UUID) ([]domain.Webhook, error) {
+	args := m.Called(projectID)
+	return args.Get(0).([]domain.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) UpdateWebhook(webhook *domain.Webhook) error {
+	args := m.Called(webhook)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) DeleteWebhook(webhookID uuid.UUID) error {
+	args := m.Called(webhookID)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) CreateDelivery(delivery *domain.WebhookDelivery) error {
+	args := m.Called(delivery)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) CreateDeliveries(deliveries []domain.WebhookDelivery) error {
+	args := m.Called(deliveries)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) FindDeliveryByID(deliveryID uuid.UUID) (*domain.WebhookDelivery, error) {
+	args := m.Called(deliveryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookRepository) FindDeliveriesByWebhook(webhookID uuid.UUID, page, limit int) ([]domain.WebhookDelivery, int64, error) {
+	args := m.Called(webhookID, page, limit)
+	return args.Get(0).([]domain.WebhookDelivery), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockWebhookRepository) ClaimDueDeliveries(now time.Time, lease time.Duration, limit int) ([]domain.WebhookDelivery, error) {
+	args := m.Called(now, lease, limit)
+	return args.Get(0).([]domain.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookRepository) ReleaseDeliveries(ids []uuid.UUID, at time.Time) error {
+	args := m.Called(ids, at)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) UpdateDelivery(delivery *domain.WebhookDelivery) error {
+	args := m.Called(delivery)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) CreateAttempt(attempt *domain.WebhookDeliveryAttempt) error {
+	args := m.Called(attempt)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) FindAttemptsByDelivery(deliveryID uuid.UUID) ([]domain.WebhookDeliveryAttempt, error) {
+	args := m.Called(deliveryID)
+	return args.Get(0).([]domain.WebhookDeliveryAttempt), args.Error(1)
+}
+
+// ==================== Mock NotificationRepository ====================
+
+type MockNotificationRepository struct {
+	mock.Mock
+}
+
+func (m *MockNotificationRepository) BatchCreate(notifications []domain.Notification) error {
+	args := m.Called(notifications)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) FindByUser(userID uuid.UUID, unreadOnly bool, page, limit int) ([]domain.Notification, int64, error) {
+	args := m.Called(userID, unreadOnly, page, limit)
+	return args.Get(0).([]domain.Notification), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockNotificationRepository) CountUnread(userID uuid.UUID) (int64, error) {
+	args := m.Called(userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockNotificationRepository) MarkRead(userID, notificationID uuid.UUID) error {
+	args := m.Called(userID, notificationID)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) MarkAllRead(userID uuid.UUID) (int64, error) {
+	args := m.Called(userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockNotificationRepository) AddWatchers(boardID uuid.UUID, userIDs []uuid.UUID) error {
+	args := m.Called(boardID, userIDs)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) RemoveWatcher(boardID, userID uuid.UUID) error {
+	args := m.Called(boardID, userID)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) IsWatching(boardID, userID uuid.UUID) (bool, error) {
+	args := m.Called(boardID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockNotificationRepository) FindWatcherIDs(boardID uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(boardID)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockNotificationRepository) FindWatchedBoards(userID uuid.UUID, page, limit int) ([]domain.Board, int64, error) {
+	args := m.Called(userID, page, limit)
+	return args.Get(0).([]domain.Board), args.Get(1).(int64), args.Error(2)
+}
+
+// ==================== Mock BoardRelationRepository ====================
+
+type MockBoardRelationRepository struct {
+	mock.Mock
+}
+
+func (m *MockBoardRelationRepository) Create(relation *domain.BoardRelation) error {
+	args := m.Called(relation)
+	return args.Error(0)
+}
+
+func (m *MockBoardRelationRepository) FindByID(id uuid.UUID) (*domain.BoardRelation, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.BoardRelation), args.Error(1)
+}
+
+func (m *MockBoardRelationRepository) Delete(id uuid.UUID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockBoardRelationRepository) FindByBoard(boardID uuid.UUID) ([]domain.BoardRelation, error) {
+	args := m.Called(boardID)
+	return args.Get(0).([]domain.BoardRelation), args.Error(1)
+}
+
+func (m *MockBoardRelationRepository) FindBetween(boardA, boardB uuid.UUID, relationType domain.BoardRelationType) ([]domain.BoardRelation, error) {
+	args := m.Called(boardA, boardB, relationType)
+	return args.Get(0).([]domain.BoardRelation), args.Error(1)
+}
+
+func (m *MockBoardRelationRepository) FindBlockedByAny(boardIDs []uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(boardIDs)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockBoardRelationRepository) FilterBlocked(boardIDs []uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(boardIDs)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+// ==================== Mock ChecklistRepository ====================
+
+type MockChecklistRepository struct {
+	mock.Mock
+}
+
+func (m *MockChecklistRepository) Create(item *domain.ChecklistItem) error {
+	args := m.Called(item)
+	return args.Error(0)
+}
+
+func (m *MockChecklistRepository) FindByID(id uuid.UUID) (*domain.ChecklistItem, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ChecklistItem), args.Error(1)
+}
+
+func (m *MockChecklistRepository) FindByBoard(boardID uuid.UUID) ([]domain.ChecklistItem, error) {
+	args := m.Called(boardID)
+	return args.Get(0).([]domain.ChecklistItem), args.Error(1)
+}
+
+func (m *MockChecklistRepository) Update(item *domain.ChecklistItem) error {
+	args := m.Called(item)
+	return args.Error(0)
+}
+
+func (m *MockChecklistRepository) Delete(id uuid.UUID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockChecklistRepository) CountByBoards(boardIDs []uuid.UUID) (map[uuid.UUID]domain.ChecklistCount, error) {
+	args := m.Called(boardIDs)
+	return args.Get(0).(map[uuid.UUID]domain.ChecklistCount), args.Error(1)
+}
+
+// ==================== Mock WorkLog Repository ====================
+
+type MockWorkLogRepository struct {
+	mock.Mock
+}
+
+func (m *MockWorkLogRepository) Create(workLog *domain.WorkLog) error {
+	args := m.Called(workLog)
+	return args.Error(0)
+}
+
+func (m *MockWorkLogRepository) FindByID(id uuid.UUID) (*domain.WorkLog, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WorkLog), args.Error(1)
+}
+
+func (m *MockWorkLogRepository) FindByBoard(boardID uuid.UUID) ([]domain.WorkLog, error) {
+	args := m.Called(boardID)
+	return args.Get(0).([]domain.WorkLog), args.Error(1)
+}
+
+func (m *MockWorkLogRepository) Update(workLog *domain.WorkLog) error {
+	args := m.Called(workLog)
+	return args.Error(0)
+}
+
+func (m *MockWorkLogRepository) Delete(id uuid.UUID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockWorkLogRepository) SumByBoards(boardIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	args := m.Called(boardIDs)
+	return args.Get(0).(map[uuid.UUID]int), args.Error(1)
+}
+
+func (m *MockWorkLogRepository) FindByProject(projectID uuid.UUID, filter repository.WorkLogFilter, page, limit int) ([]domain.WorkLog, int64, error) {
+	args := m.Called(projectID, filter, page, limit)
+	return args.Get(0).([]domain.WorkLog), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockWorkLogRepository) SumByProject(projectID uuid.UUID, filter repository.WorkLogFilter, group repository.WorkLogGroup) ([]repository.WorkLogSum, error) {
+	args := m.Called(projectID, filter, group)
+	return args.Get(0).([]repository.WorkLogSum), args.Error(1)
+}
+
+func (m *MockWorkLogRepository) SumEstimatesByAssignee(projectID uuid.UUID, assigneeID *uuid.UUID) ([]repository.EstimateSum, error) {
+	args := m.Called(projectID, assigneeID)
+	return args.Get(0).([]repository.EstimateSum), args.Error(1)
+}
+
+// ==================== Mock Search Repository ====================
+
+type MockSearchRepository struct {
+	mock.Mock
+}
+
+func (m *MockSearchRepository) Search(userID uuid.UUID, query string, filters repository.SearchFilters, page, limit int) ([]repository.SearchHit, int64, error) {
+	args := m.Called(userID, query, filters, page, limit)
+	return args.Get(0).([]repository.SearchHit), args.Get(1).(int64), args.Error(2)
+}
+
+// ==================== Mock Event Subscriber ====================
+
+type MockEventSubscriber struct {
+	mock.Mock
+}
+
+func (m *MockEventSubscriber) Subscribe(ctx context.Context, projectID uuid.UUID) (<-chan event.Event, error) {
+	args := m.Called(ctx, projectID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(<-chan event.Event), args.Error(1)
+}
+
 // ==================== Helper Functions ====================
 
 // ExpectNotFoundError configures mock to return gorm.ErrRecordNotFound
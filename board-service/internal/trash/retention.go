@@ -0,0 +1,65 @@
+package trash
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DefaultRetentionInterval is how often the job looks for expired trash items
+const DefaultRetentionInterval = time.Hour
+
+// Purger permanently deletes trash items older than the retention period
+// Implemented by service.TrashService
+type Purger interface {
+	PurgeExpired() (int, error)
+}
+
+// RetentionJob periodically purges expired trash items in the background
+// Each run removes at most one batch; a full trash is drained over several runs
+type RetentionJob struct {
+	purger   Purger
+	interval time.Duration
+	logger   *zap.Logger
+}
+
+// NewRetentionJob creates a retention job that runs every interval
+func NewRetentionJob(purger Purger, interval time.Duration, logger *zap.Logger) *RetentionJob {
+	return &RetentionJob{
+		purger:   purger,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run purges expired items until ctx is cancelled
+func (j *RetentionJob) Run(ctx context.Context) {
+	j.logger.Info("Trash retention job started", zap.Duration("interval", j.interval))
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			j.logger.Info("Trash retention job stopped")
+			return
+		case <-ticker.C:
+			j.RunOnce()
+		}
+	}
+}
+
+// RunOnce purges one batch of expired items and returns how many were purged
+func (j *RetentionJob) RunOnce() int {
+	purged, err := j.purger.PurgeExpired()
+	if err != nil {
+		j.logger.Error("Failed to purge expired trash items", zap.Error(err))
+		return 0
+	}
+	if purged > 0 {
+		j.logger.Info("Purged expired trash items", zap.Int("count", purged))
+	}
+	return purged
+}
@@ -95,6 +95,21 @@ package uow
 //       })
 //   }
 //
+// Example 4: 도메인 이벤트 기록 (Transactional Outbox)
+//
+//   func (s *boardService) DeleteBoard(board *domain.Board, userID uuid.UUID) error {
+//       return s.uow.Do(func(repos *uow.Repositories) error {
+//           board.MarkAsDeleted()
+//           if err := repos.Board.Update(board); err != nil {
+//               return err
+//           }
+//
+//           // 이벤트는 보드 변경과 같은 트랜잭션으로 outbox_events에 기록되고,
+//           // 커밋 이후 outbox.Relay가 발행합니다 (롤백 시 이벤트도 함께 사라짐)
+//           return repos.Outbox.Write(event.NewBoardEvent(event.BoardDeleted, board.ProjectID, board.ID, userID, nil))
+//       })
+//   }
+//
 // ==================== 장점 ====================
 //
 // 1. 트랜잭션 관리 중앙화: 비즈니스 로직에서 트랜잭션 시작/커밋/롤백을 명시적으로 관리할 필요 없음
@@ -107,5 +122,6 @@ package uow
 // 1. Do 함수 내에서는 panic을 사용하지 말 것 (트랜잭션이 롤백되지 않을 수 있음)
 // 2. Do 함수는 가능한 짧게 유지 (긴 트랜잭션은 DB 락을 오래 잡음)
 // 3. Do 함수 내에서 외부 API 호출은 피할 것 (실패 시 롤백이 어려움)
+//    이벤트 발행도 직접 하지 말고 repos.Outbox에 기록할 것
 // 4. 중첩된 UnitOfWork.Do는 지원되지 않음 (GORM의 제약)
 //
@@ -24,6 +24,21 @@ type Repositories struct {
 	Comment repository.CommentRepository
 	Field   repository.FieldRepository
 	Role    repository.RoleRepository
+	Outbox  repository.OutboxWriter // 도메인 변경과 함께 커밋되는 이벤트 기록
+
+	ProjectCascade repository.ProjectCascadeRepository  // 프로젝트 하위 데이터 일괄 삭제
+	Trash          repository.TrashRepository           // 휴지통 항목 기록, 복원, 영구 삭제
+	Relation       repository.BoardRelationRepository   // 보드 간 관계 (순환 검사와 생성을 한 트랜잭션으로)
+	Checklist      repository.ChecklistRepository       // 보드 체크리스트 항목
+	Attachment     repository.AttachmentRepository      // 첨부 파일 메타데이터 (영구 삭제 시 저장소 키 조회)
+	WorkLog        repository.WorkLogRepository         // 보드 작업 시간 기록
+	Sprint         repository.SprintRepository          // 스프린트와 보드 배정 이력
+	StageChange    repository.StageChangeRepository     // 보드 Stage 변경 이력 (분석용)
+	Milestone      repository.MilestoneRepository       // 마일스톤과 보드 배정
+	BoardTemplate  repository.BoardTemplateRepository   // 보드 템플릿
+	Recurrence     repository.BoardRecurrenceRepository // 보드 템플릿 반복 규칙 (보드 생성과 다음 실행 시각을 한 트랜잭션으로)
+	Notification   repository.NotificationRepository    // 알림함과 보드 구독
+	Reminder       repository.ReminderRepository        // 마감일 알림과 일일 요약 발송 표식 (발송과 한 트랜잭션으로)
 }
 
 type unitOfWork struct {
@@ -45,6 +60,21 @@ func (uow *unitOfWork) Do(fn func(repos *Repositories) error) error {
 			Comment: repository.NewCommentRepository(tx),
 			Field:   repository.NewFieldRepository(tx),
 			Role:    repository.NewRoleRepository(tx),
+			Outbox:  repository.NewOutboxRepository(tx),
+
+			ProjectCascade: repository.NewProjectCascadeRepository(tx),
+			Trash:          repository.NewTrashRepository(tx),
+			Relation:       repository.NewBoardRelationRepository(tx),
+			Checklist:      repository.NewChecklistRepository(tx),
+			Attachment:     repository.NewAttachmentRepository(tx),
+			WorkLog:        repository.NewWorkLogRepository(tx),
+			Sprint:         repository.NewSprintRepository(tx),
+			StageChange:    repository.NewStageChangeRepository(tx),
+			Milestone:      repository.NewMilestoneRepository(tx),
+			BoardTemplate:  repository.NewBoardTemplateRepository(tx),
+			Recurrence:     repository.NewBoardRecurrenceRepository(tx),
+			Notification:   repository.NewNotificationRepository(tx),
+			Reminder:       repository.NewReminderRepository(tx),
 		}
 
 		// Execute the business logic
@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// SupportedEvents are the event types that webhooks can subscribe to
+var SupportedEvents = []event.Type{
+	event.BoardCreated,
+	event.BoardMoved,
+	event.FieldValueChanged,
+	event.CommentCreated,
+	event.MemberJoined,
+}
+
+// IsSupportedEvent reports whether webhooks can subscribe to the event type
+func IsSupportedEvent(eventType string) bool {
+	for _, t := range SupportedEvents {
+		if string(t) == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Dispatcher turns project events into pending webhook deliveries
+// It is one of the outbox relay's sinks, next to the real-time stream.
+// Deliveries are persisted here and sent asynchronously by the Worker.
+type Dispatcher struct {
+	repo   repository.WebhookRepository
+	logger *zap.Logger
+}
+
+// NewDispatcher creates a webhook dispatcher
+func NewDispatcher(repo repository.WebhookRepository, logger *zap.Logger) *Dispatcher {
+	return &Dispatcher{repo: repo, logger: logger}
+}
+
+func (d *Dispatcher) Publish(ctx context.Context, evt event.Event) error {
+	if !IsSupportedEvent(string(evt.Type)) {
+		return nil
+	}
+
+	projectID, err := uuid.Parse(evt.ProjectID)
+	if err != nil {
+		return fmt.Errorf("invalid project id in event: %w", err)
+	}
+
+	webhooks, err := d.repo.FindActiveWebhooksByProject(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to find webhooks: %w", err)
+	}
+
+	var payload []byte
+	deliveries := make([]domain.WebhookDelivery, 0, len(webhooks))
+	for i := range webhooks {
+		if !webhooks[i].Subscribes(string(evt.Type)) {
+			continue
+		}
+		if payload == nil {
+			if payload, err = json.Marshal(evt); err != nil {
+				return fmt.Errorf("failed to marshal webhook payload: %w", err)
+			}
+		}
+		deliveries = append(deliveries, *webhooks[i].NewDelivery(evt.ID, string(evt.Type), string(payload)))
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	if err := d.repo.CreateDeliveries(deliveries); err != nil {
+		return fmt.Errorf("failed to enqueue webhook deliveries: %w", err)
+	}
+
+	d.logger.Debug("Webhook deliveries enqueued",
+		zap.String("event_type", string(evt.Type)),
+		zap.String("project_id", evt.ProjectID),
+		zap.Int("count", len(deliveries)),
+	)
+
+	return nil
+}
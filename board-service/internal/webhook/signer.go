@@ -0,0 +1,45 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+)
+
+// Request headers sent with every delivery
+const (
+	HeaderSignature = "X-Wealist-Signature" // sha256=<hex HMAC of "<timestamp>.<body>">
+	HeaderTimestamp = "X-Wealist-Timestamp" // Unix seconds, part of the signed content to prevent replays
+	HeaderEvent     = "X-Wealist-Event"
+	HeaderDelivery  = "X-Wealist-Delivery"
+)
+
+const secretBytes = 32
+
+// GenerateSecret returns a random hex-encoded signing secret
+func GenerateSecret() (string, error) {
+	buf := make([]byte, secretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// Sign computes the signature header value for a payload
+// Receivers verify it by computing HMAC-SHA256(secret, "<timestamp>.<raw body>")
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature matches the payload, using a constant-time comparison
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	expected := Sign(secret, timestamp, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
@@ -0,0 +1,254 @@
+package webhook
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/repository"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// maxStoredResponseBytes limits how much of a receiver's response is kept in the attempt log
+const maxStoredResponseBytes = 2048
+
+// WorkerConfig controls polling, retries and timeouts of the delivery worker
+type WorkerConfig struct {
+	PollInterval   time.Duration // How often to look for due deliveries
+	BatchSize      int           // Max deliveries claimed per poll
+	MaxAttempts    int           // Attempts before a delivery is marked FAILED
+	BaseBackoff    time.Duration // Delay before the 2nd attempt; doubled for every further attempt
+	MaxBackoff     time.Duration // Upper bound of the retry delay
+	RequestTimeout time.Duration // Timeout of a single HTTP request
+}
+
+// DefaultWorkerConfig returns the configuration used when nothing is overridden
+// With these values a delivery is retried for roughly 2 hours before giving up
+func DefaultWorkerConfig() WorkerConfig {
+	return WorkerConfig{
+		PollInterval:   5 * time.Second,
+		BatchSize:      20,
+		MaxAttempts:    8,
+		BaseBackoff:    30 * time.Second,
+		MaxBackoff:     1 * time.Hour,
+		RequestTimeout: 10 * time.Second,
+	}
+}
+
+// Backoff returns the delay before the next attempt after `attempt` failed attempts
+func (c WorkerConfig) Backoff(attempt int) time.Duration {
+	delay := c.BaseBackoff
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= c.MaxBackoff {
+			return c.MaxBackoff
+		}
+	}
+	return delay
+}
+
+// Worker sends pending webhook deliveries in the background
+// Several replicas can run a Worker concurrently: deliveries are claimed with
+// row locks (see WebhookRepository.ClaimDueDeliveries)
+type Worker struct {
+	repo   repository.WebhookRepository
+	client *http.Client
+	config WorkerConfig
+	logger *zap.Logger
+	now    func() time.Time
+}
+
+// NewWorker creates a delivery worker
+func NewWorker(repo repository.WebhookRepository, config WorkerConfig, logger *zap.Logger) *Worker {
+	return &Worker{
+		repo:   repo,
+		client: newDeliveryClient(config.RequestTimeout),
+		config: config,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// newDeliveryClient returns an HTTP client that refuses to connect to internal addresses
+// The check runs on the resolved address of every connection, so DNS names pointing
+// inside the network and redirects to internal URLs are rejected as well
+func newDeliveryClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || domain.IsInternalIP(ip) {
+				return fmt.Errorf("webhook target %s is an internal address", host)
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:               nil, // A proxy would dial the target on our behalf and bypass the check
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConnsPerHost: 2,
+		},
+	}
+}
+
+// Run polls for due deliveries until ctx is cancelled
+func (w *Worker) Run(ctx context.Context) {
+	w.logger.Info("Webhook worker started", zap.Duration("poll_interval", w.config.PollInterval))
+
+	ticker := time.NewTicker(w.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Webhook worker stopped")
+			return
+		case <-ticker.C:
+			if _, err := w.ProcessDue(ctx); err != nil {
+				w.logger.Error("Failed to process webhook deliveries", zap.Error(err))
+			}
+		}
+	}
+}
+
+// ProcessDue sends every delivery that is currently due and returns how many were processed
+func (w *Worker) ProcessDue(ctx context.Context) (int, error) {
+	// Lease: claimed deliveries are hidden from other workers for longer than the batch can take
+	lease := w.config.claimLease()
+	claimedAt := w.now()
+	start := time.Now()
+	deliveries, err := w.repo.ClaimDueDeliveries(claimedAt, lease, w.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range deliveries {
+		// A delivery started later could still be sending when the lease runs out and another
+		// worker claims it again, so the rest of the batch is handed back instead
+		if ctx.Err() != nil || time.Since(start) > lease-w.config.RequestTimeout {
+			w.releaseClaims(deliveries[i:], claimedAt)
+			return i, nil
+		}
+		w.deliver(ctx, &deliveries[i])
+	}
+
+	return len(deliveries), nil
+}
+
+// claimLease covers a batch whose requests all time out, plus one request timeout for
+// the database writes around them
+func (c WorkerConfig) claimLease() time.Duration {
+	return time.Duration(c.BatchSize+1) * c.RequestTimeout
+}
+
+// releaseClaims lets the next poll pick up deliveries that were claimed but not attempted
+func (w *Worker) releaseClaims(deliveries []domain.WebhookDelivery, at time.Time) {
+	ids := make([]uuid.UUID, len(deliveries))
+	for i := range deliveries {
+		ids[i] = deliveries[i].ID
+	}
+	if err := w.repo.ReleaseDeliveries(ids, at); err != nil {
+		// The lease expires on its own; the deliveries are only delayed
+		w.logger.Warn("Failed to release webhook deliveries", zap.Error(err), zap.Int("count", len(ids)))
+	}
+}
+
+// deliver makes one attempt and records its outcome
+func (w *Worker) deliver(ctx context.Context, delivery *domain.WebhookDelivery) {
+	webhook, err := w.repo.FindWebhookByID(delivery.WebhookID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		w.logger.Warn("Failed to load webhook for delivery", zap.Error(err), zap.String("delivery_id", delivery.ID.String()))
+		return // Retried once the lease expires
+	}
+	if webhook == nil || !webhook.IsActive {
+		// The subscription is gone: stop retrying without sending
+		delivery.RecordFailure(nil, "webhook is deleted or inactive", w.now(), nil)
+		w.saveDelivery(delivery)
+		return
+	}
+
+	attempt := w.send(ctx, webhook, delivery)
+
+	now := w.now()
+	if attempt.Succeeded {
+		delivery.RecordSuccess(*attempt.StatusCode, now)
+	} else {
+		var next *time.Time
+		if delivery.AttemptCount+1 < w.config.MaxAttempts {
+			t := now.Add(w.config.Backoff(delivery.AttemptCount + 1))
+			next = &t
+		}
+		delivery.RecordFailure(attempt.StatusCode, attempt.Error, now, next)
+	}
+
+	if err := w.repo.CreateAttempt(attempt); err != nil {
+		w.logger.Warn("Failed to record webhook attempt", zap.Error(err), zap.String("delivery_id", delivery.ID.String()))
+	}
+	w.saveDelivery(delivery)
+}
+
+// send performs the signed HTTP request; any 2xx response counts as success
+func (w *Worker) send(ctx context.Context, webhook *domain.Webhook, delivery *domain.WebhookDelivery) *domain.WebhookDeliveryAttempt {
+	attempt := &domain.WebhookDeliveryAttempt{
+		DeliveryID:    delivery.ID,
+		AttemptNumber: delivery.AttemptCount + 1,
+	}
+
+	body := []byte(delivery.Payload)
+	timestamp := w.now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		attempt.Error = fmt.Sprintf("failed to build request: %v", err)
+		return attempt
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "weAlist-Webhooks/1.0")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.ID.String())
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret, timestamp, body))
+
+	start := time.Now()
+	resp, err := w.client.Do(req)
+	attempt.DurationMs = time.Since(start).Milliseconds()
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxStoredResponseBytes))
+	statusCode := resp.StatusCode
+	attempt.StatusCode = &statusCode
+	attempt.ResponseBody = string(respBody)
+	attempt.Succeeded = statusCode >= 200 && statusCode < 300
+	if !attempt.Succeeded {
+		attempt.Error = fmt.Sprintf("unexpected status code %d", statusCode)
+	}
+
+	return attempt
+}
+
+func (w *Worker) saveDelivery(delivery *domain.WebhookDelivery) {
+	if err := w.repo.UpdateDelivery(delivery); err != nil {
+		w.logger.Error("Failed to update webhook delivery", zap.Error(err), zap.String("delivery_id", delivery.ID.String()))
+	}
+}
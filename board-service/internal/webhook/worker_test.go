@@ -0,0 +1,339 @@
+package webhook
+
+import (
+	"board-service/internal/domain"
+	"board-service/internal/event"
+	"board-service/internal/repository"
+	"board-service/internal/testutil"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newTestWebhook(url string, events ...string) *domain.Webhook {
+	hook := &domain.Webhook{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: uuid.New(),
+		URL:       url,
+		Secret:    "test-secret",
+		IsActive:  true,
+	}
+	hook.SetEventTypes(events)
+	return hook
+}
+
+func newTestWorker(repo *testutil.MockWebhookRepository, now time.Time) *Worker {
+	worker := NewWorker(repo, DefaultWorkerConfig(), zap.NewNop())
+	worker.now = func() time.Time { return now }
+	// httptest servers listen on loopback, which the delivery client refuses
+	worker.client = &http.Client{Timeout: DefaultWorkerConfig().RequestTimeout}
+	return worker
+}
+
+// ==================== Signer Tests ====================
+
+func TestSign_VerifiesWithSameSecret(t *testing.T) {
+	body := []byte(`{"type":"board.created"}`)
+	signature := Sign("secret", 1700000000, body)
+
+	assert.Contains(t, signature, "sha256=")
+	assert.True(t, Verify("secret", 1700000000, body, signature))
+	assert.False(t, Verify("other-secret", 1700000000, body, signature))
+	assert.False(t, Verify("secret", 1700000001, body, signature), "timestamp is part of the signed content")
+}
+
+// ==================== Backoff Tests ====================
+
+func TestWorkerConfig_Backoff(t *testing.T) {
+	config := DefaultWorkerConfig()
+
+	assert.Equal(t, 30*time.Second, config.Backoff(1))
+	assert.Equal(t, 60*time.Second, config.Backoff(2))
+	assert.Equal(t, 120*time.Second, config.Backoff(3))
+	assert.Equal(t, time.Hour, config.Backoff(20), "capped at MaxBackoff")
+}
+
+// ==================== Dispatcher Tests ====================
+
+func TestDispatcher_Publish_OnlySubscribedWebhooks(t *testing.T) {
+	repo := new(testutil.MockWebhookRepository)
+	dispatcher := NewDispatcher(repo, zap.NewNop())
+
+	projectID := uuid.New()
+	subscribed := newTestWebhook("https://a.example.com", "board.created")
+	other := newTestWebhook("https://b.example.com", "comment.created")
+	evt := event.New(event.BoardCreated, projectID, uuid.New(), map[string]string{"title": "Board"})
+
+	repo.On("FindActiveWebhooksByProject", projectID).Return([]domain.Webhook{*subscribed, *other}, nil)
+	repo.On("CreateDeliveries", mock.MatchedBy(func(deliveries []domain.WebhookDelivery) bool {
+		return len(deliveries) == 1 &&
+			deliveries[0].WebhookID == subscribed.ID &&
+			deliveries[0].EventID == evt.ID &&
+			deliveries[0].Status == domain.WebhookDeliveryPending
+	})).Return(nil)
+
+	err := dispatcher.Publish(context.Background(), evt)
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestDispatcher_Publish_IgnoresUnsupportedEvents(t *testing.T) {
+	repo := new(testutil.MockWebhookRepository)
+	dispatcher := NewDispatcher(repo, zap.NewNop())
+
+	err := dispatcher.Publish(context.Background(), event.New(event.ViewCreated, uuid.New(), uuid.New(), nil))
+
+	assert.NoError(t, err)
+	repo.AssertNotCalled(t, "FindActiveWebhooksByProject", mock.Anything)
+}
+
+func TestDispatcher_Publish_SameEventTwiceEnqueuesOnce(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	// Mirrors the migrations, including the (webhook_id, event_id) unique index
+	for _, stmt := range []string{
+		`CREATE TABLE project_webhooks (id TEXT PRIMARY KEY, project_id TEXT NOT NULL, url TEXT NOT NULL, secret TEXT NOT NULL,
+			event_types TEXT NOT NULL DEFAULT '[]', description TEXT, is_active BOOLEAN DEFAULT true, created_by TEXT NOT NULL,
+			created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+		`CREATE TABLE webhook_deliveries (id TEXT PRIMARY KEY, webhook_id TEXT NOT NULL, project_id TEXT NOT NULL, event_id TEXT NOT NULL,
+			event_type TEXT NOT NULL, payload TEXT NOT NULL, status TEXT NOT NULL DEFAULT 'PENDING', attempt_count INTEGER NOT NULL DEFAULT 0,
+			next_attempt_at DATETIME, last_attempt_at DATETIME, last_status_code INTEGER, last_error TEXT, redelivered_from TEXT,
+			created_at DATETIME, updated_at DATETIME, is_deleted BOOLEAN DEFAULT false)`,
+		`CREATE UNIQUE INDEX idx_webhook_deliveries_webhook_event ON webhook_deliveries(webhook_id, event_id) WHERE redelivered_from IS NULL`,
+	} {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+
+	repo := repository.NewWebhookRepository(db)
+	dispatcher := NewDispatcher(repo, zap.NewNop())
+
+	hook := newTestWebhook("https://a.example.com", "board.created")
+	hook.CreatedBy = uuid.New()
+	require.NoError(t, repo.CreateWebhook(hook))
+	evt := event.New(event.BoardCreated, hook.ProjectID, uuid.New(), nil)
+
+	// When: The relay publishes the event again after another sink failed
+	require.NoError(t, dispatcher.Publish(context.Background(), evt))
+	require.NoError(t, dispatcher.Publish(context.Background(), evt))
+
+	// Then: Only one delivery is queued
+	deliveries, total, err := repo.FindDeliveriesByWebhook(hook.ID, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+
+	// A manual redelivery of the same event is still allowed
+	require.NoError(t, repo.CreateDelivery(deliveries[0].Redeliver()))
+	_, total, err = repo.FindDeliveriesByWebhook(hook.ID, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+}
+
+// ==================== Worker Tests ====================
+
+func TestWorker_ProcessDue_SignedDeliverySucceeds(t *testing.T) {
+	var received *http.Request
+	var receivedBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	repo := new(testutil.MockWebhookRepository)
+	now := time.Now()
+	worker := newTestWorker(repo, now)
+
+	hook := newTestWebhook(server.URL, "board.created")
+	delivery := hook.NewDelivery("evt-1", "board.created", `{"type":"board.created"}`)
+	delivery.ID = uuid.New()
+
+	repo.On("ClaimDueDeliveries", now, mock.Anything, 20).Return([]domain.WebhookDelivery{*delivery}, nil)
+	repo.On("FindWebhookByID", hook.ID).Return(hook, nil)
+	repo.On("CreateAttempt", mock.MatchedBy(func(a *domain.WebhookDeliveryAttempt) bool {
+		return a.Succeeded && a.AttemptNumber == 1 && *a.StatusCode == http.StatusNoContent
+	})).Return(nil)
+	repo.On("UpdateDelivery", mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+		return d.Status == domain.WebhookDeliverySucceeded && d.AttemptCount == 1 && d.NextAttemptAt == nil
+	})).Return(nil)
+
+	processed, err := worker.ProcessDue(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, "board.created", received.Header.Get(HeaderEvent))
+	assert.Equal(t, delivery.ID.String(), received.Header.Get(HeaderDelivery))
+	timestamp, _ := strconv.ParseInt(received.Header.Get(HeaderTimestamp), 10, 64)
+	assert.True(t, Verify(hook.Secret, timestamp, receivedBody, received.Header.Get(HeaderSignature)))
+	repo.AssertExpectations(t)
+}
+
+func TestWorker_ProcessDue_FailureSchedulesRetry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte("boom"))
+	}))
+	defer server.Close()
+
+	repo := new(testutil.MockWebhookRepository)
+	now := time.Now()
+	worker := newTestWorker(repo, now)
+
+	hook := newTestWebhook(server.URL, "board.created")
+	delivery := hook.NewDelivery("evt-1", "board.created", `{}`)
+	delivery.ID = uuid.New()
+	delivery.AttemptCount = 2
+
+	repo.On("ClaimDueDeliveries", now, mock.Anything, 20).Return([]domain.WebhookDelivery{*delivery}, nil)
+	repo.On("FindWebhookByID", hook.ID).Return(hook, nil)
+	repo.On("CreateAttempt", mock.MatchedBy(func(a *domain.WebhookDeliveryAttempt) bool {
+		return !a.Succeeded && a.AttemptNumber == 3 && a.ResponseBody == "boom"
+	})).Return(nil)
+	repo.On("UpdateDelivery", mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+		return d.Status == domain.WebhookDeliveryPending &&
+			d.AttemptCount == 3 &&
+			d.NextAttemptAt != nil && d.NextAttemptAt.Equal(now.Add(2*time.Minute))
+	})).Return(nil)
+
+	_, err := worker.ProcessDue(context.Background())
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestWorker_ProcessDue_LastAttemptMarksFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	repo := new(testutil.MockWebhookRepository)
+	now := time.Now()
+	worker := newTestWorker(repo, now)
+
+	hook := newTestWebhook(server.URL, "board.created")
+	delivery := hook.NewDelivery("evt-1", "board.created", `{}`)
+	delivery.ID = uuid.New()
+	delivery.AttemptCount = DefaultWorkerConfig().MaxAttempts - 1
+
+	repo.On("ClaimDueDeliveries", now, mock.Anything, 20).Return([]domain.WebhookDelivery{*delivery}, nil)
+	repo.On("FindWebhookByID", hook.ID).Return(hook, nil)
+	repo.On("CreateAttempt", mock.Anything).Return(nil)
+	repo.On("UpdateDelivery", mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+		return d.Status == domain.WebhookDeliveryFailed && d.NextAttemptAt == nil && *d.LastStatusCode == http.StatusBadGateway
+	})).Return(nil)
+
+	_, err := worker.ProcessDue(context.Background())
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestWorker_ProcessDue_InternalAddressIsRefused(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	repo := new(testutil.MockWebhookRepository)
+	now := time.Now()
+	worker := NewWorker(repo, DefaultWorkerConfig(), zap.NewNop())
+	worker.now = func() time.Time { return now }
+
+	// The URL passed validation, e.g. a public host name that now resolves to loopback
+	hook := newTestWebhook(server.URL, "board.created")
+	delivery := hook.NewDelivery("evt-1", "board.created", `{}`)
+	delivery.ID = uuid.New()
+
+	repo.On("ClaimDueDeliveries", now, mock.Anything, 20).Return([]domain.WebhookDelivery{*delivery}, nil)
+	repo.On("FindWebhookByID", hook.ID).Return(hook, nil)
+	repo.On("CreateAttempt", mock.MatchedBy(func(a *domain.WebhookDeliveryAttempt) bool {
+		return !a.Succeeded && a.StatusCode == nil && strings.Contains(a.Error, "internal address")
+	})).Return(nil)
+	repo.On("UpdateDelivery", mock.Anything).Return(nil)
+
+	_, err := worker.ProcessDue(context.Background())
+
+	assert.NoError(t, err)
+	assert.False(t, called)
+	repo.AssertExpectations(t)
+}
+
+func TestWorker_ProcessDue_InactiveWebhookIsNotSent(t *testing.T) {
+	repo := new(testutil.MockWebhookRepository)
+	now := time.Now()
+	worker := newTestWorker(repo, now)
+
+	hook := newTestWebhook("http://127.0.0.1:1", "board.created")
+	hook.IsActive = false
+	delivery := hook.NewDelivery("evt-1", "board.created", `{}`)
+	delivery.ID = uuid.New()
+
+	repo.On("ClaimDueDeliveries", now, mock.Anything, 20).Return([]domain.WebhookDelivery{*delivery}, nil)
+	repo.On("FindWebhookByID", hook.ID).Return(hook, nil)
+	repo.On("UpdateDelivery", mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+		return d.Status == domain.WebhookDeliveryFailed
+	})).Return(nil)
+
+	_, err := worker.ProcessDue(context.Background())
+
+	assert.NoError(t, err)
+	repo.AssertNotCalled(t, "CreateAttempt", mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+func TestWorker_ProcessDue_ReleasesDeliveriesBeyondLease(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	repo := new(testutil.MockWebhookRepository)
+	now := time.Now()
+	worker := newTestWorker(repo, now)
+	worker.config.BatchSize = 2
+	worker.config.RequestTimeout = 50 * time.Millisecond
+
+	hook := newTestWebhook(server.URL, "board.created")
+	first := hook.NewDelivery("evt-1", "board.created", `{}`)
+	first.ID = uuid.New()
+	second := hook.NewDelivery("evt-2", "board.created", `{}`)
+	second.ID = uuid.New()
+
+	// Given: The lease covers the whole batch, but the first delivery uses most of it
+	repo.On("ClaimDueDeliveries", now, 150*time.Millisecond, 2).Return([]domain.WebhookDelivery{*first, *second}, nil)
+	repo.On("FindWebhookByID", hook.ID).Return(hook, nil).After(120 * time.Millisecond)
+	repo.On("CreateAttempt", mock.Anything).Return(nil)
+	repo.On("UpdateDelivery", mock.Anything).Return(nil)
+	repo.On("ReleaseDeliveries", []uuid.UUID{second.ID}, now).Return(nil)
+
+	// When
+	processed, err := worker.ProcessDue(context.Background())
+
+	// Then: The second delivery is handed back instead of being sent after its lease may have expired
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	repo.AssertNumberOfCalls(t, "FindWebhookByID", 1)
+	repo.AssertExpectations(t)
+}
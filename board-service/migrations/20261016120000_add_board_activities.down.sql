@@ -0,0 +1,10 @@
+-- ============================================
+-- Rollback: Remove board_activities table
+-- Created: 2026-10-16
+-- ============================================
+
+-- Drop table (indexes are dropped with it)
+DROP TABLE IF EXISTS board_activities;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261016120000';
@@ -0,0 +1,40 @@
+-- ============================================
+-- Add board_activities table
+-- Created: 2026-10-16
+-- Description: Audit trail of board changes (who changed what, with old/new values)
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS board_activities (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    board_id UUID NOT NULL,
+    project_id UUID NOT NULL,
+    actor_id UUID NOT NULL,
+    action VARCHAR(50) NOT NULL,
+    field VARCHAR(100),
+    field_id UUID,
+    field_name VARCHAR(255),
+    comment_id UUID,
+    old_value TEXT,
+    new_value TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+-- Board history is always read newest first
+CREATE INDEX IF NOT EXISTS idx_board_activities_board_created ON board_activities(board_id, created_at DESC) WHERE is_deleted = false;
+CREATE INDEX IF NOT EXISTS idx_board_activities_project_id ON board_activities(project_id);
+CREATE INDEX IF NOT EXISTS idx_board_activities_actor_id ON board_activities(actor_id);
+CREATE INDEX IF NOT EXISTS idx_board_activities_action ON board_activities(action);
+CREATE INDEX IF NOT EXISTS idx_board_activities_field_id ON board_activities(field_id) WHERE field_id IS NOT NULL;
+
+COMMENT ON TABLE board_activities IS 'Append-only change history of boards (audit trail)';
+COMMENT ON COLUMN board_activities.action IS 'BOARD_CREATED, BOARD_UPDATED, FIELD_VALUE_CHANGED, BOARD_MOVED, COMMENT_ADDED, COMMENT_UPDATED, COMMENT_DELETED';
+COMMENT ON COLUMN board_activities.field IS 'title, description, assignee, participants, due_date, custom_field, comment';
+COMMENT ON COLUMN board_activities.old_value IS 'JSON encoded previous value (NULL if unset)';
+COMMENT ON COLUMN board_activities.new_value IS 'JSON encoded new value (NULL if cleared)';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016120000', 'Add board_activities table')
+ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,12 @@
+-- ============================================
+-- Rollback: Remove project webhook tables
+-- Created: 2026-10-16
+-- ============================================
+
+-- Drop tables (indexes are dropped with them)
+DROP TABLE IF EXISTS webhook_delivery_attempts;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS project_webhooks;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261016120100';
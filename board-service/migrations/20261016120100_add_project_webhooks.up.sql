@@ -0,0 +1,74 @@
+-- ============================================
+-- Add project webhook tables
+-- Created: 2026-10-16
+-- Description: Outgoing webhooks per project, their deliveries and every delivery attempt
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS project_webhooks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(128) NOT NULL,
+    event_types TEXT NOT NULL DEFAULT '[]',
+    description TEXT,
+    is_active BOOLEAN DEFAULT true,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_webhooks_project_id ON project_webhooks(project_id) WHERE is_deleted = false;
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    webhook_id UUID NOT NULL,
+    project_id UUID NOT NULL,
+    event_id VARCHAR(64) NOT NULL,
+    event_type VARCHAR(64) NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    attempt_count INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP,
+    last_attempt_at TIMESTAMP,
+    last_status_code INTEGER,
+    last_error TEXT,
+    redelivered_from UUID,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+-- Delivery log is read newest first per webhook
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook_created ON webhook_deliveries(webhook_id, created_at DESC) WHERE is_deleted = false;
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_project_id ON webhook_deliveries(project_id);
+-- The worker only polls pending deliveries that are due
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'PENDING' AND is_deleted = false;
+
+CREATE TABLE IF NOT EXISTS webhook_delivery_attempts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    delivery_id UUID NOT NULL,
+    attempt_number INTEGER NOT NULL,
+    status_code INTEGER,
+    response_body TEXT,
+    error TEXT,
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    succeeded BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_attempts_delivery_id ON webhook_delivery_attempts(delivery_id, attempt_number);
+
+COMMENT ON TABLE project_webhooks IS 'Outgoing webhook subscriptions of a project';
+COMMENT ON COLUMN project_webhooks.secret IS 'HMAC-SHA256 key used for the X-Wealist-Signature header';
+COMMENT ON COLUMN project_webhooks.event_types IS 'JSON array: board.created, board.moved, field.value_changed, comment.created, member.joined';
+COMMENT ON COLUMN webhook_deliveries.status IS 'PENDING, SUCCEEDED, FAILED';
+COMMENT ON COLUMN webhook_deliveries.redelivered_from IS 'Original delivery when created by a manual redelivery';
+COMMENT ON COLUMN webhook_delivery_attempts.response_body IS 'Receiver response, truncated to 2KB';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016120100', 'Add project webhook tables')
+ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,10 @@
+-- ============================================
+-- Rollback: Remove outbox_events table
+-- Created: 2026-10-16
+-- ============================================
+
+-- Drop table (indexes are dropped with it)
+DROP TABLE IF EXISTS outbox_events;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261016120200';
@@ -0,0 +1,34 @@
+-- ============================================
+-- Add outbox_events table
+-- Created: 2026-10-16
+-- Description: Transactional outbox - domain events written in the same transaction as the change
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id VARCHAR(64) NOT NULL,
+    event_type VARCHAR(64) NOT NULL,
+    project_id UUID NOT NULL,
+    payload TEXT NOT NULL,
+    published_at TIMESTAMP,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_outbox_events_event_id ON outbox_events(event_id);
+-- The relay only scans unpublished events, oldest first
+CREATE INDEX IF NOT EXISTS idx_outbox_events_unpublished ON outbox_events(created_at, id) WHERE published_at IS NULL;
+-- Cleanup of published events past the retention period
+CREATE INDEX IF NOT EXISTS idx_outbox_events_published_at ON outbox_events(published_at) WHERE published_at IS NOT NULL;
+
+COMMENT ON TABLE outbox_events IS 'Domain events pending publication by the outbox relay';
+COMMENT ON COLUMN outbox_events.payload IS 'JSON encoded event as published to the sinks';
+COMMENT ON COLUMN outbox_events.published_at IS 'NULL until the relay has published the event';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016120200', 'Add outbox_events table')
+ON CONFLICT (version) DO NOTHING;
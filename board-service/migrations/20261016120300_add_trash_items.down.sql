@@ -0,0 +1,10 @@
+-- ============================================
+-- Rollback: Remove trash_items table
+-- Created: 2026-10-16
+-- ============================================
+
+-- Drop table (indexes are dropped with it)
+DROP TABLE IF EXISTS trash_items;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261016120300';
@@ -0,0 +1,33 @@
+-- ============================================
+-- Add trash_items table
+-- Created: 2026-10-16
+-- Description: Trash bin - restorable soft-deleted boards, comments and projects
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS trash_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL,
+    item_type VARCHAR(20) NOT NULL,
+    item_id UUID NOT NULL,
+    title VARCHAR(255),
+    deleted_by UUID NOT NULL,
+    deleted_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+-- An entity is in the trash at most once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_trash_item ON trash_items(item_type, item_id);
+CREATE INDEX IF NOT EXISTS idx_trash_items_project_id ON trash_items(project_id);
+-- The retention job scans the oldest items first
+CREATE INDEX IF NOT EXISTS idx_trash_items_deleted_at ON trash_items(deleted_at);
+
+COMMENT ON TABLE trash_items IS 'Soft-deleted boards, comments and projects that can be restored or purged';
+COMMENT ON COLUMN trash_items.item_type IS 'board, comment or project';
+COMMENT ON COLUMN trash_items.deleted_at IS 'Rows deleted together with the item have updated_at >= deleted_at';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016120300', 'Add trash_items table')
+ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,14 @@
+-- ============================================
+-- Rollback: Remove comment threads and reactions
+-- Created: 2026-10-16
+-- ============================================
+
+-- Drop table (indexes are dropped with it)
+DROP TABLE IF EXISTS comment_reactions;
+
+DROP INDEX IF EXISTS idx_comments_parent_comment_id;
+ALTER TABLE comments DROP COLUMN IF EXISTS depth;
+ALTER TABLE comments DROP COLUMN IF EXISTS parent_comment_id;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261016120400';
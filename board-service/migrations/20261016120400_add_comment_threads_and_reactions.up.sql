@@ -0,0 +1,33 @@
+-- ============================================
+-- Add comment threads and reactions
+-- Created: 2026-10-16
+-- Description: Replies to comments (parent_comment_id, depth) and per-user emoji reactions
+-- ============================================
+
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS parent_comment_id UUID;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS depth INTEGER NOT NULL DEFAULT 0;
+
+-- Replies of a thread are paginated by parent
+CREATE INDEX IF NOT EXISTS idx_comments_parent_comment_id ON comments(parent_comment_id);
+
+CREATE TABLE IF NOT EXISTS comment_reactions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    comment_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    emoji VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+-- A user reacts with the same emoji at most once (toggle)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_comment_reaction ON comment_reactions(comment_id, user_id, emoji);
+
+COMMENT ON COLUMN comments.parent_comment_id IS 'NULL for top-level comments';
+COMMENT ON COLUMN comments.depth IS '0 for top-level comments, parent depth + 1 for replies';
+COMMENT ON TABLE comment_reactions IS 'Emoji reactions on comments, one row per user and emoji';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016120400', 'Add comment threads and reactions')
+ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,11 @@
+-- ============================================
+-- Rollback: Remove notifications
+-- Created: 2026-10-16
+-- ============================================
+
+-- Drop tables (indexes are dropped with them)
+DROP TABLE IF EXISTS board_watchers;
+DROP TABLE IF EXISTS notifications;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261016120500';
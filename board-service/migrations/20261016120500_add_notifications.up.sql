@@ -0,0 +1,50 @@
+-- ============================================
+-- Add notifications
+-- Created: 2026-10-16
+-- Description: Per-user notification inbox (mentions, assignments, comments) and board watchers
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    project_id UUID NOT NULL,
+    board_id UUID NOT NULL,
+    comment_id UUID,
+    actor_id UUID NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    board_title VARCHAR(255),
+    preview TEXT,
+    read_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+-- The inbox and the unread count filter by recipient and read state
+CREATE INDEX IF NOT EXISTS idx_notifications_user_read ON notifications(user_id, read_at);
+-- Purging a board or project deletes its notifications
+CREATE INDEX IF NOT EXISTS idx_notifications_project_id ON notifications(project_id);
+CREATE INDEX IF NOT EXISTS idx_notifications_board_id ON notifications(board_id);
+
+CREATE TABLE IF NOT EXISTS board_watchers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    board_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+-- A user watches a board at most once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_board_watcher ON board_watchers(board_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_board_watchers_user_id ON board_watchers(user_id);
+
+COMMENT ON TABLE notifications IS 'Notification inbox, one row per recipient';
+COMMENT ON COLUMN notifications.board_title IS 'Board title when the notification was created';
+COMMENT ON COLUMN notifications.preview IS 'Excerpt of the comment or description that triggered the notification';
+COMMENT ON TABLE board_watchers IS 'Users notified of new comments on a board';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016120500', 'Add notifications')
+ON CONFLICT (version) DO NOTHING;
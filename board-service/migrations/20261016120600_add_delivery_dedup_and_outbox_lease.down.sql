@@ -0,0 +1,11 @@
+-- ============================================
+-- Rollback: Remove webhook delivery dedup and outbox claim lease
+-- Created: 2026-10-16
+-- ============================================
+
+DROP INDEX IF EXISTS idx_webhook_deliveries_webhook_event;
+
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS locked_until;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261016120600';
@@ -0,0 +1,27 @@
+-- ============================================
+-- Add webhook delivery dedup and outbox claim lease
+-- Created: 2026-10-16
+-- Description: The relay publishes at least once; deliveries are enqueued once per (webhook, event)
+--              and outbox events are claimed with a lease instead of a lock held while publishing
+-- ============================================
+
+-- Remove duplicate original deliveries left by earlier relay retries, keeping the oldest
+DELETE FROM webhook_deliveries d
+USING webhook_deliveries keep
+WHERE d.redelivered_from IS NULL
+  AND keep.redelivered_from IS NULL
+  AND d.webhook_id = keep.webhook_id
+  AND d.event_id = keep.event_id
+  AND (d.created_at, d.id) > (keep.created_at, keep.id);
+
+-- One original delivery per webhook and event; manual redeliveries are exempt
+CREATE UNIQUE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook_event ON webhook_deliveries(webhook_id, event_id) WHERE redelivered_from IS NULL;
+
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS locked_until TIMESTAMP;
+
+COMMENT ON COLUMN outbox_events.locked_until IS 'Lease of the relay currently publishing the event; NULL or past when unclaimed';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016120600', 'Add webhook delivery dedup and outbox claim lease')
+ON CONFLICT (version) DO NOTHING;
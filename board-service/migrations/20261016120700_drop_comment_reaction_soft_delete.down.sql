@@ -0,0 +1,10 @@
+-- ============================================
+-- Rollback: Restore soft delete columns of comment_reactions
+-- Created: 2026-10-16
+-- ============================================
+
+ALTER TABLE comment_reactions ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE comment_reactions ADD COLUMN IF NOT EXISTS is_deleted BOOLEAN DEFAULT false;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261016120700';
@@ -0,0 +1,13 @@
+-- ============================================
+-- Drop soft delete columns of comment_reactions
+-- Created: 2026-10-16
+-- Description: Reactions are deleted outright when toggled off; is_deleted and updated_at were never used
+-- ============================================
+
+ALTER TABLE comment_reactions DROP COLUMN IF EXISTS is_deleted;
+ALTER TABLE comment_reactions DROP COLUMN IF EXISTS updated_at;
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016120700', 'Drop soft delete columns of comment_reactions')
+ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,10 @@
+-- ============================================
+-- Rollback: Backfill board watchers
+-- Created: 2026-10-16
+-- Note: Backfilled rows cannot be told apart from explicit watches, so they are kept
+-- ============================================
+
+COMMENT ON TABLE board_watchers IS 'Users notified of new comments on a board';
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261016120800';
@@ -0,0 +1,28 @@
+-- ============================================
+-- Backfill board watchers
+-- Created: 2026-10-16
+-- Description: Board authors, assignees and commenters now watch boards automatically;
+--              subscribe them to existing boards so they receive board-level notifications
+-- ============================================
+
+INSERT INTO board_watchers (board_id, user_id)
+SELECT id, created_by FROM boards WHERE is_deleted = false
+ON CONFLICT (board_id, user_id) DO NOTHING;
+
+INSERT INTO board_watchers (board_id, user_id)
+SELECT id, assignee_id FROM boards WHERE is_deleted = false AND assignee_id IS NOT NULL
+ON CONFLICT (board_id, user_id) DO NOTHING;
+
+INSERT INTO board_watchers (board_id, user_id)
+SELECT DISTINCT comments.board_id, comments.user_id
+FROM comments
+JOIN boards ON boards.id = comments.board_id AND boards.is_deleted = false
+WHERE comments.is_deleted = false
+ON CONFLICT (board_id, user_id) DO NOTHING;
+
+COMMENT ON TABLE board_watchers IS 'Recipients of board-level notifications (comments, field and due date changes)';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016120800', 'Backfill board watchers')
+ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,18 @@
+-- ============================================
+-- Rollback: Add board keys
+-- Created: 2026-10-16
+-- ============================================
+
+DROP TABLE IF EXISTS board_key_aliases;
+
+DROP INDEX IF EXISTS idx_boards_key;
+DROP INDEX IF EXISTS idx_boards_project_number;
+ALTER TABLE boards DROP COLUMN IF EXISTS key;
+ALTER TABLE boards DROP COLUMN IF EXISTS number;
+
+DROP INDEX IF EXISTS idx_projects_key;
+ALTER TABLE projects DROP COLUMN IF EXISTS board_sequence;
+ALTER TABLE projects DROP COLUMN IF EXISTS key;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261016120900';
@@ -0,0 +1,75 @@
+-- ============================================
+-- Add board keys
+-- Created: 2026-10-16
+-- Description: Projects get an immutable key (e.g. "WEB") and boards a gap-free
+--              per-project number, exposed as "WEB-123". Previous keys of boards
+--              moved to another project are kept in board_key_aliases
+-- ============================================
+
+-- Project keys: letters of the name (up to 4), "PRJ" as fallback.
+-- Bases contain letters only, so numeric suffixes never collide with another base
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS key VARCHAR(10);
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS board_sequence INTEGER NOT NULL DEFAULT 0;
+
+WITH candidates AS (
+    SELECT id, created_at,
+           CASE WHEN base ~ '^[A-Z]{2,4}$' THEN base ELSE 'PRJ' END AS base
+    FROM (
+        SELECT id, created_at, upper(left(regexp_replace(name, '[^A-Za-z]', '', 'g'), 4)) AS base
+        FROM projects
+    ) p
+), numbered AS (
+    SELECT id, base, ROW_NUMBER() OVER (PARTITION BY base ORDER BY created_at, id) AS n
+    FROM candidates
+)
+UPDATE projects
+SET key = CASE WHEN numbered.n = 1 THEN numbered.base ELSE numbered.base || numbered.n END
+FROM numbered
+WHERE projects.id = numbered.id AND projects.key IS NULL;
+
+ALTER TABLE projects ALTER COLUMN key SET NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_projects_key ON projects(key);
+
+-- Board numbers in creation order, deleted boards included so trash restores keep their keys
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS number INTEGER;
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS key VARCHAR(20);
+
+WITH numbered AS (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY project_id ORDER BY created_at, id) AS n
+    FROM boards
+)
+UPDATE boards
+SET number = numbered.n,
+    key = projects.key || '-' || numbered.n
+FROM numbered, projects
+WHERE boards.id = numbered.id AND projects.id = boards.project_id AND boards.number IS NULL;
+
+ALTER TABLE boards ALTER COLUMN number SET NOT NULL;
+ALTER TABLE boards ALTER COLUMN key SET NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_boards_project_number ON boards(project_id, number);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_boards_key ON boards(key);
+
+UPDATE projects
+SET board_sequence = COALESCE((SELECT MAX(number) FROM boards WHERE boards.project_id = projects.id), 0);
+
+CREATE TABLE IF NOT EXISTS board_key_aliases (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    key VARCHAR(20) NOT NULL,
+    board_id UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_board_key_aliases_key ON board_key_aliases(key);
+CREATE INDEX IF NOT EXISTS idx_board_key_aliases_board_id ON board_key_aliases(board_id);
+
+COMMENT ON COLUMN projects.key IS 'Immutable board key prefix (e.g. WEB)';
+COMMENT ON COLUMN projects.board_sequence IS 'Last board number allocated in the project';
+COMMENT ON COLUMN boards.key IS 'Human-readable board key (project key + number, e.g. WEB-123)';
+COMMENT ON TABLE board_key_aliases IS 'Previous keys of boards moved to another project';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016120900', 'Add board keys')
+ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,9 @@
+-- ============================================
+-- Rollback: Add board relations
+-- Created: 2026-10-16
+-- ============================================
+
+DROP TABLE IF EXISTS board_relations;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261016121000';
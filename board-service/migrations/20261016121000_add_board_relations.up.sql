@@ -0,0 +1,32 @@
+-- ============================================
+-- Add board relations
+-- Created: 2026-10-16
+-- Description: Typed links between boards of the same workspace (BLOCKS, RELATES, DUPLICATES).
+--              "Blocked by" is an incoming BLOCKS relation; blocking cycles are rejected by the service
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS board_relations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    source_board_id UUID NOT NULL,
+    target_board_id UUID NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false,
+    CONSTRAINT chk_board_relations_type CHECK (type IN ('BLOCKS', 'RELATES', 'DUPLICATES')),
+    CONSTRAINT chk_board_relations_not_self CHECK (source_board_id <> target_board_id)
+);
+
+-- A pair of boards is linked at most once per type and direction
+CREATE UNIQUE INDEX IF NOT EXISTS idx_board_relation ON board_relations(source_board_id, target_board_id, type);
+-- Incoming relations and the "blocked" view filter
+CREATE INDEX IF NOT EXISTS idx_board_relations_target_board_id ON board_relations(target_board_id);
+
+COMMENT ON TABLE board_relations IS 'Directed links between boards (BLOCKS, RELATES, DUPLICATES)';
+COMMENT ON COLUMN board_relations.source_board_id IS 'Board that blocks, duplicates or relates to the target';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016121000', 'Add board relations')
+ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,12 @@
+-- ============================================
+-- Rollback: Add board sub-tasks and checklists
+-- Created: 2026-10-16
+-- ============================================
+
+DROP TABLE IF EXISTS checklist_items;
+
+DROP INDEX IF EXISTS idx_boards_parent_board_id;
+ALTER TABLE boards DROP COLUMN IF EXISTS parent_board_id;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261016121100';
@@ -0,0 +1,39 @@
+-- ============================================
+-- Add board sub-tasks and checklists
+-- Created: 2026-10-16
+-- Description: Boards can have a parent board of the same project (sub-tasks),
+--              and an ordered checklist whose checked items drive the board progress
+-- ============================================
+
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS parent_board_id UUID;
+
+-- Children of a board and the "subtask" view filter
+CREATE INDEX IF NOT EXISTS idx_boards_parent_board_id ON boards(parent_board_id);
+
+COMMENT ON COLUMN boards.parent_board_id IS 'Parent board of a sub-task (same project, no cycles)';
+
+CREATE TABLE IF NOT EXISTS checklist_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    board_id UUID NOT NULL,
+    content VARCHAR(500) NOT NULL,
+    position VARCHAR(255) NOT NULL,
+    is_checked BOOLEAN NOT NULL DEFAULT false,
+    checked_by UUID,
+    checked_at TIMESTAMP,
+    assignee_id UUID,
+    due_date TIMESTAMP,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+CREATE INDEX IF NOT EXISTS idx_checklist_items_board_id ON checklist_items(board_id);
+CREATE INDEX IF NOT EXISTS idx_checklist_items_assignee_id ON checklist_items(assignee_id);
+
+COMMENT ON TABLE checklist_items IS 'Ordered checklist steps of a board (fractional index positions)';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016121100', 'Add board sub-tasks and checklists')
+ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,35 @@
+-- ============================================
+-- Add attachments
+-- Created: 2026-10-16
+-- Description: Files attached to boards and comments. Only the metadata lives here;
+--              the content is stored once per SHA-256 checksum in the storage backend
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS attachments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    board_id UUID NOT NULL,
+    comment_id UUID,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    checksum VARCHAR(64) NOT NULL,
+    storage_key VARCHAR(255) NOT NULL,
+    uploaded_by UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+CREATE INDEX IF NOT EXISTS idx_attachments_board_id ON attachments(board_id);
+CREATE INDEX IF NOT EXISTS idx_attachments_comment_id ON attachments(comment_id);
+
+-- Reference count of a stored object before it is removed
+CREATE INDEX IF NOT EXISTS idx_attachments_storage_key ON attachments(storage_key);
+
+COMMENT ON TABLE attachments IS 'Files of boards and comments (content in the attachment storage)';
+COMMENT ON COLUMN attachments.storage_key IS 'Content-addressed object key (attachments/<checksum prefix>/<checksum>), shared by identical files';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016121200', 'Add attachments')
+ON CONFLICT (version) DO NOTHING;
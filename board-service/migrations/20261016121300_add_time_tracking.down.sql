@@ -0,0 +1,12 @@
+-- ============================================
+-- Rollback: Add time tracking
+-- Created: 2026-10-16
+-- ============================================
+
+DROP TABLE IF EXISTS work_logs;
+
+ALTER TABLE boards DROP COLUMN IF EXISTS remaining_estimate_minutes;
+ALTER TABLE boards DROP COLUMN IF EXISTS original_estimate_minutes;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261016121300';
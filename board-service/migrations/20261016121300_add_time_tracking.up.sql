@@ -0,0 +1,38 @@
+-- ============================================
+-- Add time tracking
+-- Created: 2026-10-16
+-- Description: Original and remaining estimates of boards in minutes,
+--              and the time users logged on boards per day
+-- ============================================
+
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS original_estimate_minutes INTEGER;
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS remaining_estimate_minutes INTEGER;
+
+COMMENT ON COLUMN boards.original_estimate_minutes IS 'Original estimate in minutes (NULL when not estimated)';
+COMMENT ON COLUMN boards.remaining_estimate_minutes IS 'Remaining estimate in minutes, not adjusted by work logs';
+
+CREATE TABLE IF NOT EXISTS work_logs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    board_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    duration_minutes INTEGER NOT NULL,
+    work_date DATE NOT NULL,
+    note VARCHAR(1000),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false,
+    CONSTRAINT chk_work_logs_duration CHECK (duration_minutes BETWEEN 1 AND 1440)
+);
+
+CREATE INDEX IF NOT EXISTS idx_work_logs_board_id ON work_logs(board_id);
+CREATE INDEX IF NOT EXISTS idx_work_logs_user_id ON work_logs(user_id);
+
+-- Date range of the project work logs and time report
+CREATE INDEX IF NOT EXISTS idx_work_logs_work_date ON work_logs(work_date);
+
+COMMENT ON TABLE work_logs IS 'Time a user spent on a board on one day (removed with the board only when purged)';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016121300', 'Add time tracking')
+ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,14 @@
+-- ============================================
+-- Rollback: Add sprints
+-- Created: 2026-10-16
+-- ============================================
+
+DROP TABLE IF EXISTS sprint_boards;
+
+DROP INDEX IF EXISTS idx_boards_sprint_id;
+ALTER TABLE boards DROP COLUMN IF EXISTS sprint_id;
+
+DROP TABLE IF EXISTS sprints;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261016121400';
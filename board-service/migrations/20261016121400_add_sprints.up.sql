@@ -0,0 +1,66 @@
+-- ============================================
+-- Add sprints
+-- Created: 2026-10-16
+-- Description: Sprints (iterations) of a project, the sprint of each board,
+--              and the scope history of each sprint for sprint reports
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS sprints (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    goal TEXT,
+    start_date DATE,
+    end_date DATE,
+    state VARCHAR(20) NOT NULL DEFAULT 'planned',
+    started_at TIMESTAMP,
+    closed_at TIMESTAMP,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false,
+    CONSTRAINT chk_sprints_state CHECK (state IN ('planned', 'active', 'closed')),
+    CONSTRAINT chk_sprints_dates CHECK (start_date IS NULL OR end_date IS NULL OR start_date <= end_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_sprints_project_id ON sprints(project_id);
+CREATE INDEX IF NOT EXISTS idx_sprints_state ON sprints(state);
+
+-- A project has at most one active sprint
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sprints_project_active
+    ON sprints(project_id) WHERE state = 'active' AND is_deleted = false;
+
+COMMENT ON TABLE sprints IS 'Sprints of a project: planned -> active -> closed';
+
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS sprint_id UUID;
+
+CREATE INDEX IF NOT EXISTS idx_boards_sprint_id ON boards(sprint_id);
+
+COMMENT ON COLUMN boards.sprint_id IS 'Sprint the board is planned in (NULL for the backlog)';
+
+CREATE TABLE IF NOT EXISTS sprint_boards (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    sprint_id UUID NOT NULL,
+    board_id UUID NOT NULL,
+    added_at TIMESTAMP NOT NULL,
+    removed_at TIMESTAMP,
+    committed BOOLEAN NOT NULL DEFAULT false,
+    completed BOOLEAN NOT NULL DEFAULT false,
+    carried_over BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sprint_boards_sprint_board ON sprint_boards(sprint_id, board_id);
+CREATE INDEX IF NOT EXISTS idx_sprint_boards_board_id ON sprint_boards(board_id);
+
+COMMENT ON TABLE sprint_boards IS 'Boards that were ever planned in a sprint, for the committed/added/removed scope of the sprint report';
+COMMENT ON COLUMN sprint_boards.committed IS 'In the sprint when it started';
+COMMENT ON COLUMN sprint_boards.completed IS 'In a finished Stage option when the sprint closed';
+COMMENT ON COLUMN sprint_boards.carried_over IS 'Unfinished and moved to the next sprint when the sprint closed';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016121400', 'Add sprints')
+ON CONFLICT (version) DO NOTHING;
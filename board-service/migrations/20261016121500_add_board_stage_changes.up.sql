@@ -0,0 +1,42 @@
+-- ============================================
+-- Add board stage changes
+-- Created: 2026-10-16
+-- Description: Change log of the Stage field of boards, the history behind
+--              the cumulative flow, burndown and cycle time analytics
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS board_stage_changes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL,
+    board_id UUID NOT NULL,
+    field_id UUID NOT NULL,
+    from_option_id UUID,
+    to_option_id UUID,
+    changed_by UUID NOT NULL,
+    changed_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_stage_changes_project_id ON board_stage_changes(project_id);
+CREATE INDEX IF NOT EXISTS idx_board_stage_changes_board_id ON board_stage_changes(board_id);
+CREATE INDEX IF NOT EXISTS idx_board_stage_changes_changed_at ON board_stage_changes(changed_at);
+
+-- Seed the log with the current Stage of every board (the earlier history is unknown)
+INSERT INTO board_stage_changes (project_id, board_id, field_id, from_option_id, to_option_id, changed_by, changed_at)
+SELECT b.project_id, b.id, v.field_id, NULL, v.value_option_id, b.created_by, COALESCE(v.updated_at, b.created_at)
+FROM board_field_values v
+JOIN project_fields f ON f.id = v.field_id
+JOIN boards b ON b.id = v.board_id
+WHERE f.name = 'Stage'
+  AND f.is_system_default = true
+  AND f.field_type = 'single_select'
+  AND v.value_option_id IS NOT NULL
+  AND v.is_deleted = false
+  AND NOT EXISTS (SELECT 1 FROM board_stage_changes c WHERE c.board_id = v.board_id);
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016121500', 'Add board stage changes')
+ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,12 @@
+-- ============================================
+-- Rollback: Add milestones
+-- Created: 2026-10-16
+-- ============================================
+
+DROP INDEX IF EXISTS idx_boards_milestone_id;
+ALTER TABLE boards DROP COLUMN IF EXISTS milestone_id;
+
+DROP TABLE IF EXISTS milestones;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261016121600';
@@ -0,0 +1,37 @@
+-- ============================================
+-- Add milestones
+-- Created: 2026-10-16
+-- Description: Milestones (release versions) of a project
+--              and the milestone each board is targeted at
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS milestones (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    target_date DATE,
+    released BOOLEAN NOT NULL DEFAULT false,
+    released_at TIMESTAMP,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+CREATE INDEX IF NOT EXISTS idx_milestones_project_id ON milestones(project_id);
+CREATE INDEX IF NOT EXISTS idx_milestones_released ON milestones(released);
+
+COMMENT ON TABLE milestones IS 'Release targets of a project (e.g. versions)';
+COMMENT ON COLUMN milestones.target_date IS 'Planned release day; unreleased milestones past it with open boards are overdue';
+
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS milestone_id UUID;
+
+CREATE INDEX IF NOT EXISTS idx_boards_milestone_id ON boards(milestone_id);
+
+COMMENT ON COLUMN boards.milestone_id IS 'Milestone the board is targeted at (NULL for none)';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016121600', 'Add milestones')
+ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,31 @@
+-- ============================================
+-- Add project templates
+-- Created: 2026-10-16
+-- Description: Reusable project structures (fields, options, shared views
+--              and optional sample boards) of a workspace
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS project_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    workspace_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    content JSONB NOT NULL DEFAULT '{}',
+    source_project_id UUID,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_templates_workspace_id ON project_templates(workspace_id);
+CREATE INDEX IF NOT EXISTS idx_project_templates_is_deleted ON project_templates(is_deleted);
+
+COMMENT ON TABLE project_templates IS 'Project structures a new project of the workspace can be created from';
+COMMENT ON COLUMN project_templates.content IS 'Fields, options, shared views and sample boards; refs are replaced by new IDs when applied';
+COMMENT ON COLUMN project_templates.source_project_id IS 'Project the template was created from (kept after the project is deleted)';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016121700', 'Add project templates')
+ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,36 @@
+-- ============================================
+-- Add board templates
+-- Created: 2026-10-16
+-- Description: Reusable board skeletons of a project (title pattern,
+--              description, preset field values, checklist and
+--              default assignee rule)
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS board_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    title_pattern VARCHAR(200),
+    description TEXT,
+    field_values JSONB NOT NULL DEFAULT '{}',
+    checklist JSONB NOT NULL DEFAULT '[]',
+    assignee_rule VARCHAR(20) NOT NULL DEFAULT 'NONE',
+    assignee_id UUID,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_templates_project_id ON board_templates(project_id);
+CREATE INDEX IF NOT EXISTS idx_board_templates_is_deleted ON board_templates(is_deleted);
+
+COMMENT ON TABLE board_templates IS 'Skeletons new boards of the project can be created from';
+COMMENT ON COLUMN board_templates.title_pattern IS 'Title with {title} (requested title) and {date} (YYYY-MM-DD) placeholders';
+COMMENT ON COLUMN board_templates.field_values IS 'Field ID to value, in the format of the field-values API';
+COMMENT ON COLUMN board_templates.assignee_rule IS 'NONE, CREATOR or USER (assignee_id) when the request names no assignee';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261016121800', 'Add board templates')
+ON CONFLICT (version) DO NOTHING;
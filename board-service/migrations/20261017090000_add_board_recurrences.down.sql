@@ -0,0 +1,11 @@
+-- ============================================
+-- Rollback: Add board recurrences
+-- Created: 2026-10-17
+-- ============================================
+
+DROP TABLE IF EXISTS board_recurrences;
+
+ALTER TABLE projects DROP COLUMN IF EXISTS time_zone;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261017090000';
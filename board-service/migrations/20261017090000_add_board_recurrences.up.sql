@@ -0,0 +1,48 @@
+-- ============================================
+-- Add board recurrences
+-- Created: 2026-10-17
+-- Description: Recurrence rules that create boards from board
+--              templates on a schedule, and the project time zone
+--              the rules are read in
+-- ============================================
+
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+CREATE TABLE IF NOT EXISTS board_recurrences (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    template_id UUID NOT NULL,
+    project_id UUID NOT NULL,
+    frequency VARCHAR(10) NOT NULL,
+    repeat_interval INTEGER NOT NULL DEFAULT 1,
+    weekdays VARCHAR(20),
+    month_day INTEGER NOT NULL DEFAULT 0,
+    time_of_day VARCHAR(5) NOT NULL,
+    starts_on DATE NOT NULL,
+    ends_on DATE,
+    max_occurrences INTEGER,
+    occurrence_count INTEGER NOT NULL DEFAULT 0,
+    next_run_at TIMESTAMPTZ,
+    last_run_at TIMESTAMPTZ,
+    last_board_id UUID,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_recurrences_template_id ON board_recurrences(template_id);
+CREATE INDEX IF NOT EXISTS idx_board_recurrences_project_id ON board_recurrences(project_id);
+CREATE INDEX IF NOT EXISTS idx_board_recurrences_next_run_at ON board_recurrences(next_run_at)
+    WHERE is_deleted = false AND next_run_at IS NOT NULL;
+
+COMMENT ON COLUMN projects.time_zone IS 'IANA time zone recurrence rules are read in';
+COMMENT ON TABLE board_recurrences IS 'Schedules creating boards from a board template';
+COMMENT ON COLUMN board_recurrences.repeat_interval IS 'Every n days, weeks or months';
+COMMENT ON COLUMN board_recurrences.weekdays IS 'WEEKLY: comma separated RRULE weekday codes, e.g. MO,TH';
+COMMENT ON COLUMN board_recurrences.month_day IS 'MONTHLY: day of month, the last day in shorter months';
+COMMENT ON COLUMN board_recurrences.next_run_at IS 'Next occurrence; NULL once the rule has ended';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261017090000', 'Add board recurrences')
+ON CONFLICT (version) DO NOTHING;
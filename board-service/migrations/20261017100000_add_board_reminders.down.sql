@@ -0,0 +1,10 @@
+-- ============================================
+-- Rollback: Add board reminders
+-- Created: 2026-10-17
+-- ============================================
+
+DROP TABLE IF EXISTS reminder_digests;
+DROP TABLE IF EXISTS board_reminders;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261017100000';
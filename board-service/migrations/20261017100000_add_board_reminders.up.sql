@@ -0,0 +1,46 @@
+-- ============================================
+-- Add board reminders
+-- Created: 2026-10-17
+-- Description: Markers for due date reminders and daily reminder
+--              digests, so that every reminder is sent once across
+--              restarts and replicas
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS board_reminders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    board_id UUID NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    lead_minutes INTEGER NOT NULL DEFAULT 0,
+    due_date TIMESTAMPTZ NOT NULL,
+    recipients INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_board_reminder ON board_reminders(board_id, kind, lead_minutes, due_date);
+
+CREATE TABLE IF NOT EXISTS reminder_digests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    digest_date DATE NOT NULL,
+    board_count INTEGER NOT NULL DEFAULT 0,
+    delivered BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_deleted BOOLEAN DEFAULT false
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reminder_digest ON reminder_digests(user_id, digest_date);
+
+COMMENT ON TABLE board_reminders IS 'Due date reminders already sent, one row per board, kind, lead time and due date';
+COMMENT ON COLUMN board_reminders.kind IS 'DUE_SOON or OVERDUE';
+COMMENT ON COLUMN board_reminders.lead_minutes IS 'DUE_SOON: lead time before the due date; 0 for OVERDUE';
+COMMENT ON COLUMN board_reminders.due_date IS 'Due date the reminder was sent for; a moved due date is reminded again';
+COMMENT ON TABLE reminder_digests IS 'Daily reminder digests already sent, one row per user and day';
+COMMENT ON COLUMN reminder_digests.delivered IS 'False if the user had no email address';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261017100000', 'Add board reminders')
+ON CONFLICT (version) DO NOTHING;
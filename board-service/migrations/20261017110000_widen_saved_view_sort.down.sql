@@ -0,0 +1,14 @@
+-- ============================================
+-- Rollback: Widen saved view sort
+-- Created: 2026-10-17
+-- ============================================
+
+-- Sort keys do not fit a column name; keep the field of the first key
+UPDATE saved_views
+SET sort_by = sort_by::jsonb->0->>'field'
+WHERE sort_by LIKE '[%';
+
+ALTER TABLE saved_views ALTER COLUMN sort_by TYPE VARCHAR(255);
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261017110000';
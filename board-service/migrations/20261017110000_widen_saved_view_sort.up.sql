@@ -0,0 +1,15 @@
+-- ============================================
+-- Widen saved view sort
+-- Created: 2026-10-17
+-- Description: saved_views.sort_by holds a JSON list of sort keys
+--              (field, direction, nulls) instead of a column name.
+--              Plain column names saved before are still read as a
+--              single key in sort_direction
+-- ============================================
+
+ALTER TABLE saved_views ALTER COLUMN sort_by TYPE TEXT;
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261017110000', 'Widen saved view sort')
+ON CONFLICT (version) DO NOTHING;
@@ -0,0 +1,25 @@
+-- ============================================
+-- Rollback: Add full-text search
+-- Created: 2026-10-17
+-- ============================================
+
+DROP INDEX IF EXISTS idx_comments_content_trgm;
+DROP INDEX IF EXISTS idx_comments_search_vector;
+ALTER TABLE comments DROP COLUMN IF EXISTS search_vector;
+
+DROP TRIGGER IF EXISTS trg_board_field_values_search_index ON board_field_values;
+DROP TRIGGER IF EXISTS trg_boards_search_index ON boards;
+DROP FUNCTION IF EXISTS board_field_values_search_index();
+DROP FUNCTION IF EXISTS boards_search_index();
+DROP FUNCTION IF EXISTS board_search_vector(TEXT, TEXT, TEXT);
+DROP FUNCTION IF EXISTS board_search_fields(UUID);
+
+DROP INDEX IF EXISTS idx_boards_search_text_trgm;
+DROP INDEX IF EXISTS idx_boards_search_vector;
+ALTER TABLE boards DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE boards DROP COLUMN IF EXISTS search_text;
+
+DROP EXTENSION IF EXISTS pg_trgm;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261017120000';
@@ -0,0 +1,100 @@
+-- ============================================
+-- Add full-text search
+-- Created: 2026-10-17
+-- Description: Search index over board titles, descriptions, text custom
+--              field values and comment contents.
+--              tsvector columns ('simple' configuration, no stemming) serve
+--              word and prefix matches; pg_trgm indexes serve fuzzy and
+--              partial matches inside words (e.g. Korean words with particles).
+--              Triggers keep the index up to date in the transaction of
+--              the write, whichever code path changes the board or value
+-- ============================================
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- ==================== Boards ====================
+
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS search_text TEXT NOT NULL DEFAULT '';
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS search_vector TSVECTOR NOT NULL DEFAULT ''::tsvector;
+
+-- Values of the board's text custom fields that are not deleted
+CREATE OR REPLACE FUNCTION board_search_fields(board UUID) RETURNS TEXT AS $$
+    SELECT COALESCE(string_agg(v.value_text, ' ' ORDER BY pf.display_order, pf.id), '')
+    FROM board_field_values v
+    JOIN project_fields pf ON pf.id = v.field_id
+    WHERE v.board_id = board
+      AND v.is_deleted = false
+      AND v.value_text IS NOT NULL
+      AND pf.field_type = 'text'
+      AND pf.is_deleted = false
+$$ LANGUAGE sql STABLE;
+
+-- Title matches rank above description matches, and those above field values
+CREATE OR REPLACE FUNCTION board_search_vector(title TEXT, description TEXT, fields TEXT) RETURNS TSVECTOR AS $$
+    SELECT setweight(to_tsvector('simple', COALESCE(title, '')), 'A')
+        || setweight(to_tsvector('simple', COALESCE(description, '')), 'B')
+        || setweight(to_tsvector('simple', COALESCE(fields, '')), 'C')
+$$ LANGUAGE sql IMMUTABLE;
+
+CREATE OR REPLACE FUNCTION boards_search_index() RETURNS TRIGGER AS $$
+DECLARE
+    fields TEXT := board_search_fields(NEW.id);
+BEGIN
+    NEW.search_text := concat_ws(' ', NEW.title, NEW.description, fields);
+    NEW.search_vector := board_search_vector(NEW.title, NEW.description, fields);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_boards_search_index
+    BEFORE INSERT OR UPDATE OF title, description ON boards
+    FOR EACH ROW EXECUTE FUNCTION boards_search_index();
+
+-- A changed text value re-indexes its board
+CREATE OR REPLACE FUNCTION board_field_values_search_index() RETURNS TRIGGER AS $$
+DECLARE
+    changed board_field_values%ROWTYPE;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        changed := OLD;
+    ELSE
+        changed := NEW;
+    END IF;
+    IF changed.value_text IS NOT NULL OR (TG_OP = 'UPDATE' AND OLD.value_text IS NOT NULL) THEN
+        UPDATE boards
+        SET search_text = concat_ws(' ', title, description, board_search_fields(id)),
+            search_vector = board_search_vector(title, description, board_search_fields(id))
+        WHERE id = changed.board_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_board_field_values_search_index
+    AFTER INSERT OR UPDATE OR DELETE ON board_field_values
+    FOR EACH ROW EXECUTE FUNCTION board_field_values_search_index();
+
+-- Index the existing boards
+UPDATE boards
+SET search_text = concat_ws(' ', title, description, board_search_fields(id)),
+    search_vector = board_search_vector(title, description, board_search_fields(id));
+
+CREATE INDEX IF NOT EXISTS idx_boards_search_vector ON boards USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_boards_search_text_trgm ON boards USING GIN (search_text gin_trgm_ops);
+
+-- ==================== Comments ====================
+
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+    GENERATED ALWAYS AS (to_tsvector('simple', COALESCE(content, ''))) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_comments_search_vector ON comments USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_comments_content_trgm ON comments USING GIN (content gin_trgm_ops);
+
+COMMENT ON COLUMN boards.search_text IS 'Title, description and text custom field values for trigram matching (maintained by trigger)';
+COMMENT ON COLUMN boards.search_vector IS 'Weighted tsvector of search_text: title A, description B, fields C (maintained by trigger)';
+COMMENT ON COLUMN comments.search_vector IS 'tsvector of the comment content';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261017120000', 'Add full-text search')
+ON CONFLICT (version) DO NOTHING;
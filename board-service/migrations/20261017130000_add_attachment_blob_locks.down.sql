@@ -0,0 +1,9 @@
+-- ============================================
+-- Rollback: Add attachment blob locks
+-- Created: 2026-10-17
+-- ============================================
+
+DROP TABLE IF EXISTS attachment_blobs;
+
+-- Remove migration version
+DELETE FROM schema_versions WHERE version = '20261017130000';
@@ -0,0 +1,20 @@
+-- ============================================
+-- Add attachment blob locks
+-- Created: 2026-10-17
+-- Description: One lock row per stored attachment object. Uploads that reuse an object and
+--              the removal of an object after its last attachment is deleted lock the row,
+--              so an object is never removed while an attachment reusing it is being created
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS attachment_blobs (
+    storage_key VARCHAR(255) PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE attachment_blobs IS 'Lock rows of stored attachment content (created on first use, kept after the content is removed)';
+COMMENT ON COLUMN attachment_blobs.storage_key IS 'Content-addressed object key shared with attachments.storage_key';
+
+-- Insert migration version
+INSERT INTO schema_versions (version, description)
+VALUES ('20261017130000', 'Add attachment blob locks')
+ON CONFLICT (version) DO NOTHING;